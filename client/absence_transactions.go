@@ -4,16 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
+
+	"github.com/pkg/errors"
 )
 
 const absenceTransactionsURI = "absencetransactions"
 
-const (
-	employeeIDParamName = "employeeid"
-	dateParamName       = "date"
-)
-
 const (
 	ASK = "ASK"
 	FPE = "FPE"
@@ -127,31 +123,55 @@ var CauseCodes = map[string]string{
 // GetAllAbsenceTransactions does _GET https://api.fortnox.se/3/absencetransactions
 //
 // filter - may contain employeeID and date
-func (c *Client) GetAllAbsenceTransactions(
+func (c *absenceTransactionsService) GetAllAbsenceTransactions(
 	ctx context.Context,
 	filter *GetAbsenceTransactionsFilter) ([]AbsenceTransaction, error) {
+	return c.IterateAbsenceTransactions(filter).All(ctx)
+}
+
+// GetAbsenceTransactionsPage does _GET https://api.fortnox.se/3/absencetransactions and returns a single page along with its MetaInformation
+//
+// filter - may contain employeeID and date
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *absenceTransactionsService) GetAbsenceTransactionsPage(
+	ctx context.Context,
+	filter *GetAbsenceTransactionsFilter,
+	page *PageOptions) ([]AbsenceTransaction, *MetaInformation, error) {
 
 	resp := &GetAllAbsenceTransactionsResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, absenceTransactionsURI, params, resp)
+	err = c._GETPage(ctx, getAbsenceTransactionsPageOp, absenceTransactionsURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.AbsenceTransactions, nil
+	return resp.AbsenceTransactions, &resp.MetaInformation, nil
+}
+
+// IterateAbsenceTransactions lazily walks all pages of https://api.fortnox.se/3/absencetransactions
+//
+// filter - may contain employeeID and date
+func (c *absenceTransactionsService) IterateAbsenceTransactions(filter *GetAbsenceTransactionsFilter) *Iterator[AbsenceTransaction] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]AbsenceTransaction, *MetaInformation, error) {
+		return c.GetAbsenceTransactionsPage(ctx, filter, page)
+	})
 }
 
 // CreateNewAbsenceTransaction does _POST https://api.fortnox.se/3/absencetransactions
 //
 // at - absence transaction to create
-func (c *Client) CreateNewAbsenceTransaction(ctx context.Context, at *AbsenceTransaction) (*AbsenceTransaction, error) {
+func (c *absenceTransactionsService) CreateNewAbsenceTransaction(ctx context.Context, at *AbsenceTransaction) (*AbsenceTransaction, error) {
 
 	req := CreateNewAbsenceTransactionReq{AbsenceTransaction: *at}
 	resp := &CreateNewAbsenceTransactionResp{}
 
-	err := c._POST(ctx, absenceTransactionsURI, nil, req, resp)
+	err := c._POST(ctx, createNewAbsenceTransactionOp, absenceTransactionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -162,12 +182,12 @@ func (c *Client) CreateNewAbsenceTransaction(ctx context.Context, at *AbsenceTra
 // GetAbsenceTransactionByID does _GET https://api.fortnox.se/3/absencetransactions/{id}
 //
 // id - identifies the transaction
-func (c *Client) GetAbsenceTransactionByID(ctx context.Context, id string) (*AbsenceTransaction, error) {
+func (c *absenceTransactionsService) GetAbsenceTransactionByID(ctx context.Context, id string) (*AbsenceTransaction, error) {
 	resp := &GetAbsenceTransactionByIDResp{}
 
 	uri := fmt.Sprintf("%s/%s", absenceTransactionsURI, id)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getAbsenceTransactionByIDOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +200,7 @@ func (c *Client) GetAbsenceTransactionByID(ctx context.Context, id string) (*Abs
 // id - identifies the transaction
 //
 // at - absence transaction to update
-func (c *Client) UpdateAbsenceTransactionByID(
+func (c *absenceTransactionsService) UpdateAbsenceTransactionByID(
 	ctx context.Context,
 	id string,
 	at *AbsenceTransaction) (*AbsenceTransaction, error) {
@@ -190,7 +210,7 @@ func (c *Client) UpdateAbsenceTransactionByID(
 
 	uri := fmt.Sprintf("%s/%s", absenceTransactionsURI, id)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateAbsenceTransactionByIDOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -201,12 +221,12 @@ func (c *Client) UpdateAbsenceTransactionByID(
 // DeleteAbsenceTransactionByID does _DELETE https://api.fortnox.se/3/absencetransactions/{id}
 //
 // id - identifies the transaction
-func (c *Client) DeleteAbsenceTransactionByID(ctx context.Context, id string) (*AbsenceTransaction, error) {
+func (c *absenceTransactionsService) DeleteAbsenceTransactionByID(ctx context.Context, id string) (*AbsenceTransaction, error) {
 	resp := &DeleteAbsenceTransactionByIDResp{}
 
 	uri := fmt.Sprintf("%s/%s", absenceTransactionsURI, id)
 
-	err := c._DELETEWithResult(ctx, uri, resp)
+	err := c._DELETEWithResult(ctx, deleteAbsenceTransactionByIDOp, uri, resp)
 
 	if err != nil {
 		return nil, err
@@ -222,11 +242,16 @@ func (c *Client) DeleteAbsenceTransactionByID(ctx context.Context, id string) (*
 // date - date of the absence transaction
 //
 // code - status code of the absence transaction
-func (c *Client) GetAbsenceTransactionForEmployee(
+func (c *absenceTransactionsService) GetAbsenceTransactionForEmployee(
 	ctx context.Context,
-	employeeID, date string,
+	employeeID string,
+	date Date,
 	code AbsenceTransactionStatusCode) ([]AbsenceTransaction, error) {
 
+	if date.IsZero() || !date.Valid() {
+		return nil, errors.Wrapf(ErrInvalidDate, "can't get the absence transaction of %q", string(date))
+	}
+
 	if !isCodeInCodeSet(code.String()) {
 		return nil, fmt.Errorf("code should be in code set range: %s", AbsenceTransactionsCodes)
 	}
@@ -235,7 +260,7 @@ func (c *Client) GetAbsenceTransactionForEmployee(
 
 	uri := fmt.Sprintf("%s/%s/%s/%s", absenceTransactionsURI, employeeID, date, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getAbsenceTransactionForEmployeeOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -250,26 +275,12 @@ func (txCode AbsenceTransactionStatusCode) String() string {
 }
 
 type GetAbsenceTransactionsFilter struct {
-	EmployeeID string
-	Date       string
+	EmployeeID string `fortnox:"employeeid"`
+	Date       Date   `fortnox:"date"`
 }
 
-func (p *GetAbsenceTransactionsFilter) urlValues() url.Values {
-	if p == nil {
-		return nil
-	}
-
-	urlValues := url.Values{}
-
-	if strings.TrimSpace(p.EmployeeID) != "" {
-		urlValues[employeeIDParamName] = []string{p.EmployeeID}
-	}
-
-	if strings.TrimSpace(p.Date) != "" {
-		urlValues[dateParamName] = []string{p.Date}
-	}
-
-	return urlValues
+func (p *GetAbsenceTransactionsFilter) urlValues() (url.Values, error) {
+	return encodeQuery(p)
 }
 
 type AbsenceTransaction struct {
@@ -277,7 +288,7 @@ type AbsenceTransaction struct {
 	Id               string `json:"id,omitempty,omitempty"`
 	EmployeeId       string `json:"EmployeeId,omitempty"`
 	CauseCode        string `json:"CauseCode,omitempty"`
-	Date             string `json:"Date,omitempty"`
+	Date             Date   `json:"Date,omitempty"`
 	Extent           int    `json:"Extent,omitempty"`
 	Hours            int    `json:"Hours,omitempty"`
 	HolidayEntitling bool   `json:"HolidayEntitling,omitempty"`
@@ -287,6 +298,7 @@ type AbsenceTransaction struct {
 
 type GetAllAbsenceTransactionsResp struct {
 	AbsenceTransactions []AbsenceTransaction `json:"AbsenceTransactions"`
+	MetaInformation     MetaInformation      `json:"MetaInformation"`
 }
 
 type CreateNewAbsenceTransactionReq struct {
@@ -7,10 +7,10 @@ const (
 )
 
 // GetAccountCharts does _GET https://api.fortnox.se/3/accountcharts
-func (c *Client) GetAccountCharts(ctx context.Context) ([]AccountChart, error) {
+func (c *accountChartsService) GetAccountCharts(ctx context.Context) ([]AccountChart, error) {
 	resp := &AccountChartResp{}
 
-	err := c._GET(ctx, accountChartsURI, nil, resp)
+	err := c._GET(ctx, getAccountChartsOp, accountChartsURI, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -4,8 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strconv"
-	"strings"
+	"time"
 )
 
 // URI
@@ -13,24 +12,15 @@ const (
 	accountsURI = "accounts"
 )
 
-// url query param names
-const (
-	financialYearParamName = "financialyear"
-
-	lastModifiedParamName = "lastmodified"
-	sortByParamName       = "sortby"
-	sruParamName          = "sru"
-)
-
 // GetAccount does _GET https://api.fortnox.se/3/accounts/{Number}
 //
 // accountID - identifies the account
-func (c *Client) GetAccount(ctx context.Context, accountID int) (*Account, error) {
+func (c *accountsService) GetAccount(ctx context.Context, accountID int) (*Account, error) {
 	resp := &GetAccountResp{}
 
 	uri := fmt.Sprintf("%s/%d", accountsURI, accountID)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getAccountOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +33,7 @@ func (c *Client) GetAccount(ctx context.Context, accountID int) (*Account, error
 // accountID - identifies the account
 //
 // financialYear - financial year to update account against, param is optional
-func (c *Client) UpdateAccount(
+func (c *accountsService) UpdateAccount(
 	ctx context.Context,
 	accountID int,
 	a *Account,
@@ -54,12 +44,12 @@ func (c *Client) UpdateAccount(
 
 	uri := fmt.Sprintf("%s/%d", accountsURI, accountID)
 
-	var params url.Values
-	if filter != nil {
-		params = filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, err
 	}
 
-	err := c._PUT(ctx, uri, params, req, resp)
+	err = c._PUT(ctx, updateAccountOp, uri, params, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -70,26 +60,48 @@ func (c *Client) UpdateAccount(
 // GetAllAccounts does _GET https://api.fortnox.se/3/accounts/
 //
 // filter - GetAllAccountsFilter
-func (c *Client) GetAllAccounts(ctx context.Context, filter *GetAllAccountsFilter) ([]Account, error) {
+func (c *accountsService) GetAllAccounts(ctx context.Context, filter *GetAllAccountsFilter) ([]Account, error) {
+	return c.IterateAccounts(filter).All(ctx)
+}
+
+// GetAccountsPage does _GET https://api.fortnox.se/3/accounts/ and returns a single page along with its MetaInformation
+//
+// filter - GetAllAccountsFilter
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *accountsService) GetAccountsPage(
+	ctx context.Context,
+	filter *GetAllAccountsFilter,
+	page *PageOptions) ([]Account, *MetaInformation, error) {
+
 	resp := &GetAllAccountsResp{}
 
-	var params url.Values
-	if filter != nil {
-		params = filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	err := c._GET(ctx, accountsURI, params, resp)
+	err = c._GETPage(ctx, getAccountsPageOp, accountsURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Accounts, nil
+	return resp.Accounts, &resp.MetaInformation, nil
+}
+
+// IterateAccounts lazily walks all pages of https://api.fortnox.se/3/accounts/
+//
+// filter - GetAllAccountsFilter
+func (c *accountsService) IterateAccounts(filter *GetAllAccountsFilter) *Iterator[Account] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Account, *MetaInformation, error) {
+		return c.GetAccountsPage(ctx, filter, page)
+	})
 }
 
 // CreateAccount does _POST https://api.fortnox.se/3/accounts/
 //
 // filter - financial year to create account against
-func (c *Client) CreateAccount(
+func (c *accountsService) CreateAccount(
 	ctx context.Context,
 	a *Account,
 	filter *FinancialYearFilter) (*Account, error) {
@@ -97,12 +109,12 @@ func (c *Client) CreateAccount(
 	req := &CreateAccountReq{Account: *a}
 	resp := CreateAccountResp{}
 
-	var params url.Values
-	if filter != nil {
-		params = filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, err
 	}
 
-	err := c._POST(ctx, accountsURI, params, req, resp)
+	err = c._POST(ctx, createAccountOp, accountsURI, params, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -111,51 +123,27 @@ func (c *Client) CreateAccount(
 }
 
 type GetAllAccountsFilter struct {
-	LastModified string
-	SortBy       string
-	SRU          int
+	LastModified time.Time `fortnox:"lastmodified,datetime"`
+	SortBy       string    `fortnox:"sortby"`
+	SRU          int       `fortnox:"sru"`
 }
 
-func (f *GetAllAccountsFilter) urlValues() url.Values {
-	params := url.Values{}
-
-	if strings.TrimSpace(f.LastModified) != "" {
-		params[lastModifiedParamName] = []string{f.LastModified}
-	}
-
-	if strings.TrimSpace(f.SortBy) != "" {
-		params[sortByParamName] = []string{f.SortBy}
-	}
-
-	if f.SRU > 0 {
-		params[sruParamName] = []string{strconv.Itoa(f.SRU)}
-	}
-
-	return params
+func (f *GetAllAccountsFilter) urlValues() (url.Values, error) {
+	return encodeQuery(f)
 }
 
 type FinancialYearFilter struct {
-	FinancialYear int
+	FinancialYear int `fortnox:"financialyear"`
 }
 
-func (f *FinancialYearFilter) urlValues() url.Values {
-	if f == nil {
-		return nil
-	}
-
-	params := url.Values{}
-
-	if f.FinancialYear > 0 {
-		params[financialYearParamName] = []string{strconv.Itoa(f.FinancialYear)}
-	}
-
-	return params
+func (f *FinancialYearFilter) urlValues() (url.Values, error) {
+	return encodeQuery(f)
 }
 
 type Account struct {
 	Url                            string            `json:"@url,omitempty"`
 	Active                         bool              `json:"Active,omitempty"`
-	BalanceBroughtForward          int               `json:"BalanceBroughtForward,omitempty"`
+	BalanceBroughtForward          Money             `json:"BalanceBroughtForward,omitzero"`
 	CostCenter                     string            `json:"CostCenter,omitempty"`
 	CostCenterSettings             string            `json:"CostCenterSettings,omitempty"`
 	Description                    string            `json:"Description,omitempty"`
@@ -165,7 +153,7 @@ type Account struct {
 	SRU                            int               `json:"SRU,omitempty"`
 	Year                           int               `json:"Year,omitempty"`
 	VATCode                        string            `json:"VATCode,omitempty"`
-	BalanceCarriedForward          int               `json:"BalanceCarriedForward,omitempty"`
+	BalanceCarriedForward          Money             `json:"BalanceCarriedForward,omitzero"`
 	TransactionInformation         string            `json:"TransactionInformation,omitempty"`
 	TransactionInformationSettings string            `json:"TransactionInformationSettings,omitempty"`
 	QuantitySettings               string            `json:"QuantitySettings,omitempty"`
@@ -175,7 +163,7 @@ type Account struct {
 
 type OpeningQuantity struct {
 	Project string `json:"Project,omitempty"`
-	Balance int    `json:"Balance,omitempty"`
+	Balance Money  `json:"Balance,omitzero"`
 }
 
 type GetAccountResp struct {
@@ -187,7 +175,8 @@ type UpdateAccountReq GetAccountResp
 type UpdateAccountResp GetAccountResp
 
 type GetAllAccountsResp struct {
-	Accounts []Account `json:"Accounts"`
+	Accounts        []Account       `json:"Accounts"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateAccountReq struct {
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"strings"
 
@@ -25,12 +26,15 @@ const (
 // 1. path - name of folder
 //
 // 2. fileID - fileId from fileattachments
-func (c *Client) GetFileOrFolder(ctx context.Context, filter *PathFileIDFilter) (*Folder, error) {
+func (c *archiveService) GetFileOrFolder(ctx context.Context, filter *PathFileIDFilter) (*Folder, error) {
 	resp := &GetFileOrFolderResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, err
+	}
 
-	err := c._GET(ctx, archiveURI, params, resp)
+	err = c._GET(ctx, getFileOrFolderOp, archiveURI, params, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -40,19 +44,33 @@ func (c *Client) GetFileOrFolder(ctx context.Context, filter *PathFileIDFilter)
 
 // UploadFileToDir does _POST https://api.fortnox.se/3/archive/
 //
-// file - file to upload
+// fileName - name of the file in the archive
+//
+// r - file contents, streamed as multipart/form-data
 //
 // filter:
 //
 // 1. path - name of folder
 //
 // 2. folderID - if of folder
-func (c Client) UploadFileToDir(ctx context.Context, filter *PathFileIDFilter, file *File) (*File, error) {
+func (c *archiveService) UploadFileToDir(
+	ctx context.Context,
+	filter *PathFileIDFilter,
+	fileName string,
+	r io.Reader) (*File, error) {
+
 	resp := &UploadFileToDirResp{}
 
-	params := filter.urlValues()
+	if strings.TrimSpace(fileName) == "" {
+		return nil, errors.New("can't upload file without name")
+	}
+
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, err
+	}
 
-	err := c._POST(ctx, archiveURI, params, file, resp)
+	err = c._POST(ctx, uploadFileToDirOp, archiveURI, params, newFileUpload(fileName, r), resp)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +81,7 @@ func (c Client) UploadFileToDir(ctx context.Context, filter *PathFileIDFilter, f
 // RemoveFiles does _DELETE https://api.fortnox.se/3/archive/
 //
 // path - identifies file/folder to remove
-func (c Client) RemoveFiles(ctx context.Context, path string) error {
+func (c *archiveService) RemoveFiles(ctx context.Context, path string) error {
 	uri := archiveURI
 
 	if strings.TrimSpace(path) == "" {
@@ -72,7 +90,7 @@ func (c Client) RemoveFiles(ctx context.Context, path string) error {
 
 	uri = fmt.Sprintf("%s?%s=%s", archiveURI, pathParamName, path)
 
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeFilesOp, uri)
 }
 
 // GetFile does _GET https://api.fortnox.se/3/archive/{id}
@@ -80,60 +98,39 @@ func (c Client) RemoveFiles(ctx context.Context, path string) error {
 // id - identifies the file
 //
 // fileID - fileId from fileattachments
-func (c *Client) GetFile(ctx context.Context, id, fileID string) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *archiveService) GetFile(ctx context.Context, id, fileID string) (*Download, error) {
 	if strings.TrimSpace(id) == "" {
 		return nil, errors.New("can't get file without id")
 	}
 
 	uri := fmt.Sprintf("%s/%s", archiveURI, id)
 
-	var params url.Values
+	params := url.Values{}
 	if strings.TrimSpace(fileID) != "" {
 		params[fileIDParamName] = []string{fileID}
 	}
 
-	err := c._GET(ctx, uri, params, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c._GETDownload(ctx, getFileOp, uri, params)
 }
 
 // DeleteFile does _DELETE https://api.fortnox.se/3/archive/{id}
 //
 // id - identifies the file
-func (c *Client) DeleteFile(ctx context.Context, id string) error {
+func (c *archiveService) DeleteFile(ctx context.Context, id string) error {
 	if strings.TrimSpace(id) == "" {
 		return errors.New("can't delete without id")
 	}
 	uri := fmt.Sprintf("%s/%s", archiveURI, id)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteFileOp, uri)
 }
 
 type PathFileIDFilter struct {
-	Path   string
-	FileID string
+	Path   string `fortnox:"path"`
+	FileID string `fortnox:"fileid"`
 }
 
-func (f *PathFileIDFilter) urlValues() url.Values {
-	if f == nil {
-		return nil
-	}
-
-	params := url.Values{}
-
-	if strings.TrimSpace(f.Path) != "" {
-		params[pathParamName] = []string{f.Path}
-	}
-
-	if strings.TrimSpace(f.FileID) != "" {
-		params[fileIDParamName] = []string{fileIDParamName}
-	}
-
-	return params
+func (f *PathFileIDFilter) urlValues() (url.Values, error) {
+	return encodeQuery(f)
 }
 
 type Folder struct {
@@ -10,25 +10,42 @@ const (
 )
 
 // GetAllArticleFileConnections does _GET https://api.fortnox.se/3/articlefileconnections/
-func (c *Client) GetAllArticleFileConnections(ctx context.Context) ([]ArticleFileConnection, error) {
+func (c *articleFileConnectionsService) GetAllArticleFileConnections(ctx context.Context) ([]ArticleFileConnection, error) {
+	return c.IterateArticleFileConnections().All(ctx)
+}
+
+// GetArticleFileConnectionsPage does _GET https://api.fortnox.se/3/articlefileconnections/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *articleFileConnectionsService) GetArticleFileConnectionsPage(
+	ctx context.Context,
+	page *PageOptions) ([]ArticleFileConnection, *MetaInformation, error) {
+
 	resp := &GetAllArticleFileConnectionsResp{}
 
-	err := c._GET(ctx, articleFileConnectionsURI, nil, resp)
+	err := c._GETPage(ctx, getArticleFileConnectionsPageOp, articleFileConnectionsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.ArticleFileConnections, nil
+	return resp.ArticleFileConnections, &resp.MetaInformation, nil
+}
+
+// IterateArticleFileConnections lazily walks all pages of https://api.fortnox.se/3/articlefileconnections/
+func (c *articleFileConnectionsService) IterateArticleFileConnections() *Iterator[ArticleFileConnection] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]ArticleFileConnection, *MetaInformation, error) {
+		return c.GetArticleFileConnectionsPage(ctx, page)
+	})
 }
 
 // CreateArticleFileConnection does _POST https://api.fortnox.se/3/articlefileconnections/
 //
 // req - article file connection to create
-func (c *Client) CreateArticleFileConnection(ctx context.Context, afc *ArticleFileConnection) (*ArticleFileConnection, error) {
+func (c *articleFileConnectionsService) CreateArticleFileConnection(ctx context.Context, afc *ArticleFileConnection) (*ArticleFileConnection, error) {
 	req := CreateArticleFileConnectionReq{ArticleFileConnection: *afc}
 	resp := &CreateArticleFileConnectionResp{}
 
-	err := c._POST(ctx, articleFileConnectionsURI, nil, req, resp)
+	err := c._POST(ctx, createArticleFileConnectionOp, articleFileConnectionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +56,12 @@ func (c *Client) CreateArticleFileConnection(ctx context.Context, afc *ArticleFi
 // GetArticleFileConnectionByID does _GET https://api.fortnox.se/3/articlefileconnections/{FileId}
 //
 // fileID - identifies the article file connection
-func (c *Client) GetArticleFileConnectionByID(ctx context.Context, fileID string) (*ArticleFileConnection, error) {
+func (c *articleFileConnectionsService) GetArticleFileConnectionByID(ctx context.Context, fileID string) (*ArticleFileConnection, error) {
 	resp := &GetArticleFileConnectionByIDResp{}
 
 	uri := fmt.Sprintf("%s/%s", articleFileConnectionsURI, fileID)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getArticleFileConnectionByIDOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -55,9 +72,9 @@ func (c *Client) GetArticleFileConnectionByID(ctx context.Context, fileID string
 // DeleteArticleFileConnection does _DELETE https://api.fortnox.se/3/articlefileconnections/{FileId}
 //
 // fileID - identifies the article file connection
-func (c *Client) DeleteArticleFileConnection(ctx context.Context, fileID string) error {
+func (c *articleFileConnectionsService) DeleteArticleFileConnection(ctx context.Context, fileID string) error {
 	uri := fmt.Sprintf("%s/%s", articleFileConnectionsURI, fileID)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteArticleFileConnectionOp, uri)
 }
 
 type ArticleFileConnection struct {
@@ -68,6 +85,7 @@ type ArticleFileConnection struct {
 
 type GetAllArticleFileConnectionsResp struct {
 	ArticleFileConnections []ArticleFileConnection `json:"ArticleFileConnections"`
+	MetaInformation        MetaInformation         `json:"MetaInformation"`
 }
 
 type CreateArticleFileConnectionReq struct {
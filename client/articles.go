@@ -4,26 +4,21 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 )
 
 const (
 	articlesURI = "articles"
 )
 
-const (
-	filterParamName = "filter"
-)
-
 // GetArticle does _GET https://api.fortnox.se/3/articles/{ArticleNumber}
 //
 // articleNumber - identifies the article
-func (c *Client) GetArticle(ctx context.Context, articleNumber int) (*Article, error) {
+func (c *articlesService) GetArticle(ctx context.Context, articleNumber int) (*Article, error) {
 	resp := &GetArticleResp{}
 
 	uri := fmt.Sprintf("%s/%d", articlesURI, articleNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getArticleOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -35,13 +30,13 @@ func (c *Client) GetArticle(ctx context.Context, articleNumber int) (*Article, e
 //
 // articleNumber - identifies the article
 //
-// updateArticle - article to update
-func (c *Client) UpdateArticle(ctx context.Context, articleNumber int, req *UpdateArticleReq) (*UpdateArticleResp, error) {
+// req - fields to update, only fields that are Set are sent
+func (c *articlesService) UpdateArticle(ctx context.Context, articleNumber int, req *UpdateArticleReq) (*UpdateArticleResp, error) {
 	resp := &UpdateArticleResp{}
 
 	uri := fmt.Sprintf("%s/%d", articlesURI, articleNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateArticleOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -49,37 +44,100 @@ func (c *Client) UpdateArticle(ctx context.Context, articleNumber int, req *Upda
 	return resp, nil
 }
 
+// PatchArticle does _PUT https://api.fortnox.se/3/articles/{ArticleNumber} sending only the fields changed between original and edited
+//
+// original - article as returned by Fortnox
+//
+// edited - modified copy of original
+//
+// Nothing is sent when no writable field changed, original is returned then.
+func (c *articlesService) PatchArticle(ctx context.Context, original, edited *Article) (*Article, error) {
+	update := DiffArticle(original, edited)
+	if !hasSetFields(update) {
+		return original, nil
+	}
+
+	req := &UpdateArticleReq{Article: *update}
+	resp := &UpdateArticleResp{}
+
+	uri := fmt.Sprintf("%s/%s", articlesURI, original.ArticleNumber)
+
+	err := c._PUT(ctx, patchArticleOp, uri, nil, req, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Article, nil
+}
+
 // DeleteArticle does _DELETE https://api.fortnox.se/3/articles/{ArticleNumber}
 //
 // articleNumber - identifies the article
-func (c *Client) DeleteArticle(ctx context.Context, articleNumber int) error {
+func (c *articlesService) DeleteArticle(ctx context.Context, articleNumber int) error {
 	uri := fmt.Sprintf("%s/%d", articlesURI, articleNumber)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteArticleOp, uri)
 }
 
 // GetArticles does _GET https://api.fortnox.se/3/articles
 //
 // filter - Enum: {"active", "inactive"}, possibility to filter supplier invoices
-func (c *Client) GetArticles(ctx context.Context, filter *ArticleFilter) (*GetArticlesResp, error) {
-	resp := &GetArticlesResp{}
-
-	params := filter.urlValues()
+func (c *articlesService) GetArticles(ctx context.Context, filter *ArticleFilter) (*GetArticlesResp, error) {
+	it := c.IterateArticles(filter)
 
-	err := c._GET(ctx, articlesURI, params, resp)
+	items, err := it.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp := &GetArticlesResp{Articles: items}
+	if meta := it.PageInfo(); meta != nil {
+		resp.MetaInformation = *meta
+	}
+
 	return resp, nil
 }
 
+// GetArticlesPage does _GET https://api.fortnox.se/3/articles and returns a single page along with its MetaInformation
+//
+// filter - Enum: {"active", "inactive"}, possibility to filter supplier invoices
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *articlesService) GetArticlesPage(
+	ctx context.Context,
+	filter *ArticleFilter,
+	page *PageOptions) ([]Article, *MetaInformation, error) {
+
+	resp := &GetArticlesResp{}
+
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = c._GETPage(ctx, getArticlesPageOp, articlesURI, params, page, resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Articles, &resp.MetaInformation, nil
+}
+
+// IterateArticles lazily walks all pages of https://api.fortnox.se/3/articles
+//
+// filter - Enum: {"active", "inactive"}, possibility to filter supplier invoices
+func (c *articlesService) IterateArticles(filter *ArticleFilter) *Iterator[Article] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Article, *MetaInformation, error) {
+		return c.GetArticlesPage(ctx, filter, page)
+	})
+}
+
 // CreateArticle does _POST https://api.fortnox.se/3/articles
 //
 // article - object to create
-func (c *Client) CreateArticle(ctx context.Context, req *CreateArticleReq) (*CreateArticleResp, error) {
+func (c *articlesService) CreateArticle(ctx context.Context, req *CreateArticleReq) (*CreateArticleResp, error) {
 	resp := &CreateArticleResp{}
 
-	err := c._POST(ctx, articlesURI, nil, req, resp)
+	err := c._POST(ctx, createArticleOp, articlesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -89,19 +147,14 @@ func (c *Client) CreateArticle(ctx context.Context, req *CreateArticleReq) (*Cre
 
 type ArticleFilter string
 
-func (f *ArticleFilter) urlValues() url.Values {
+func (f *ArticleFilter) urlValues() (url.Values, error) {
 	if f == nil {
-		return nil
+		return nil, nil
 	}
 
-	params := url.Values{}
-	fStr := string(*f)
-
-	if strings.TrimSpace(fStr) != "" {
-		params[filterParamName] = []string{fStr}
-	}
-
-	return params
+	return encodeQuery(struct {
+		Filter ArticleFilter `fortnox:"filter"`
+	}{*f})
 }
 
 const (
@@ -114,7 +167,7 @@ type GetArticleResp struct {
 }
 
 type UpdateArticleReq struct {
-	Article Article `json:"Article"`
+	Article ArticleUpdate `json:"Article"`
 }
 
 type UpdateArticleResp struct {
@@ -122,7 +175,8 @@ type UpdateArticleResp struct {
 }
 
 type GetArticlesResp struct {
-	Articles []Article `json:"Articles"`
+	Articles        []Article       `json:"Articles"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateArticleReq struct {
@@ -153,13 +207,13 @@ type Article struct {
 	ManufacturerArticleNumber string `json:"ManufacturerArticleNumber"`
 	Note                      string `json:"Note"`
 	PurchaseAccount           int    `json:"PurchaseAccount"`
-	PurchasePrice             int    `json:"PurchasePrice"`
+	PurchasePrice             Money  `json:"PurchasePrice"`
 	QuantityInStock           int    `json:"QuantityInStock"`
 	ReservedQuantity          int    `json:"ReservedQuantity"`
 	SalesAccount              int    `json:"SalesAccount"`
 	StockGoods                bool   `json:"StockGoods"`
 	StockPlace                string `json:"StockPlace"`
-	StockValue                int    `json:"StockValue"`
+	StockValue                Money  `json:"StockValue"`
 	StockWarning              int    `json:"StockWarning"`
 	SupplierName              string `json:"SupplierName"`
 	SupplierNumber            string `json:"SupplierNumber"`
@@ -170,13 +224,84 @@ type Article struct {
 	Weight                    int    `json:"Weight"`
 	Width                     int    `json:"Width"`
 	Expired                   bool   `json:"Expired"`
-	SalesPrice                int    `json:"SalesPrice"`
+	SalesPrice                Money  `json:"SalesPrice"`
 	CostCalculationMethod     string `json:"CostCalculationMethod"`
 	StockAccount              int    `json:"StockAccount"`
 	StockChangeAccount        int    `json:"StockChangeAccount"`
-	DirectCost                int    `json:"DirectCost"`
-	FreightCost               int    `json:"FreightCost"`
-	OtherCost                 int    `json:"OtherCost"`
+	DirectCost                Money  `json:"DirectCost"`
+	FreightCost               Money  `json:"FreightCost"`
+	OtherCost                 Money  `json:"OtherCost"`
 	DefaultStockPoint         string `json:"DefaultStockPoint"`
 	DefaultStockLocation      string `json:"DefaultStockLocation"`
 }
+
+// ArticleUpdate is the payload of UpdateArticle, only fields that are Set are sent,
+// so they can be changed to their zero value
+type ArticleUpdate struct {
+	ArticleNumber             Optional[string] `json:"ArticleNumber"`
+	Bulky                     Optional[bool]   `json:"Bulky"`
+	ConstructionAccount       Optional[int]    `json:"ConstructionAccount"`
+	Depth                     Optional[int]    `json:"Depth"`
+	Description               Optional[string] `json:"Description"`
+	DisposableQuantity        Optional[int]    `json:"DisposableQuantity"`
+	EAN                       Optional[string] `json:"EAN"`
+	EUAccount                 Optional[int]    `json:"EUAccount"`
+	EUVATAccount              Optional[int]    `json:"EUVATAccount"`
+	ExportAccount             Optional[int]    `json:"ExportAccount"`
+	Height                    Optional[int]    `json:"Height"`
+	Housework                 Optional[bool]   `json:"Housework"`
+	HouseworkType             Optional[string] `json:"HouseworkType"`
+	Active                    Optional[bool]   `json:"Active"`
+	Manufacturer              Optional[string] `json:"Manufacturer"`
+	ManufacturerArticleNumber Optional[string] `json:"ManufacturerArticleNumber"`
+	Note                      Optional[string] `json:"Note"`
+	PurchaseAccount           Optional[int]    `json:"PurchaseAccount"`
+	PurchasePrice             Optional[Money]  `json:"PurchasePrice"`
+	QuantityInStock           Optional[int]    `json:"QuantityInStock"`
+	ReservedQuantity          Optional[int]    `json:"ReservedQuantity"`
+	SalesAccount              Optional[int]    `json:"SalesAccount"`
+	StockGoods                Optional[bool]   `json:"StockGoods"`
+	StockPlace                Optional[string] `json:"StockPlace"`
+	StockValue                Optional[Money]  `json:"StockValue"`
+	StockWarning              Optional[int]    `json:"StockWarning"`
+	SupplierName              Optional[string] `json:"SupplierName"`
+	SupplierNumber            Optional[string] `json:"SupplierNumber"`
+	Type                      Optional[string] `json:"Type"`
+	Unit                      Optional[string] `json:"Unit"`
+	VAT                       Optional[int]    `json:"VAT"`
+	WebshopArticle            Optional[bool]   `json:"WebshopArticle"`
+	Weight                    Optional[int]    `json:"Weight"`
+	Width                     Optional[int]    `json:"Width"`
+	Expired                   Optional[bool]   `json:"Expired"`
+	SalesPrice                Optional[Money]  `json:"SalesPrice"`
+	CostCalculationMethod     Optional[string] `json:"CostCalculationMethod"`
+	StockAccount              Optional[int]    `json:"StockAccount"`
+	StockChangeAccount        Optional[int]    `json:"StockChangeAccount"`
+	DirectCost                Optional[Money]  `json:"DirectCost"`
+	FreightCost               Optional[Money]  `json:"FreightCost"`
+	OtherCost                 Optional[Money]  `json:"OtherCost"`
+	DefaultStockPoint         Optional[string] `json:"DefaultStockPoint"`
+	DefaultStockLocation      Optional[string] `json:"DefaultStockLocation"`
+}
+
+func (u ArticleUpdate) MarshalJSON() ([]byte, error) {
+	return marshalSetFields(u)
+}
+
+// articleReadOnlyFields are computed or set by Fortnox, DiffArticle never sends them
+var articleReadOnlyFields = map[string]bool{
+	"ArticleNumber":      true,
+	"DisposableQuantity": true,
+	"ReservedQuantity":   true,
+	"SalesPrice":         true,
+	"StockValue":         true,
+}
+
+// DiffArticle returns an update holding only the writable fields that differ between original, as returned by Fortnox,
+// and edited, a modified copy of it
+func DiffArticle(original, edited *Article) *ArticleUpdate {
+	u := &ArticleUpdate{}
+	diffInto(original, edited, u, articleReadOnlyFields)
+
+	return u
+}
@@ -10,27 +10,56 @@ const (
 )
 
 // GetAllAssetFileConnections does _GET https://api.fortnox.se/3/assetfileconnections
-func (c *Client) GetAllAssetFileConnections(ctx context.Context) (*GetAllAssetFileConnectionsResp, error) {
-	resp := &GetAllAssetFileConnectionsResp{}
+func (c *assetFileConnectionsService) GetAllAssetFileConnections(ctx context.Context) (*GetAllAssetFileConnectionsResp, error) {
+	it := c.IterateAssetFileConnections()
 
-	err := c._GET(ctx, assetFileConnectionsURI, nil, resp)
+	items, err := it.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp := &GetAllAssetFileConnectionsResp{AssetFileConnections: items}
+	if meta := it.PageInfo(); meta != nil {
+		resp.MetaInformation = *meta
+	}
+
 	return resp, nil
 }
 
+// GetAssetFileConnectionsPage does _GET https://api.fortnox.se/3/assetfileconnections and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *assetFileConnectionsService) GetAssetFileConnectionsPage(
+	ctx context.Context,
+	page *PageOptions) ([]AssetFileConnection, *MetaInformation, error) {
+
+	resp := &GetAllAssetFileConnectionsResp{}
+
+	err := c._GETPage(ctx, getAssetFileConnectionsPageOp, assetFileConnectionsURI, nil, page, resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.AssetFileConnections, &resp.MetaInformation, nil
+}
+
+// IterateAssetFileConnections lazily walks all pages of https://api.fortnox.se/3/assetfileconnections
+func (c *assetFileConnectionsService) IterateAssetFileConnections() *Iterator[AssetFileConnection] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]AssetFileConnection, *MetaInformation, error) {
+		return c.GetAssetFileConnectionsPage(ctx, page)
+	})
+}
+
 // CreateAssetFileConnection does _POST https://api.fortnox.se/3/assetfileconnections
 //
 // req - asset file connection to create
-func (c *Client) CreateAssetFileConnection(
+func (c *assetFileConnectionsService) CreateAssetFileConnection(
 	ctx context.Context,
 	req *CreateAssetFileConnectionReq) (*AssetFileConnection, error) {
 
 	resp := &AssetFileConnection{}
 
-	err := c._POST(ctx, assetFileConnectionsURI, nil, req, resp)
+	err := c._POST(ctx, createAssetFileConnectionOp, assetFileConnectionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -41,9 +70,9 @@ func (c *Client) CreateAssetFileConnection(
 // DeleteAssetFileConnection does _DELETE https://api.fortnox.se/3/assetfileconnections/{fileId}
 //
 // fileID - fileId
-func (c *Client) DeleteAssetFileConnection(ctx context.Context, fileID string) error {
-	uri := fmt.Sprintf("%s/%s", articleFileConnectionsURI, fileID)
-	return c._DELETE(ctx, uri)
+func (c *assetFileConnectionsService) DeleteAssetFileConnection(ctx context.Context, fileID string) error {
+	uri := fmt.Sprintf("%s/%s", assetFileConnectionsURI, fileID)
+	return c._DELETE(ctx, deleteAssetFileConnectionOp, uri)
 }
 
 type AssetFileConnection struct {
@@ -53,11 +82,8 @@ type AssetFileConnection struct {
 	AssetId string `json:"AssetId"`
 }
 
-type AssetFileConnectionMetaInformation struct {
-	TotalResources int `json:"@TotalResources"`
-	TotalPages     int `json:"@TotalPages"`
-	CurrentPage    int `json:"@CurrentPage"`
-}
+// AssetFileConnectionMetaInformation is kept for compatibility, use MetaInformation
+type AssetFileConnectionMetaInformation = MetaInformation
 
 type GetAllAssetFileConnectionsResp struct {
 	AssetFileConnections []AssetFileConnection              `json:"AssetFileConnections"`
@@ -12,211 +12,134 @@ const (
 // GetAssetType does _GET https://api.fortnox.se/3/assets/types/{id}
 //
 // id - id
-func (c *Client) GetAssetType(ctx context.Context, id int) (*GetAssetTypeResp, error) {
+func (c *assetTypesService) GetAssetType(ctx context.Context, id int) (*AssetType, error) {
 	resp := &GetAssetTypeResp{}
 
 	uri := fmt.Sprintf("%s/%d", assetTypesURI, id)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getAssetTypeOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Type, nil
 }
 
-// CreateAssetType does _POST https://api.fortnox.se/3/assets/types/{id}
+// CreateAssetType does _POST https://api.fortnox.se/3/assets/types
 //
-// id - id
-//
-// req - request
-func (c *Client) CreateAssetType(ctx context.Context, id int, req *CreateAssetTypeReq) (*CreateAssetTypeResp, error) {
+// at - asset type to create
+func (c *assetTypesService) CreateAssetType(ctx context.Context, at *AssetType) (*AssetType, error) {
+	req := &CreateAssetTypeReq{AssetType: *at}
 	resp := &CreateAssetTypeResp{}
 
-	uri := fmt.Sprintf("%s/%d", assetTypesURI, id)
-
-	err := c._POST(ctx, uri, nil, req, resp)
+	err := c._POST(ctx, createAssetTypeOp, assetTypesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Type, nil
 }
 
 // UpdateAssetType does _PUT https://api.fortnox.se/3/assets/types/{id}
 //
 // id - id
 //
-// req - request
-func (c *Client) UpdateAssetType(ctx context.Context, id int, req *UpdateAssetTypeReq) (*UpdateAssetTypeResp, error) {
+// at - asset type to update, only Description and Notes can be changed
+func (c *assetTypesService) UpdateAssetType(ctx context.Context, id int, at *AssetType) (*AssetType, error) {
+	req := &UpdateAssetTypeReq{AssetType: *at}
 	resp := &UpdateAssetTypeResp{}
 
 	uri := fmt.Sprintf("%s/%d", assetTypesURI, id)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateAssetTypeOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Type, nil
 }
 
 // DeleteAssetType does _DELETE https://api.fortnox.se/3/assets/types/{id}
 //
 // id - id
-func (c *Client) DeleteAssetType(ctx context.Context, id int) error {
+func (c *assetTypesService) DeleteAssetType(ctx context.Context, id int) error {
 	uri := fmt.Sprintf("%s/%d", assetTypesURI, id)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteAssetTypeOp, uri)
 }
 
 // GetAllAssetTypes does _GET https://api.fortnox.se/3/assets/types/
-func (c *Client) GetAllAssetTypes(ctx context.Context) (*GetAllAssetTypesResp, error) {
+func (c *assetTypesService) GetAllAssetTypes(ctx context.Context) ([]AssetType, error) {
+	return c.IterateAssetTypes().All(ctx)
+}
+
+// GetAssetTypesPage does _GET https://api.fortnox.se/3/assets/types/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *assetTypesService) GetAssetTypesPage(ctx context.Context, page *PageOptions) ([]AssetType, *MetaInformation, error) {
 	resp := &GetAllAssetTypesResp{}
 
-	err := c._GET(ctx, assetTypesURI, nil, resp)
+	err := c._GETPage(ctx, getAssetTypesPageOp, assetTypesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp, nil
+	return resp.Types, &resp.MetaInformation, nil
 }
 
-type GetAssetTypeResp struct {
-	Type struct {
-		Url                   string `json:"@url"`
-		Id                    int    `json:"Id"`
-		Number                string `json:"Number"`
-		Description           string `json:"Description"`
-		Notes                 string `json:"Notes"`
-		Type                  int    `json:"Type"`
-		InUse                 bool   `json:"InUse"`
-		AccountAssetId        int    `json:"AccountAssetId"`
-		AccountValueLossId    int    `json:"AccountValueLossId"`
-		AccountSaleLossId     int    `json:"AccountSaleLossId"`
-		AccountSaleWinId      int    `json:"AccountSaleWinId"`
-		AccountRevaluationId  int    `json:"AccountRevaluationId"`
-		AccountWriteDownAckId int    `json:"AccountWriteDownAckId"`
-		AccountWriteDownId    int    `json:"AccountWriteDownId"`
-		AccountDepreciationId int    `json:"AccountDepreciationId"`
-		AccountAsset          int    `json:"AccountAsset"`
-		AccountValueLoss      int    `json:"AccountValueLoss"`
-		AccountSaleLoss       int    `json:"AccountSaleLoss"`
-		AccountSaleWin        int    `json:"AccountSaleWin"`
-		AccountRevaluation    int    `json:"AccountRevaluation"`
-		AccountWriteDownAck   int    `json:"AccountWriteDownAck"`
-		AccountWriteDown      int    `json:"AccountWriteDown"`
-		AccountDepreciation   int    `json:"AccountDepreciation"`
-	} `json:"Type"`
+// IterateAssetTypes lazily walks all pages of https://api.fortnox.se/3/assets/types/
+func (c *assetTypesService) IterateAssetTypes() *Iterator[AssetType] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]AssetType, *MetaInformation, error) {
+		return c.GetAssetTypesPage(ctx, page)
+	})
 }
 
-type AssetTypeShort struct {
-	Number                string `json:"Number"`
-	Description           string `json:"Description"`
-	Notes                 string `json:"Notes"`
-	Type                  int    `json:"Type"`
-	AccountAssetId        int    `json:"AccountAssetId"`
-	AccountDepreciationId int    `json:"AccountDepreciationId"`
-	AccountValueLossId    int    `json:"AccountValueLossId"`
+type GetAssetTypeResp struct {
+	Type AssetType `json:"Type"`
 }
 
 type CreateAssetTypeReq struct {
-	AssetType AssetTypeShort `json:"AssetType"`
+	AssetType AssetType `json:"AssetType"`
 }
 
 type CreateAssetTypeResp struct {
-	Type struct {
-		Url                   string `json:"@url"`
-		Id                    int    `json:"Id"`
-		Number                string `json:"Number"`
-		Description           string `json:"Description"`
-		Notes                 string `json:"Notes"`
-		Type                  int    `json:"Type"`
-		InUse                 bool   `json:"InUse"`
-		AccountAssetId        int    `json:"AccountAssetId"`
-		AccountValueLossId    int    `json:"AccountValueLossId"`
-		AccountSaleLossId     int    `json:"AccountSaleLossId"`
-		AccountSaleWinId      int    `json:"AccountSaleWinId"`
-		AccountRevaluationId  int    `json:"AccountRevaluationId"`
-		AccountWriteDownAckId int    `json:"AccountWriteDownAckId"`
-		AccountWriteDownId    int    `json:"AccountWriteDownId"`
-		AccountDepreciationId int    `json:"AccountDepreciationId"`
-		AccountAsset          int    `json:"AccountAsset"`
-		AccountValueLoss      int    `json:"AccountValueLoss"`
-		AccountSaleLoss       int    `json:"AccountSaleLoss"`
-		AccountSaleWin        int    `json:"AccountSaleWin"`
-		AccountRevaluation    int    `json:"AccountRevaluation"`
-		AccountWriteDownAck   int    `json:"AccountWriteDownAck"`
-		AccountWriteDown      int    `json:"AccountWriteDown"`
-		AccountDepreciation   int    `json:"AccountDepreciation"`
-	} `json:"Type"`
+	Type AssetType `json:"Type"`
 }
 
-type UpdateAssetType struct {
-	Description string `json:"Description"`
-	Notes       string `json:"Notes"`
-}
 type UpdateAssetTypeReq struct {
-	AssetType UpdateAssetType `json:"AssetType"`
-}
-type UpdateAssetTypeResp struct {
-	Type struct {
-		Url                   string `json:"@url"`
-		Id                    int    `json:"Id"`
-		Number                string `json:"Number"`
-		Description           string `json:"Description"`
-		Notes                 string `json:"Notes"`
-		Type                  int    `json:"Type"`
-		InUse                 bool   `json:"InUse"`
-		AccountAssetId        int    `json:"AccountAssetId"`
-		AccountValueLossId    int    `json:"AccountValueLossId"`
-		AccountSaleLossId     int    `json:"AccountSaleLossId"`
-		AccountSaleWinId      int    `json:"AccountSaleWinId"`
-		AccountRevaluationId  int    `json:"AccountRevaluationId"`
-		AccountWriteDownAckId int    `json:"AccountWriteDownAckId"`
-		AccountWriteDownId    int    `json:"AccountWriteDownId"`
-		AccountDepreciationId int    `json:"AccountDepreciationId"`
-		AccountAsset          int    `json:"AccountAsset"`
-		AccountValueLoss      int    `json:"AccountValueLoss"`
-		AccountSaleLoss       int    `json:"AccountSaleLoss"`
-		AccountSaleWin        int    `json:"AccountSaleWin"`
-		AccountRevaluation    int    `json:"AccountRevaluation"`
-		AccountWriteDownAck   int    `json:"AccountWriteDownAck"`
-		AccountWriteDown      int    `json:"AccountWriteDown"`
-		AccountDepreciation   int    `json:"AccountDepreciation"`
-	} `json:"Type"`
+	AssetType AssetType `json:"AssetType"`
 }
 
-type MetaInformation struct {
-	TotalResources int `json:"@TotalResources"`
-	TotalPages     int `json:"@TotalPages"`
-	CurrentPage    int `json:"@CurrentPage"`
+type UpdateAssetTypeResp struct {
+	Type AssetType `json:"Type"`
 }
 
 type GetAllAssetTypesResp struct {
 	MetaInformation MetaInformation `json:"MetaInformation"`
-	Types           []struct {
-		Url                   string `json:"@url"`
-		Id                    int    `json:"Id"`
-		Number                string `json:"Number"`
-		Description           string `json:"Description"`
-		Notes                 string `json:"Notes"`
-		Type                  int    `json:"Type"`
-		InUse                 bool   `json:"InUse"`
-		AccountAssetId        int    `json:"AccountAssetId"`
-		AccountValueLossId    int    `json:"AccountValueLossId"`
-		AccountSaleLossId     int    `json:"AccountSaleLossId"`
-		AccountSaleWinId      int    `json:"AccountSaleWinId"`
-		AccountRevaluationId  int    `json:"AccountRevaluationId"`
-		AccountWriteDownAckId int    `json:"AccountWriteDownAckId"`
-		AccountWriteDownId    int    `json:"AccountWriteDownId"`
-		AccountDepreciationId int    `json:"AccountDepreciationId"`
-		AccountAsset          int    `json:"AccountAsset"`
-		AccountValueLoss      int    `json:"AccountValueLoss"`
-		AccountSaleLoss       int    `json:"AccountSaleLoss"`
-		AccountSaleWin        int    `json:"AccountSaleWin"`
-		AccountRevaluation    int    `json:"AccountRevaluation"`
-		AccountWriteDownAck   int    `json:"AccountWriteDownAck"`
-		AccountWriteDown      int    `json:"AccountWriteDown"`
-		AccountDepreciation   int    `json:"AccountDepreciation"`
-	} `json:"Types"`
+	Types           []AssetType     `json:"Types"`
+}
+
+type AssetType struct {
+	Url                   string `json:"@url,omitempty"`
+	Id                    int    `json:"Id,omitempty"`
+	Number                string `json:"Number,omitempty"`
+	Description           string `json:"Description,omitempty"`
+	Notes                 string `json:"Notes,omitempty"`
+	Type                  int    `json:"Type,omitempty"`
+	InUse                 bool   `json:"InUse,omitempty"`
+	AccountAssetId        int    `json:"AccountAssetId,omitempty"`
+	AccountValueLossId    int    `json:"AccountValueLossId,omitempty"`
+	AccountSaleLossId     int    `json:"AccountSaleLossId,omitempty"`
+	AccountSaleWinId      int    `json:"AccountSaleWinId,omitempty"`
+	AccountRevaluationId  int    `json:"AccountRevaluationId,omitempty"`
+	AccountWriteDownAckId int    `json:"AccountWriteDownAckId,omitempty"`
+	AccountWriteDownId    int    `json:"AccountWriteDownId,omitempty"`
+	AccountDepreciationId int    `json:"AccountDepreciationId,omitempty"`
+	AccountAsset          int    `json:"AccountAsset,omitempty"`
+	AccountValueLoss      int    `json:"AccountValueLoss,omitempty"`
+	AccountSaleLoss       int    `json:"AccountSaleLoss,omitempty"`
+	AccountSaleWin        int    `json:"AccountSaleWin,omitempty"`
+	AccountRevaluation    int    `json:"AccountRevaluation,omitempty"`
+	AccountWriteDownAck   int    `json:"AccountWriteDownAck,omitempty"`
+	AccountWriteDown      int    `json:"AccountWriteDown,omitempty"`
+	AccountDepreciation   int    `json:"AccountDepreciation,omitempty"`
 }
@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -10,45 +12,60 @@ const (
 )
 
 // GetAllAssets does _GET https://api.fortnox.se/3/assets/
-func (c *Client) GetAllAssets(ctx context.Context) (*GetAllAssetsResp, error) {
+func (c *assetsService) GetAllAssets(ctx context.Context) ([]Asset, error) {
+	return c.IterateAssets().All(ctx)
+}
+
+// GetAssetsPage does _GET https://api.fortnox.se/3/assets/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *assetsService) GetAssetsPage(ctx context.Context, page *PageOptions) ([]Asset, *MetaInformation, error) {
 	resp := &GetAllAssetsResp{}
 
-	err := c._GET(ctx, assetsURI, nil, resp)
+	err := c._GETPage(ctx, getAssetsPageOp, assetsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp, nil
+	return resp.Assets, &resp.MetaInformation, nil
+}
+
+// IterateAssets lazily walks all pages of https://api.fortnox.se/3/assets/
+func (c *assetsService) IterateAssets() *Iterator[Asset] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Asset, *MetaInformation, error) {
+		return c.GetAssetsPage(ctx, page)
+	})
 }
 
 // CreateAsset does _POST https://api.fortnox.se/3/assets/
 //
-// req - request
-func (c *Client) CreateAsset(ctx context.Context, req *CreateAssetTypeReq) (*CreateAssetResp, error) {
+// a - asset to create
+func (c *assetsService) CreateAsset(ctx context.Context, a *Asset) (*Asset, error) {
+	req := &CreateAssetReq{Asset: *a}
 	resp := &CreateAssetResp{}
 
-	err := c._POST(ctx, assetsURI, nil, req, resp)
+	err := c._POST(ctx, createAssetOp, assetsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Asset, nil
 }
 
 // GetAsset does _GET https://api.fortnox.se/3/assets/{GivenNumber}
 //
 // givenNumber - asset number
-func (c *Client) GetAsset(ctx context.Context, givenNumber string) (*GetAssetResp, error) {
+func (c *assetsService) GetAsset(ctx context.Context, givenNumber string) (*Asset, error) {
 	resp := &GetAssetResp{}
 
 	uri := fmt.Sprintf("%s/%s", assetsURI, givenNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getAssetOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Asset, nil
 }
 
 // ChangeManualAssetOBValue does _PUT https://api.fortnox.se/3/assets/{GivenNumber}
@@ -56,21 +73,21 @@ func (c *Client) GetAsset(ctx context.Context, givenNumber string) (*GetAssetRes
 // givenNumber - Asset number
 //
 // req - request
-func (c *Client) ChangeManualAssetOBValue(
+func (c *assetsService) ChangeManualAssetOBValue(
 	ctx context.Context,
 	givenNumber int,
-	req *ChangeManualAssetOBValueReq) (*ChangeManualAssetOBValueResp, error) {
+	req *ChangeManualAssetOBValueReq) (*Asset, error) {
 
 	resp := &ChangeManualAssetOBValueResp{}
 
 	uri := fmt.Sprintf("%s/%d", assetsURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, changeManualAssetOBValueOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Asset, nil
 }
 
 // DeleteOrVoidAsset does _DELETE https://api.fortnox.se/3/assets/{GivenNumber}
@@ -80,26 +97,30 @@ func (c *Client) ChangeManualAssetOBValue(
 // req - request
 //
 // TODO: pass to _DELETE req
-func (c *Client) DeleteOrVoidAsset(ctx context.Context, givenNumber int, req *DeleteOrVoidAssetReq) error {
+func (c *assetsService) DeleteOrVoidAsset(ctx context.Context, givenNumber int, req *DeleteOrVoidAssetReq) error {
 	uri := fmt.Sprintf("%s/%d", assetsURI, givenNumber)
 
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteOrVoidAssetOp, uri)
 }
 
 // GetAssetsDepreciationList does _GET https://api.fortnox.se/3/assets/depreciations/{ToDate}
 //
-// toDate - toDate
-func (c *Client) GetAssetsDepreciationList(ctx context.Context, toDate string) (*GetAssetsDepreciationListResp, error) {
+// toDate - date up to which depreciations are listed
+func (c *assetsService) GetAssetsDepreciationList(ctx context.Context, toDate Date) ([]Asset, error) {
+	if toDate.IsZero() || !toDate.Valid() {
+		return nil, errors.Wrapf(ErrInvalidDate, "can't list depreciations up to %q", string(toDate))
+	}
+
 	resp := &GetAssetsDepreciationListResp{}
 
 	uri := fmt.Sprintf("%s/depreciations/%s", assetsURI, toDate)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getAssetsDepreciationListOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return resp.Assets, nil
 }
 
 // WriteUpAsset does _PUT https://api.fortnox.se/3/assets/writeup/{GivenNumber}
@@ -107,17 +128,17 @@ func (c *Client) GetAssetsDepreciationList(ctx context.Context, toDate string) (
 // givenNumber - asset number
 //
 // req - request
-func (c *Client) WriteUpAsset(ctx context.Context, givenNumber string, req *WriteUpAssetReq) (*WriteUpAssetResp, error) {
+func (c *assetsService) WriteUpAsset(ctx context.Context, givenNumber string, req *WriteUpAssetReq) (*Asset, error) {
 	resp := &WriteUpAssetResp{}
 
 	uri := fmt.Sprintf("%s/writeup/%s", assetsURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, writeUpAssetOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Asset, nil
 }
 
 // WriteDownAsset does _PUT https://api.fortnox.se/3/assets/writedown/{GivenNumber}
@@ -125,21 +146,21 @@ func (c *Client) WriteUpAsset(ctx context.Context, givenNumber string, req *Writ
 // givenNumber - asset number
 //
 // req - request
-func (c *Client) WriteDownAsset(
+func (c *assetsService) WriteDownAsset(
 	ctx context.Context,
 	givenNumber string,
-	req *WriteDownAssetReq) (*WriteDownAssetResp, error) {
+	req *WriteDownAssetReq) (*Asset, error) {
 
 	resp := &WriteDownAssetResp{}
 
 	uri := fmt.Sprintf("%s/writedown/%s", assetsURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, writeDownAssetOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Asset, nil
 }
 
 // ScrapAsset does _PUT https://api.fortnox.se/3/assets/scrap/{GivenNumber}
@@ -147,21 +168,21 @@ func (c *Client) WriteDownAsset(
 // givenNumber - asset number
 //
 // req - request
-func (c *Client) ScrapAsset(
+func (c *assetsService) ScrapAsset(
 	ctx context.Context,
 	givenNumber string,
-	req *ScrapAssetReq) (*ScrapAssetResp, error) {
+	req *ScrapAssetReq) (*Asset, error) {
 
 	resp := &ScrapAssetResp{}
 
 	uri := fmt.Sprintf("%s/scrap/%s", assetsURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, scrapAssetOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Asset, nil
 }
 
 // SellAsset does _PUT https://api.fortnox.se/3/assets/sell/{GivenNumber}
@@ -169,27 +190,27 @@ func (c *Client) ScrapAsset(
 // givenNumber - asset number
 //
 // req - request
-func (c *Client) SellAsset(
+func (c *assetsService) SellAsset(
 	ctx context.Context,
 	givenNumber string,
-	req *SellAssetReq) (*SellAssetResp, error) {
+	req *SellAssetReq) (*Asset, error) {
 
 	resp := &SellAssetResp{}
 
 	uri := fmt.Sprintf("%s/sell/%s", assetsURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, sellAssetOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Asset, nil
 }
 
 // PerformAssetDepreciation does _POST https://api.fortnox.se/3/assets/depreciate
 //
 // req - request
-func (c *Client) PerformAssetDepreciation(
+func (c *assetsService) PerformAssetDepreciation(
 	ctx context.Context,
 	req *PerformAssetDepreciationReq) (*PerformAssetDepreciationResp, error) {
 
@@ -197,7 +218,7 @@ func (c *Client) PerformAssetDepreciation(
 
 	uri := fmt.Sprintf("%s/depreciate", assetsURI)
 
-	err := c._POST(ctx, uri, nil, req, resp)
+	err := c._POST(ctx, performAssetDepreciationOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -206,414 +227,139 @@ func (c *Client) PerformAssetDepreciation(
 }
 
 type GetAllAssetsResp struct {
-	Assets []struct {
-		Url               string `json:"@url"`
-		Id                int    `json:"Id"`
-		Number            string `json:"Number"`
-		Description       string `json:"Description"`
-		Status            string `json:"Status"`
-		StatusId          string `json:"StatusId"`
-		Type              string `json:"Type"`
-		TypeId            int    `json:"TypeId"`
-		AcquisitionValue  int    `json:"AcquisitionValue"`
-		AcquisitionDate   string `json:"AcquisitionDate"`
-		DepreciationFinal string `json:"DepreciationFinal"`
-		DepreciatedTo     string `json:"DepreciatedTo"`
-	} `json:"Assets"`
+	Assets          []Asset         `json:"Assets"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateAssetReq struct {
-	AssetType struct {
-		Number                string `json:"Number"`
-		Description           string `json:"Description"`
-		Notes                 string `json:"Notes"`
-		Type                  int    `json:"Type"`
-		AccountAssetId        int    `json:"AccountAssetId"`
-		AccountDepreciationId int    `json:"AccountDepreciationId"`
-		AccountValueLossId    int    `json:"AccountValueLossId"`
-	} `json:"AssetType"`
+	Asset Asset `json:"Asset"`
 }
 
 type CreateAssetResp struct {
-	Assets struct {
-		Url                       string `json:"@url"`
-		Id                        int    `json:"Id"`
-		Number                    string `json:"Number"`
-		Description               string `json:"Description"`
-		Status                    string `json:"Status"`
-		StatusId                  string `json:"StatusId"`
-		CostCenter                string `json:"CostCenter"`
-		Project                   string `json:"Project"`
-		Type                      string `json:"Type"`
-		TypeId                    int    `json:"TypeId"`
-		DepreciationMethod        int    `json:"DepreciationMethod"`
-		AcquisitionValue          int    `json:"AcquisitionValue"`
-		DepreciateToResidualValue int    `json:"DepreciateToResidualValue"`
-		AcquisitionDate           string `json:"AcquisitionDate"`
-		AcquisitionStart          string `json:"AcquisitionStart"`
-		DepreciationFinal         string `json:"DepreciationFinal"`
-		DepreciatedTo             string `json:"DepreciatedTo"`
-		ManualOb                  int    `json:"ManualOb"`
-		Notes                     string `json:"Notes"`
-		Reference                 string `json:"Reference"`
-		Brand                     string `json:"Brand"`
-		InsuredNumber             string `json:"InsuredNumber"`
-		InsuredWith               string `json:"InsuredWith"`
-		Group                     string `json:"Group"`
-		Room                      string `json:"Room"`
-		Placement                 string `json:"Placement"`
-		Department                string `json:"Department"`
-		History                   []struct {
-			Id              int    `json:"Id"`
-			Date            string `json:"Date"`
-			EventId         int    `json:"EventId"`
-			Amount          string `json:"Amount"`
-			UserId          int    `json:"UserId"`
-			UserName        string `json:"UserName"`
-			Notes           string `json:"Notes"`
-			VoucherNumber   int    `json:"VoucherNumber"`
-			VoucherSeries   string `json:"VoucherSeries"`
-			VoucherYear     int    `json:"VoucherYear"`
-			SupplierInvoice int    `json:"SupplierInvoice"`
-		} `json:"History"`
-	} `json:"Assets"`
+	Asset Asset `json:"Assets"`
 }
 
 type GetAssetResp struct {
-	Assets struct {
-		Url                       string `json:"@url"`
-		Id                        int    `json:"Id"`
-		Number                    string `json:"Number"`
-		Description               string `json:"Description"`
-		Status                    string `json:"Status"`
-		StatusId                  string `json:"StatusId"`
-		CostCenter                string `json:"CostCenter"`
-		Project                   string `json:"Project"`
-		Type                      string `json:"Type"`
-		TypeId                    int    `json:"TypeId"`
-		DepreciationMethod        int    `json:"DepreciationMethod"`
-		AcquisitionValue          int    `json:"AcquisitionValue"`
-		DepreciateToResidualValue int    `json:"DepreciateToResidualValue"`
-		AcquisitionDate           string `json:"AcquisitionDate"`
-		AcquisitionStart          string `json:"AcquisitionStart"`
-		DepreciationFinal         string `json:"DepreciationFinal"`
-		DepreciatedTo             string `json:"DepreciatedTo"`
-		ManualOb                  int    `json:"ManualOb"`
-		Notes                     string `json:"Notes"`
-		Reference                 string `json:"Reference"`
-		Brand                     string `json:"Brand"`
-		InsuredNumber             string `json:"InsuredNumber"`
-		InsuredWith               string `json:"InsuredWith"`
-		Group                     string `json:"Group"`
-		Room                      string `json:"Room"`
-		Placement                 string `json:"Placement"`
-		Department                string `json:"Department"`
-		History                   []struct {
-			Id              int    `json:"Id"`
-			Date            string `json:"Date"`
-			EventId         int    `json:"EventId"`
-			Amount          string `json:"Amount"`
-			UserId          int    `json:"UserId"`
-			UserName        string `json:"UserName"`
-			Notes           string `json:"Notes"`
-			VoucherNumber   int    `json:"VoucherNumber"`
-			VoucherSeries   string `json:"VoucherSeries"`
-			VoucherYear     int    `json:"VoucherYear"`
-			SupplierInvoice int    `json:"SupplierInvoice"`
-		} `json:"History"`
-	} `json:"Assets"`
+	Asset Asset `json:"Assets"`
 }
 
-type ChangeManualAssetOBValueReq struct {
-	Amount  int    `json:"Amount"`
-	Comment string `json:"Comment"`
+type ChangeManualAssetOBValueResp struct {
+	Asset Asset `json:"Assets"`
 }
 
-type ChangeManualAssetOBValueResp struct {
-	Assets struct {
-		Url                       string `json:"@url"`
-		Id                        int    `json:"Id"`
-		Number                    string `json:"Number"`
-		Description               string `json:"Description"`
-		Status                    string `json:"Status"`
-		StatusId                  string `json:"StatusId"`
-		CostCenter                string `json:"CostCenter"`
-		Project                   string `json:"Project"`
-		Type                      string `json:"Type"`
-		TypeId                    int    `json:"TypeId"`
-		DepreciationMethod        int    `json:"DepreciationMethod"`
-		AcquisitionValue          int    `json:"AcquisitionValue"`
-		DepreciateToResidualValue int    `json:"DepreciateToResidualValue"`
-		AcquisitionDate           string `json:"AcquisitionDate"`
-		AcquisitionStart          string `json:"AcquisitionStart"`
-		DepreciationFinal         string `json:"DepreciationFinal"`
-		DepreciatedTo             string `json:"DepreciatedTo"`
-		ManualOb                  int    `json:"ManualOb"`
-		Notes                     string `json:"Notes"`
-		Reference                 string `json:"Reference"`
-		Brand                     string `json:"Brand"`
-		InsuredNumber             string `json:"InsuredNumber"`
-		InsuredWith               string `json:"InsuredWith"`
-		Group                     string `json:"Group"`
-		Room                      string `json:"Room"`
-		Placement                 string `json:"Placement"`
-		Department                string `json:"Department"`
-		History                   []struct {
-			Id              int    `json:"Id"`
-			Date            string `json:"Date"`
-			EventId         int    `json:"EventId"`
-			Amount          string `json:"Amount"`
-			UserId          int    `json:"UserId"`
-			UserName        string `json:"UserName"`
-			Notes           string `json:"Notes"`
-			VoucherNumber   int    `json:"VoucherNumber"`
-			VoucherSeries   string `json:"VoucherSeries"`
-			VoucherYear     int    `json:"VoucherYear"`
-			SupplierInvoice int    `json:"SupplierInvoice"`
-		} `json:"History"`
-	} `json:"Assets"`
+type GetAssetsDepreciationListResp struct {
+	Assets []Asset `json:"Assets"`
+}
+
+type WriteUpAssetResp struct {
+	Asset Asset `json:"Assets"`
+}
+
+type WriteDownAssetResp struct {
+	Asset Asset `json:"Assets"`
+}
+
+type ScrapAssetResp struct {
+	Asset Asset `json:"Assets"`
+}
+
+type SellAssetResp struct {
+	Asset Asset `json:"Assets"`
+}
+
+type Asset struct {
+	Url                       string         `json:"@url,omitempty"`
+	Id                        int            `json:"Id,omitempty"`
+	Number                    string         `json:"Number,omitempty"`
+	Description               string         `json:"Description,omitempty"`
+	Status                    string         `json:"Status,omitempty"`
+	StatusId                  string         `json:"StatusId,omitempty"`
+	CostCenter                string         `json:"CostCenter,omitempty"`
+	Project                   string         `json:"Project,omitempty"`
+	Type                      string         `json:"Type,omitempty"`
+	TypeId                    int            `json:"TypeId,omitempty"`
+	DepreciationMethod        int            `json:"DepreciationMethod,omitempty"`
+	AcquisitionValue          Money          `json:"AcquisitionValue,omitzero"`
+	DepreciateToResidualValue Money          `json:"DepreciateToResidualValue,omitzero"`
+	AcquisitionDate           Date           `json:"AcquisitionDate,omitempty"`
+	AcquisitionStart          Date           `json:"AcquisitionStart,omitempty"`
+	DepreciationFinal         Date           `json:"DepreciationFinal,omitempty"`
+	DepreciatedTo             Date           `json:"DepreciatedTo,omitempty"`
+	ManualOb                  int            `json:"ManualOb,omitempty"`
+	Notes                     string         `json:"Notes,omitempty"`
+	Reference                 string         `json:"Reference,omitempty"`
+	Brand                     string         `json:"Brand,omitempty"`
+	InsuredNumber             string         `json:"InsuredNumber,omitempty"`
+	InsuredWith               string         `json:"InsuredWith,omitempty"`
+	Group                     string         `json:"Group,omitempty"`
+	Room                      string         `json:"Room,omitempty"`
+	Placement                 string         `json:"Placement,omitempty"`
+	Department                string         `json:"Department,omitempty"`
+	History                   []AssetHistory `json:"History,omitempty"`
+}
+
+type AssetHistory struct {
+	Id              int    `json:"Id,omitempty"`
+	Date            Date   `json:"Date,omitempty"`
+	EventId         int    `json:"EventId,omitempty"`
+	Amount          Money  `json:"Amount,omitzero"`
+	UserId          int    `json:"UserId,omitempty"`
+	UserName        string `json:"UserName,omitempty"`
+	Notes           string `json:"Notes,omitempty"`
+	VoucherNumber   int    `json:"VoucherNumber,omitempty"`
+	VoucherSeries   string `json:"VoucherSeries,omitempty"`
+	VoucherYear     int    `json:"VoucherYear,omitempty"`
+	SupplierInvoice int    `json:"SupplierInvoice,omitempty"`
+}
+
+type ChangeManualAssetOBValueReq struct {
+	Amount  Money  `json:"Amount"`
+	Comment string `json:"Comment"`
 }
 
 type DeleteOrVoidAssetReq struct {
 	Asset struct {
-		Date string `json:"Date"`
+		Date Date `json:"Date"`
 	} `json:"Asset"`
 }
 
-type GetAssetsDepreciationListResp struct {
-	Assets []struct {
-		Url               string `json:"@url"`
-		Id                int    `json:"Id"`
-		Number            string `json:"Number"`
-		Description       string `json:"Description"`
-		Status            string `json:"Status"`
-		StatusId          string `json:"StatusId"`
-		Type              string `json:"Type"`
-		TypeId            int    `json:"TypeId"`
-		AcquisitionValue  int    `json:"AcquisitionValue"`
-		AcquisitionDate   string `json:"AcquisitionDate"`
-		DepreciationFinal string `json:"DepreciationFinal"`
-		DepreciatedTo     string `json:"DepreciatedTo"`
-	} `json:"Assets"`
-}
-
 type WriteUpAssetReq struct {
 	Asset struct {
-		Amount  int    `json:"Amount"`
+		Amount  Money  `json:"Amount"`
 		Comment string `json:"Comment"`
-		Date    string `json:"Date"`
+		Date    Date   `json:"Date"`
 	} `json:"Asset"`
 }
 
-type WriteUpAssetResp struct {
-	Assets struct {
-		Url                       string `json:"@url"`
-		Id                        int    `json:"Id"`
-		Number                    string `json:"Number"`
-		Description               string `json:"Description"`
-		Status                    string `json:"Status"`
-		StatusId                  string `json:"StatusId"`
-		CostCenter                string `json:"CostCenter"`
-		Project                   string `json:"Project"`
-		Type                      string `json:"Type"`
-		TypeId                    int    `json:"TypeId"`
-		DepreciationMethod        int    `json:"DepreciationMethod"`
-		AcquisitionValue          int    `json:"AcquisitionValue"`
-		DepreciateToResidualValue int    `json:"DepreciateToResidualValue"`
-		AcquisitionDate           string `json:"AcquisitionDate"`
-		AcquisitionStart          string `json:"AcquisitionStart"`
-		DepreciationFinal         string `json:"DepreciationFinal"`
-		DepreciatedTo             string `json:"DepreciatedTo"`
-		ManualOb                  int    `json:"ManualOb"`
-		Notes                     string `json:"Notes"`
-		Reference                 string `json:"Reference"`
-		Brand                     string `json:"Brand"`
-		InsuredNumber             string `json:"InsuredNumber"`
-		InsuredWith               string `json:"InsuredWith"`
-		Group                     string `json:"Group"`
-		Room                      string `json:"Room"`
-		Placement                 string `json:"Placement"`
-		Department                string `json:"Department"`
-		History                   []struct {
-			Id              int    `json:"Id"`
-			Date            string `json:"Date"`
-			EventId         int    `json:"EventId"`
-			Amount          string `json:"Amount"`
-			UserId          int    `json:"UserId"`
-			UserName        string `json:"UserName"`
-			Notes           string `json:"Notes"`
-			VoucherNumber   int    `json:"VoucherNumber"`
-			VoucherSeries   string `json:"VoucherSeries"`
-			VoucherYear     int    `json:"VoucherYear"`
-			SupplierInvoice int    `json:"SupplierInvoice"`
-		} `json:"History"`
-	} `json:"Assets"`
-}
-
 type WriteDownAssetReq struct {
 	Asset struct {
-		Amount  int    `json:"Amount"`
+		Amount  Money  `json:"Amount"`
 		Comment string `json:"Comment"`
-		Date    string `json:"Date"`
+		Date    Date   `json:"Date"`
 	} `json:"Asset"`
 }
 
-type WriteDownAssetResp struct {
-	Assets struct {
-		Url                       string `json:"@url"`
-		Id                        int    `json:"Id"`
-		Number                    string `json:"Number"`
-		Description               string `json:"Description"`
-		Status                    string `json:"Status"`
-		StatusId                  string `json:"StatusId"`
-		CostCenter                string `json:"CostCenter"`
-		Project                   string `json:"Project"`
-		Type                      string `json:"Type"`
-		TypeId                    int    `json:"TypeId"`
-		DepreciationMethod        int    `json:"DepreciationMethod"`
-		AcquisitionValue          int    `json:"AcquisitionValue"`
-		DepreciateToResidualValue int    `json:"DepreciateToResidualValue"`
-		AcquisitionDate           string `json:"AcquisitionDate"`
-		AcquisitionStart          string `json:"AcquisitionStart"`
-		DepreciationFinal         string `json:"DepreciationFinal"`
-		DepreciatedTo             string `json:"DepreciatedTo"`
-		ManualOb                  int    `json:"ManualOb"`
-		Notes                     string `json:"Notes"`
-		Reference                 string `json:"Reference"`
-		Brand                     string `json:"Brand"`
-		InsuredNumber             string `json:"InsuredNumber"`
-		InsuredWith               string `json:"InsuredWith"`
-		Group                     string `json:"Group"`
-		Room                      string `json:"Room"`
-		Placement                 string `json:"Placement"`
-		Department                string `json:"Department"`
-		History                   []struct {
-			Id              int    `json:"Id"`
-			Date            string `json:"Date"`
-			EventId         int    `json:"EventId"`
-			Amount          string `json:"Amount"`
-			UserId          int    `json:"UserId"`
-			UserName        string `json:"UserName"`
-			Notes           string `json:"Notes"`
-			VoucherNumber   int    `json:"VoucherNumber"`
-			VoucherSeries   string `json:"VoucherSeries"`
-			VoucherYear     int    `json:"VoucherYear"`
-			SupplierInvoice int    `json:"SupplierInvoice"`
-		} `json:"History"`
-	} `json:"Assets"`
-}
-
 type ScrapAssetReq struct {
 	Asset struct {
 		Percentage int    `json:"Percentage"`
 		Comment    string `json:"Comment"`
-		Date       string `json:"Date"`
+		Date       Date   `json:"Date"`
 	} `json:"Asset"`
 }
 
-type ScrapAssetResp struct {
-	Assets struct {
-		Url                       string `json:"@url"`
-		Id                        int    `json:"Id"`
-		Number                    string `json:"Number"`
-		Description               string `json:"Description"`
-		Status                    string `json:"Status"`
-		StatusId                  string `json:"StatusId"`
-		CostCenter                string `json:"CostCenter"`
-		Project                   string `json:"Project"`
-		Type                      string `json:"Type"`
-		TypeId                    int    `json:"TypeId"`
-		DepreciationMethod        int    `json:"DepreciationMethod"`
-		AcquisitionValue          int    `json:"AcquisitionValue"`
-		DepreciateToResidualValue int    `json:"DepreciateToResidualValue"`
-		AcquisitionDate           string `json:"AcquisitionDate"`
-		AcquisitionStart          string `json:"AcquisitionStart"`
-		DepreciationFinal         string `json:"DepreciationFinal"`
-		DepreciatedTo             string `json:"DepreciatedTo"`
-		ManualOb                  int    `json:"ManualOb"`
-		Notes                     string `json:"Notes"`
-		Reference                 string `json:"Reference"`
-		Brand                     string `json:"Brand"`
-		InsuredNumber             string `json:"InsuredNumber"`
-		InsuredWith               string `json:"InsuredWith"`
-		Group                     string `json:"Group"`
-		Room                      string `json:"Room"`
-		Placement                 string `json:"Placement"`
-		Department                string `json:"Department"`
-		History                   []struct {
-			Id              int    `json:"Id"`
-			Date            string `json:"Date"`
-			EventId         int    `json:"EventId"`
-			Amount          string `json:"Amount"`
-			UserId          int    `json:"UserId"`
-			UserName        string `json:"UserName"`
-			Notes           string `json:"Notes"`
-			VoucherNumber   int    `json:"VoucherNumber"`
-			VoucherSeries   string `json:"VoucherSeries"`
-			VoucherYear     int    `json:"VoucherYear"`
-			SupplierInvoice int    `json:"SupplierInvoice"`
-		} `json:"History"`
-	} `json:"Assets"`
-}
-
 type SellAssetReq struct {
 	Asset struct {
 		Percentage int    `json:"Percentage"`
-		Price      int    `json:"Price"`
+		Price      Money  `json:"Price"`
 		Comment    string `json:"Comment"`
-		Date       string `json:"Date"`
+		Date       Date   `json:"Date"`
 	} `json:"Asset"`
 }
 
-type SellAssetResp struct {
-	Assets struct {
-		Url                       string `json:"@url"`
-		Id                        int    `json:"Id"`
-		Number                    string `json:"Number"`
-		Description               string `json:"Description"`
-		Status                    string `json:"Status"`
-		StatusId                  string `json:"StatusId"`
-		CostCenter                string `json:"CostCenter"`
-		Project                   string `json:"Project"`
-		Type                      string `json:"Type"`
-		TypeId                    int    `json:"TypeId"`
-		DepreciationMethod        int    `json:"DepreciationMethod"`
-		AcquisitionValue          int    `json:"AcquisitionValue"`
-		DepreciateToResidualValue int    `json:"DepreciateToResidualValue"`
-		AcquisitionDate           string `json:"AcquisitionDate"`
-		AcquisitionStart          string `json:"AcquisitionStart"`
-		DepreciationFinal         string `json:"DepreciationFinal"`
-		DepreciatedTo             string `json:"DepreciatedTo"`
-		ManualOb                  int    `json:"ManualOb"`
-		Notes                     string `json:"Notes"`
-		Reference                 string `json:"Reference"`
-		Brand                     string `json:"Brand"`
-		InsuredNumber             string `json:"InsuredNumber"`
-		InsuredWith               string `json:"InsuredWith"`
-		Group                     string `json:"Group"`
-		Room                      string `json:"Room"`
-		Placement                 string `json:"Placement"`
-		Department                string `json:"Department"`
-		History                   []struct {
-			Id              int    `json:"Id"`
-			Date            string `json:"Date"`
-			EventId         int    `json:"EventId"`
-			Amount          string `json:"Amount"`
-			UserId          int    `json:"UserId"`
-			UserName        string `json:"UserName"`
-			Notes           string `json:"Notes"`
-			VoucherNumber   int    `json:"VoucherNumber"`
-			VoucherSeries   string `json:"VoucherSeries"`
-			VoucherYear     int    `json:"VoucherYear"`
-			SupplierInvoice int    `json:"SupplierInvoice"`
-		} `json:"History"`
-	} `json:"Assets"`
-}
-
 type PerformAssetDepreciationReq struct {
 	Asset struct {
-		DepreciateUntil string `json:"DepreciateUntil"`
-		AssetIds        []int  `json:"AssetIds"`
+		DepreciateUntil Date  `json:"DepreciateUntil"`
+		AssetIds        []int `json:"AssetIds"`
 	} `json:"Asset"`
 }
 
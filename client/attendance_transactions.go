@@ -4,18 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 )
 
 const (
 	attendanceTransactionsURI = "attendancetransactions"
 )
 
-const (
-	attendanceTransactionsEmployeeIDParamName = "employeeid"
-	attendanceTransactionsDateParamName       = "date"
-)
-
 var _AttendanceTransactionsCauseCodes = map[string]string{
 	"ARB": "Timlön",
 	"BE2": "Beredskapstid 2",
@@ -63,33 +57,57 @@ var _AttendanceTransactionsCauseCodes = map[string]string{
 // GetAllAttendanceTransactions does _GET https://api.fortnox.se/3/attendancetransactions
 //
 // filter - GetAllAttendanceTransactionsFilter
-func (c *Client) GetAllAttendanceTransactions(
+func (c *attendanceTransactionsService) GetAllAttendanceTransactions(
 	ctx context.Context,
 	filter *GetAllAttendanceTransactionsFilter) ([]AttendanceTransaction, error) {
+	return c.IterateAttendanceTransactions(filter).All(ctx)
+}
+
+// GetAttendanceTransactionsPage does _GET https://api.fortnox.se/3/attendancetransactions and returns a single page along with its MetaInformation
+//
+// filter - GetAllAttendanceTransactionsFilter
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *attendanceTransactionsService) GetAttendanceTransactionsPage(
+	ctx context.Context,
+	filter *GetAllAttendanceTransactionsFilter,
+	page *PageOptions) ([]AttendanceTransaction, *MetaInformation, error) {
 
 	resp := &GetAllAttendanceTransactionsResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, attendanceTransactionsURI, params, resp)
+	err = c._GETPage(ctx, getAttendanceTransactionsPageOp, attendanceTransactionsURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.AttendanceTransactions, nil
+	return resp.AttendanceTransactions, &resp.MetaInformation, nil
+}
+
+// IterateAttendanceTransactions lazily walks all pages of https://api.fortnox.se/3/attendancetransactions
+//
+// filter - GetAllAttendanceTransactionsFilter
+func (c *attendanceTransactionsService) IterateAttendanceTransactions(filter *GetAllAttendanceTransactionsFilter) *Iterator[AttendanceTransaction] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]AttendanceTransaction, *MetaInformation, error) {
+		return c.GetAttendanceTransactionsPage(ctx, filter, page)
+	})
 }
 
 // CreateAttendanceTransaction does _POST https://api.fortnox.se/3/attendancetransactions
 //
 // req - attendance transaction to create
-func (c *Client) CreateAttendanceTransaction(
+func (c *attendanceTransactionsService) CreateAttendanceTransaction(
 	ctx context.Context,
 	at *AttendanceTransaction) (*AttendanceTransaction, error) {
 
 	req := CreateAttendanceTransactionReq{AttendanceTransaction: *at}
 	resp := &CreateAttendanceTransactionResp{}
 
-	err := c._POST(ctx, attendanceTransactionsURI, nil, req, resp)
+	err := c._POST(ctx, createAttendanceTransactionOp, attendanceTransactionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -100,12 +118,12 @@ func (c *Client) CreateAttendanceTransaction(
 // GetAttendanceTransaction does _GET https://api.fortnox.se/3/attendancetransactions/{id}
 //
 // id - identifies the transaction
-func (c *Client) GetAttendanceTransaction(ctx context.Context, id string) (*AttendanceTransaction, error) {
+func (c *attendanceTransactionsService) GetAttendanceTransaction(ctx context.Context, id string) (*AttendanceTransaction, error) {
 	resp := &GetAttendanceTransactionResp{}
 
 	uri := fmt.Sprintf("%s/%s", attendanceTransactionsURI, id)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getAttendanceTransactionOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +134,7 @@ func (c *Client) GetAttendanceTransaction(ctx context.Context, id string) (*Atte
 // UpdateAttendanceTransaction does _PUT https://api.fortnox.se/3/attendancetransactions/{id}
 //
 // id - identifies the transaction
-func (c *Client) UpdateAttendanceTransaction(
+func (c *attendanceTransactionsService) UpdateAttendanceTransaction(
 	ctx context.Context,
 	id string,
 	at *AttendanceTransaction) (*AttendanceTransaction, error) {
@@ -126,7 +144,7 @@ func (c *Client) UpdateAttendanceTransaction(
 
 	uri := fmt.Sprintf("%s/%s", attendanceTransactionsURI, id)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateAttendanceTransactionOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -136,27 +154,13 @@ func (c *Client) UpdateAttendanceTransaction(
 
 type GetAllAttendanceTransactionsFilter struct {
 	// filter by employee id
-	employeeID string
+	EmployeeID string `fortnox:"employeeid"`
 	// filter by date
-	date string
+	Date Date `fortnox:"date"`
 }
 
-func (f *GetAllAttendanceTransactionsFilter) urlValues() url.Values {
-	if f == nil {
-		return nil
-	}
-
-	params := url.Values{}
-
-	if strings.TrimSpace(f.employeeID) != "" {
-		params[attendanceTransactionsEmployeeIDParamName] = []string{f.employeeID}
-	}
-
-	if strings.TrimSpace(f.employeeID) != "" {
-		params[attendanceTransactionsDateParamName] = []string{f.date}
-	}
-
-	return params
+func (f *GetAllAttendanceTransactionsFilter) urlValues() (url.Values, error) {
+	return encodeQuery(f)
 }
 
 type AttendanceTransaction struct {
@@ -164,7 +168,7 @@ type AttendanceTransaction struct {
 	Id         string `json:"id,omitempty,omitempty"`
 	EmployeeId string `json:"EmployeeId,omitempty"`
 	CauseCode  string `json:"CauseCode,omitempty"`
-	Date       string `json:"Date,omitempty"`
+	Date       Date   `json:"Date,omitempty"`
 	Hours      string `json:"Hours,omitempty"`
 	CostCenter string `json:"CostCenter,omitempty"`
 	Project    string `json:"Project,omitempty"`
@@ -172,6 +176,7 @@ type AttendanceTransaction struct {
 
 type GetAllAttendanceTransactionsResp struct {
 	AttendanceTransactions []AttendanceTransaction `json:"AttendanceTransactions"`
+	MetaInformation        MetaInformation         `json:"MetaInformation"`
 }
 
 type CreateAttendanceTransactionReq struct {
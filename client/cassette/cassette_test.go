@@ -0,0 +1,185 @@
+package cassette
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+const employeeBody = `{"Employee":{"EmployeeId":"7","PersonalIdentityNumber":"19811218-9876","FirstName":"Anna","ClearingNo":"8327","BankAccountNo":"9234567"}}`
+
+func TestRecordReplay(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.URL.Path != "/3/employees/7" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, employeeBody)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "employees.json")
+
+	rec, err := New(path, Record)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := client.NewClient(
+		client.WithAuthOpt("access-token", "client-secret"),
+		client.WithURLOpt(server.URL+"/3/"),
+		client.WithHTTPClientOpt(rec.HTTPClient()))
+
+	recorded, err := c.GetEmployee(context.Background(), "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the caller gets the response as it was sent
+	if recorded.PersonalIdentityNumber != "19811218-9876" || recorded.BankAccountNo != "9234567" {
+		t.Errorf("recorded response was scrubbed: %+v", recorded)
+	}
+
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"access-token", "client-secret", "19811218-9876", "8327", "9234567"} {
+		if strings.Contains(string(bts), secret) {
+			t.Errorf("cassette contains %q", secret)
+		}
+	}
+	if !strings.Contains(string(bts), "Anna") {
+		t.Errorf("cassette lost fields without personal data:\n%s", bts)
+	}
+
+	rec, err = New(path, Replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c = client.NewClient(
+		client.WithAuthOpt("other-token", "other-secret"),
+		client.WithURLOpt(server.URL+"/3/"),
+		client.WithHTTPClientOpt(rec.HTTPClient()))
+
+	replayed, err := c.GetEmployee(context.Background(), "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if replayed.FirstName != "Anna" || replayed.PersonalIdentityNumber != redactedValue {
+		t.Errorf("replayed employee = %+v", replayed)
+	}
+	if requests != 1 {
+		t.Errorf("server got %d requests, want 1", requests)
+	}
+	if unplayed := rec.Unplayed(); len(unplayed) != 0 {
+		t.Errorf("unplayed = %v", unplayed)
+	}
+
+	_, err = rec.HTTPClient().Get(server.URL + "/3/employees/7")
+	if !errors.Is(err, ErrNoMatch) {
+		t.Errorf("second replay error = %v, want ErrNoMatch", err)
+	}
+}
+
+func TestScrubBody(t *testing.T) {
+	jsonHeader := http.Header{"Content-Type": {"application/json"}}
+	formHeader := http.Header{"Content-Type": {"application/x-www-form-urlencoded"}}
+
+	tests := []struct {
+		name   string
+		header http.Header
+		body   string
+		want   string
+	}{
+		{
+			"token response",
+			jsonHeader,
+			`{"access_token":"a","refresh_token":"r","scope":"invoice","expires_in":3600}`,
+			`{"access_token":"[REDACTED]","expires_in":3600,"refresh_token":"[REDACTED]","scope":"invoice"}`,
+		},
+		{
+			"no personal data is kept as is",
+			jsonHeader,
+			`{"CostCenter":{"Code":"ABC","Description":"Sales"},"Supplier":{"BG":"123-4567","Bank":"SEB"}}`,
+			`{"CostCenter":{"Code":"ABC","Description":"Sales"},"Supplier":{"BG":"123-4567","Bank":"SEB"}}`,
+		},
+		{
+			"personal identity numbers outside personal data fields",
+			jsonHeader,
+			`{"Invoice":{"OCR":"198112189875","YourReference":"Anna 811218-9876"}}`,
+			`{"Invoice":{"OCR":"198112189875","YourReference":"Anna [REDACTED]"}}`,
+		},
+		{
+			"sole trader",
+			jsonHeader,
+			`{"Customers":[{"Name":"Anna","OrganisationNumber":"811218-9876"}]}`,
+			`{"Customers":[{"Name":"Anna","OrganisationNumber":"[REDACTED]"}]}`,
+		},
+		{
+			"supplier bank details",
+			jsonHeader,
+			`{"Supplier":{"BankAccountNumber":"9234567","BIC":"ESSESESS","ClearingNumber":"8327","IBAN":"SE4550000000058398257466","Name":"Acme"}}`,
+			`{"Supplier":{"BIC":"[REDACTED]","BankAccountNumber":"[REDACTED]","ClearingNumber":"[REDACTED]","IBAN":"[REDACTED]","Name":"Acme"}}`,
+		},
+		{
+			"authorization code form",
+			formHeader,
+			`code=abc&grant_type=authorization_code&redirect_uri=https%3A%2F%2Fexample.com`,
+			`code=%5BREDACTED%5D&grant_type=authorization_code&redirect_uri=https%3A%2F%2Fexample.com`,
+		},
+		{
+			"search form",
+			formHeader,
+			`name=Anna&organisationnumber=19811218-9876`,
+			`name=Anna&organisationnumber=%5BREDACTED%5D`,
+		},
+		{
+			"code outside forms",
+			jsonHeader,
+			`{"ErrorInformation":{"code":2000359,"message":"Ogiltigt värde"}}`,
+			`{"ErrorInformation":{"code":2000359,"message":"Ogiltigt värde"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(scrubBody(tt.header, []byte(tt.body))); got != tt.want {
+				t.Errorf("scrubBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScrubURL(t *testing.T) {
+	u, err := url.Parse("https://apps.fortnox.se/oauth-v1/callback?state=s&code=abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := scrubURL(&http.Request{URL: u}), "/oauth-v1/callback?code=%5BREDACTED%5D&state=s"; got != want {
+		t.Errorf("scrubURL() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,202 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Mode is whether a Recorder records or replays
+type Mode int
+
+const (
+	// Replay serves the interactions of an existing cassette and fails requests that weren't recorded
+	Replay Mode = iota
+	// Record sends requests to Fortnox and records the interactions, the cassette is written on Stop
+	Record
+)
+
+// ErrNoMatch is returned, wrapped, in Replay mode for a request that matches no unplayed interaction
+var ErrNoMatch = errors.New("cassette: no recorded interaction matches the request")
+
+// Recorder is an http.RoundTripper recording or replaying a cassette, safe for concurrent use
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+	played   []bool
+}
+
+// Option configures a Recorder
+type Option func(r *Recorder)
+
+// WithTransport sets the RoundTripper requests are sent with in Record mode, http.DefaultTransport by default
+func WithTransport(t http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = t
+	}
+}
+
+// New returns a Recorder of the cassette at path, which must exist in Replay mode
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		cassette:  &Cassette{},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == Replay {
+		c, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		r.cassette = c
+		r.played = make([]bool, len(c.Interactions))
+	}
+
+	return r, nil
+}
+
+// HTTPClient returns an http.Client sending requests through the Recorder, to pass to client.WithHTTPClientOpt
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop writes the recorded cassette in Record mode, in Replay mode it does nothing
+func (r *Recorder) Stop() error {
+	if r.mode != Record {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// Unplayed returns the recorded requests that haven't been replayed yet,
+// a test can check it is empty to make sure the code sent every request it used to
+func (r *Recorder) Unplayed() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unplayed []Request
+	for i, played := range r.played {
+		if !played {
+			unplayed = append(unplayed, r.cassette.Interactions[i].Request)
+		}
+	}
+
+	return unplayed
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		bts, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "cassette: can't read request body")
+		}
+		body = bts
+	}
+
+	recorded := Request{
+		Method: req.Method,
+		URL:    scrubURL(req),
+		Header: scrubHeader(req.Header),
+		Body:   newBody(scrubBody(req.Header, body)),
+	}
+
+	if r.mode == Replay {
+		return r.replay(req, recorded)
+	}
+
+	return r.record(req, recorded, body)
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request, body []byte) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "cassette: can't read response body")
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeader(resp.Header),
+			Body:       newBody(scrubBody(resp.Header, respBody)),
+		},
+	})
+	r.mu.Unlock()
+
+	// the caller gets the response as Fortnox sent it, only the cassette is scrubbed
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+
+		body, err := interaction.Response.Body.Bytes()
+		if err != nil {
+			return nil, errors.Wrap(err, "cassette: can't decode response body")
+		}
+
+		r.played[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, errors.Wrapf(ErrNoMatch, "%s %s", recorded.Method, recorded.URL)
+}
+
+// matches reports whether a request is the recorded one, both scrubbed
+func matches(recorded, req Request) bool {
+	return recorded.Method == req.Method &&
+		recorded.URL == req.URL &&
+		recorded.Body == req.Body
+}
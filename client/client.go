@@ -3,13 +3,17 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,10 +26,6 @@ const (
 	defaultRateLimit = 4 // 4 rps
 )
 
-const (
-	refreshTokenURL = "https://apps.fortnox.se/oauth-v1/token"
-)
-
 const (
 	DefaultURL = "https://api.fortnox.se/3/"
 	TestURL    = "https://api.fortnox.se/3/test/"
@@ -33,6 +33,8 @@ const (
 
 const (
 	defaultTimeout = 10 * time.Second
+	// refreshLeeway is how long before expiry the access token is refreshed proactively
+	refreshLeeway = time.Minute
 )
 
 var (
@@ -44,7 +46,14 @@ var (
 )
 
 type Client struct {
+	Services
+
 	clientOptions *Options
+	creds         *credentials
+	// limiterKey is the key of the shared rate limiter, empty if the limiter lives on creds
+	limiterKey string
+	logger     *slog.Logger
+	handler    Handler
 }
 
 func NewClient(options ...OptionFunc) *Client {
@@ -53,22 +62,98 @@ func NewClient(options ...OptionFunc) *Client {
 	}
 
 	co := &Options{
-		BaseURL:    DefaultURL,
-		HTTPClient: c,
+		BaseURL:     DefaultURL,
+		TokenURL:    postToken,
+		RevokeURL:   revokeToken,
+		HTTPClient:  c,
+		RateLimit:   defaultRateLimit,
+		RateBurst:   defaultRateBurst,
+		RetryPolicy: DefaultRetryPolicy,
 	}
 
 	for _, f := range options {
 		f(co)
 	}
 
-	return &Client{
+	if cc, ok := co.TokenSource.(*ClientCredentials); ok && co.TenantID == "" {
+		co.TenantID = cc.TenantID
+	}
+
+	cl := &Client{
 		clientOptions: co,
+		creds:         newCredentials(co.AccessToken, co.RefreshToken),
+		limiterKey:    rateLimiterKey(co),
+		logger:        newLogger(co.Logger),
 	}
+	cl.Services = newServices(cl)
+	cl.handler = chain(cl.execute, co.Middlewares)
+
+	return cl
 }
 
+// String describes the client without revealing its secrets
 func (c *Client) String() string {
+	accessToken, refreshToken := c.creds.get()
 	return fmt.Sprintf("AccessToken: %s, RefreshToken: %s, ClientSecret:%s, BaseURL: %s",
-		c.clientOptions.AccessToken, c.clientOptions.RefreshToken, c.clientOptions.ClientSecret, c.clientOptions.BaseURL)
+		redactSecret(accessToken), redactSecret(refreshToken), redactSecret(c.clientOptions.ClientSecret), c.clientOptions.BaseURL)
+}
+
+func redactSecret(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	return redactedValue
+}
+
+// RateLimitHeadroom returns how many requests can be sent right now without waiting for the rate limiter
+func (c *Client) RateLimitHeadroom() float64 {
+	return c.rateLimiter().Headroom()
+}
+
+// rateLimiter returns the limiter shared by all Clients of the same tenant,
+// or of the same token or client ID when no tenant is set
+func (c *Client) rateLimiter() *RateLimiter {
+	co := c.clientOptions
+
+	if co.RateLimiter != nil {
+		return co.RateLimiter
+	}
+
+	if co.RateLimit <= 0 {
+		return nil
+	}
+
+	// nothing identifies the account, the limiter lives as long as the token pair
+	if c.limiterKey == "" {
+		return c.creds.rateLimiter(co.RateLimit, co.RateBurst)
+	}
+
+	return sharedRateLimiter(c.limiterKey, co.RateLimit, co.RateBurst)
+}
+
+// rateLimiterKey identifies the account Fortnox counts the requests of a new Client against:
+// its tenant, otherwise the token it was created with, otherwise its client ID.
+// The key of a token is taken once, so a Client keeps its limiter when the token is refreshed.
+func rateLimiterKey(co *Options) string {
+	switch {
+	case co.TenantID != "":
+		return "tenant:" + co.TenantID
+	case co.AccessToken != "":
+		return "token:" + hashSecret(co.AccessToken)
+	case co.RefreshToken != "":
+		return "token:" + hashSecret(co.RefreshToken)
+	case co.ClientID != "":
+		return "client:" + co.ClientID
+	default:
+		return ""
+	}
+}
+
+// hashSecret keeps secrets out of the keys of long lived maps
+func hashSecret(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
 }
 
 func (c *Client) buildURL(section string) (*url.URL, error) {
@@ -85,32 +170,77 @@ func (c *Client) buildURL(section string) (*url.URL, error) {
 	return u.ResolveReference(u2), nil
 }
 
-func (c *Client) _GET(ctx context.Context, uri string, params url.Values, resp interface{}) error {
-	return c.request(ctx, http.MethodGet, uri, params, nil, resp)
+func (c *Client) _GET(ctx context.Context, operation, uri string, params url.Values, resp interface{}) error {
+	return c.request(ctx, operation, http.MethodGet, uri, params, nil, resp)
 }
 
-func (c *Client) _POST(ctx context.Context, uri string, params url.Values, body, resp interface{}) error {
-	return c.request(ctx, http.MethodPost, uri, params, body, resp)
+// _GETPage does _GET for a single page of a list resource, page params are merged into params
+func (c *Client) _GETPage(
+	ctx context.Context,
+	operation, uri string,
+	params url.Values,
+	page *PageOptions,
+	resp interface{}) error {
+
+	params, err := page.mergeURLValues(params)
+	if err != nil {
+		return err
+	}
+
+	return c.request(ctx, operation, http.MethodGet, uri, params, nil, resp)
+}
+
+// _GETDownload does _GET for a binary resource, the returned Download must be closed
+func (c *Client) _GETDownload(ctx context.Context, operation, uri string, params url.Values) (*Download, error) {
+	raw := &rawResponse{}
+
+	err := c.request(ctx, operation, http.MethodGet, uri, params, nil, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDownload(raw.resp), nil
+}
+
+func (c *Client) _POST(ctx context.Context, operation, uri string, params url.Values, body, resp interface{}) error {
+	return c.request(ctx, operation, http.MethodPost, uri, params, body, resp)
 }
 
-func (c *Client) _PUT(ctx context.Context, uri string, params url.Values, body, resp interface{}) error {
-	return c.request(ctx, http.MethodPut, uri, params, body, resp)
+func (c *Client) _PUT(ctx context.Context, operation, uri string, params url.Values, body, resp interface{}) error {
+	return c.request(ctx, operation, http.MethodPut, uri, params, body, resp)
 }
 
-func (c *Client) _DELETE(ctx context.Context, uri string) error {
-	return c.request(ctx, http.MethodDelete, uri, nil, nil, nil)
+func (c *Client) _DELETE(ctx context.Context, operation, uri string) error {
+	return c.request(ctx, operation, http.MethodDelete, uri, nil, nil, nil)
 }
 
-func (c *Client) _DELETEWithResult(ctx context.Context, uri string, resp interface{}) error {
-	return c.request(ctx, http.MethodDelete, uri, nil, nil, resp)
+func (c *Client) _DELETEWithResult(ctx context.Context, operation, uri string, resp interface{}) error {
+	return c.request(ctx, operation, http.MethodDelete, uri, nil, nil, resp)
 }
 
+// request passes the call through the middlewares, operation is the Client method making it, see the xxxOp constants
 func (c *Client) request(
 	ctx context.Context,
+	operation string,
 	method string,
 	uri string,
 	params url.Values,
 	body, result interface{}) error {
+
+	return c.handler(ctx, &Call{
+		Operation: operation,
+		Method:    method,
+		URI:       uri,
+		Params:    params,
+		Body:      body,
+		Result:    result,
+	})
+}
+
+// execute is the innermost Handler, it sends call refreshing the access token when needed
+func (c *Client) execute(ctx context.Context, call *Call) error {
+	method, uri, params, body, result := call.Method, call.URI, call.Params, call.Body, call.Result
+
 	u, err := c.buildURL(uri)
 	if err != nil {
 		return err
@@ -120,119 +250,314 @@ func (c *Client) request(
 		u.RawQuery = params.Encode()
 	}
 
-	headers := map[string]string{
-		"Authorization": fmt.Sprintf("Bearer %s", c.clientOptions.AccessToken),
-		"Client-Secret": c.clientOptions.ClientSecret,
+	if accessToken, _ := c.creds.get(); accessToken == "" && c.clientOptions.TokenStore != nil && c.clientOptions.TokenSource == nil {
+		err = c.LoadToken(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the body is kept as bytes, so it can be sent again on retry and after refresh
+	var data requestBody = jsonBody(nil)
+	if upload, ok := body.(*fileUpload); ok {
+		data = upload
+	} else if strings.ToLower(method) != "delete" {
+		bodyBuffer := &bytes.Buffer{}
+		err = json.NewEncoder(bodyBuffer).Encode(body)
+		if err != nil {
+			return err
+		}
+		data = jsonBody(bodyBuffer.Bytes())
 	}
 
-	if strings.ToLower(method) == "delete" {
-		bodyBuffer := http.NoBody
+	if c.clientOptions.TokenSource != nil {
+		return c.sendWithTokenSource(ctx, method, u.String(), data, result)
+	}
 
-		if !c.clientOptions.AutoRefreshToken {
-			return request(ctx, c.clientOptions.HTTPClient, headers, method, u.String(), bodyBuffer, result)
+	if !c.clientOptions.AutoRefreshToken {
+		return c.send(ctx, method, u.String(), data, result)
+	}
+
+	if c.creds.expiresWithin(refreshLeeway) {
+		c.logger.DebugContext(ctx, "fortnox access token expires soon", "expires_at", c.creds.getExpiresAt())
+		accessToken, _ := c.creds.get()
+		err = c.creds.refresh(ctx, accessToken, c.refreshToken)
+		// a transient failure is fine as long as the current token has not expired yet
+		if err != nil && (errors.Is(err, ErrRefreshFailed) || c.creds.expiresWithin(0)) {
+			return err
 		}
+	}
+
+	usedAccessToken, _ := c.creds.get()
 
-		err := request(ctx, c.clientOptions.HTTPClient, headers, method, u.String(), bodyBuffer, result)
-		ferr := &FortnoxError{}
-		if errors.As(err, ferr) {
-			if ferr.Code == 0 && ferr.Message == ErrAccessTokenSE.Error() {
-				fmt.Println("ErrAccessTokenSE | Going to RefreshToken")
-				err := c.RefreshToken()
-				if err != nil {
-					return err
-				}
-				fmt.Println("AFTER REFRESH")
-				fmt.Printf("AccessToken:%s\n", c.clientOptions.AccessToken)
-				fmt.Printf("RefreshToken:%s\n", c.clientOptions.RefreshToken)
-
-				return request(ctx, c.clientOptions.HTTPClient, headers, method, u.String(), bodyBuffer, result)
+	err = c.send(ctx, method, u.String(), data, result)
+	ferr := &FortnoxError{}
+	if errors.As(err, ferr) {
+		if ferr.HTTPStatus == http.StatusUnauthorized {
+			c.logger.InfoContext(ctx, "fortnox rejected access token", "method", method, "uri", uri)
+			rerr := c.creds.refresh(ctx, usedAccessToken, c.refreshToken)
+			if rerr != nil {
+				return rerr
 			}
-		}
 
-		return err
+			if !data.replayable() {
+				return fmt.Errorf("%w: %w", ErrUploadNotReplayable, err)
+			}
+
+			return c.send(ctx, method, u.String(), data, result)
+		}
 	}
 
-	bodyBuffer := &bytes.Buffer{}
-	err = json.NewEncoder(bodyBuffer).Encode(body)
+	return err
+}
+
+// sendWithTokenSource sends the request with the token of the TokenSource,
+// a rejected token is expired and the request sent once more when the source supports it
+func (c *Client) sendWithTokenSource(
+	ctx context.Context,
+	method string,
+	url string,
+	body requestBody,
+	result interface{}) error {
+
+	src := c.clientOptions.TokenSource
+	token, err := src.Token(ctx)
 	if err != nil {
 		return err
 	}
 
-	if !c.clientOptions.AutoRefreshToken {
-		return request(ctx, c.clientOptions.HTTPClient, headers, method, u.String(), bodyBuffer, result)
+	err = c.send(ctx, method, url, body, result)
+	ferr := &FortnoxError{}
+	if expirer, ok := src.(TokenExpirer); ok && errors.As(err, ferr) && ferr.HTTPStatus == http.StatusUnauthorized {
+		c.logger.InfoContext(ctx, "fortnox rejected access token", "method", method, "url", url)
+		expirer.Expire(token.AccessToken)
+
+		if !body.replayable() {
+			return fmt.Errorf("%w: %w", ErrUploadNotReplayable, err)
+		}
+
+		return c.send(ctx, method, url, body, result)
 	}
 
-	err = request(ctx, c.clientOptions.HTTPClient, headers, method, u.String(), bodyBuffer, result)
-	ferr := &FortnoxError{}
-	if errors.As(err, ferr) {
-		if ferr.Code == 0 && ferr.Message == ErrAccessTokenSE.Error() {
-			fmt.Println("ErrAccessTokenSE | Going to RefreshToken")
-			err := c.RefreshToken()
-			if err != nil {
-				return err
+	return err
+}
+
+// send sends the request, waiting for the rate limiter before every attempt
+// and retrying transient failures according to the client's RetryPolicy
+func (c *Client) send(
+	ctx context.Context,
+	method string,
+	url string,
+	body requestBody,
+	result interface{}) error {
+
+	policy := c.clientOptions.RetryPolicy
+	retryAllowed := isRetryAllowed(ctx, method, url)
+
+	httpClient := c.clientOptions.HTTPClient
+	raw, isRaw := result.(*rawResponse)
+	if isRaw {
+		httpClient = downloadClient(httpClient)
+	}
+
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		if err := c.rateLimiter().Wait(ctx); err != nil {
+			return err
+		}
+
+		accessToken, err := c.accessToken(ctx)
+		if err != nil {
+			return err
+		}
+
+		// opened last, an upload starts streaming into its pipe right away
+		data, contentType, err := body.open()
+		if err != nil {
+			// a streamed upload can't be retried, report why the last attempt failed
+			if lastErr != nil && errors.Is(err, ErrUploadNotReplayable) {
+				return lastErr
 			}
+			return err
+		}
+		headers := map[string]string{
+			"Authorization": fmt.Sprintf("Bearer %s", accessToken),
+			"Client-Secret": c.clientOptions.ClientSecret,
+		}
+		if contentType != "" {
+			headers["Content-Type"] = contentType
+		}
 
-			fmt.Println("AFTER REFRESH")
-			fmt.Printf("AccessToken:%s\n", c.clientOptions.AccessToken)
-			fmt.Printf("RefreshToken:%s\n", c.clientOptions.RefreshToken)
+		// redacting the body is only worth it when it is logged
+		if c.logger.Enabled(ctx, slog.LevelDebug) {
+			c.logger.DebugContext(ctx, "fortnox request",
+				"method", method, "url", url, "attempt", attempt, "body", logValue(body))
+		}
 
-			return request(ctx, c.clientOptions.HTTPClient, headers, method, u.String(), bodyBuffer, result)
+		start := time.Now()
+		if isRaw {
+			err = c.download(ctx, httpClient, headers, method, url, data, raw)
+		} else {
+			err = request(ctx, httpClient, headers, method, url, data, result)
+		}
+		if err == nil {
+			c.logger.DebugContext(ctx, "fortnox response",
+				"method", method, "url", url, "duration", time.Since(start))
+			return nil
+		}
+
+		if !retryAllowed || attempt >= policy.MaxAttempts || !isRetryableError(err) {
+			c.logger.DebugContext(ctx, "fortnox request failed",
+				"method", method, "url", url, "duration", time.Since(start), "error", err)
+			return err
+		}
+
+		lastErr = err
+		delay := policy.backoff(attempt, err)
+		c.logger.WarnContext(ctx, "retrying fortnox request",
+			"method", method, "url", url, "attempt", attempt, "delay", delay, "error", err)
+
+		if err := sleepCtx(ctx, delay); err != nil {
+			return err
 		}
 	}
+}
 
-	return err
+// download does request for a Download, the Timeout of the client's http.Client bounds
+// the wait for the response headers and every read of the body rather than the whole transfer
+func (c *Client) download(
+	ctx context.Context,
+	httpClient *http.Client,
+	headers map[string]string,
+	method string,
+	url string,
+	data io.Reader,
+	raw *rawResponse) error {
+
+	var timeout time.Duration
+	if hc := c.clientOptions.HTTPClient; hc != nil {
+		timeout = hc.Timeout
+	}
+
+	watchCtx, watch := watchIdle(ctx, timeout)
+
+	err := request(watchCtx, httpClient, headers, method, url, data, raw)
+	if err != nil {
+		watch.stop()
+		return watch.wrap(err)
+	}
+
+	watch.reset()
+	raw.resp.Body = watch.body(raw.resp.Body)
+
+	return nil
 }
 
-// GetAuthCodeLink requires manual authentication
+const (
+	authURL     = "https://apps.fortnox.se/oauth-v1/auth"
+	postToken   = "https://apps.fortnox.se/oauth-v1/token"
+	revokeToken = "https://apps.fortnox.se/oauth-v1/revoke"
+)
+
+// GetAuthCodeLink requires manual authentication, ScopesFor returns the scopes to request for the methods used.
+// The state is not random, use the oauth package to start the flow with a state that is verified on callback.
 func GetAuthCodeLink(clientID, redirectURI string, scopes Scopes, isService bool) string {
-	state := time.Now().Unix()
+	return AuthCodeURL(authURL, clientID, redirectURI, strconv.FormatInt(time.Now().Unix(), 10), scopes, isService)
+}
 
-	var authCodeURL string
+// AuthCodeURL returns the link at baseURL the user authorizes the integration on,
+// isService requests a service account (account_type=service) rather than one tied to the user
+func AuthCodeURL(baseURL, clientID, redirectURI, state string, scopes Scopes, isService bool) string {
+	q := url.Values{}
+	q.Set("client_id", clientID)
+	q.Set("redirect_uri", redirectURI)
+	q.Set("scope", strings.Join(scopes.toStrings(), " "))
+	q.Set("state", state)
+	q.Set("access_type", "offline")
+	q.Set("response_type", "code")
 	if isService {
-		authCodeURL = fmt.Sprintf("https://apps.fortnox.se/oauth-v1/auth?client_id=%s&redirect_uri=%s&scope=%s&state=%d&access_type=offline&response_type=code",
-			clientID,
-			redirectURI,
-			scopes.toString(),
-			state,
-		)
-	} else {
-		authCodeURL = fmt.Sprintf("https://apps.fortnox.se/oauth-v1/auth?client_id=%s&redirect_uri=%s&scope=%s&state=%d&access_type=offline&response_type=code&account_type=service",
-			clientID,
-			redirectURI,
-			scopes.toString(),
-			state,
-		)
+		q.Set("account_type", "service")
 	}
 
-	return authCodeURL
+	// scopes are separated by %20, a literal + is escaped as %2B so it can't be confused with a space
+	return baseURL + "?" + strings.ReplaceAll(q.Encode(), "+", "%20")
 }
 
-const postToken = "https://apps.fortnox.se/oauth-v1/token"
-
+// Authorize exchanges the authorization code Fortnox redirected the user back with for a token pair
 func Authorize(clientID, clientSecret, authCode, redirectURI string) (*TokenInfo, error) {
-	body := &bytes.Buffer{}
+	return AuthorizeContext(context.Background(), nil, postToken, clientID, clientSecret, authCode, redirectURI)
+}
+
+// AuthorizeContext exchanges authCode for a token pair at tokenURL using httpClient, a nil httpClient uses one with the default timeout
+func AuthorizeContext(
+	ctx context.Context,
+	httpClient *http.Client,
+	tokenURL, clientID, clientSecret, authCode, redirectURI string) (*TokenInfo, error) {
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", authCode)
+	form.Set("redirect_uri", redirectURI)
+
+	return tokenRequest(ctx, httpClient, tokenURL, clientID, clientSecret, form, nil)
+}
 
-	body.WriteString(
-		fmt.Sprintf("grant_type=authorization_code&code=%s&redirect_uri=%s", authCode, redirectURI),
-	)
+// tokenRequest posts form to tokenURL authenticated with the client credentials, header is added to the request
+func tokenRequest(
+	ctx context.Context,
+	httpClient *http.Client,
+	tokenURL, clientID, clientSecret string,
+	form url.Values,
+	header http.Header) (*TokenInfo, error) {
+
+	bts, err := postOAuthForm(ctx, httpClient, tokenURL, clientID, clientSecret, form, header)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInfo := TokenInfo{}
+	err = json.Unmarshal(bts, &tokenInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenInfo.AccessToken == "" {
+		return nil, &OAuthError{HTTPStatus: http.StatusOK, Description: "no access token in response"}
+	}
+
+	return &tokenInfo, nil
+}
+
+// postOAuthForm posts form to an OAuth endpoint authenticated with the client credentials
+// and returns the response body, a response other than 200 OK is returned as an *OAuthError
+func postOAuthForm(
+	ctx context.Context,
+	httpClient *http.Client,
+	endpoint, clientID, clientSecret string,
+	form url.Values,
+	header http.Header) ([]byte, error) {
 
 	data := fmt.Sprintf("%s:%s", clientID, clientSecret)
 	encoded := base64.StdEncoding.EncodeToString([]byte(data))
-	fmt.Println("encoded: ", encoded)
 
-	req, err := http.NewRequest("POST", postToken, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, err
 	}
 
+	for k, v := range header {
+		req.Header[k] = v
+	}
 	req.Header.Add("Authorization", fmt.Sprintf("Basic %s", encoded))
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
-	c := http.Client{
-		Timeout: defaultTimeout,
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: defaultTimeout,
+		}
 	}
 
-	resp, err := c.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -243,61 +568,112 @@ func Authorize(clientID, clientSecret, authCode, redirectURI string) (*TokenInfo
 		return nil, err
 	}
 
-	tokenInfo := TokenInfo{}
-	err = json.Unmarshal(bts, &tokenInfo)
-	if err != nil {
-		return nil, err
+	if resp.StatusCode != http.StatusOK {
+		return nil, newOAuthError(resp.StatusCode, bts)
 	}
 
-	return &tokenInfo, nil
+	return bts, nil
 }
 
+// RefreshToken exchanges the refresh token for a new token pair.
+// Concurrent calls, also from copies of the Client, share a single refresh.
 func (c *Client) RefreshToken() error {
-	body := &bytes.Buffer{}
+	accessToken, _ := c.creds.get()
+	return c.creds.refresh(context.Background(), accessToken, c.refreshToken)
+}
 
-	body.WriteString(
-		fmt.Sprintf("grant_type=refresh_token&refresh_token=%s", c.clientOptions.RefreshToken),
-	)
+// refreshToken must only be called through credentials.refresh, which guarantees a single refresh in flight
+func (c *Client) refreshToken(ctx context.Context, refreshToken string) (err error) {
+	c.logger.InfoContext(ctx, "refreshing fortnox access token")
+	defer func() {
+		if err != nil {
+			c.logger.ErrorContext(ctx, "fortnox token refresh failed", "error", err)
+		}
+	}()
 
-	data := fmt.Sprintf("%s:%s", c.clientOptions.ClientID, c.clientOptions.ClientSecret)
-	encoded := base64.StdEncoding.EncodeToString([]byte(data))
-	fmt.Println("encoded: ", encoded)
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+
+	bts, err := postOAuthForm(ctx, c.clientOptions.HTTPClient, c.clientOptions.TokenURL,
+		c.clientOptions.ClientID, c.clientOptions.ClientSecret, form, nil)
 
-	req, err := http.NewRequest("POST", refreshTokenURL, body)
+	oerr := &OAuthError{}
+	if errors.As(err, &oerr) && (oerr.HTTPStatus == http.StatusBadRequest || oerr.HTTPStatus == http.StatusUnauthorized) {
+		return &RefreshError{OAuthError: *oerr}
+	}
 	if err != nil {
 		return err
 	}
 
-	req.Header.Add("Authorization", fmt.Sprintf("Basic %s", encoded))
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	tokenInfo := TokenInfo{}
+	err = json.Unmarshal(bts, &tokenInfo)
+	if err != nil {
+		return err
+	}
 
-	cc := http.Client{
-		Timeout: defaultTimeout,
+	if tokenInfo.AccessToken == "" {
+		return &RefreshError{OAuthError: OAuthError{HTTPStatus: http.StatusOK, Description: "no access token in response"}}
 	}
 
-	resp, err := cc.Do(req)
-	if err != nil {
-		return err
+	// a refreshed token keeps the granted scopes, Fortnox doesn't always repeat them
+	if tokenInfo.Scope == "" {
+		tokenInfo.Scope = c.creds.getScope()
 	}
-	defer resp.Body.Close()
 
-	bts, err := ioutil.ReadAll(resp.Body)
+	// StoreToken takes the refreshed scope into use with setScope, so required scopes are checked against it
+	err = c.StoreToken(ctx, &tokenInfo)
 	if err != nil {
 		return err
 	}
 
-	tokenInfo := TokenInfo{}
-	err = json.Unmarshal(bts, &tokenInfo)
+	c.logger.InfoContext(ctx, "fortnox access token refreshed", "expires_at", tokenInfo.ExpiresAt)
+
+	if c.clientOptions.OnTokenRefreshed != nil {
+		c.clientOptions.OnTokenRefreshed(ctx, &tokenInfo)
+	}
+
+	return nil
+}
+
+// StoreToken persists token in the configured TokenStore and then takes it into use.
+// Use it to hand the TokenInfo returned by Authorize to the client.
+//
+// The token is used even if persisting fails, since Fortnox has already invalidated
+// the previous refresh token, the error is returned so the caller can alert on it.
+func (c *Client) StoreToken(ctx context.Context, token *TokenInfo) error {
+	if token.ExpiresAt.IsZero() && token.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	var err error
+	if c.clientOptions.TokenStore != nil {
+		err = c.clientOptions.TokenStore.Save(ctx, token)
+		if err != nil {
+			err = errors.Wrap(err, "failed to persist token")
+		}
+	}
+
+	c.creds.set(token.AccessToken, token.RefreshToken, token.ExpiresAt)
+	c.creds.setScope(token.Scope)
+
+	return err
+}
+
+// LoadToken takes the token saved in the configured TokenStore into use.
+// It is called on the first request when the client was created without an access token.
+func (c *Client) LoadToken(ctx context.Context) error {
+	if c.clientOptions.TokenStore == nil {
+		return nil
+	}
+
+	token, err := c.clientOptions.TokenStore.Load(ctx)
 	if err != nil {
 		return err
 	}
 
-	c.clientOptions.AccessToken = tokenInfo.AccessToken
-	c.clientOptions.RefreshToken = tokenInfo.RefreshToken
-
-	fmt.Println("REFRESH SUCCESS~~")
-	fmt.Printf("AccessToken:%s\n", c.clientOptions.AccessToken)
-	fmt.Printf("RefreshToken:%s\n", c.clientOptions.RefreshToken)
+	c.creds.set(token.AccessToken, token.RefreshToken, token.ExpiresAt)
+	c.creds.setScope(token.Scope)
 
 	return nil
 }
@@ -307,11 +683,13 @@ func (c *Client) IsAutoRefreshTokenActive() bool {
 }
 
 func (c *Client) GetAccessToken() string {
-	return c.clientOptions.AccessToken
+	accessToken, _ := c.creds.get()
+	return accessToken
 }
 
 func (c *Client) GetRefreshToken() string {
-	return c.clientOptions.RefreshToken
+	_, refreshToken := c.creds.get()
+	return refreshToken
 }
 
 type TokenInfo struct {
@@ -320,6 +698,27 @@ type TokenInfo struct {
 	TokenType    string `json:"token_type"`
 	Scope        string `json:"scope"`
 	RefreshToken string `json:"refresh_token"`
+	// ExpiresAt is not sent by Fortnox, it is derived from ExpiresIn when the token is stored
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// accessToken returns the token of the TokenSource when one is set, the client's own access token otherwise
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	if src := c.clientOptions.TokenSource; src != nil {
+		token, err := src.Token(ctx)
+		if err != nil {
+			return "", err
+		}
+		return token.AccessToken, nil
+	}
+
+	accessToken, _ := c.creds.get()
+	return accessToken, nil
+}
+
+// TokenExpiresAt returns when the current access token expires, zero if unknown
+func (c *Client) TokenExpiresAt() time.Time {
+	return c.creds.getExpiresAt()
 }
 
 func request(
@@ -333,6 +732,10 @@ func request(
 
 	req, err := http.NewRequest(method, url, data)
 	if err != nil {
+		// Do closes the body, stop the writer of an upload ourselves
+		if closer, ok := data.(io.Closer); ok {
+			_ = closer.Close()
+		}
 		return fmt.Errorf("%s: %s", ErrCreateRequest, err)
 	}
 
@@ -343,21 +746,38 @@ func request(
 	}
 
 	for k, v := range headers {
-		req.Header.Add(k, v)
+		req.Header.Set(k, v)
+	}
+
+	raw, isRaw := result.(*rawResponse)
+	if isRaw {
+		// binary downloads are not JSON
+		req.Header.Set("Accept", "*/*")
 	}
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("%s: %s", ErrSendRequest, err)
+		return fmt.Errorf("%w: %s", ErrSendRequest, err)
 	}
 
+	keepBody := false
 	defer func() {
+		if keepBody {
+			return
+		}
 		_, _ = io.CopyN(ioutil.Discard, resp.Body, 64)
 		_ = resp.Body.Close()
 	}()
 
 	switch resp.StatusCode {
 	case 200, 201:
+		if isRaw {
+			// the caller streams and closes the body
+			keepBody = true
+			raw.resp = resp
+			return nil
+		}
+
 		bodyPreview, _ := getRespBodyPreview(resp, 30)
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			if err == io.EOF {
@@ -372,8 +792,18 @@ func request(
 		// if malformed, want to see
 		errMsg := &ErrorResp{}
 		bodyPreview, _ := getRespBodyPreview(resp, 128)
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		requestID := getRequestID(resp)
 		if err := json.NewDecoder(resp.Body).Decode(&errMsg); err != nil {
-			return errors.Wrap(err, fmt.Sprintf("failed to decode %d error from response [%s]", resp.StatusCode, bodyPreview))
+			ferr := FortnoxError{
+				HTTPStatus: resp.StatusCode,
+				Message:    bodyPreview,
+				RetryAfter: retryAfter,
+				Method:     method,
+				Endpoint:   req.URL.Path,
+				RequestID:  requestID,
+			}
+			return errors.Wrap(ferr, fmt.Sprintf("failed to decode %d error from response [%s]", resp.StatusCode, bodyPreview))
 		}
 		msg := errMsg.ErrorInformation.Message
 		if errMsg.ErrorInformation.Code == 0 {
@@ -383,6 +813,10 @@ func request(
 			HTTPStatus: resp.StatusCode,
 			Code:       errMsg.ErrorInformation.Code,
 			Message:    msg,
+			RetryAfter: retryAfter,
+			Method:     method,
+			Endpoint:   req.URL.Path,
+			RequestID:  requestID,
 		}
 	}
 }
@@ -408,8 +842,50 @@ var (
 	ErrCreateRequest = errors.New("error creating request")
 	ErrSendRequest   = errors.New("error sending request")
 	ErrAccessTokenSE = errors.New(`{"message":"unauthorized"} | try to refresh token`)
+	// ErrRefreshFailed is matched by errors.Is when Fortnox rejected the refresh token,
+	// the integration has to be authorized again
+	ErrRefreshFailed = errors.New("refresh token rejected")
 )
 
+// OAuthError error response from Fortnox's OAuth endpoints
+type OAuthError struct {
+	HTTPStatus  int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func newOAuthError(status int, body []byte) *OAuthError {
+	oerr := &OAuthError{}
+	if err := json.Unmarshal(body, oerr); err != nil || oerr.Code == "" {
+		oerr.Description = string(body)
+	}
+	oerr.HTTPStatus = status
+
+	return oerr
+}
+
+func (e *OAuthError) Error() string {
+	return fmt.Sprintf("HTTP Status Code [%d]: %s\nOAuth Error: %s <-> %s",
+		e.HTTPStatus, http.StatusText(e.HTTPStatus), e.Code, e.Description)
+}
+
+// RefreshError is returned when Fortnox rejected the refresh token, it matches ErrRefreshFailed
+type RefreshError struct {
+	OAuthError
+}
+
+func (e *RefreshError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrRefreshFailed, e.OAuthError.Error())
+}
+
+func (e *RefreshError) Is(target error) bool {
+	return target == ErrRefreshFailed
+}
+
+func (e *RefreshError) Unwrap() error {
+	return &e.OAuthError
+}
+
 // ErrorResp error response from Fortnox
 type ErrorResp struct {
 	ErrorInformation ErrorMessage
@@ -420,12 +896,30 @@ type FortnoxError struct {
 	HTTPStatus int
 	Code       int
 	Message    string
+	// RetryAfter is how long Fortnox asked to wait before the next request, zero if not given
+	RetryAfter time.Duration
+	// Method is the HTTP method of the failed request
+	Method string
+	// Endpoint is the path of the failed request, without query parameters
+	Endpoint string
+	// RequestID is the id Fortnox assigned to the request, quote it when contacting Fortnox support
+	RequestID string
 }
 
 func (f FortnoxError) Error() string {
-	return fmt.Sprintf(
+	msg := fmt.Sprintf(
 		"HTTP Status Code [%d]: %s\nFortnox Code: %d <-> %s",
 		f.HTTPStatus, http.StatusText(f.HTTPStatus), f.Code, f.Message)
+
+	if f.Method != "" || f.Endpoint != "" {
+		msg = fmt.Sprintf("%s\nRequest: %s %s", msg, f.Method, f.Endpoint)
+	}
+
+	if f.RequestID != "" {
+		msg = fmt.Sprintf("%s\nRequest ID: %s", msg, f.RequestID)
+	}
+
+	return msg
 }
 
 // Translate translates error message to languages defined by [langCode]
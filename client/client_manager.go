@@ -0,0 +1,452 @@
+package client
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultIdleTimeout = 30 * time.Minute
+	// maxIdleConnsPerHost is raised from the default 2, all tenants talk to the same host
+	maxIdleConnsPerHost = 64
+)
+
+var ErrTenantNotFound = errors.New("tenant not found in credential store")
+
+// TenantCredentials are what the Client of a tenant is created from
+type TenantCredentials struct {
+	ClientID     string
+	ClientSecret string
+	// ServiceAccount gets tokens with the client credentials flow, no token pair is stored
+	ServiceAccount bool
+	// TokenStore holds the token pair of a tenant connected with the authorization code flow,
+	// the refreshed pair is saved back to it
+	TokenStore TokenStore
+}
+
+// CredentialStore looks up the credentials of tenants.
+//
+// Credentials returns ErrTenantNotFound for an unknown tenant.
+type CredentialStore interface {
+	Credentials(ctx context.Context, tenantID string) (*TenantCredentials, error)
+	TenantIDs(ctx context.Context) ([]string, error)
+}
+
+// MemoryCredentialStore keeps credentials in memory, useful for tests and a fixed set of tenants
+type MemoryCredentialStore struct {
+	mu      sync.Mutex
+	tenants map[string]TenantCredentials
+}
+
+// NewMemoryCredentialStore creates an empty MemoryCredentialStore
+func NewMemoryCredentialStore() *MemoryCredentialStore {
+	return &MemoryCredentialStore{tenants: map[string]TenantCredentials{}}
+}
+
+// Set adds or replaces the credentials of tenantID
+func (s *MemoryCredentialStore) Set(tenantID string, creds TenantCredentials) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tenants[tenantID] = creds
+}
+
+func (s *MemoryCredentialStore) Credentials(_ context.Context, tenantID string) (*TenantCredentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	creds, ok := s.tenants[tenantID]
+	if !ok {
+		return nil, ErrTenantNotFound
+	}
+
+	return &creds, nil
+}
+
+func (s *MemoryCredentialStore) TenantIDs(_ context.Context) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.tenants))
+	for id := range s.tenants {
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// ClientManager hands out one Client per tenant, created on first use from a CredentialStore.
+//
+// All Clients share one http.Transport, every tenant has its own rate limiter and, since there is a single
+// Client per tenant, its own token refresh. Tenants that haven't been used for the idle timeout are evicted.
+// Get the Client from the manager for every job rather than keeping it, an evicted Client still works
+// and shares the tenant's rate limiter, which outlives eviction, but no longer its token refresh.
+type ClientManager struct {
+	store       CredentialStore
+	httpClient  *http.Client
+	idleTimeout time.Duration
+	rateLimit   float64
+	rateBurst   int
+	options     []OptionFunc
+	now         func() time.Time
+
+	mu      sync.Mutex
+	tenants map[string]*tenantClient
+	// limiters are kept apart from tenants, so a Client created after eviction waits for the requests of the evicted one
+	limiters map[string]*RateLimiter
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// tenantClient is a Client of the manager, ready is closed once client or err is set
+type tenantClient struct {
+	ready    chan struct{}
+	client   *Client
+	err      error
+	lastUsed time.Time
+	inflight int
+}
+
+// ManagerOption configures a ClientManager
+type ManagerOption func(m *ClientManager)
+
+// WithManagerHTTPClientOpt sets the http.Client shared by all tenants, by default one with a pooled transport and a 10 second timeout
+func WithManagerHTTPClientOpt(c *http.Client) ManagerOption {
+	return func(m *ClientManager) {
+		m.httpClient = c
+	}
+}
+
+// WithManagerIdleTimeoutOpt sets how long a tenant is kept after its last call, 30 minutes by default, d <= 0 never evicts
+func WithManagerIdleTimeoutOpt(d time.Duration) ManagerOption {
+	return func(m *ClientManager) {
+		m.idleTimeout = d
+	}
+}
+
+// WithManagerRateLimitOpt sets requests per second and burst of the rate limiter of every tenant
+func WithManagerRateLimitOpt(requestsPerSecond float64, burst int) ManagerOption {
+	return func(m *ClientManager) {
+		m.rateLimit = requestsPerSecond
+		m.rateBurst = burst
+	}
+}
+
+// WithManagerClientOpts adds options every Client is created with, e.g. WithLoggerOpt or WithMiddlewareOpt
+func WithManagerClientOpts(options ...OptionFunc) ManagerOption {
+	return func(m *ClientManager) {
+		m.options = append(m.options, options...)
+	}
+}
+
+// NewClientManager creates a ClientManager, Close stops its eviction of idle tenants
+func NewClientManager(store CredentialStore, opts ...ManagerOption) *ClientManager {
+	m := &ClientManager{
+		store:       store,
+		idleTimeout: defaultIdleTimeout,
+		rateLimit:   defaultRateLimit,
+		rateBurst:   defaultRateBurst,
+		now:         time.Now,
+		tenants:     map[string]*tenantClient{},
+		limiters:    map[string]*RateLimiter{},
+		stop:        make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.httpClient == nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+
+		m.httpClient = &http.Client{
+			Transport: transport,
+			Timeout:   defaultTimeout,
+		}
+	}
+
+	if m.idleTimeout > 0 {
+		go m.evictLoop()
+	}
+
+	return m
+}
+
+// Client returns the Client of tenantID, creating it from the credential store on first use
+func (m *ClientManager) Client(ctx context.Context, tenantID string) (*Client, error) {
+	m.mu.Lock()
+	t, ok := m.tenants[tenantID]
+	if !ok {
+		t = &tenantClient{ready: make(chan struct{})}
+		m.tenants[tenantID] = t
+	}
+	t.lastUsed = m.now()
+	m.mu.Unlock()
+
+	// created detached from ctx, the callers waiting for the Client don't fail when the first one gives up
+	if !ok {
+		go func() {
+			t.client, t.err = m.newClient(context.WithoutCancel(ctx), tenantID, t)
+			if t.err != nil {
+				m.mu.Lock()
+				if m.tenants[tenantID] == t {
+					delete(m.tenants, tenantID)
+				}
+				m.mu.Unlock()
+			}
+			close(t.ready)
+		}()
+	}
+
+	select {
+	case <-t.ready:
+		return t.client, t.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (m *ClientManager) newClient(ctx context.Context, tenantID string, t *tenantClient) (*Client, error) {
+	creds, err := m.store.Credentials(ctx, tenantID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't get credentials of tenant %s", tenantID)
+	}
+
+	options := []OptionFunc{
+		WithTenantIDOpt(tenantID),
+		WithHTTPClientOpt(m.httpClient),
+		WithRateLimiterOpt(m.rateLimiter(tenantID)),
+		WithClientIDOpt(creds.ClientID),
+		WithAuthOpt("", creds.ClientSecret),
+	}
+
+	switch {
+	case creds.ServiceAccount:
+		options = append(options, WithTokenSourceOpt(&ClientCredentials{
+			ClientID:     creds.ClientID,
+			ClientSecret: creds.ClientSecret,
+			TenantID:     tenantID,
+			HTTPClient:   m.httpClient,
+		}))
+	case creds.TokenStore != nil:
+		options = append(options, WithTokenStoreOpt(creds.TokenStore), WithAutoRefreshTokenOpt(true))
+	default:
+		return nil, errors.Errorf("tenant %s has neither a service account nor a token store", tenantID)
+	}
+
+	options = append(options, m.options...)
+	// the manager's middleware is the outermost, so it sees every call
+	options = append([]OptionFunc{WithMiddlewareOpt(m.track(t))}, options...)
+
+	return NewClient(options...), nil
+}
+
+// rateLimiter returns the RateLimiter of tenantID, creating it on first use
+func (m *ClientManager) rateLimiter(tenantID string) *RateLimiter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, ok := m.limiters[tenantID]
+	if !ok {
+		l = NewRateLimiter(m.rateLimit, m.rateBurst)
+		m.limiters[tenantID] = l
+	}
+
+	return l
+}
+
+// track keeps t from being evicted while a call is in flight
+func (m *ClientManager) track(t *tenantClient) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			m.mu.Lock()
+			t.inflight++
+			t.lastUsed = m.now()
+			m.mu.Unlock()
+
+			defer func() {
+				m.mu.Lock()
+				t.inflight--
+				t.lastUsed = m.now()
+				m.mu.Unlock()
+			}()
+
+			return next(ctx, call)
+		}
+	}
+}
+
+// Tenants returns the IDs of the tenants that currently have a Client
+func (m *ClientManager) Tenants() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := make([]string, 0, len(m.tenants))
+	for id := range m.tenants {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// Evict drops the Client of tenantID, e.g. after its credentials changed
+func (m *ClientManager) Evict(tenantID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.tenants, tenantID)
+}
+
+// EvictIdle drops the Clients without calls in flight that haven't been used for the idle timeout
+func (m *ClientManager) EvictIdle() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for id, t := range m.tenants {
+		select {
+		case <-t.ready:
+		default:
+			continue
+		}
+
+		if t.inflight == 0 && now.Sub(t.lastUsed) >= m.idleTimeout {
+			delete(m.tenants, id)
+		}
+	}
+}
+
+func (m *ClientManager) evictLoop() {
+	ticker := time.NewTicker(m.idleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.EvictIdle()
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+// Close stops evicting idle tenants and closes the idle connections of the shared transport
+func (m *ClientManager) Close() {
+	m.stopOnce.Do(func() {
+		close(m.stop)
+		m.httpClient.CloseIdleConnections()
+	})
+}
+
+// TenantError is the error of a job of ForEachTenant
+type TenantError struct {
+	TenantID string
+	Err      error
+}
+
+func (e *TenantError) Error() string {
+	return fmt.Sprintf("tenant %s: %s", e.TenantID, e.Err)
+}
+
+func (e *TenantError) Unwrap() error {
+	return e.Err
+}
+
+// TenantErrors are the errors of the jobs of ForEachTenant that failed
+type TenantErrors []*TenantError
+
+func (e TenantErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d tenants failed: %s", len(e), strings.Join(msgs, "; "))
+}
+
+func (e TenantErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// ForEachTenant runs fn for every tenant of the credential store, at most concurrency at a time.
+// A failing job doesn't stop the others, the errors are returned as TenantErrors.
+// No more jobs are started once ctx is done, ctx.Err() is then returned joined with the errors of the jobs that ran.
+func (m *ClientManager) ForEachTenant(
+	ctx context.Context,
+	concurrency int,
+	fn func(ctx context.Context, tenantID string, c *Client) error) error {
+
+	ids, err := m.store.TenantIDs(ctx)
+	if err != nil {
+		return errors.Wrap(err, "can't list tenants")
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu   sync.Mutex
+		errs TenantErrors
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, concurrency)
+	)
+
+	fail := func(tenantID string, err error) {
+		mu.Lock()
+		errs = append(errs, &TenantError{TenantID: tenantID, Err: err})
+		mu.Unlock()
+	}
+
+	for _, id := range ids {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			if len(errs) > 0 {
+				return stderrors.Join(errs, ctx.Err())
+			}
+			return ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(tenantID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			c, err := m.Client(ctx, tenantID)
+			if err != nil {
+				fail(tenantID, err)
+				return
+			}
+
+			if err := fn(ctx, tenantID, c); err != nil {
+				fail(tenantID, err)
+			}
+		}(id)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// countingStore counts the lookups of a MemoryCredentialStore, blocking them until release is closed when it is set
+type countingStore struct {
+	*MemoryCredentialStore
+	lookups atomic.Int32
+	release chan struct{}
+}
+
+func (s *countingStore) Credentials(ctx context.Context, tenantID string) (*TenantCredentials, error) {
+	s.lookups.Add(1)
+	if s.release != nil {
+		<-s.release
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.MemoryCredentialStore.Credentials(ctx, tenantID)
+}
+
+func newCountingStore(tenantIDs ...string) *countingStore {
+	s := &countingStore{MemoryCredentialStore: NewMemoryCredentialStore()}
+	for _, id := range tenantIDs {
+		s.Set(id, TenantCredentials{
+			ClientID:     "client-" + id,
+			ClientSecret: "secret",
+			TokenStore:   NewMemoryTokenStore(&TokenInfo{AccessToken: "token-" + id}),
+		})
+	}
+
+	return s
+}
+
+func TestClientManagerClient(t *testing.T) {
+	store := newCountingStore("1", "2")
+	m := NewClientManager(store, WithManagerIdleTimeoutOpt(0))
+	defer m.Close()
+
+	ctx := context.Background()
+
+	a, err := m.Client(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := m.Client(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := m.Client(ctx, "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a != again || a == b {
+		t.Error("want one Client per tenant")
+	}
+	if store.lookups.Load() != 2 {
+		t.Errorf("credential store was asked %d times, want 2", store.lookups.Load())
+	}
+
+	if _, err := m.Client(ctx, "unknown"); !errors.Is(err, ErrTenantNotFound) {
+		t.Errorf("Client() of an unknown tenant = %v, want ErrTenantNotFound", err)
+	}
+	// a failed lookup isn't cached
+	if _, err := m.Client(ctx, "unknown"); !errors.Is(err, ErrTenantNotFound) || store.lookups.Load() != 4 {
+		t.Errorf("second Client() of an unknown tenant = %v after %d lookups", err, store.lookups.Load())
+	}
+}
+
+func TestClientManagerConcurrentClient(t *testing.T) {
+	store := newCountingStore("1")
+	store.release = make(chan struct{})
+
+	m := NewClientManager(store, WithManagerIdleTimeoutOpt(0))
+	defer m.Close()
+
+	// the first caller gives up before the Client is created, the others still get it
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := m.Client(firstCtx, "1")
+		firstErr <- err
+	}()
+
+	for store.lookups.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	clients := make([]*Client, 10)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			c, err := m.Client(context.Background(), "1")
+			if err != nil {
+				t.Error(err)
+			}
+			clients[i] = c
+		}(i)
+	}
+
+	cancelFirst()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled caller got %v, want Canceled", err)
+	}
+
+	close(store.release)
+	wg.Wait()
+
+	for i, c := range clients {
+		if c == nil || c != clients[0] {
+			t.Errorf("caller %d got another Client", i)
+		}
+	}
+	if store.lookups.Load() != 1 {
+		t.Errorf("credential store was asked %d times, want 1", store.lookups.Load())
+	}
+}
+
+func TestClientManagerEviction(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	m := NewClientManager(newCountingStore("1", "2"), WithManagerIdleTimeoutOpt(0))
+	defer m.Close()
+
+	m.idleTimeout = time.Minute
+	m.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	first, err := m.Client(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Client(ctx, "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(30 * time.Second)
+	if _, err := m.Client(ctx, "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(30 * time.Second)
+	m.EvictIdle()
+
+	if tenants := m.Tenants(); len(tenants) != 1 || tenants[0] != "2" {
+		t.Errorf("tenants after eviction = %v, want [2]", tenants)
+	}
+
+	second, err := m.Client(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if second == first {
+		t.Error("evicted tenant got its old Client back")
+	}
+	if second.rateLimiter() != first.rateLimiter() {
+		t.Error("the Client created after eviction has a new rate limiter")
+	}
+
+	m.Evict("2")
+	if tenants := m.Tenants(); len(tenants) != 1 || tenants[0] != "1" {
+		t.Errorf("tenants after Evict = %v, want [1]", tenants)
+	}
+}
+
+func TestClientManagerKeepsBusyTenants(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	m := NewClientManager(newCountingStore("1"), WithManagerIdleTimeoutOpt(0))
+	defer m.Close()
+
+	m.idleTimeout = time.Minute
+	m.now = func() time.Time { return now }
+
+	if _, err := m.Client(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	m.mu.Lock()
+	tenant := m.tenants["1"]
+	m.mu.Unlock()
+
+	// the idle timeout passes while a call is in flight
+	err := m.track(tenant)(func(ctx context.Context, call *Call) error {
+		now = now.Add(time.Hour)
+		m.EvictIdle()
+		return nil
+	})(context.Background(), &Call{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tenants := m.Tenants(); len(tenants) != 1 {
+		t.Error("tenant with a call in flight was evicted")
+	}
+}
+
+func TestForEachTenantCancelled(t *testing.T) {
+	m := NewClientManager(newCountingStore("1", "2", "3", "4"), WithManagerIdleTimeoutOpt(0))
+	defer m.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var ran atomic.Int32
+	jobErr := errors.New("job failed")
+
+	err := m.ForEachTenant(ctx, 1, func(ctx context.Context, tenantID string, c *Client) error {
+		if ran.Add(1) == 2 {
+			cancel()
+		}
+		return jobErr
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ForEachTenant() = %v, want Canceled", err)
+	}
+	if !errors.Is(err, jobErr) {
+		t.Errorf("ForEachTenant() = %v, want the errors of the jobs that ran", err)
+	}
+
+	var terr *TenantError
+	if !errors.As(err, &terr) || terr.TenantID == "" {
+		t.Errorf("ForEachTenant() = %v, want a TenantError", err)
+	}
+	if got := ran.Load(); got != 2 {
+		t.Errorf("%d jobs ran, want 2", got)
+	}
+}
+
+func TestForEachTenant(t *testing.T) {
+	m := NewClientManager(newCountingStore("1", "2", "3"), WithManagerIdleTimeoutOpt(0))
+	defer m.Close()
+
+	var mu sync.Mutex
+	seen := map[string]bool{}
+
+	err := m.ForEachTenant(context.Background(), 2, func(ctx context.Context, tenantID string, c *Client) error {
+		mu.Lock()
+		seen[tenantID] = true
+		mu.Unlock()
+
+		if tenantID == "2" {
+			return errors.New("job failed")
+		}
+		return nil
+	})
+
+	var terrs TenantErrors
+	if !errors.As(err, &terrs) || len(terrs) != 1 || terrs[0].TenantID != "2" {
+		t.Errorf("ForEachTenant() = %v, want the error of tenant 2", err)
+	}
+	if len(seen) != 3 {
+		t.Errorf("ran for %v, want every tenant", seen)
+	}
+}
@@ -7,10 +7,10 @@ const (
 )
 
 // GetCompanyInformation does _GET https://api.fortnox.se/3/companyinformation
-func (c Client) GetCompanyInformation(ctx context.Context) (*CompanyInformation, error) {
+func (c *companyInformationService) GetCompanyInformation(ctx context.Context) (*CompanyInformation, error) {
 	resp := &GetCompanyInformationResp{}
 
-	err := c._GET(ctx, companyInformationURI, nil, resp)
+	err := c._GET(ctx, getCompanyInformationOp, companyInformationURI, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -7,10 +7,10 @@ const (
 )
 
 // GetCompanySettings does _GET https://api.fortnox.se/3/settings/company/
-func (c *Client) GetCompanySettings(ctx context.Context) (*CompanySettings, error) {
+func (c *companySettingsService) GetCompanySettings(ctx context.Context) (*CompanySettings, error) {
 	resp := &GetCompanySettingResp{}
 
-	err := c._GET(ctx, companySettingsURI, nil, resp)
+	err := c._GET(ctx, getCompanySettingsOp, companySettingsURI, nil, resp)
 	if err != nil {
 		return nil, err
 	}
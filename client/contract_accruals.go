@@ -10,26 +10,43 @@ const (
 )
 
 // GetAllContractAccruals does _GET https://api.fortnox.se/3/contractaccruals/
-func (c *Client) GetAllContractAccruals(ctx context.Context) ([]ShortContractAccrual, error) {
+func (c *contractAccrualsService) GetAllContractAccruals(ctx context.Context) ([]ShortContractAccrual, error) {
+	return c.IterateContractAccruals().All(ctx)
+}
+
+// GetContractAccrualsPage does _GET https://api.fortnox.se/3/contractaccruals/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *contractAccrualsService) GetContractAccrualsPage(
+	ctx context.Context,
+	page *PageOptions) ([]ShortContractAccrual, *MetaInformation, error) {
+
 	resp := &GetAllContractAccrualsResp{}
 
-	err := c._GET(ctx, contractAccrualsURI, nil, resp)
+	err := c._GETPage(ctx, getContractAccrualsPageOp, contractAccrualsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.ContractAccruals, nil
+	return resp.ContractAccruals, &resp.MetaInformation, nil
+}
+
+// IterateContractAccruals lazily walks all pages of https://api.fortnox.se/3/contractaccruals/
+func (c *contractAccrualsService) IterateContractAccruals() *Iterator[ShortContractAccrual] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]ShortContractAccrual, *MetaInformation, error) {
+		return c.GetContractAccrualsPage(ctx, page)
+	})
 }
 
 // CreateContractAccrual does _POST https://api.fortnox.se/3/contractaccruals/
 //
 // req - contract accrual to create
-func (c *Client) CreateContractAccrual(ctx context.Context, fca *FullContractAccrual) (*FullContractAccrual, error) {
+func (c *contractAccrualsService) CreateContractAccrual(ctx context.Context, fca *FullContractAccrual) (*FullContractAccrual, error) {
 
 	req := &CreateContractAccrualReq{ContractAccrual: *fca}
 	resp := &CreateContractAccrualResp{}
 
-	err := c._POST(ctx, contractAccrualsURI, nil, req, resp)
+	err := c._POST(ctx, createContractAccrualOp, contractAccrualsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -40,12 +57,12 @@ func (c *Client) CreateContractAccrual(ctx context.Context, fca *FullContractAcc
 // GetContractAccrual does _GET https://api.fortnox.se/3/contractaccruals/{DocumentNumber}
 //
 // documentNumber - identifies the contract accrual
-func (c *Client) GetContractAccrual(ctx context.Context, documentNumber int) (*FullContractAccrual, error) {
+func (c *contractAccrualsService) GetContractAccrual(ctx context.Context, documentNumber int) (*FullContractAccrual, error) {
 	resp := &GetContractAccrualResp{}
 
 	uri := fmt.Sprintf("%s/%d", contractAccrualsURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getContractAccrualOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +75,7 @@ func (c *Client) GetContractAccrual(ctx context.Context, documentNumber int) (*F
 // documentNumber - identifies the contract accrual
 //
 // req - contract accruals to update
-func (c *Client) UpdateContractAccrual(
+func (c *contractAccrualsService) UpdateContractAccrual(
 	ctx context.Context,
 	documentNumber int,
 	fca *FullContractAccrual) (*FullContractAccrual, error) {
@@ -68,7 +85,7 @@ func (c *Client) UpdateContractAccrual(
 
 	uri := fmt.Sprintf("%s/%d", contractAccrualsURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateContractAccrualOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -79,9 +96,9 @@ func (c *Client) UpdateContractAccrual(
 // RemoveContractAccrual does _DELETE https://api.fortnox.se/3/contractaccruals/{DocumentNumber}
 //
 // documentNumber - identifies the contract accrual
-func (c *Client) RemoveContractAccrual(ctx context.Context, documentNumber int) error {
+func (c *contractAccrualsService) RemoveContractAccrual(ctx context.Context, documentNumber int) error {
 	uri := fmt.Sprintf("%s/%d", contractAccrualsURI, documentNumber)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeContractAccrualOp, uri)
 }
 
 type FullContractAccrual struct {
@@ -93,15 +110,15 @@ type FullContractAccrual struct {
 	DocumentNumber int          `json:"DocumentNumber,omitempty"`
 	Period         string       `json:"Period,omitempty"`
 	Times          int          `json:"Times,omitempty"`
-	Total          int          `json:"Total,omitempty"`
+	Total          Money        `json:"Total,omitzero"`
 	VATIncluded    bool         `json:"VATIncluded,omitempty"`
 }
 
 type AccrualRow struct {
 	Account                int    `json:"Account,omitempty"`
 	CostCenter             string `json:"CostCenter,omitempty"`
-	Credit                 int    `json:"Credit,omitempty"`
-	Debit                  int    `json:"Debit,omitempty"`
+	Credit                 Money  `json:"Credit,omitzero"`
+	Debit                  Money  `json:"Debit,omitzero"`
 	Project                string `json:"Project,omitempty"`
 	TransactionInformation string `json:"TransactionInformation,omitempty"`
 }
@@ -115,6 +132,7 @@ type ShortContractAccrual struct {
 
 type GetAllContractAccrualsResp struct {
 	ContractAccruals []ShortContractAccrual `json:"ContractAccruals"`
+	MetaInformation  MetaInformation        `json:"MetaInformation"`
 }
 
 type CreateContractAccrualReq struct {
@@ -10,27 +10,56 @@ const (
 )
 
 // GetAllContractTemplates does _GET https://api.fortnox.se/3/contracttemplates/
-func (c *Client) GetAllContractTemplates(ctx context.Context) (*GetAllContractTemplatesResp, error) {
-	resp := &GetAllContractTemplatesResp{}
+func (c *contractTemplatesService) GetAllContractTemplates(ctx context.Context) (*GetAllContractTemplatesResp, error) {
+	it := c.IterateContractTemplates()
 
-	err := c._GET(ctx, contractTemplatesURI, nil, resp)
+	items, err := it.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp := &GetAllContractTemplatesResp{ContractTemplates: items}
+	if meta := it.PageInfo(); meta != nil {
+		resp.MetaInformation = *meta
+	}
+
 	return resp, nil
 }
 
+// GetContractTemplatesPage does _GET https://api.fortnox.se/3/contracttemplates/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *contractTemplatesService) GetContractTemplatesPage(
+	ctx context.Context,
+	page *PageOptions) ([]ShortContractTemplate, *MetaInformation, error) {
+
+	resp := &GetAllContractTemplatesResp{}
+
+	err := c._GETPage(ctx, getContractTemplatesPageOp, contractTemplatesURI, nil, page, resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.ContractTemplates, &resp.MetaInformation, nil
+}
+
+// IterateContractTemplates lazily walks all pages of https://api.fortnox.se/3/contracttemplates/
+func (c *contractTemplatesService) IterateContractTemplates() *Iterator[ShortContractTemplate] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]ShortContractTemplate, *MetaInformation, error) {
+		return c.GetContractTemplatesPage(ctx, page)
+	})
+}
+
 // CreateContractTemplate does _POST https://api.fortnox.se/3/contracttemplates/
 //
 // req - contract template to create
-func (c *Client) CreateContractTemplate(
+func (c *contractTemplatesService) CreateContractTemplate(
 	ctx context.Context,
 	req *CreateContractTemplateReq) (*CreateContractTemplateResp, error) {
 
 	resp := &CreateContractTemplateResp{}
 
-	err := c._POST(ctx, contractTemplatesURI, nil, req, resp)
+	err := c._POST(ctx, createContractTemplateOp, contractTemplatesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -41,12 +70,12 @@ func (c *Client) CreateContractTemplate(
 // GetContractTemplate does _GET https://api.fortnox.se/3/contracttemplates/{DocumentNumber}
 //
 // templateNumber - identifies the contract accrual
-func (c *Client) GetContractTemplate(ctx context.Context, templateNumber int) (*GetContractTemplateResp, error) {
+func (c *contractTemplatesService) GetContractTemplate(ctx context.Context, templateNumber int) (*GetContractTemplateResp, error) {
 	resp := &GetContractTemplateResp{}
 
 	uri := fmt.Sprintf("%s/%d", contractTemplatesURI, templateNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getContractTemplateOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +88,7 @@ func (c *Client) GetContractTemplate(ctx context.Context, templateNumber int) (*
 // templateNumber - identifies the contract accrual
 //
 // req - contract template to update
-func (c *Client) UpdateContractTemplate(
+func (c *contractTemplatesService) UpdateContractTemplate(
 	ctx context.Context,
 	templateNumber int,
 	req *UpdateContractTemplateReq) (*UpdateContractTemplateResp, error) {
@@ -68,7 +97,7 @@ func (c *Client) UpdateContractTemplate(
 
 	uri := fmt.Sprintf("%s/%d", contractTemplatesURI, templateNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateContractTemplateOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -77,21 +106,24 @@ func (c *Client) UpdateContractTemplate(
 }
 
 type GetAllContractTemplatesResp struct {
-	ContractTemplates []struct {
-		Url                  string `json:"@url"`
-		ContractLength       int    `json:"ContractLength"`
-		ContractTemplate     int    `json:"ContractTemplate"`
-		ContractTemplateName string `json:"ContractTemplateName"`
-		InvoiceInterval      int    `json:"InvoiceInterval"`
-	} `json:"ContractTemplates"`
+	ContractTemplates []ShortContractTemplate `json:"ContractTemplates"`
+	MetaInformation   MetaInformation         `json:"MetaInformation"`
+}
+
+type ShortContractTemplate struct {
+	Url                  string `json:"@url"`
+	ContractLength       int    `json:"ContractLength"`
+	ContractTemplate     int    `json:"ContractTemplate"`
+	ContractTemplateName string `json:"ContractTemplateName"`
+	InvoiceInterval      int    `json:"InvoiceInterval"`
 }
 
 type CreateContractTemplateReq struct {
 	ContractTemplate struct {
 		Url               string       `json:"@url"`
-		AdministrationFee int          `json:"AdministrationFee"`
+		AdministrationFee Money        `json:"AdministrationFee"`
 		ContractLength    int          `json:"ContractLength"`
-		Freight           int          `json:"Freight"`
+		Freight           Money        `json:"Freight"`
 		InvoiceInterval   int          `json:"InvoiceInterval"`
 		InvoiceRows       []InvoiceRow `json:"InvoiceRows"`
 		Continuous        bool         `json:"Continuous"`
@@ -109,9 +141,9 @@ type CreateContractTemplateReq struct {
 type CreateContractTemplateResp struct {
 	ContractTemplate struct {
 		Url               string       `json:"@url"`
-		AdministrationFee int          `json:"AdministrationFee"`
+		AdministrationFee Money        `json:"AdministrationFee"`
 		ContractLength    int          `json:"ContractLength"`
-		Freight           int          `json:"Freight"`
+		Freight           Money        `json:"Freight"`
 		InvoiceInterval   int          `json:"InvoiceInterval"`
 		InvoiceRows       []InvoiceRow `json:"InvoiceRows"`
 		Continuous        bool         `json:"Continuous"`
@@ -129,9 +161,9 @@ type CreateContractTemplateResp struct {
 type GetContractTemplateResp struct {
 	ContractTemplate struct {
 		Url               string       `json:"@url"`
-		AdministrationFee int          `json:"AdministrationFee"`
+		AdministrationFee Money        `json:"AdministrationFee"`
 		ContractLength    int          `json:"ContractLength"`
-		Freight           int          `json:"Freight"`
+		Freight           Money        `json:"Freight"`
 		InvoiceInterval   int          `json:"InvoiceInterval"`
 		InvoiceRows       []InvoiceRow `json:"InvoiceRows"`
 		Continuous        bool         `json:"Continuous"`
@@ -149,9 +181,9 @@ type GetContractTemplateResp struct {
 type UpdateContractTemplateReq struct {
 	ContractTemplate struct {
 		Url               string       `json:"@url"`
-		AdministrationFee int          `json:"AdministrationFee"`
+		AdministrationFee Money        `json:"AdministrationFee"`
 		ContractLength    int          `json:"ContractLength"`
-		Freight           int          `json:"Freight"`
+		Freight           Money        `json:"Freight"`
 		InvoiceInterval   int          `json:"InvoiceInterval"`
 		InvoiceRows       []InvoiceRow `json:"InvoiceRows"`
 		Continuous        bool         `json:"Continuous"`
@@ -169,9 +201,9 @@ type UpdateContractTemplateReq struct {
 type UpdateContractTemplateResp struct {
 	ContractTemplate struct {
 		Url               string       `json:"@url"`
-		AdministrationFee int          `json:"AdministrationFee"`
+		AdministrationFee Money        `json:"AdministrationFee"`
 		ContractLength    int          `json:"ContractLength"`
-		Freight           int          `json:"Freight"`
+		Freight           Money        `json:"Freight"`
 		InvoiceInterval   int          `json:"InvoiceInterval"`
 		InvoiceRows       []InvoiceRow `json:"InvoiceRows"`
 		Continuous        bool         `json:"Continuous"`
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 )
 
 const (
@@ -14,115 +13,144 @@ const (
 // GetContract does _GET https://api.fortnox.se/3/contracts/{DocumentNumber}
 //
 // documentNumber - identifies the contract
-func (c *Client) GetContract(ctx context.Context, documentNumber string) (*GetContractResp, error) {
+func (c *contractsService) GetContract(ctx context.Context, documentNumber string) (*Contract, error) {
 	resp := &GetContractResp{}
 
 	uri := fmt.Sprintf("%s/%s", contractsURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getContractOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Contract, nil
 }
 
 // UpdateContract does _PUT https://api.fortnox.se/3/contracts/{DocumentNumber}
 //
 // documentNumber - identifies the contract
-func (c *Client) UpdateContract(
+//
+// con - contract to update
+func (c *contractsService) UpdateContract(
 	ctx context.Context,
 	documentNumber int,
-	req *UpdateContractReq) (*UpdateContractResp, error) {
+	con *Contract) (*Contract, error) {
 
+	req := &UpdateContractReq{Contract: *con}
 	resp := &UpdateContractResp{}
 
 	uri := fmt.Sprintf("%s/%d", contractsURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateContractOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Contract, nil
 }
 
 // GetAllContract does _GET https://api.fortnox.se/3/contracts/
 //
 // filter - Enum: "active" "inactive" "finished"  possibility to filter contracts
-func (c *Client) GetAllContract(ctx context.Context, filter GetAllContractFilter) (*GetContractResp, error) {
-	resp := &GetContractResp{}
+func (c *contractsService) GetAllContract(ctx context.Context, filter GetAllContractFilter) ([]Contract, error) {
+	return c.IterateContracts(filter).All(ctx)
+}
+
+// GetContractsPage does _GET https://api.fortnox.se/3/contracts/ and returns a single page along with its MetaInformation
+//
+// filter - Enum: "active" "inactive" "finished"  possibility to filter contracts
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *contractsService) GetContractsPage(
+	ctx context.Context,
+	filter GetAllContractFilter,
+	page *PageOptions) ([]Contract, *MetaInformation, error) {
 
-	params := filter.urlValues()
+	resp := &GetAllContractsResp{}
 
-	err := c._GET(ctx, contractsURI, params, resp)
+	params, err := filter.urlValues()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	err = c._GETPage(ctx, getContractsPageOp, contractsURI, params, page, resp)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return resp, nil
+	return resp.Contracts, &resp.MetaInformation, nil
+}
+
+// IterateContracts lazily walks all pages of https://api.fortnox.se/3/contracts/
+//
+// filter - Enum: "active" "inactive" "finished"  possibility to filter contracts
+func (c *contractsService) IterateContracts(filter GetAllContractFilter) *Iterator[Contract] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Contract, *MetaInformation, error) {
+		return c.GetContractsPage(ctx, filter, page)
+	})
 }
 
 // CreateContract does _POST https://api.fortnox.se/3/contracts/
 //
-// req - contract to create
-func (c *Client) CreateContract(ctx context.Context, req *CreateContractReq) (*CreateContractResp, error) {
+// con - contract to create
+func (c *contractsService) CreateContract(ctx context.Context, con *Contract) (*Contract, error) {
+	req := &CreateContractReq{Contract: *con}
 	resp := &CreateContractResp{}
 
-	err := c._POST(ctx, contractsURI, nil, req, resp)
+	err := c._POST(ctx, createContractOp, contractsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Contract, nil
 }
 
 // SetContractAsFinished does _PUT https://api.fortnox.se/3/contracts/{DocumentNumber}/finish
 //
 // documentNumber - identifies the contract
-func (c *Client) SetContractAsFinished(ctx context.Context, documentNumber string) (*SetContractAsFinishedResp, error) {
+func (c *contractsService) SetContractAsFinished(ctx context.Context, documentNumber string) (*Contract, error) {
 	resp := &SetContractAsFinishedResp{}
 
 	uri := fmt.Sprintf("%s/%s/finish", contractsURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, setContractAsFinishedOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Contract, nil
 }
 
 // CreateInvoiceFromContract does _PUT https://api.fortnox.se/3/contracts/{DocumentNumber}/createinvoice
 //
-// identifies the contract
-func (c *Client) CreateInvoiceFromContract(ctx context.Context, documentNumber string) (*CreateInvoiceFromContractResp, error) {
+// documentNumber - identifies the contract
+func (c *contractsService) CreateInvoiceFromContract(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &CreateInvoiceFromContractResp{}
 
 	uri := fmt.Sprintf("%s/%s/createinvoice", contractsURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, createInvoiceFromContractOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Invoice, nil
 }
 
 // IncreaseInvoiceCount does _PUT https://api.fortnox.se/3/contracts/{DocumentNumber}/increaseinvoicecount
 //
 // documentNumber - identifies the contract
-func (c *Client) IncreaseInvoiceCount(ctx context.Context, documentNumber string) (*CreateInvoiceFromContractResp, error) {
-	resp := &CreateInvoiceFromContractResp{}
+func (c *contractsService) IncreaseInvoiceCount(ctx context.Context, documentNumber string) (*Contract, error) {
+	resp := &IncreaseInvoiceCountResp{}
 
 	uri := fmt.Sprintf("%s/%s/increaseinvoicecount", contractsURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, increaseInvoiceCountOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Contract, nil
 }
 
 type GetAllContractFilter string
@@ -133,500 +161,98 @@ const (
 	FinishedGetAllContractFilter GetAllContractFilter = "finished"
 )
 
-func (f GetAllContractFilter) urlValues() url.Values {
-	urlValues := url.Values{}
-
-	fStr := string(f)
-
-	if strings.TrimSpace(fStr) != "" {
-		urlValues["filter"] = []string{fStr}
-	}
+func (f GetAllContractFilter) urlValues() (url.Values, error) {
+	return encodeQuery(struct {
+		Filter GetAllContractFilter `fortnox:"filter"`
+	}{f})
+}
 
-	return urlValues
+type GetAllContractsResp struct {
+	Contracts       []Contract      `json:"Contracts"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type GetContractResp struct {
-	Contract struct {
-		Url                       string           `json:"@url"`
-		UrlTaxReductionList       string           `json:"@urlTaxReductionList"`
-		Active                    bool             `json:"Active"`
-		AdministrationFee         int              `json:"AdministrationFee"`
-		BasisTaxReduction         int              `json:"BasisTaxReduction"`
-		Comments                  string           `json:"Comments"`
-		Continuous                bool             `json:"Continuous"`
-		ContractDate              string           `json:"ContractDate"`
-		ContractLength            int              `json:"ContractLength"`
-		ContributionPercent       int              `json:"ContributionPercent"`
-		ContributionValue         int              `json:"ContributionValue"`
-		CostCenter                string           `json:"CostCenter"`
-		Currency                  string           `json:"Currency"`
-		CustomerName              string           `json:"CustomerName"`
-		CustomerNumber            string           `json:"CustomerNumber"`
-		DocumentNumber            string           `json:"DocumentNumber"`
-		EmailInformation          EmailInformation `json:"EmailInformation"`
-		ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1"`
-		ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2"`
-		Freight                   int              `json:"Freight"`
-		Gross                     int              `json:"Gross"`
-		HouseWork                 bool             `json:"HouseWork"`
-		InvoiceDiscount           int              `json:"InvoiceDiscount"`
-		InvoiceInterval           int              `json:"InvoiceInterval"`
-		InvoicesRemaining         string           `json:"InvoicesRemaining"`
-		InvoiceRows               []InvoiceRow     `json:"InvoiceRows"`
-		Language                  string           `json:"Language"`
-		LastInvoiceDate           string           `json:"LastInvoiceDate"`
-		Net                       int              `json:"Net"`
-		OurReference              string           `json:"OurReference"`
-		PeriodEnd                 string           `json:"PeriodEnd"`
-		PeriodStart               string           `json:"PeriodStart"`
-		PriceList                 string           `json:"PriceList"`
-		PrintTemplate             string           `json:"PrintTemplate"`
-		Project                   string           `json:"Project"`
-		Remarks                   string           `json:"Remarks"`
-		RoundOff                  int              `json:"RoundOff"`
-		TaxReduction              int              `json:"TaxReduction"`
-		TemplateName              string           `json:"TemplateName"`
-		TemplateNumber            int              `json:"TemplateNumber"`
-		TermsOfDelivery           string           `json:"TermsOfDelivery"`
-		TermsOfPayment            string           `json:"TermsOfPayment"`
-		Total                     int              `json:"Total"`
-		TotalToPay                int              `json:"TotalToPay"`
-		TotalVAT                  int              `json:"TotalVAT"`
-		VatIncluded               bool             `json:"VatIncluded"`
-		WayOfDelivery             string           `json:"WayOfDelivery"`
-		YourOrderNumber           string           `json:"YourOrderNumber"`
-		YourReference             string           `json:"YourReference"`
-		TaxReductionType          string           `json:"TaxReductionType"`
-	} `json:"Contract"`
+	Contract Contract `json:"Contract"`
 }
 
 type UpdateContractReq struct {
-	Contract struct {
-		Url                       string           `json:"@url"`
-		UrlTaxReductionList       string           `json:"@urlTaxReductionList"`
-		Active                    bool             `json:"Active"`
-		AdministrationFee         int              `json:"AdministrationFee"`
-		BasisTaxReduction         int              `json:"BasisTaxReduction"`
-		Comments                  string           `json:"Comments"`
-		Continuous                bool             `json:"Continuous"`
-		ContractDate              string           `json:"ContractDate"`
-		ContractLength            int              `json:"ContractLength"`
-		ContributionPercent       int              `json:"ContributionPercent"`
-		ContributionValue         int              `json:"ContributionValue"`
-		CostCenter                string           `json:"CostCenter"`
-		Currency                  string           `json:"Currency"`
-		CustomerName              string           `json:"CustomerName"`
-		CustomerNumber            string           `json:"CustomerNumber"`
-		DocumentNumber            string           `json:"DocumentNumber"`
-		EmailInformation          EmailInformation `json:"EmailInformation"`
-		ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1"`
-		ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2"`
-		Freight                   int              `json:"Freight"`
-		Gross                     int              `json:"Gross"`
-		HouseWork                 bool             `json:"HouseWork"`
-		InvoiceDiscount           int              `json:"InvoiceDiscount"`
-		InvoiceInterval           int              `json:"InvoiceInterval"`
-		InvoicesRemaining         string           `json:"InvoicesRemaining"`
-		InvoiceRows               []InvoiceRow     `json:"InvoiceRows"`
-		Language                  string           `json:"Language"`
-		LastInvoiceDate           string           `json:"LastInvoiceDate"`
-		Net                       int              `json:"Net"`
-		OurReference              string           `json:"OurReference"`
-		PeriodEnd                 string           `json:"PeriodEnd"`
-		PeriodStart               string           `json:"PeriodStart"`
-		PriceList                 string           `json:"PriceList"`
-		PrintTemplate             string           `json:"PrintTemplate"`
-		Project                   string           `json:"Project"`
-		Remarks                   string           `json:"Remarks"`
-		RoundOff                  int              `json:"RoundOff"`
-		TaxReduction              int              `json:"TaxReduction"`
-		TemplateName              string           `json:"TemplateName"`
-		TemplateNumber            int              `json:"TemplateNumber"`
-		TermsOfDelivery           string           `json:"TermsOfDelivery"`
-		TermsOfPayment            string           `json:"TermsOfPayment"`
-		Total                     int              `json:"Total"`
-		TotalToPay                int              `json:"TotalToPay"`
-		TotalVAT                  int              `json:"TotalVAT"`
-		VatIncluded               bool             `json:"VatIncluded"`
-		WayOfDelivery             string           `json:"WayOfDelivery"`
-		YourOrderNumber           string           `json:"YourOrderNumber"`
-		YourReference             string           `json:"YourReference"`
-		TaxReductionType          string           `json:"TaxReductionType"`
-	} `json:"Contract"`
+	Contract Contract `json:"Contract"`
 }
 
 type UpdateContractResp struct {
-	Contract struct {
-		Url                       string           `json:"@url"`
-		UrlTaxReductionList       string           `json:"@urlTaxReductionList"`
-		Active                    bool             `json:"Active"`
-		AdministrationFee         int              `json:"AdministrationFee"`
-		BasisTaxReduction         int              `json:"BasisTaxReduction"`
-		Comments                  string           `json:"Comments"`
-		Continuous                bool             `json:"Continuous"`
-		ContractDate              string           `json:"ContractDate"`
-		ContractLength            int              `json:"ContractLength"`
-		ContributionPercent       int              `json:"ContributionPercent"`
-		ContributionValue         int              `json:"ContributionValue"`
-		CostCenter                string           `json:"CostCenter"`
-		Currency                  string           `json:"Currency"`
-		CustomerName              string           `json:"CustomerName"`
-		CustomerNumber            string           `json:"CustomerNumber"`
-		DocumentNumber            string           `json:"DocumentNumber"`
-		EmailInformation          EmailInformation `json:"EmailInformation"`
-		ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1"`
-		ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2"`
-		Freight                   int              `json:"Freight"`
-		Gross                     int              `json:"Gross"`
-		HouseWork                 bool             `json:"HouseWork"`
-		InvoiceDiscount           int              `json:"InvoiceDiscount"`
-		InvoiceInterval           int              `json:"InvoiceInterval"`
-		InvoicesRemaining         string           `json:"InvoicesRemaining"`
-		InvoiceRows               []InvoiceRow     `json:"InvoiceRows"`
-		Language                  string           `json:"Language"`
-		LastInvoiceDate           string           `json:"LastInvoiceDate"`
-		Net                       int              `json:"Net"`
-		OurReference              string           `json:"OurReference"`
-		PeriodEnd                 string           `json:"PeriodEnd"`
-		PeriodStart               string           `json:"PeriodStart"`
-		PriceList                 string           `json:"PriceList"`
-		PrintTemplate             string           `json:"PrintTemplate"`
-		Project                   string           `json:"Project"`
-		Remarks                   string           `json:"Remarks"`
-		RoundOff                  int              `json:"RoundOff"`
-		TaxReduction              int              `json:"TaxReduction"`
-		TemplateName              string           `json:"TemplateName"`
-		TemplateNumber            int              `json:"TemplateNumber"`
-		TermsOfDelivery           string           `json:"TermsOfDelivery"`
-		TermsOfPayment            string           `json:"TermsOfPayment"`
-		Total                     int              `json:"Total"`
-		TotalToPay                int              `json:"TotalToPay"`
-		TotalVAT                  int              `json:"TotalVAT"`
-		VatIncluded               bool             `json:"VatIncluded"`
-		WayOfDelivery             string           `json:"WayOfDelivery"`
-		YourOrderNumber           string           `json:"YourOrderNumber"`
-		YourReference             string           `json:"YourReference"`
-		TaxReductionType          string           `json:"TaxReductionType"`
-	} `json:"Contract"`
+	Contract Contract `json:"Contract"`
 }
 
 type CreateContractReq struct {
-	Contract struct {
-		Url                       string           `json:"@url"`
-		UrlTaxReductionList       string           `json:"@urlTaxReductionList"`
-		Active                    bool             `json:"Active"`
-		AdministrationFee         int              `json:"AdministrationFee"`
-		BasisTaxReduction         int              `json:"BasisTaxReduction"`
-		Comments                  string           `json:"Comments"`
-		Continuous                bool             `json:"Continuous"`
-		ContractDate              string           `json:"ContractDate"`
-		ContractLength            int              `json:"ContractLength"`
-		ContributionPercent       int              `json:"ContributionPercent"`
-		ContributionValue         int              `json:"ContributionValue"`
-		CostCenter                string           `json:"CostCenter"`
-		Currency                  string           `json:"Currency"`
-		CustomerName              string           `json:"CustomerName"`
-		CustomerNumber            string           `json:"CustomerNumber"`
-		DocumentNumber            string           `json:"DocumentNumber"`
-		EmailInformation          EmailInformation `json:"EmailInformation"`
-		ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1"`
-		ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2"`
-		Freight                   int              `json:"Freight"`
-		Gross                     int              `json:"Gross"`
-		HouseWork                 bool             `json:"HouseWork"`
-		InvoiceDiscount           int              `json:"InvoiceDiscount"`
-		InvoiceInterval           int              `json:"InvoiceInterval"`
-		InvoicesRemaining         string           `json:"InvoicesRemaining"`
-		InvoiceRows               []InvoiceRow     `json:"InvoiceRows"`
-		Language                  string           `json:"Language"`
-		LastInvoiceDate           string           `json:"LastInvoiceDate"`
-		Net                       int              `json:"Net"`
-		OurReference              string           `json:"OurReference"`
-		PeriodEnd                 string           `json:"PeriodEnd"`
-		PeriodStart               string           `json:"PeriodStart"`
-		PriceList                 string           `json:"PriceList"`
-		PrintTemplate             string           `json:"PrintTemplate"`
-		Project                   string           `json:"Project"`
-		Remarks                   string           `json:"Remarks"`
-		RoundOff                  int              `json:"RoundOff"`
-		TaxReduction              int              `json:"TaxReduction"`
-		TemplateName              string           `json:"TemplateName"`
-		TemplateNumber            int              `json:"TemplateNumber"`
-		TermsOfDelivery           string           `json:"TermsOfDelivery"`
-		TermsOfPayment            string           `json:"TermsOfPayment"`
-		Total                     int              `json:"Total"`
-		TotalToPay                int              `json:"TotalToPay"`
-		TotalVAT                  int              `json:"TotalVAT"`
-		VatIncluded               bool             `json:"VatIncluded"`
-		WayOfDelivery             string           `json:"WayOfDelivery"`
-		YourOrderNumber           string           `json:"YourOrderNumber"`
-		YourReference             string           `json:"YourReference"`
-		TaxReductionType          string           `json:"TaxReductionType"`
-	} `json:"Contract"`
+	Contract Contract `json:"Contract"`
 }
 
 type CreateContractResp struct {
-	Contract struct {
-		Url                       string           `json:"@url"`
-		UrlTaxReductionList       string           `json:"@urlTaxReductionList"`
-		Active                    bool             `json:"Active"`
-		AdministrationFee         int              `json:"AdministrationFee"`
-		BasisTaxReduction         int              `json:"BasisTaxReduction"`
-		Comments                  string           `json:"Comments"`
-		Continuous                bool             `json:"Continuous"`
-		ContractDate              string           `json:"ContractDate"`
-		ContractLength            int              `json:"ContractLength"`
-		ContributionPercent       int              `json:"ContributionPercent"`
-		ContributionValue         int              `json:"ContributionValue"`
-		CostCenter                string           `json:"CostCenter"`
-		Currency                  string           `json:"Currency"`
-		CustomerName              string           `json:"CustomerName"`
-		CustomerNumber            string           `json:"CustomerNumber"`
-		DocumentNumber            string           `json:"DocumentNumber"`
-		EmailInformation          EmailInformation `json:"EmailInformation"`
-		ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1"`
-		ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2"`
-		Freight                   int              `json:"Freight"`
-		Gross                     int              `json:"Gross"`
-		HouseWork                 bool             `json:"HouseWork"`
-		InvoiceDiscount           int              `json:"InvoiceDiscount"`
-		InvoiceInterval           int              `json:"InvoiceInterval"`
-		InvoicesRemaining         string           `json:"InvoicesRemaining"`
-		InvoiceRows               []InvoiceRow     `json:"InvoiceRows"`
-		Language                  string           `json:"Language"`
-		LastInvoiceDate           string           `json:"LastInvoiceDate"`
-		Net                       int              `json:"Net"`
-		OurReference              string           `json:"OurReference"`
-		PeriodEnd                 string           `json:"PeriodEnd"`
-		PeriodStart               string           `json:"PeriodStart"`
-		PriceList                 string           `json:"PriceList"`
-		PrintTemplate             string           `json:"PrintTemplate"`
-		Project                   string           `json:"Project"`
-		Remarks                   string           `json:"Remarks"`
-		RoundOff                  int              `json:"RoundOff"`
-		TaxReduction              int              `json:"TaxReduction"`
-		TemplateName              string           `json:"TemplateName"`
-		TemplateNumber            int              `json:"TemplateNumber"`
-		TermsOfDelivery           string           `json:"TermsOfDelivery"`
-		TermsOfPayment            string           `json:"TermsOfPayment"`
-		Total                     int              `json:"Total"`
-		TotalToPay                int              `json:"TotalToPay"`
-		TotalVAT                  int              `json:"TotalVAT"`
-		VatIncluded               bool             `json:"VatIncluded"`
-		WayOfDelivery             string           `json:"WayOfDelivery"`
-		YourOrderNumber           string           `json:"YourOrderNumber"`
-		YourReference             string           `json:"YourReference"`
-		TaxReductionType          string           `json:"TaxReductionType"`
-	} `json:"Contract"`
+	Contract Contract `json:"Contract"`
 }
 
 type SetContractAsFinishedResp struct {
-	Contract struct {
-		Url                       string           `json:"@url"`
-		UrlTaxReductionList       string           `json:"@urlTaxReductionList"`
-		Active                    bool             `json:"Active"`
-		AdministrationFee         int              `json:"AdministrationFee"`
-		BasisTaxReduction         int              `json:"BasisTaxReduction"`
-		Comments                  string           `json:"Comments"`
-		Continuous                bool             `json:"Continuous"`
-		ContractDate              string           `json:"ContractDate"`
-		ContractLength            int              `json:"ContractLength"`
-		ContributionPercent       int              `json:"ContributionPercent"`
-		ContributionValue         int              `json:"ContributionValue"`
-		CostCenter                string           `json:"CostCenter"`
-		Currency                  string           `json:"Currency"`
-		CustomerName              string           `json:"CustomerName"`
-		CustomerNumber            string           `json:"CustomerNumber"`
-		DocumentNumber            string           `json:"DocumentNumber"`
-		EmailInformation          EmailInformation `json:"EmailInformation"`
-		ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1"`
-		ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2"`
-		Freight                   int              `json:"Freight"`
-		Gross                     int              `json:"Gross"`
-		HouseWork                 bool             `json:"HouseWork"`
-		InvoiceDiscount           int              `json:"InvoiceDiscount"`
-		InvoiceInterval           int              `json:"InvoiceInterval"`
-		InvoicesRemaining         string           `json:"InvoicesRemaining"`
-		InvoiceRows               []InvoiceRow     `json:"InvoiceRows"`
-		Language                  string           `json:"Language"`
-		LastInvoiceDate           string           `json:"LastInvoiceDate"`
-		Net                       int              `json:"Net"`
-		OurReference              string           `json:"OurReference"`
-		PeriodEnd                 string           `json:"PeriodEnd"`
-		PeriodStart               string           `json:"PeriodStart"`
-		PriceList                 string           `json:"PriceList"`
-		PrintTemplate             string           `json:"PrintTemplate"`
-		Project                   string           `json:"Project"`
-		Remarks                   string           `json:"Remarks"`
-		RoundOff                  int              `json:"RoundOff"`
-		TaxReduction              int              `json:"TaxReduction"`
-		TemplateName              string           `json:"TemplateName"`
-		TemplateNumber            int              `json:"TemplateNumber"`
-		TermsOfDelivery           string           `json:"TermsOfDelivery"`
-		TermsOfPayment            string           `json:"TermsOfPayment"`
-		Total                     int              `json:"Total"`
-		TotalToPay                int              `json:"TotalToPay"`
-		TotalVAT                  int              `json:"TotalVAT"`
-		VatIncluded               bool             `json:"VatIncluded"`
-		WayOfDelivery             string           `json:"WayOfDelivery"`
-		YourOrderNumber           string           `json:"YourOrderNumber"`
-		YourReference             string           `json:"YourReference"`
-		TaxReductionType          string           `json:"TaxReductionType"`
-	} `json:"Contract"`
+	Contract Contract `json:"Contract"`
 }
 
 type CreateInvoiceFromContractResp struct {
-	Invoice struct {
-		Url                    string         `json:"@url"`
-		UrlTaxReductionList    string         `json:"@urlTaxReductionList"`
-		AdministrationFee      int            `json:"AdministrationFee"`
-		AdministrationFeeVAT   int            `json:"AdministrationFeeVAT"`
-		Address1               string         `json:"Address1"`
-		Address2               string         `json:"Address2"`
-		Balance                int            `json:"Balance"`
-		BasisTaxReduction      int            `json:"BasisTaxReduction"`
-		Booked                 bool           `json:"Booked"`
-		Cancelled              bool           `json:"Cancelled"`
-		City                   string         `json:"City"`
-		Comments               string         `json:"Comments"`
-		ContractReference      int            `json:"ContractReference"`
-		ContributionPercent    int            `json:"ContributionPercent"`
-		ContributionValue      int            `json:"ContributionValue"`
-		Country                string         `json:"Country"`
-		CostCenter             string         `json:"CostCenter"`
-		Credit                 string         `json:"Credit"`
-		CreditInvoiceReference string         `json:"CreditInvoiceReference"`
-		Currency               string         `json:"Currency"`
-		CurrencyRate           int            `json:"CurrencyRate"`
-		CurrencyUnit           int            `json:"CurrencyUnit"`
-		CustomerName           string         `json:"CustomerName"`
-		CustomerNumber         string         `json:"CustomerNumber"`
-		DeliveryAddress1       string         `json:"DeliveryAddress1"`
-		DeliveryAddress2       string         `json:"DeliveryAddress2"`
-		DeliveryCity           string         `json:"DeliveryCity"`
-		DeliveryCountry        string         `json:"DeliveryCountry"`
-		DeliveryDate           string         `json:"DeliveryDate"`
-		DeliveryName           string         `json:"DeliveryName"`
-		DeliveryZipCode        string         `json:"DeliveryZipCode"`
-		DocumentNumber         string         `json:"DocumentNumber"`
-		DueDate                string         `json:"DueDate"`
-		EDIInformation         EDIInformation `json:"EDIInformation"`
-		EmailInformation       struct {
-			EmailAddressFrom string `json:"EmailAddressFrom"`
-			EmailAddressTo   string `json:"EmailAddressTo"`
-			EmailAddressCC   string `json:"EmailAddressCC"`
-			EmailAddressBCC  string `json:"EmailAddressBCC"`
-			EmailSubject     string `json:"EmailSubject"`
-			EmailBody        string `json:"EmailBody"`
-		} `json:"EmailInformation"`
-		EUQuarterlyReport         bool         `json:"EUQuarterlyReport"`
-		ExternalInvoiceReference1 string       `json:"ExternalInvoiceReference1"`
-		ExternalInvoiceReference2 string       `json:"ExternalInvoiceReference2"`
-		Freight                   int          `json:"Freight"`
-		FreightVAT                int          `json:"FreightVAT"`
-		Gross                     int          `json:"Gross"`
-		HouseWork                 bool         `json:"HouseWork"`
-		InvoiceDate               string       `json:"InvoiceDate"`
-		InvoicePeriodStart        string       `json:"InvoicePeriodStart"`
-		InvoicePeriodEnd          string       `json:"InvoicePeriodEnd"`
-		InvoicePeriodReference    string       `json:"InvoicePeriodReference"`
-		InvoiceRows               []InvoiceRow `json:"InvoiceRows"`
-		InvoiceType               string       `json:"InvoiceType"`
-		Labels                    []Label      `json:"Labels"`
-		Language                  string       `json:"Language"`
-		LastRemindDate            string       `json:"LastRemindDate"`
-		Net                       int          `json:"Net"`
-		NotCompleted              bool         `json:"NotCompleted"`
-		NoxFinans                 bool         `json:"NoxFinans"`
-		OCR                       string       `json:"OCR"`
-		OfferReference            string       `json:"OfferReference"`
-		OrderReference            string       `json:"OrderReference"`
-		OrganisationNumber        string       `json:"OrganisationNumber"`
-		OurReference              string       `json:"OurReference"`
-		PaymentWay                string       `json:"PaymentWay"`
-		Phone1                    string       `json:"Phone1"`
-		Phone2                    string       `json:"Phone2"`
-		PriceList                 string       `json:"PriceList"`
-		PrintTemplate             string       `json:"PrintTemplate"`
-		Project                   string       `json:"Project"`
-		WarehouseReady            bool         `json:"WarehouseReady"`
-		OutboundDate              string       `json:"OutboundDate"`
-		Remarks                   string       `json:"Remarks"`
-		Reminders                 int          `json:"Reminders"`
-		RoundOff                  int          `json:"RoundOff"`
-		Sent                      bool         `json:"Sent"`
-		TaxReduction              int          `json:"TaxReduction"`
-		TermsOfDelivery           string       `json:"TermsOfDelivery"`
-		TermsOfPayment            string       `json:"TermsOfPayment"`
-		TimeBasisReference        int          `json:"TimeBasisReference"`
-		Total                     int          `json:"Total"`
-		TotalToPay                int          `json:"TotalToPay"`
-		TotalVAT                  int          `json:"TotalVAT"`
-		VATIncluded               bool         `json:"VATIncluded"`
-		VoucherNumber             int          `json:"VoucherNumber"`
-		VoucherSeries             string       `json:"VoucherSeries"`
-		VoucherYear               int          `json:"VoucherYear"`
-		WayOfDelivery             string       `json:"WayOfDelivery"`
-		YourOrderNumber           string       `json:"YourOrderNumber"`
-		YourReference             string       `json:"YourReference"`
-		ZipCode                   string       `json:"ZipCode"`
-		AccountingMethod          string       `json:"AccountingMethod"`
-		TaxReductionType          string       `json:"TaxReductionType"`
-		FinalPayDate              string       `json:"FinalPayDate"`
-	} `json:"Invoice"`
+	Invoice Invoice `json:"Invoice"`
 }
 
 type IncreaseInvoiceCountResp struct {
-	Contract struct {
-		Url                       string           `json:"@url"`
-		UrlTaxReductionList       string           `json:"@urlTaxReductionList"`
-		Active                    bool             `json:"Active"`
-		AdministrationFee         int              `json:"AdministrationFee"`
-		BasisTaxReduction         int              `json:"BasisTaxReduction"`
-		Comments                  string           `json:"Comments"`
-		Continuous                bool             `json:"Continuous"`
-		ContractDate              string           `json:"ContractDate"`
-		ContractLength            int              `json:"ContractLength"`
-		ContributionPercent       int              `json:"ContributionPercent"`
-		ContributionValue         int              `json:"ContributionValue"`
-		CostCenter                string           `json:"CostCenter"`
-		Currency                  string           `json:"Currency"`
-		CustomerName              string           `json:"CustomerName"`
-		CustomerNumber            string           `json:"CustomerNumber"`
-		DocumentNumber            string           `json:"DocumentNumber"`
-		EmailInformation          EmailInformation `json:"EmailInformation"`
-		ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1"`
-		ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2"`
-		Freight                   int              `json:"Freight"`
-		Gross                     int              `json:"Gross"`
-		HouseWork                 bool             `json:"HouseWork"`
-		InvoiceDiscount           int              `json:"InvoiceDiscount"`
-		InvoiceInterval           int              `json:"InvoiceInterval"`
-		InvoicesRemaining         string           `json:"InvoicesRemaining"`
-		InvoiceRows               []InvoiceRow     `json:"InvoiceRows"`
-		Language                  string           `json:"Language"`
-		LastInvoiceDate           string           `json:"LastInvoiceDate"`
-		Net                       int              `json:"Net"`
-		OurReference              string           `json:"OurReference"`
-		PeriodEnd                 string           `json:"PeriodEnd"`
-		PeriodStart               string           `json:"PeriodStart"`
-		PriceList                 string           `json:"PriceList"`
-		PrintTemplate             string           `json:"PrintTemplate"`
-		Project                   string           `json:"Project"`
-		Remarks                   string           `json:"Remarks"`
-		RoundOff                  int              `json:"RoundOff"`
-		TaxReduction              int              `json:"TaxReduction"`
-		TemplateName              string           `json:"TemplateName"`
-		TemplateNumber            int              `json:"TemplateNumber"`
-		TermsOfDelivery           string           `json:"TermsOfDelivery"`
-		TermsOfPayment            string           `json:"TermsOfPayment"`
-		Total                     int              `json:"Total"`
-		TotalToPay                int              `json:"TotalToPay"`
-		TotalVAT                  int              `json:"TotalVAT"`
-		VatIncluded               bool             `json:"VatIncluded"`
-		WayOfDelivery             string           `json:"WayOfDelivery"`
-		YourOrderNumber           string           `json:"YourOrderNumber"`
-		YourReference             string           `json:"YourReference"`
-		TaxReductionType          string           `json:"TaxReductionType"`
-	} `json:"Contract"`
+	Contract Contract `json:"Contract"`
+}
+
+type Contract struct {
+	Url                       string           `json:"@url,omitempty"`
+	UrlTaxReductionList       string           `json:"@urlTaxReductionList,omitempty"`
+	Active                    bool             `json:"Active,omitempty"`
+	AdministrationFee         Money            `json:"AdministrationFee,omitzero"`
+	BasisTaxReduction         Money            `json:"BasisTaxReduction,omitzero"`
+	Comments                  string           `json:"Comments,omitempty"`
+	Continuous                bool             `json:"Continuous,omitempty"`
+	ContractDate              Date             `json:"ContractDate,omitempty"`
+	ContractLength            int              `json:"ContractLength,omitempty"`
+	ContributionPercent       int              `json:"ContributionPercent,omitempty"`
+	ContributionValue         Money            `json:"ContributionValue,omitzero"`
+	CostCenter                string           `json:"CostCenter,omitempty"`
+	Currency                  string           `json:"Currency,omitempty"`
+	CustomerName              string           `json:"CustomerName,omitempty"`
+	CustomerNumber            string           `json:"CustomerNumber,omitempty"`
+	DocumentNumber            string           `json:"DocumentNumber,omitempty"`
+	EmailInformation          EmailInformation `json:"EmailInformation,omitempty"`
+	ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1,omitempty"`
+	ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2,omitempty"`
+	Freight                   Money            `json:"Freight,omitzero"`
+	Gross                     Money            `json:"Gross,omitzero"`
+	HouseWork                 bool             `json:"HouseWork,omitempty"`
+	InvoiceDiscount           int              `json:"InvoiceDiscount,omitempty"`
+	InvoiceInterval           int              `json:"InvoiceInterval,omitempty"`
+	InvoicesRemaining         string           `json:"InvoicesRemaining,omitempty"`
+	InvoiceRows               []InvoiceRow     `json:"InvoiceRows,omitempty"`
+	Language                  string           `json:"Language,omitempty"`
+	LastInvoiceDate           Date             `json:"LastInvoiceDate,omitempty"`
+	Net                       Money            `json:"Net,omitzero"`
+	OurReference              string           `json:"OurReference,omitempty"`
+	PeriodEnd                 Date             `json:"PeriodEnd,omitempty"`
+	PeriodStart               Date             `json:"PeriodStart,omitempty"`
+	PriceList                 string           `json:"PriceList,omitempty"`
+	PrintTemplate             string           `json:"PrintTemplate,omitempty"`
+	Project                   string           `json:"Project,omitempty"`
+	Remarks                   string           `json:"Remarks,omitempty"`
+	RoundOff                  Money            `json:"RoundOff,omitzero"`
+	TaxReduction              Money            `json:"TaxReduction,omitzero"`
+	TemplateName              string           `json:"TemplateName,omitempty"`
+	TemplateNumber            int              `json:"TemplateNumber,omitempty"`
+	TermsOfDelivery           string           `json:"TermsOfDelivery,omitempty"`
+	TermsOfPayment            string           `json:"TermsOfPayment,omitempty"`
+	Total                     Money            `json:"Total,omitzero"`
+	TotalToPay                Money            `json:"TotalToPay,omitzero"`
+	TotalVAT                  Money            `json:"TotalVAT,omitzero"`
+	VatIncluded               bool             `json:"VatIncluded,omitempty"`
+	WayOfDelivery             string           `json:"WayOfDelivery,omitempty"`
+	YourOrderNumber           string           `json:"YourOrderNumber,omitempty"`
+	YourReference             string           `json:"YourReference,omitempty"`
+	TaxReductionType          string           `json:"TaxReductionType,omitempty"`
 }
@@ -10,25 +10,39 @@ const (
 )
 
 // GetAllCostCenters does _GET https://api.fortnox.se/3/costcenters
-func (c *Client) GetAllCostCenters(ctx context.Context) ([]CostCenter, error) {
+func (c *costCentersService) GetAllCostCenters(ctx context.Context) ([]CostCenter, error) {
+	return c.IterateCostCenters().All(ctx)
+}
+
+// GetCostCentersPage does _GET https://api.fortnox.se/3/costcenters and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *costCentersService) GetCostCentersPage(ctx context.Context, page *PageOptions) ([]CostCenter, *MetaInformation, error) {
 	resp := &GetAllCostCentersResp{}
 
-	err := c._GET(ctx, costCentersURI, nil, resp)
+	err := c._GETPage(ctx, getCostCentersPageOp, costCentersURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.CostCenters, nil
+	return resp.CostCenters, &resp.MetaInformation, nil
+}
+
+// IterateCostCenters lazily walks all pages of https://api.fortnox.se/3/costcenters
+func (c *costCentersService) IterateCostCenters() *Iterator[CostCenter] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]CostCenter, *MetaInformation, error) {
+		return c.GetCostCentersPage(ctx, page)
+	})
 }
 
 // CreateCostCenter does _POST https://api.fortnox.se/3/costcenters
 //
 // cc - cost center to create
-func (c *Client) CreateCostCenter(ctx context.Context, cc *CostCenter) (*CostCenter, error) {
+func (c *costCentersService) CreateCostCenter(ctx context.Context, cc *CostCenter) (*CostCenter, error) {
 	req := &CreateCostCenterReq{CostCenter: *cc}
 	resp := &CreateCostCenterResp{}
 
-	err := c._POST(ctx, costCentersURI, nil, req, resp)
+	err := c._POST(ctx, createCostCenterOp, costCentersURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +53,12 @@ func (c *Client) CreateCostCenter(ctx context.Context, cc *CostCenter) (*CostCen
 // GetCostCenter does _GET https://api.fortnox.se/3/costcenters/{Code}
 //
 // code - identifies the cost center
-func (c Client) GetCostCenter(ctx context.Context, code string) (*CostCenter, error) {
+func (c *costCentersService) GetCostCenter(ctx context.Context, code string) (*CostCenter, error) {
 	resp := &GetCostCenterResp{}
 
 	uri := fmt.Sprintf("%s/%s", costCentersURI, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getCostCenterOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,13 +71,13 @@ func (c Client) GetCostCenter(ctx context.Context, code string) (*CostCenter, er
 // code - identifies the cost center
 //
 // cc - cost center to update
-func (c Client) UpdateCostCenter(ctx context.Context, code string, cc *CostCenter) (*CostCenter, error) {
+func (c *costCentersService) UpdateCostCenter(ctx context.Context, code string, cc *CostCenter) (*CostCenter, error) {
 	req := UpdateCostCenterReq{CostCenter: *cc}
 	resp := &UpdateCostCenterResp{}
 
 	uri := fmt.Sprintf("%s/%s", costCentersURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateCostCenterOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -74,9 +88,9 @@ func (c Client) UpdateCostCenter(ctx context.Context, code string, cc *CostCente
 // RemoveCostCenter does _DELETE https://api.fortnox.se/3/costcenters/{Code}
 //
 // code - identifies the cost center to remove
-func (c *Client) RemoveCostCenter(ctx context.Context, code string) error {
-	uri := fmt.Sprintf("%s/%s", currenciesURI, code)
-	return c._DELETE(ctx, uri)
+func (c *costCentersService) RemoveCostCenter(ctx context.Context, code string) error {
+	uri := fmt.Sprintf("%s/%s", costCentersURI, code)
+	return c._DELETE(ctx, removeCostCenterOp, uri)
 }
 
 type CostCenter struct {
@@ -88,7 +102,8 @@ type CostCenter struct {
 }
 
 type GetAllCostCentersResp struct {
-	CostCenters []CostCenter `json:"CostCenters"`
+	CostCenters     []CostCenter    `json:"CostCenters"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateCostCenterReq struct {
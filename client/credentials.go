@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// credentials is the token pair shared by a Client and all its copies.
+// Fortnox refresh tokens are single use, so only one refresh may be in flight at a time.
+type credentials struct {
+	mu           sync.RWMutex
+	accessToken  string
+	refreshToken string
+	expiresAt    time.Time
+	// scope is the space separated scopes granted to the token, empty if unknown
+	scope    string
+	inflight *refreshCall
+	// limiter is the rate limiter of clients with neither a tenant, a token nor a client ID, created on first use
+	limiter *RateLimiter
+}
+
+// refreshTimeout bounds a token refresh, which runs detached from the caller's context
+// so a cancelled caller can't lose a single use refresh token halfway through the exchange
+const refreshTimeout = 30 * time.Second
+
+type refreshCall struct {
+	done chan struct{}
+	err  error
+}
+
+type refreshFunc func(ctx context.Context, refreshToken string) error
+
+func newCredentials(accessToken, refreshToken string) *credentials {
+	return &credentials{
+		accessToken:  accessToken,
+		refreshToken: refreshToken,
+	}
+}
+
+func (cr *credentials) get() (accessToken, refreshToken string) {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	return cr.accessToken, cr.refreshToken
+}
+
+func (cr *credentials) getExpiresAt() time.Time {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	return cr.expiresAt
+}
+
+func (cr *credentials) set(accessToken, refreshToken string, expiresAt time.Time) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	cr.accessToken = accessToken
+	cr.refreshToken = refreshToken
+	cr.expiresAt = expiresAt
+}
+
+func (cr *credentials) getScope() string {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	return cr.scope
+}
+
+func (cr *credentials) setScope(scope string) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	cr.scope = scope
+}
+
+// rateLimiter returns the RateLimiter of the token pair, creating it on first use
+func (cr *credentials) rateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	if cr.limiter == nil {
+		cr.limiter = NewRateLimiter(requestsPerSecond, burst)
+	}
+
+	return cr.limiter
+}
+
+// expiresWithin reports whether the access token is known to expire within d and can be refreshed
+func (cr *credentials) expiresWithin(d time.Duration) bool {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	if cr.expiresAt.IsZero() || cr.refreshToken == "" {
+		return false
+	}
+
+	return time.Until(cr.expiresAt) < d
+}
+
+// refresh calls fn with the current refresh token, unless the access token is no longer
+// staleAccessToken, which means another caller has refreshed it already.
+// Concurrent callers wait for the refresh in flight and share its result.
+// fn runs under refreshTimeout without ctx's cancellation, ctx only bounds how long the caller waits.
+func (cr *credentials) refresh(ctx context.Context, staleAccessToken string, fn refreshFunc) error {
+	cr.mu.Lock()
+
+	if cr.accessToken != staleAccessToken {
+		cr.mu.Unlock()
+		return nil
+	}
+
+	call := cr.inflight
+	if call == nil {
+		call = &refreshCall{done: make(chan struct{})}
+		cr.inflight = call
+		refreshToken := cr.refreshToken
+
+		go func() {
+			refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+			defer cancel()
+
+			call.err = fn(refreshCtx, refreshToken)
+
+			cr.mu.Lock()
+			cr.inflight = nil
+			cr.mu.Unlock()
+
+			close(call.done)
+		}()
+	}
+
+	cr.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -10,25 +10,39 @@ const (
 )
 
 // GetAllCurrencies does _GET https://api.fortnox.se/3/currencies
-func (c *Client) GetAllCurrencies(ctx context.Context) ([]Currency, error) {
+func (c *currenciesService) GetAllCurrencies(ctx context.Context) ([]Currency, error) {
+	return c.IterateCurrencies().All(ctx)
+}
+
+// GetCurrenciesPage does _GET https://api.fortnox.se/3/currencies and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *currenciesService) GetCurrenciesPage(ctx context.Context, page *PageOptions) ([]Currency, *MetaInformation, error) {
 	resp := &GetAllCurrenciesResp{}
 
-	err := c._GET(ctx, currenciesURI, nil, resp)
+	err := c._GETPage(ctx, getCurrenciesPageOp, currenciesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Currencies, nil
+	return resp.Currencies, &resp.MetaInformation, nil
+}
+
+// IterateCurrencies lazily walks all pages of https://api.fortnox.se/3/currencies
+func (c *currenciesService) IterateCurrencies() *Iterator[Currency] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Currency, *MetaInformation, error) {
+		return c.GetCurrenciesPage(ctx, page)
+	})
 }
 
 // CreateCurrency does _POST https://api.fortnox.se/3/currencies
 //
 // req - currency to create
-func (c *Client) CreateCurrency(ctx context.Context, cur *Currency) (*Currency, error) {
+func (c *currenciesService) CreateCurrency(ctx context.Context, cur *Currency) (*Currency, error) {
 	req := &CreateCurrencyReq{Currency: *cur}
 	resp := &CreateCurrencyResp{}
 
-	err := c._POST(ctx, currenciesURI, nil, req, resp)
+	err := c._POST(ctx, createCurrencyOp, currenciesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +53,12 @@ func (c *Client) CreateCurrency(ctx context.Context, cur *Currency) (*Currency,
 // GetCurrency does _GET https://api.fortnox.se/3/currencies/{Code}
 //
 // code - identifies currency
-func (c *Client) GetCurrency(ctx context.Context, code string) (*Currency, error) {
+func (c *currenciesService) GetCurrency(ctx context.Context, code string) (*Currency, error) {
 	resp := &GetCurrencyResp{}
 
 	uri := fmt.Sprintf("%s/%s", currenciesURI, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getCurrencyOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,13 +71,13 @@ func (c *Client) GetCurrency(ctx context.Context, code string) (*Currency, error
 // code - identifies currency
 //
 // req - currency to update
-func (c *Client) UpdateCurrency(ctx context.Context, code string, cur *Currency) (*Currency, error) {
+func (c *currenciesService) UpdateCurrency(ctx context.Context, code string, cur *Currency) (*Currency, error) {
 	req := &UpdateCurrencyReq{Currency: *cur}
 	resp := &UpdateCurrencyResp{}
 
 	uri := fmt.Sprintf("%s/%s", currenciesURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateCurrencyOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -74,19 +88,20 @@ func (c *Client) UpdateCurrency(ctx context.Context, code string, cur *Currency)
 // RemoveCurrency does _DELETE
 //
 // code - identifies the currency to remove
-func (c *Client) RemoveCurrency(ctx context.Context, code string) error {
+func (c *currenciesService) RemoveCurrency(ctx context.Context, code string) error {
 	uri := fmt.Sprintf("%s/%s", currenciesURI, code)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeCurrencyOp, uri)
 }
 
 type Currency struct {
-	Currency string  `json:"currency,omitempty"`
-	Rate     float64 `json:"rate,omitempty"`
-	Unit     int     `json:"unit,omitempty"`
+	Currency string `json:"currency,omitempty"`
+	Rate     Money  `json:"rate,omitzero"`
+	Unit     int    `json:"unit,omitempty"`
 }
 
 type GetAllCurrenciesResp struct {
-	Currencies []Currency `json:"Currencies"`
+	Currencies      []Currency      `json:"Currencies"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateCurrencyReq struct {
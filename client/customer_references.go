@@ -10,27 +10,56 @@ const (
 )
 
 // GetAllCustomerReferences does _GET https://api.fortnox.se/3/customerreferences/
-func (c *Client) GetAllCustomerReferences(ctx context.Context) (*GetAllCustomerReferencesResp, error) {
-	resp := &GetAllCustomerReferencesResp{}
+func (c *customerReferencesService) GetAllCustomerReferences(ctx context.Context) (*GetAllCustomerReferencesResp, error) {
+	it := c.IterateCustomerReferences()
 
-	err := c._GET(ctx, customerReferencesURI, nil, resp)
+	rows, err := it.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp := &GetAllCustomerReferencesResp{CustomerReference: CustomerReference{CustomerReferenceRows: rows}}
+	if meta := it.PageInfo(); meta != nil {
+		resp.MetaInformation = *meta
+	}
+
 	return resp, nil
 }
 
+// GetCustomerReferencesPage does _GET https://api.fortnox.se/3/customerreferences/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *customerReferencesService) GetCustomerReferencesPage(
+	ctx context.Context,
+	page *PageOptions) ([]CustomerReferenceRow, *MetaInformation, error) {
+
+	resp := &GetAllCustomerReferencesResp{}
+
+	err := c._GETPage(ctx, getCustomerReferencesPageOp, customerReferencesURI, nil, page, resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.CustomerReference.CustomerReferenceRows, &resp.MetaInformation, nil
+}
+
+// IterateCustomerReferences lazily walks all pages of https://api.fortnox.se/3/customerreferences/
+func (c *customerReferencesService) IterateCustomerReferences() *Iterator[CustomerReferenceRow] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]CustomerReferenceRow, *MetaInformation, error) {
+		return c.GetCustomerReferencesPage(ctx, page)
+	})
+}
+
 // CreateCustomerReference does _POST https://api.fortnox.se/3/customerreferences/
 //
 // req - customer reference row to create
-func (c *Client) CreateCustomerReference(
+func (c *customerReferencesService) CreateCustomerReference(
 	ctx context.Context,
 	req *CreateCustomerReferenceReq) (*CreateCustomerReferenceResp, error) {
 
 	resp := &CreateCustomerReferenceResp{}
 
-	err := c._POST(ctx, customerReferencesURI, nil, req, resp)
+	err := c._POST(ctx, createCustomerReferenceOp, customerReferencesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +70,7 @@ func (c *Client) CreateCustomerReference(
 // GetCustomerReference does _GET https://api.fortnox.se/3/customerreferences/{CustomerReferenceRowId}
 //
 // customerReferenceRowID - identifies the customer reference row
-func (c *Client) GetCustomerReference(
+func (c *customerReferencesService) GetCustomerReference(
 	ctx context.Context,
 	customerReferenceRowID string) (*GetCustomerReferenceResp, error) {
 
@@ -49,7 +78,7 @@ func (c *Client) GetCustomerReference(
 
 	uri := fmt.Sprintf("%s/%s", customerReferencesURI, customerReferenceRowID)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getCustomerReferenceOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -62,16 +91,16 @@ func (c *Client) GetCustomerReference(
 // customerReferenceRowID - identifies the customer reference row
 //
 // req - customer reference row to update
-func (c *Client) UpdateCustomerReference(
+func (c *customerReferencesService) UpdateCustomerReference(
 	ctx context.Context,
 	customerReferenceRowID string,
 	req *UpdateCustomerReferenceReq) (*UpdateCustomerReferenceResp, error) {
 
 	resp := &UpdateCustomerReferenceResp{}
 
-	uri := fmt.Sprintf("%s/%s", customersURI, customerReferenceRowID)
+	uri := fmt.Sprintf("%s/%s", customerReferencesURI, customerReferenceRowID)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateCustomerReferenceOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -82,13 +111,14 @@ func (c *Client) UpdateCustomerReference(
 // DeleteCustomerReferenceRow does _DELETE https://api.fortnox.se/3/customerreferences/{CustomerReferenceRowId}
 //
 // customerReferenceRowID - identifies the customer reference row
-func (c *Client) DeleteCustomerReferenceRow(ctx context.Context, customerReferenceRowID string) error {
+func (c *customerReferencesService) DeleteCustomerReferenceRow(ctx context.Context, customerReferenceRowID string) error {
 	uri := fmt.Sprintf("%s/%s", customerReferencesURI, customerReferenceRowID)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteCustomerReferenceRowOp, uri)
 }
 
 type GetAllCustomerReferencesResp struct {
 	CustomerReference CustomerReference `json:"CustomerReference"`
+	MetaInformation   MetaInformation   `json:"MetaInformation"`
 }
 
 type CreateCustomerReferenceReq struct {
@@ -156,9 +186,9 @@ type UpdateCustomerReferenceResp struct {
 		Fax                      string               `json:"Fax"`
 		GLN                      string               `json:"GLN"`
 		GLNDelivery              string               `json:"GLNDelivery"`
-		InvoiceAdministrationFee string               `json:"InvoiceAdministrationFee"`
+		InvoiceAdministrationFee Money                `json:"InvoiceAdministrationFee"`
 		InvoiceDiscount          int                  `json:"InvoiceDiscount"`
-		InvoiceFreight           string               `json:"InvoiceFreight"`
+		InvoiceFreight           Money                `json:"InvoiceFreight"`
 		InvoiceRemark            string               `json:"InvoiceRemark"`
 		Name                     string               `json:"Name"`
 		OrganisationNumber       string               `json:"OrganisationNumber"`
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strconv"
 )
 
 const (
@@ -10,25 +11,39 @@ const (
 )
 
 // GetAllCustomers does _GET https://api.fortnox.se/3/customers/
-func (c *Client) GetAllCustomers(ctx context.Context) ([]Customer, error) {
+func (c *customersService) GetAllCustomers(ctx context.Context) ([]Customer, error) {
+	return c.IterateCustomers().All(ctx)
+}
+
+// GetCustomersPage does _GET https://api.fortnox.se/3/customers/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *customersService) GetCustomersPage(ctx context.Context, page *PageOptions) ([]Customer, *MetaInformation, error) {
 	resp := &GetAllCustomersResp{}
 
-	err := c._GET(ctx, customersURI, nil, resp)
+	err := c._GETPage(ctx, getCustomersPageOp, customersURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Customers, nil
+	return resp.Customers, &resp.MetaInformation, nil
+}
+
+// IterateCustomers lazily walks all pages of https://api.fortnox.se/3/customers/
+func (c *customersService) IterateCustomers() *Iterator[Customer] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Customer, *MetaInformation, error) {
+		return c.GetCustomersPage(ctx, page)
+	})
 }
 
 // CreateCustomer does _POST https://api.fortnox.se/3/customers/
 //
 // req - customer to create
-func (c *Client) CreateCustomer(ctx context.Context, cus *Customer) (*Customer, error) {
+func (c *customersService) CreateCustomer(ctx context.Context, cus *Customer) (*Customer, error) {
 	req := &CreateCustomerReq{Customer: *cus}
 	resp := &CreateCustomerResp{}
 
-	err := c._POST(ctx, customersURI, nil, req, resp)
+	err := c._POST(ctx, createCustomerOp, customersURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +54,12 @@ func (c *Client) CreateCustomer(ctx context.Context, cus *Customer) (*Customer,
 // GetCustomer does _GET https://api.fortnox.se/3/customers/{CustomerId}
 //
 // customerNumber - identifies the customer
-func (c *Client) GetCustomer(ctx context.Context, customerNumber string) (*Customer, error) {
+func (c *customersService) GetCustomer(ctx context.Context, customerNumber string) (*Customer, error) {
 	resp := &GetCustomerResp{}
 
 	uri := fmt.Sprintf("%s/%s", customersURI, customerNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getCustomerOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -52,22 +67,22 @@ func (c *Client) GetCustomer(ctx context.Context, customerNumber string) (*Custo
 	return &resp.Customer, nil
 }
 
-// UpdateCustomer does _PUT https://api.fortnox.se/3/employees/{CustomerId}
+// UpdateCustomer does _PUT https://api.fortnox.se/3/customers/{CustomerNumber}
 //
 // customerNumber - identifies the customer
 //
-// req - customer to update
-func (c *Client) UpdateCustomer(
+// cus - fields to update, only fields that are Set are sent
+func (c *customersService) UpdateCustomer(
 	ctx context.Context,
 	customerNumber string,
-	cus *Customer) (*Customer, error) {
+	cus *CustomerUpdate) (*Customer, error) {
 
 	req := &UpdateCustomerReq{Customer: *cus}
 	resp := &UpdateCustomerResp{}
 
 	uri := fmt.Sprintf("%s/%s", customersURI, customerNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateCustomerOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -75,16 +90,33 @@ func (c *Client) UpdateCustomer(
 	return &resp.Customer, nil
 }
 
+// PatchCustomer does _PUT https://api.fortnox.se/3/customers/{CustomerNumber} sending only the fields changed between original and edited
+//
+// original - customer as returned by Fortnox
+//
+// edited - modified copy of original
+//
+// Nothing is sent when no writable field changed, original is returned then.
+func (c *customersService) PatchCustomer(ctx context.Context, original, edited *Customer) (*Customer, error) {
+	update := DiffCustomer(original, edited)
+	if !hasSetFields(update) {
+		return original, nil
+	}
+
+	return c.UpdateCustomer(ctx, strconv.FormatInt(original.CustomerNumber, 10), update)
+}
+
 // DeleteCustomer does _DELETE https://api.fortnox.se/3/customers/{CustomerNumber}
 //
 // customerNumber - identifies the customer
-func (c *Client) DeleteCustomer(ctx context.Context, customerNumber string) error {
+func (c *customersService) DeleteCustomer(ctx context.Context, customerNumber string) error {
 	uri := fmt.Sprintf("%s/%s", customersURI, customerNumber)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteCustomerOp, uri)
 }
 
 type GetAllCustomersResp struct {
-	Customers []Customer `json:"Customers"`
+	Customers       []Customer      `json:"Customers"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateCustomerReq struct {
@@ -100,7 +132,7 @@ type GetCustomerResp struct {
 }
 
 type UpdateCustomerReq struct {
-	Customer Customer `json:"Customer"`
+	Customer CustomerUpdate `json:"Customer"`
 }
 
 type UpdateCustomerResp struct {
@@ -145,9 +177,9 @@ type Customer struct {
 	Fax                      string               `json:"Fax,omitempty"`
 	GLN                      string               `json:"GLN,omitempty"`
 	GLNDelivery              string               `json:"GLNDelivery,omitempty"`
-	InvoiceAdministrationFee string               `json:"InvoiceAdministrationFee,omitempty"`
+	InvoiceAdministrationFee Money                `json:"InvoiceAdministrationFee,omitzero"`
 	InvoiceDiscount          int                  `json:"InvoiceDiscount,omitempty"`
-	InvoiceFreight           string               `json:"InvoiceFreight,omitempty"`
+	InvoiceFreight           Money                `json:"InvoiceFreight,omitzero"`
 	InvoiceRemark            string               `json:"InvoiceRemark,omitempty"`
 	Name                     string               `json:"Name,omitempty"`
 	OrganisationNumber       string               `json:"OrganisationNumber,omitempty"`
@@ -173,6 +205,93 @@ type Customer struct {
 	YourReference            string               `json:"YourReference,omitempty"`
 	ZipCode                  string               `json:"ZipCode,omitempty"`
 }
+
+// CustomerUpdate is the payload of UpdateCustomer, only fields that are Set are sent,
+// so they can be changed to their zero value
+type CustomerUpdate struct {
+	Address1                 Optional[string]               `json:"Address1"`
+	Address2                 Optional[string]               `json:"Address2"`
+	City                     Optional[string]               `json:"City"`
+	Country                  Optional[string]               `json:"Country"`
+	Comments                 Optional[string]               `json:"Comments"`
+	Currency                 Optional[string]               `json:"Currency"`
+	CostCenter               Optional[string]               `json:"CostCenter"`
+	CountryCode              Optional[string]               `json:"CountryCode"`
+	Active                   Optional[bool]                 `json:"Active"`
+	CustomerNumber           Optional[int64]                `json:"CustomerNumber"`
+	DefaultDeliveryTypes     Optional[DefaultDeliveryTypes] `json:"DefaultDeliveryTypes"`
+	DefaultTemplates         Optional[DefaultTemplates]     `json:"DefaultTemplates"`
+	DeliveryAddress1         Optional[string]               `json:"DeliveryAddress1"`
+	DeliveryAddress2         Optional[string]               `json:"DeliveryAddress2"`
+	DeliveryCity             Optional[string]               `json:"DeliveryCity"`
+	DeliveryCountry          Optional[string]               `json:"DeliveryCountry"`
+	DeliveryCountryCode      Optional[string]               `json:"DeliveryCountryCode"`
+	DeliveryFax              Optional[string]               `json:"DeliveryFax"`
+	DeliveryName             Optional[string]               `json:"DeliveryName"`
+	DeliveryPhone1           Optional[string]               `json:"DeliveryPhone1"`
+	DeliveryPhone2           Optional[string]               `json:"DeliveryPhone2"`
+	DeliveryZipCode          Optional[string]               `json:"DeliveryZipCode"`
+	Email                    Optional[string]               `json:"Email"`
+	EmailInvoice             Optional[string]               `json:"EmailInvoice"`
+	EmailInvoiceBCC          Optional[string]               `json:"EmailInvoiceBCC"`
+	EmailInvoiceCC           Optional[string]               `json:"EmailInvoiceCC"`
+	EmailOffer               Optional[string]               `json:"EmailOffer"`
+	EmailOfferBCC            Optional[string]               `json:"EmailOfferBCC"`
+	EmailOfferCC             Optional[string]               `json:"EmailOfferCC"`
+	EmailOrder               Optional[string]               `json:"EmailOrder"`
+	EmailOrderBCC            Optional[string]               `json:"EmailOrderBCC"`
+	EmailOrderCC             Optional[string]               `json:"EmailOrderCC"`
+	ExternalReference        Optional[string]               `json:"ExternalReference"`
+	Fax                      Optional[string]               `json:"Fax"`
+	GLN                      Optional[string]               `json:"GLN"`
+	GLNDelivery              Optional[string]               `json:"GLNDelivery"`
+	InvoiceAdministrationFee Optional[Money]                `json:"InvoiceAdministrationFee"`
+	InvoiceDiscount          Optional[int]                  `json:"InvoiceDiscount"`
+	InvoiceFreight           Optional[Money]                `json:"InvoiceFreight"`
+	InvoiceRemark            Optional[string]               `json:"InvoiceRemark"`
+	Name                     Optional[string]               `json:"Name"`
+	OrganisationNumber       Optional[string]               `json:"OrganisationNumber"`
+	OurReference             Optional[string]               `json:"OurReference"`
+	Phone1                   Optional[string]               `json:"Phone1"`
+	Phone2                   Optional[string]               `json:"Phone2"`
+	PriceList                Optional[string]               `json:"PriceList"`
+	Project                  Optional[string]               `json:"Project"`
+	SalesAccount             Optional[string]               `json:"SalesAccount"`
+	ShowPriceVATIncluded     Optional[bool]                 `json:"ShowPriceVATIncluded"`
+	TermsOfDelivery          Optional[string]               `json:"TermsOfDelivery"`
+	TermsOfPayment           Optional[string]               `json:"TermsOfPayment"`
+	Type                     Optional[CustomerType]         `json:"Type"`
+	VATNumber                Optional[string]               `json:"VATNumber"`
+	VATType                  Optional[VATType]              `json:"VATType"`
+	VisitingAddress          Optional[string]               `json:"VisitingAddress"`
+	VisitingCity             Optional[string]               `json:"VisitingCity"`
+	VisitingCountry          Optional[string]               `json:"VisitingCountry"`
+	VisitingCountryCode      Optional[string]               `json:"VisitingCountryCode"`
+	VisitingZipCode          Optional[string]               `json:"VisitingZipCode"`
+	WayOfDelivery            Optional[string]               `json:"WayOfDelivery"`
+	WWW                      Optional[string]               `json:"WWW"`
+	YourReference            Optional[string]               `json:"YourReference"`
+	ZipCode                  Optional[string]               `json:"ZipCode"`
+}
+
+func (u CustomerUpdate) MarshalJSON() ([]byte, error) {
+	return marshalSetFields(u)
+}
+
+// customerReadOnlyFields are computed or set by Fortnox, DiffCustomer never sends them
+var customerReadOnlyFields = map[string]bool{
+	"CustomerNumber": true,
+}
+
+// DiffCustomer returns an update holding only the writable fields that differ between original, as returned by Fortnox,
+// and edited, a modified copy of it
+func DiffCustomer(original, edited *Customer) *CustomerUpdate {
+	u := &CustomerUpdate{}
+	diffInto(original, edited, u, customerReadOnlyFields)
+
+	return u
+}
+
 type DefaultDeliveryTypes struct {
 	Invoice string `json:"Invoice,omitempty"`
 	Order   string `json:"Order,omitempty"`
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const defaultISOLayout = "2006-01-02"
+
+var (
+	ErrInvalidDate = errors.New("invalid date, expected YYYY-MM-DD")
+)
+
+// Date is a calendar date without time or time zone, such as InvoiceDate or DueDate,
+// encoded as "2006-01-02" in JSON and query params.
+//
+// Date is a string underneath so omitempty leaves unset dates out of payloads,
+// the zero value "" is an unset date. Create dates with NewDate, DateOf or ParseDate.
+type Date string
+
+// NewDate returns the date year-month-day, out of range values are normalized like time.Date does
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the date of t in t's location
+func DateOf(t time.Time) Date {
+	if t.IsZero() {
+		return ""
+	}
+
+	return Date(t.Format(defaultISOLayout))
+}
+
+// Today returns the current date in Sweden, where Fortnox books everything
+func Today() Date {
+	return DateOf(time.Now().In(stockholm()))
+}
+
+// ParseDate parses "2006-01-02", an empty string is the zero Date.
+// Timestamps such as "2006-01-02 15:04" or RFC 3339 are cut to their date.
+func ParseDate(s string) (Date, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", nil
+	}
+
+	if len(s) > len(defaultISOLayout) {
+		s = s[:len(defaultISOLayout)]
+	}
+
+	t, err := time.Parse(defaultISOLayout, s)
+	if err != nil {
+		return "", errors.Wrapf(ErrInvalidDate, "can't parse %q", s)
+	}
+
+	return DateOf(t), nil
+}
+
+// MustParseDate is ParseDate panicking on error, for constants and tests
+func MustParseDate(s string) Date {
+	d, err := ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// IsZero reports whether the date is unset
+func (d Date) IsZero() bool {
+	return d == ""
+}
+
+// Valid reports whether d is unset or a valid date
+func (d Date) Valid() bool {
+	if d.IsZero() {
+		return true
+	}
+
+	_, err := time.Parse(defaultISOLayout, string(d))
+	return err == nil
+}
+
+// Time returns midnight UTC of the date, the zero time for an unset or invalid date
+func (d Date) Time() time.Time {
+	t, _ := time.Parse(defaultISOLayout, string(d))
+	return t
+}
+
+// In returns midnight of the date in loc
+func (d Date) In(loc *time.Location) time.Time {
+	if d.IsZero() {
+		return time.Time{}
+	}
+
+	t := d.Time()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+func (d Date) String() string {
+	return string(d)
+}
+
+// Compare returns -1, 0 or 1 when d is before, equal to or after o, an unset date is before every date
+func (d Date) Compare(o Date) int {
+	return d.Time().Compare(o.Time())
+}
+
+// Before reports whether d is before o
+func (d Date) Before(o Date) bool {
+	return d.Compare(o) < 0
+}
+
+// After reports whether d is after o
+func (d Date) After(o Date) bool {
+	return d.Compare(o) > 0
+}
+
+// Equal reports whether d and o are the same date
+func (d Date) Equal(o Date) bool {
+	return d.Compare(o) == 0
+}
+
+// AddDays returns the date n days after d, n may be negative. An unset date stays unset.
+func (d Date) AddDays(n int) Date {
+	if d.IsZero() {
+		return d
+	}
+
+	return DateOf(d.Time().AddDate(0, 0, n))
+}
+
+// AddMonths returns the date n months after d, n may be negative.
+// Unlike time.AddDate the day is clamped to the end of a shorter month, so 2024-01-31 plus one month is 2024-02-29.
+// An unset date stays unset.
+func (d Date) AddMonths(n int) Date {
+	if d.IsZero() {
+		return d
+	}
+
+	t := d.Time()
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return NewDate(firstOfMonth.Year(), firstOfMonth.Month(), day)
+}
+
+// DaysUntil returns the number of days from d to o, negative if o is before d.
+// It returns 0 when either date is unset or invalid.
+func (d Date) DaysUntil(o Date) int {
+	from, to := d.Time(), o.Time()
+	if from.IsZero() || to.IsZero() {
+		return 0
+	}
+
+	// seconds rather than a time.Duration, which overflows for dates more than 292 years apart
+	return int((to.Unix() - from.Unix()) / (24 * 60 * 60))
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid() {
+		return nil, errors.Wrapf(ErrInvalidDate, "can't encode %q", string(d))
+	}
+
+	return json.Marshal(string(d))
+}
+
+// UnmarshalJSON accepts "2006-01-02", timestamps starting with a date, "" and null
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = ""
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrap(ErrInvalidDate, err.Error())
+	}
+
+	parsed, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+func stockholm() *time.Location {
+	loc, err := time.LoadLocation("Europe/Stockholm")
+	if err != nil {
+		return time.Local
+	}
+
+	return loc
+}
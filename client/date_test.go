@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestDateAddMonths(t *testing.T) {
+	tests := []struct {
+		date Date
+		n    int
+		want Date
+	}{
+		{"2024-01-31", 1, "2024-02-29"},
+		{"2023-01-31", 1, "2023-02-28"},
+		{"2024-03-31", -1, "2024-02-29"},
+		{"2024-01-31", 3, "2024-04-30"},
+		{"2024-05-15", 0, "2024-05-15"},
+		{"2024-11-30", 2, "2025-01-30"},
+		{"2024-01-31", -13, "2022-12-31"},
+		{"2024-02-29", 12, "2025-02-28"},
+		{"", 1, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.date.AddMonths(tt.n); got != tt.want {
+			t.Errorf("%q.AddMonths(%d) = %q, want %q", tt.date, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDateAddDays(t *testing.T) {
+	tests := []struct {
+		date Date
+		n    int
+		want Date
+	}{
+		{"2024-02-28", 1, "2024-02-29"},
+		{"2024-03-01", -1, "2024-02-29"},
+		{"2024-12-31", 1, "2025-01-01"},
+		// the days around the switch to summer time are 23 and 25 hours long in Sweden, dates don't care
+		{"2024-03-30", 2, "2024-04-01"},
+		{"", 5, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.date.AddDays(tt.n); got != tt.want {
+			t.Errorf("%q.AddDays(%d) = %q, want %q", tt.date, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDateDaysUntil(t *testing.T) {
+	tests := []struct {
+		from, to Date
+		want     int
+	}{
+		{"2024-01-01", "2024-01-31", 30},
+		{"2024-01-31", "2024-01-01", -30},
+		{"2024-02-01", "2024-03-01", 29},
+		{"2023-02-01", "2023-03-01", 28},
+		{"2024-03-30", "2024-04-01", 2},
+		{"2024-05-01", "2024-05-01", 0},
+		{"1800-01-01", "2300-01-01", 182621},
+		{"", "2024-01-01", 0},
+		{"2024-01-01", "", 0},
+		{"2024-13-01", "2024-01-01", 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.from.DaysUntil(tt.to); got != tt.want {
+			t.Errorf("%q.DaysUntil(%q) = %d, want %d", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestDateCompare(t *testing.T) {
+	tests := []struct {
+		a, b Date
+		want int
+	}{
+		{"2024-01-01", "2024-01-02", -1},
+		{"2024-01-02", "2024-01-01", 1},
+		{"2024-01-01", "2024-01-01", 0},
+		{"", "2024-01-01", -1},
+		{"2024-01-01", "", 1},
+		{"", "", 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%q.Compare(%q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if tt.a.Before(tt.b) != (tt.want < 0) || tt.a.After(tt.b) != (tt.want > 0) || tt.a.Equal(tt.b) != (tt.want == 0) {
+			t.Errorf("Before, After or Equal of %q and %q disagree with Compare", tt.a, tt.b)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Date
+		wantErr bool
+	}{
+		{"2024-02-29", "2024-02-29", false},
+		{" 2024-02-29 ", "2024-02-29", false},
+		{"2024-02-29 13:45", "2024-02-29", false},
+		{"2024-02-29T13:45:00+01:00", "2024-02-29", false},
+		{"", "", false},
+		{"2023-02-29", "", true},
+		{"29/02/2024", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDate(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseDate(%q) = %q, %v, want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("ParseDate(%q) error = %v, want ErrInvalidDate", tt.in, err)
+		}
+	}
+}
+
+func TestDateJSON(t *testing.T) {
+	var v struct {
+		Date Date `json:"Date,omitempty"`
+	}
+
+	for in, want := range map[string]Date{
+		`{"Date":"2024-02-29"}`:       "2024-02-29",
+		`{"Date":"2024-02-29 10:00"}`: "2024-02-29",
+		`{"Date":""}`:                 "",
+		`{"Date":null}`:               "",
+	} {
+		v.Date = "stale"
+		if err := json.Unmarshal([]byte(in), &v); err != nil || v.Date != want {
+			t.Errorf("Unmarshal(%s) = %q, %v, want %q", in, v.Date, err, want)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"Date":"tomorrow"}`), &v); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("Unmarshal() of an invalid date = %v, want ErrInvalidDate", err)
+	}
+
+	v.Date = ""
+	if bts, err := json.Marshal(v); err != nil || string(bts) != `{}` {
+		t.Errorf("Marshal() of an unset date = %s, %v, want {}", bts, err)
+	}
+
+	v.Date = "2024-02-30"
+	if _, err := json.Marshal(v); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("Marshal() of an invalid date = %v, want ErrInvalidDate", err)
+	}
+}
+
+func TestDateIn(t *testing.T) {
+	loc := time.FixedZone("CET", 3600)
+
+	if got := MustParseDate("2024-02-29").In(loc); !got.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, loc)) {
+		t.Errorf("In() = %v", got)
+	}
+	if got := Date("").In(loc); !got.IsZero() {
+		t.Errorf("In() of an unset date = %v, want the zero time", got)
+	}
+}
+
+func TestDatePathParamsAreValidated(t *testing.T) {
+	// nothing listens there, an invalid date must fail before a request is sent
+	c := NewClient(WithAuthOpt("token", "secret"), WithURLOpt("http://127.0.0.1:1/3/"), WithRetryPolicyOpt(NoRetryPolicy))
+	ctx := context.Background()
+
+	for _, date := range []Date{"", "2024-02-30", "../employees"} {
+		calls := map[string]error{}
+		_, calls["GetScheduleTime"] = c.GetScheduleTime(ctx, "1", date)
+		_, calls["UpdateScheduleTime"] = c.UpdateScheduleTime(ctx, "1", date, &ScheduleTime{})
+		_, calls["ResetScheduleTime"] = c.ResetScheduleTime(ctx, "1", date)
+		_, calls["GetAbsenceTransactionForEmployee"] = c.GetAbsenceTransactionForEmployee(ctx, "1", date, "SJK")
+
+		for name, err := range calls {
+			if !errors.Is(err, ErrInvalidDate) {
+				t.Errorf("%s(%q) = %v, want ErrInvalidDate", name, date, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrDownloadStalled = errors.New("download stalled, nothing was received within the client's timeout")
+)
+
+// Download is a streamed binary response such as a PDF, SIE or archive file.
+// The caller must Close it, WriteTo and SaveToFile close it for you.
+//
+// The Timeout of the client's http.Client doesn't bound the whole download, since it would cut off
+// a large body while it is still being read. It bounds the wait for the response headers and for
+// every next chunk of the body instead, a stalled download fails with ErrDownloadStalled.
+// The ctx of the call bounds the total transfer, reading included, set a deadline on it to cap that.
+type Download struct {
+	io.ReadCloser
+	// ContentType is the media type sent by Fortnox, e.g. application/pdf
+	ContentType string
+	// FileName is taken from Content-Disposition, empty if not sent
+	FileName string
+	// ContentLength is -1 when unknown
+	ContentLength int64
+}
+
+// rawResponse makes request hand over the successful response unread instead of decoding JSON
+type rawResponse struct {
+	resp *http.Response
+}
+
+// downloadClient returns a copy of hc without its overall Timeout, sharing its Transport,
+// watchIdle enforces the Timeout between reads instead
+func downloadClient(hc *http.Client) *http.Client {
+	if hc == nil || hc.Timeout == 0 {
+		return hc
+	}
+
+	noTimeout := *hc
+	noTimeout.Timeout = 0
+
+	return &noTimeout
+}
+
+// idleWatch cancels a download when nothing was received for timeout,
+// be it the response headers or the next chunk of the body
+type idleWatch struct {
+	timeout time.Duration
+	ctx     context.Context
+	cancel  context.CancelCauseFunc
+	timer   *time.Timer
+}
+
+// watchIdle returns the context to send a download with, timeout <= 0 leaves ctx alone
+func watchIdle(ctx context.Context, timeout time.Duration) (context.Context, *idleWatch) {
+	if timeout <= 0 {
+		return ctx, nil
+	}
+
+	w := &idleWatch{timeout: timeout}
+	w.ctx, w.cancel = context.WithCancelCause(ctx)
+	w.timer = time.AfterFunc(timeout, func() { w.cancel(ErrDownloadStalled) })
+
+	return w.ctx, w
+}
+
+// reset restarts the timeout after progress
+func (w *idleWatch) reset() {
+	w.timer.Reset(w.timeout)
+}
+
+// stop releases the watch once the download is done or failed
+func (w *idleWatch) stop() {
+	if w == nil {
+		return
+	}
+
+	w.timer.Stop()
+	w.cancel(context.Canceled)
+}
+
+// wrap makes err ErrDownloadStalled when the watch cancelled the download
+func (w *idleWatch) wrap(err error) error {
+	if w == nil || err == nil || !errors.Is(context.Cause(w.ctx), ErrDownloadStalled) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", ErrDownloadStalled, err)
+}
+
+// body returns rc restarting the timeout on every read, closing it stops the watch
+func (w *idleWatch) body(rc io.ReadCloser) io.ReadCloser {
+	if w == nil {
+		return rc
+	}
+
+	return &idleBody{ReadCloser: rc, watch: w}
+}
+
+type idleBody struct {
+	io.ReadCloser
+	watch *idleWatch
+}
+
+func (b *idleBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.watch.reset()
+	}
+	if err != nil && err != io.EOF {
+		err = b.watch.wrap(err)
+	}
+
+	return n, err
+}
+
+func (b *idleBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.watch.stop()
+
+	return err
+}
+
+func newDownload(resp *http.Response) *Download {
+	d := &Download{
+		ReadCloser:    resp.Body,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(d.ContentType); err == nil {
+		d.ContentType = mediaType
+	}
+
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		d.FileName = params["filename"]
+	}
+
+	return d
+}
+
+// WriteTo streams the download to w and closes it
+func (d *Download) WriteTo(w io.Writer) (int64, error) {
+	defer d.Close()
+
+	return io.Copy(w, d.ReadCloser)
+}
+
+// SaveToFile streams the download to the file at path and closes it,
+// the file is created or truncated
+func (d *Download) SaveToFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		_ = d.Close()
+		return err
+	}
+
+	_, err = d.WriteTo(f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// pdfServer sends chunks of a PDF with pause between them, or stalls before the headers when headerDelay is set
+func pdfServer(t *testing.T, chunks int, pause, headerDelay time.Duration) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(headerDelay):
+		case <-r.Context().Done():
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.WriteHeader(http.StatusOK)
+
+		for i := 0; i < chunks; i++ {
+			_, _ = io.WriteString(w, "%PDF")
+			w.(http.Flusher).Flush()
+
+			select {
+			case <-time.After(pause):
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newDownloadClient(server *httptest.Server, timeout time.Duration) *Client {
+	return NewClient(
+		WithAuthOpt("access-token", "client-secret"),
+		WithURLOpt(server.URL+"/3/"),
+		WithHTTPClientOpt(&http.Client{Timeout: timeout}),
+		WithRateLimitOpt(0, 0),
+		WithRetryPolicyOpt(NoRetryPolicy))
+}
+
+func TestDownloadOutlivesClientTimeout(t *testing.T) {
+	// 6 chunks 30ms apart take longer than the 100ms timeout, but none of the gaps does
+	server := pdfServer(t, 6, 30*time.Millisecond, 0)
+
+	d, err := newDownloadClient(server, 100*time.Millisecond).PrintInvoice(context.Background(), "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := io.ReadAll(d)
+	_ = d.Close()
+	if err != nil {
+		t.Fatalf("reading the download: %v", err)
+	}
+	if len(body) != 24 || d.ContentType != "application/pdf" {
+		t.Errorf("got %d bytes of %s", len(body), d.ContentType)
+	}
+}
+
+func TestDownloadStalls(t *testing.T) {
+	t.Run("body", func(t *testing.T) {
+		server := pdfServer(t, 2, time.Second, 0)
+
+		d, err := newDownloadClient(server, 50*time.Millisecond).PrintInvoice(context.Background(), "1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer d.Close()
+
+		if _, err := io.ReadAll(d); !errors.Is(err, ErrDownloadStalled) {
+			t.Errorf("reading a stalled download = %v, want ErrDownloadStalled", err)
+		}
+	})
+
+	t.Run("headers", func(t *testing.T) {
+		server := pdfServer(t, 1, 0, time.Second)
+
+		_, err := newDownloadClient(server, 50*time.Millisecond).PrintInvoice(context.Background(), "1")
+		if !errors.Is(err, ErrDownloadStalled) {
+			t.Errorf("download without headers = %v, want ErrDownloadStalled", err)
+		}
+	})
+}
+
+func TestDownloadContextBoundsTransfer(t *testing.T) {
+	server := pdfServer(t, 10, 30*time.Millisecond, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	d, err := newDownloadClient(server, time.Second).PrintInvoice(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	if _, err := io.ReadAll(d); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("reading past the deadline of ctx = %v, want DeadlineExceeded", err)
+	}
+}
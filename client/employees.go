@@ -10,315 +10,154 @@ const (
 )
 
 // GetAllEmployees does _GET https://api.fortnox.se/3/employees/
-func (c *Client) GetAllEmployees(ctx context.Context) (*GetAllEmployeesResp, error) {
+func (c *employeesService) GetAllEmployees(ctx context.Context) ([]Employee, error) {
+	return c.IterateEmployees().All(ctx)
+}
+
+// GetEmployeesPage does _GET https://api.fortnox.se/3/employees/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *employeesService) GetEmployeesPage(ctx context.Context, page *PageOptions) ([]Employee, *MetaInformation, error) {
 	resp := &GetAllEmployeesResp{}
 
-	err := c._GET(ctx, employeesURI, nil, resp)
+	err := c._GETPage(ctx, getEmployeesPageOp, employeesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp, nil
+	return resp.Employees, &resp.MetaInformation, nil
+}
+
+// IterateEmployees lazily walks all pages of https://api.fortnox.se/3/employees/
+func (c *employeesService) IterateEmployees() *Iterator[Employee] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Employee, *MetaInformation, error) {
+		return c.GetEmployeesPage(ctx, page)
+	})
 }
 
 // CreateEmployee does _POST https://api.fortnox.se/3/employees/
 //
-// req - employee to create
-func (c *Client) CreateEmployee(ctx context.Context, req *CreateEmployeeReq) (*CreateEmployeeResp, error) {
+// e - employee to create
+func (c *employeesService) CreateEmployee(ctx context.Context, e *Employee) (*Employee, error) {
+	req := &CreateEmployeeReq{Employee: *e}
 	resp := &CreateEmployeeResp{}
 
-	err := c._POST(ctx, employeesURI, nil, req, resp)
+	err := c._POST(ctx, createEmployeeOp, employeesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Employee, nil
 }
 
 // GetEmployee does _GET https://api.fortnox.se/3/employees/{EmployeeId}
 //
 // employeeID - identifies the employee
-func (c *Client) GetEmployee(ctx context.Context, employeeID string) (*GetEmployeeResp, error) {
+func (c *employeesService) GetEmployee(ctx context.Context, employeeID string) (*Employee, error) {
 	resp := &GetEmployeeResp{}
 
 	uri := fmt.Sprintf("%s/%s", employeesURI, employeeID)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getEmployeeOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Employee, nil
 }
 
 // UpdateEmployee does _PUT https://api.fortnox.se/3/employees/{EmployeeId}
 //
 // employeeID - identifies the employee
 //
-// req - employee to update
-func (c *Client) UpdateEmployee(ctx context.Context, employeeID string, req *UpdateEmployeeReq) (*UpdateEmployeeResp, error) {
+// e - employee to update
+func (c *employeesService) UpdateEmployee(ctx context.Context, employeeID string, e *Employee) (*Employee, error) {
+	req := &UpdateEmployeeReq{Employee: *e}
 	resp := &UpdateEmployeeResp{}
 
 	uri := fmt.Sprintf("%s/%s", employeesURI, employeeID)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateEmployeeOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &resp.Employee, nil
 }
 
 type GetAllEmployeesResp struct {
-	Employees []struct {
-		EmployeeId             string          `json:"EmployeeId"`
-		PersonalIdentityNumber string          `json:"PersonalIdentityNumber"`
-		FirstName              string          `json:"FirstName"`
-		LastName               string          `json:"LastName"`
-		FullName               string          `json:"FullName"`
-		Address1               string          `json:"Address1"`
-		Address2               string          `json:"Address2"`
-		PostCode               string          `json:"PostCode"`
-		City                   string          `json:"City"`
-		Country                string          `json:"Country"`
-		Phone1                 string          `json:"Phone1"`
-		Phone2                 string          `json:"Phone2"`
-		Email                  string          `json:"Email"`
-		EmploymentDate         string          `json:"EmploymentDate"`
-		EmploymentForm         string          `json:"EmploymentForm"`
-		SalaryForm             string          `json:"SalaryForm"`
-		JobTitle               string          `json:"JobTitle"`
-		PersonelType           string          `json:"PersonelType"`
-		ScheduleId             string          `json:"ScheduleId"`
-		ForaType               string          `json:"ForaType"`
-		MonthlySalary          string          `json:"MonthlySalary"`
-		HourlyPay              string          `json:"HourlyPay"`
-		TaxAllowance           string          `json:"TaxAllowance"`
-		TaxTable               string          `json:"TaxTable"`
-		TaxColumn              int             `json:"TaxColumn"`
-		AutoNonRecurringTax    bool            `json:"AutoNonRecurringTax"`
-		NonRecurringTax        string          `json:"NonRecurringTax"`
-		Inactive               bool            `json:"Inactive"`
-		ClearingNo             string          `json:"ClearingNo"`
-		BankAccountNo          string          `json:"BankAccountNo"`
-		EmployedTo             string          `json:"EmployedTo"`
-		AverageWeeklyHours     string          `json:"AverageWeeklyHours"`
-		AverageHourlyWage      string          `json:"AverageHourlyWage"`
-		DatedWages             []DatedWage     `json:"DatedWages"`
-		DatedSchedules         []DatedSchedule `json:"DatedSchedules"`
-		Url                    string          `json:"@url"`
-	} `json:"Employees"`
+	Employees       []Employee      `json:"Employees"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateEmployeeReq struct {
-	Employee struct {
-		EmployeeId             string          `json:"EmployeeId"`
-		PersonalIdentityNumber string          `json:"PersonalIdentityNumber"`
-		FirstName              string          `json:"FirstName"`
-		LastName               string          `json:"LastName"`
-		FullName               string          `json:"FullName"`
-		Address1               string          `json:"Address1"`
-		Address2               string          `json:"Address2"`
-		PostCode               string          `json:"PostCode"`
-		City                   string          `json:"City"`
-		Country                string          `json:"Country"`
-		Phone1                 string          `json:"Phone1"`
-		Phone2                 string          `json:"Phone2"`
-		Email                  string          `json:"Email"`
-		EmploymentDate         string          `json:"EmploymentDate"`
-		EmploymentForm         string          `json:"EmploymentForm"`
-		SalaryForm             string          `json:"SalaryForm"`
-		JobTitle               string          `json:"JobTitle"`
-		PersonelType           string          `json:"PersonelType"`
-		ScheduleId             string          `json:"ScheduleId"`
-		ForaType               string          `json:"ForaType"`
-		MonthlySalary          string          `json:"MonthlySalary"`
-		HourlyPay              string          `json:"HourlyPay"`
-		TaxAllowance           string          `json:"TaxAllowance"`
-		TaxTable               string          `json:"TaxTable"`
-		TaxColumn              int             `json:"TaxColumn"`
-		AutoNonRecurringTax    bool            `json:"AutoNonRecurringTax"`
-		NonRecurringTax        string          `json:"NonRecurringTax"`
-		Inactive               bool            `json:"Inactive"`
-		ClearingNo             string          `json:"ClearingNo"`
-		BankAccountNo          string          `json:"BankAccountNo"`
-		EmployedTo             string          `json:"EmployedTo"`
-		AverageWeeklyHours     string          `json:"AverageWeeklyHours"`
-		AverageHourlyWage      string          `json:"AverageHourlyWage"`
-		DatedWages             []DatedWage     `json:"DatedWages"`
-		DatedSchedules         []DatedSchedule `json:"DatedSchedules"`
-	} `json:"Employee"`
+	Employee Employee `json:"Employee"`
 }
 
 type CreateEmployeeResp struct {
-	Employee struct {
-		EmployeeId             string          `json:"EmployeeId"`
-		PersonalIdentityNumber string          `json:"PersonalIdentityNumber"`
-		FirstName              string          `json:"FirstName"`
-		LastName               string          `json:"LastName"`
-		FullName               string          `json:"FullName"`
-		Address1               string          `json:"Address1"`
-		Address2               string          `json:"Address2"`
-		PostCode               string          `json:"PostCode"`
-		City                   string          `json:"City"`
-		Country                string          `json:"Country"`
-		Phone1                 string          `json:"Phone1"`
-		Phone2                 string          `json:"Phone2"`
-		Email                  string          `json:"Email"`
-		EmploymentDate         string          `json:"EmploymentDate"`
-		EmploymentForm         string          `json:"EmploymentForm"`
-		SalaryForm             string          `json:"SalaryForm"`
-		JobTitle               string          `json:"JobTitle"`
-		PersonelType           string          `json:"PersonelType"`
-		ScheduleId             string          `json:"ScheduleId"`
-		ForaType               string          `json:"ForaType"`
-		MonthlySalary          string          `json:"MonthlySalary"`
-		HourlyPay              string          `json:"HourlyPay"`
-		TaxAllowance           string          `json:"TaxAllowance"`
-		TaxTable               string          `json:"TaxTable"`
-		TaxColumn              int             `json:"TaxColumn"`
-		AutoNonRecurringTax    bool            `json:"AutoNonRecurringTax"`
-		NonRecurringTax        string          `json:"NonRecurringTax"`
-		Inactive               bool            `json:"Inactive"`
-		ClearingNo             string          `json:"ClearingNo"`
-		BankAccountNo          string          `json:"BankAccountNo"`
-		EmployedTo             string          `json:"EmployedTo"`
-		AverageWeeklyHours     string          `json:"AverageWeeklyHours"`
-		AverageHourlyWage      string          `json:"AverageHourlyWage"`
-		DatedWages             []DatedWage     `json:"DatedWages"`
-		DatedSchedules         []DatedSchedule `json:"DatedSchedules"`
-	} `json:"Employee"`
+	Employee Employee `json:"Employee"`
 }
 
 type GetEmployeeResp struct {
-	Employee struct {
-		EmployeeId             string          `json:"EmployeeId"`
-		PersonalIdentityNumber string          `json:"PersonalIdentityNumber"`
-		FirstName              string          `json:"FirstName"`
-		LastName               string          `json:"LastName"`
-		FullName               string          `json:"FullName"`
-		Address1               string          `json:"Address1"`
-		Address2               string          `json:"Address2"`
-		PostCode               string          `json:"PostCode"`
-		City                   string          `json:"City"`
-		Country                string          `json:"Country"`
-		Phone1                 string          `json:"Phone1"`
-		Phone2                 string          `json:"Phone2"`
-		Email                  string          `json:"Email"`
-		EmploymentDate         string          `json:"EmploymentDate"`
-		EmploymentForm         string          `json:"EmploymentForm"`
-		SalaryForm             string          `json:"SalaryForm"`
-		JobTitle               string          `json:"JobTitle"`
-		PersonelType           string          `json:"PersonelType"`
-		ScheduleId             string          `json:"ScheduleId"`
-		ForaType               string          `json:"ForaType"`
-		MonthlySalary          string          `json:"MonthlySalary"`
-		HourlyPay              string          `json:"HourlyPay"`
-		TaxAllowance           string          `json:"TaxAllowance"`
-		TaxTable               string          `json:"TaxTable"`
-		TaxColumn              int             `json:"TaxColumn"`
-		AutoNonRecurringTax    bool            `json:"AutoNonRecurringTax"`
-		NonRecurringTax        string          `json:"NonRecurringTax"`
-		Inactive               bool            `json:"Inactive"`
-		ClearingNo             string          `json:"ClearingNo"`
-		BankAccountNo          string          `json:"BankAccountNo"`
-		EmployedTo             string          `json:"EmployedTo"`
-		AverageWeeklyHours     string          `json:"AverageWeeklyHours"`
-		AverageHourlyWage      string          `json:"AverageHourlyWage"`
-		DatedWages             []DatedWage     `json:"DatedWages"`
-		DatedSchedules         []DatedSchedule `json:"DatedSchedules"`
-	} `json:"Employee"`
+	Employee Employee `json:"Employee"`
 }
 
 type UpdateEmployeeReq struct {
-	Employee struct {
-		EmployeeId             string          `json:"EmployeeId"`
-		PersonalIdentityNumber string          `json:"PersonalIdentityNumber"`
-		FirstName              string          `json:"FirstName"`
-		LastName               string          `json:"LastName"`
-		FullName               string          `json:"FullName"`
-		Address1               string          `json:"Address1"`
-		Address2               string          `json:"Address2"`
-		PostCode               string          `json:"PostCode"`
-		City                   string          `json:"City"`
-		Country                string          `json:"Country"`
-		Phone1                 string          `json:"Phone1"`
-		Phone2                 string          `json:"Phone2"`
-		Email                  string          `json:"Email"`
-		EmploymentDate         string          `json:"EmploymentDate"`
-		EmploymentForm         string          `json:"EmploymentForm"`
-		SalaryForm             string          `json:"SalaryForm"`
-		JobTitle               string          `json:"JobTitle"`
-		PersonelType           string          `json:"PersonelType"`
-		ScheduleId             string          `json:"ScheduleId"`
-		ForaType               string          `json:"ForaType"`
-		MonthlySalary          string          `json:"MonthlySalary"`
-		HourlyPay              string          `json:"HourlyPay"`
-		TaxAllowance           string          `json:"TaxAllowance"`
-		TaxTable               string          `json:"TaxTable"`
-		TaxColumn              int             `json:"TaxColumn"`
-		AutoNonRecurringTax    bool            `json:"AutoNonRecurringTax"`
-		NonRecurringTax        string          `json:"NonRecurringTax"`
-		Inactive               bool            `json:"Inactive"`
-		ClearingNo             string          `json:"ClearingNo"`
-		BankAccountNo          string          `json:"BankAccountNo"`
-		EmployedTo             string          `json:"EmployedTo"`
-		AverageWeeklyHours     string          `json:"AverageWeeklyHours"`
-		AverageHourlyWage      string          `json:"AverageHourlyWage"`
-		DatedWages             []DatedWage     `json:"DatedWages"`
-		DatedSchedules         []DatedSchedule `json:"DatedSchedules"`
-	} `json:"Employee"`
+	Employee Employee `json:"Employee"`
 }
 
 type UpdateEmployeeResp struct {
-	Employee struct {
-		EmployeeId             string          `json:"EmployeeId"`
-		PersonalIdentityNumber string          `json:"PersonalIdentityNumber"`
-		FirstName              string          `json:"FirstName"`
-		LastName               string          `json:"LastName"`
-		FullName               string          `json:"FullName"`
-		Address1               string          `json:"Address1"`
-		Address2               string          `json:"Address2"`
-		PostCode               string          `json:"PostCode"`
-		City                   string          `json:"City"`
-		Country                string          `json:"Country"`
-		Phone1                 string          `json:"Phone1"`
-		Phone2                 string          `json:"Phone2"`
-		Email                  string          `json:"Email"`
-		EmploymentDate         string          `json:"EmploymentDate"`
-		EmploymentForm         string          `json:"EmploymentForm"`
-		SalaryForm             string          `json:"SalaryForm"`
-		JobTitle               string          `json:"JobTitle"`
-		PersonelType           string          `json:"PersonelType"`
-		ScheduleId             string          `json:"ScheduleId"`
-		ForaType               string          `json:"ForaType"`
-		MonthlySalary          string          `json:"MonthlySalary"`
-		HourlyPay              string          `json:"HourlyPay"`
-		TaxAllowance           string          `json:"TaxAllowance"`
-		TaxTable               string          `json:"TaxTable"`
-		TaxColumn              int             `json:"TaxColumn"`
-		AutoNonRecurringTax    bool            `json:"AutoNonRecurringTax"`
-		NonRecurringTax        string          `json:"NonRecurringTax"`
-		Inactive               bool            `json:"Inactive"`
-		ClearingNo             string          `json:"ClearingNo"`
-		BankAccountNo          string          `json:"BankAccountNo"`
-		EmployedTo             string          `json:"EmployedTo"`
-		AverageWeeklyHours     string          `json:"AverageWeeklyHours"`
-		AverageHourlyWage      string          `json:"AverageHourlyWage"`
-		DatedWages             []DatedWage     `json:"DatedWages"`
-		DatedSchedules         []DatedSchedule `json:"DatedSchedules"`
-	} `json:"Employee"`
+	Employee Employee `json:"Employee"`
+}
+
+type Employee struct {
+	Url                    string          `json:"@url,omitempty"`
+	EmployeeId             string          `json:"EmployeeId,omitempty"`
+	PersonalIdentityNumber string          `json:"PersonalIdentityNumber,omitempty"`
+	FirstName              string          `json:"FirstName,omitempty"`
+	LastName               string          `json:"LastName,omitempty"`
+	FullName               string          `json:"FullName,omitempty"`
+	Address1               string          `json:"Address1,omitempty"`
+	Address2               string          `json:"Address2,omitempty"`
+	PostCode               string          `json:"PostCode,omitempty"`
+	City                   string          `json:"City,omitempty"`
+	Country                string          `json:"Country,omitempty"`
+	Phone1                 string          `json:"Phone1,omitempty"`
+	Phone2                 string          `json:"Phone2,omitempty"`
+	Email                  string          `json:"Email,omitempty"`
+	EmploymentDate         Date            `json:"EmploymentDate,omitempty"`
+	EmploymentForm         string          `json:"EmploymentForm,omitempty"`
+	SalaryForm             string          `json:"SalaryForm,omitempty"`
+	JobTitle               string          `json:"JobTitle,omitempty"`
+	PersonelType           string          `json:"PersonelType,omitempty"`
+	ScheduleId             string          `json:"ScheduleId,omitempty"`
+	ForaType               string          `json:"ForaType,omitempty"`
+	MonthlySalary          Money           `json:"MonthlySalary,omitzero"`
+	HourlyPay              Money           `json:"HourlyPay,omitzero"`
+	TaxAllowance           string          `json:"TaxAllowance,omitempty"`
+	TaxTable               string          `json:"TaxTable,omitempty"`
+	TaxColumn              int             `json:"TaxColumn,omitempty"`
+	AutoNonRecurringTax    bool            `json:"AutoNonRecurringTax,omitempty"`
+	NonRecurringTax        string          `json:"NonRecurringTax,omitempty"`
+	Inactive               bool            `json:"Inactive,omitempty"`
+	ClearingNo             string          `json:"ClearingNo,omitempty"`
+	BankAccountNo          string          `json:"BankAccountNo,omitempty"`
+	EmployedTo             Date            `json:"EmployedTo,omitempty"`
+	AverageWeeklyHours     string          `json:"AverageWeeklyHours,omitempty"`
+	AverageHourlyWage      Money           `json:"AverageHourlyWage,omitzero"`
+	DatedWages             []DatedWage     `json:"DatedWages,omitempty"`
+	DatedSchedules         []DatedSchedule `json:"DatedSchedules,omitempty"`
 }
 
 type DatedSchedule struct {
 	EmployeeId string `json:"EmployeeId"`
-	FirstDay   string `json:"FirstDay"`
+	FirstDay   Date   `json:"FirstDay"`
 	ScheduleId string `json:"ScheduleId"`
 }
 
 type DatedWage struct {
 	EmployeeId    string `json:"EmployeeId"`
-	FirstDay      string `json:"FirstDay"`
-	MonthlySalary string `json:"MonthlySalary"`
-	HourlyPay     string `json:"HourlyPay"`
+	FirstDay      Date   `json:"FirstDay"`
+	MonthlySalary Money  `json:"MonthlySalary"`
+	HourlyPay     Money  `json:"HourlyPay"`
 }
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Sentinel errors matched by errors.Is against a FortnoxError,
+// derived from its Fortnox code (see CodeToLanguagesMapping) and HTTP status
+var (
+	ErrNotFound     = errors.New("fortnox: resource not found")
+	ErrUnauthorized = errors.New("fortnox: unauthorized")
+	ErrRateLimited  = errors.New("fortnox: rate limited")
+	ErrMissingScope = errors.New("fortnox: missing scope")
+	ErrNoLicense    = errors.New("fortnox: no license")
+	ErrValidation   = errors.New("fortnox: validation error")
+	ErrLockedPeriod = errors.New("fortnox: locked period")
+)
+
+// requestIDHeaders are the response headers carrying the id of a request, in order of preference
+var requestIDHeaders = []string{
+	"X-Request-Id",
+	"X-Fortnox-Request-Id",
+	"X-Amzn-Trace-Id",
+}
+
+// codeCategories maps the codes of CodeToLanguagesMapping to the sentinel they are matched by
+var codeCategories = map[int]error{
+	2000204: ErrNotFound,
+	2000433: ErrNotFound,
+	2000428: ErrNotFound,
+	2001302: ErrNotFound,
+	2001304: ErrNotFound,
+	2003277: ErrNotFound,
+
+	2000310: ErrUnauthorized,
+	2000311: ErrUnauthorized,
+	2003275: ErrUnauthorized,
+
+	2000663: ErrMissingScope,
+
+	2001101: ErrNoLicense,
+	2001103: ErrNoLicense,
+
+	1000030: ErrValidation,
+	1000031: ErrValidation,
+	2000106: ErrValidation,
+	2000108: ErrValidation,
+	2000134: ErrValidation,
+	2000359: ErrValidation,
+	2000588: ErrValidation,
+	2000637: ErrValidation,
+	2000729: ErrValidation,
+	2000755: ErrValidation,
+	2001392: ErrValidation,
+	2001399: ErrValidation,
+	2001740: ErrValidation,
+	2002115: ErrValidation,
+	2003095: ErrValidation,
+	2003115: ErrValidation,
+	2003124: ErrValidation,
+	2003125: ErrValidation,
+	2003126: ErrValidation,
+}
+
+// lockedPeriodPhrases identify locked period errors, Fortnox reports them with different codes
+// depending on the resource, so the message is matched instead
+var lockedPeriodPhrases = []string{
+	"låst period",
+	"perioden är låst",
+	"bokföringsperiod är låst",
+	"locked period",
+	"period is locked",
+}
+
+// category returns the sentinel the error is matched by, nil if unknown
+func (f FortnoxError) category() error {
+	message := strings.ToLower(f.Message)
+	for _, phrase := range lockedPeriodPhrases {
+		if strings.Contains(message, phrase) {
+			return ErrLockedPeriod
+		}
+	}
+
+	if category, ok := codeCategories[f.Code]; ok {
+		return category
+	}
+
+	switch f.HTTPStatus {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusBadRequest:
+		return ErrValidation
+	}
+
+	return nil
+}
+
+// Is makes errors.Is(err, ErrNotFound) and the other sentinels match a FortnoxError
+func (f FortnoxError) Is(target error) bool {
+	if target == ErrRateLimited {
+		return f.HTTPStatus == http.StatusTooManyRequests
+	}
+
+	if target == ErrUnauthorized && f.HTTPStatus == http.StatusUnauthorized {
+		return true
+	}
+
+	return target != nil && f.category() == target
+}
+
+// IsNotFound reports whether err means the requested resource does not exist
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether err means the access token or client secret was rejected
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsRateLimited reports whether err means Fortnox answered 429 Too Many Requests
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsMissingScope reports whether err means the integration was not authorized for the scope of the endpoint
+func IsMissingScope(err error) bool {
+	return errors.Is(err, ErrMissingScope)
+}
+
+// IsNoLicense reports whether err means the Fortnox account lacks the license for the API or scope
+func IsNoLicense(err error) bool {
+	return errors.Is(err, ErrNoLicense)
+}
+
+// IsValidationError reports whether err means Fortnox rejected the request data
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsLockedPeriod reports whether err means the change falls in a locked accounting period
+func IsLockedPeriod(err error) bool {
+	return errors.Is(err, ErrLockedPeriod)
+}
+
+func getRequestID(resp *http.Response) string {
+	for _, h := range requestIDHeaders {
+		if id := resp.Header.Get(h); id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
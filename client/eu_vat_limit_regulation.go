@@ -7,10 +7,10 @@ const (
 )
 
 // GetEUVATLimitDetails does _GET https://api.fortnox.se/3/euvatlimitregulation/
-func (c Client) GetEUVATLimitDetails(ctx context.Context) (*EUVatLimitRegulation, error) {
+func (c *euVatLimitRegulationService) GetEUVATLimitDetails(ctx context.Context) (*EUVatLimitRegulation, error) {
 	resp := &GetEUVATLimitDetailsResp{}
 
-	err := c._GET(ctx, euVatLimitRegulationURI, nil, resp)
+	err := c._GET(ctx, getEUVATLimitDetailsOp, euVatLimitRegulationURI, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -19,9 +19,9 @@ func (c Client) GetEUVATLimitDetails(ctx context.Context) (*EUVatLimitRegulation
 }
 
 type EUVatLimitRegulation struct {
-	TotalExclVat int    `json:"TotalExclVat,omitempty"`
+	TotalExclVat Money  `json:"TotalExclVat,omitzero"`
 	IsOverLimit  bool   `json:"IsOverLimit,omitempty"`
-	Limit        int    `json:"Limit,omitempty"`
+	Limit        Money  `json:"Limit,omitzero"`
 	Year         string `json:"Year,omitempty"`
 }
 
@@ -10,25 +10,39 @@ const (
 )
 
 // GetAllExpenses does _GET https://api.fortnox.se/3/expenses/
-func (c *Client) GetAllExpenses(ctx context.Context) ([]Expense, error) {
+func (c *expensesService) GetAllExpenses(ctx context.Context) ([]Expense, error) {
+	return c.IterateExpenses().All(ctx)
+}
+
+// GetExpensesPage does _GET https://api.fortnox.se/3/expenses/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *expensesService) GetExpensesPage(ctx context.Context, page *PageOptions) ([]Expense, *MetaInformation, error) {
 	resp := &GetAllExpensesResp{}
 
-	err := c._GET(ctx, expensesURI, nil, resp)
+	err := c._GETPage(ctx, getExpensesPageOp, expensesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Expenses, nil
+	return resp.Expenses, &resp.MetaInformation, nil
+}
+
+// IterateExpenses lazily walks all pages of https://api.fortnox.se/3/expenses/
+func (c *expensesService) IterateExpenses() *Iterator[Expense] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Expense, *MetaInformation, error) {
+		return c.GetExpensesPage(ctx, page)
+	})
 }
 
 // CreateExpense does _POST https://api.fortnox.se/3/expenses/
 //
 // e - expense to create
-func (c *Client) CreateExpense(ctx context.Context, e *Expense) (*Expense, error) {
+func (c *expensesService) CreateExpense(ctx context.Context, e *Expense) (*Expense, error) {
 	req := &CreateExpenseReq{Expense: *e}
 	resp := &CreateExpenseResp{}
 
-	err := c._POST(ctx, expensesURI, nil, req, resp)
+	err := c._POST(ctx, createExpenseOp, expensesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +53,12 @@ func (c *Client) CreateExpense(ctx context.Context, e *Expense) (*Expense, error
 // GetExpense does _GET https://api.fortnox.se/3/expenses/{ExpenseCode}
 //
 // expenseCode - expenseCode
-func (c *Client) GetExpense(ctx context.Context, expenseCode string) (*Expense, error) {
+func (c *expensesService) GetExpense(ctx context.Context, expenseCode string) (*Expense, error) {
 	resp := &GetExpenseResp{}
 
 	uri := fmt.Sprintf("%s/%s", expensesURI, expenseCode)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getExpenseOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +74,8 @@ type Expense struct {
 }
 
 type GetAllExpensesResp struct {
-	Expenses []Expense `json:"Expenses"`
+	Expenses        []Expense       `json:"Expenses"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateExpenseReq struct {
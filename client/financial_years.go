@@ -4,7 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"time"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -14,7 +15,20 @@ const (
 // GetAllFinancialYears does _GET https://api.fortnox.se/3/financialyears
 //
 // date - date to filter on, for example 2020-06-30
-func (c *Client) GetAllFinancialYears(ctx context.Context, date *GetAllFinancialYearsFilterDate) ([]FinancialYear, error) {
+func (c *financialYearsService) GetAllFinancialYears(ctx context.Context, date *GetAllFinancialYearsFilterDate) ([]FinancialYear, error) {
+	return c.IterateFinancialYears(date).All(ctx)
+}
+
+// GetFinancialYearsPage does _GET https://api.fortnox.se/3/financialyears and returns a single page along with its MetaInformation
+//
+// date - date to filter on, for example 2020-06-30
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *financialYearsService) GetFinancialYearsPage(
+	ctx context.Context,
+	date *GetAllFinancialYearsFilterDate,
+	page *PageOptions) ([]FinancialYear, *MetaInformation, error) {
+
 	resp := &GetAllFinancialYearsResp{}
 
 	var filter url.Values
@@ -22,27 +36,39 @@ func (c *Client) GetAllFinancialYears(ctx context.Context, date *GetAllFinancial
 	if date != nil {
 		err := date.validate()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		filter, err = date.urlValues()
+		if err != nil {
+			return nil, nil, err
 		}
-		filter = date.urlValues()
 	}
 
-	err := c._GET(ctx, financialYearsURI, filter, resp)
+	err := c._GETPage(ctx, getFinancialYearsPageOp, financialYearsURI, filter, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.FinancialYears, nil
+	return resp.FinancialYears, &resp.MetaInformation, nil
+}
+
+// IterateFinancialYears lazily walks all pages of https://api.fortnox.se/3/financialyears
+//
+// date - date to filter on, for example 2020-06-30
+func (c *financialYearsService) IterateFinancialYears(date *GetAllFinancialYearsFilterDate) *Iterator[FinancialYear] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]FinancialYear, *MetaInformation, error) {
+		return c.GetFinancialYearsPage(ctx, date, page)
+	})
 }
 
 // CreateFinancialYear does _POST https://api.fortnox.se/3/financialyears
 //
 // fy - financial year to create
-func (c *Client) CreateFinancialYear(ctx context.Context, fy *FinancialYear) (*FinancialYear, error) {
+func (c *financialYearsService) CreateFinancialYear(ctx context.Context, fy *FinancialYear) (*FinancialYear, error) {
 	req := &CreateFinancialYearReq{FinancialYear: *fy}
 	resp := &CreateFinancialYearResp{}
 
-	err := c._POST(ctx, financialYearsURI, nil, req, resp)
+	err := c._POST(ctx, createFinancialYearOp, financialYearsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -53,12 +79,12 @@ func (c *Client) CreateFinancialYear(ctx context.Context, fy *FinancialYear) (*F
 // GetFinancialYearByID does _GET https://api.fortnox.se/3/financialyears/{Id}
 //
 // id - identifies the year
-func (c *Client) GetFinancialYearByID(ctx context.Context, id int) (*FinancialYear, error) {
+func (c *financialYearsService) GetFinancialYearByID(ctx context.Context, id int) (*FinancialYear, error) {
 	resp := &GetFinancialYearByIDResp{}
 
 	uri := fmt.Sprintf("/%s/%d", financialYearsURI, id)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getFinancialYearByIDOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -67,40 +93,34 @@ func (c *Client) GetFinancialYearByID(ctx context.Context, id int) (*FinancialYe
 }
 
 type GetAllFinancialYearsFilterDate struct {
-	date string
+	// Date selects the financial year the date falls in
+	Date Date `fortnox:"date"`
 }
 
-const defaultISOLayout = "2006-01-02"
-
 func (f GetAllFinancialYearsFilterDate) validate() error {
-	if _, err := time.Parse(defaultISOLayout, f.date); err != nil {
-		return err
+	if f.Date.IsZero() || !f.Date.Valid() {
+		return errors.Wrapf(ErrInvalidDate, "can't filter financial years by %q", string(f.Date))
 	}
 
 	return nil
 }
 
-func (f GetAllFinancialYearsFilterDate) urlValues() url.Values {
-	urlValues := url.Values{}
-
-	if f.date != "" {
-		urlValues["Date"] = []string{f.date}
-	}
-
-	return urlValues
+func (f GetAllFinancialYearsFilterDate) urlValues() (url.Values, error) {
+	return encodeQuery(f)
 }
 
 type FinancialYear struct {
 	Url              string `json:"@url,omitempty"`
 	Id               int    `json:"Id,omitempty"`
-	FromDate         string `json:"FromDate,omitempty"`
-	ToDate           string `json:"ToDate,omitempty"`
+	FromDate         Date   `json:"FromDate,omitempty"`
+	ToDate           Date   `json:"ToDate,omitempty"`
 	AccountingMethod string `json:"AccountingMethod,omitempty"`
 	AccountCharts    string `json:"accountCharts,omitempty"`
 }
 
 type GetAllFinancialYearsResp struct {
-	FinancialYears []FinancialYear `json:"FinancialYears"`
+	FinancialYears  []FinancialYear `json:"FinancialYears"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateFinancialYearReq struct {
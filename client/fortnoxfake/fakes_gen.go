@@ -0,0 +1,3216 @@
+// Code generated by genservices. DO NOT EDIT.
+
+package fortnoxfake
+
+import (
+	"context"
+	"github.com/thats4fun/go-fortnox-sdk/client"
+	"io"
+)
+
+var _ client.AbsenceTransactionsService = (*AbsenceTransactions)(nil)
+var _ client.AccountChartsService = (*AccountCharts)(nil)
+var _ client.AccountsService = (*Accounts)(nil)
+var _ client.ArchiveService = (*Archive)(nil)
+var _ client.ArticleFileConnectionsService = (*ArticleFileConnections)(nil)
+var _ client.ArticlesService = (*Articles)(nil)
+var _ client.AssetFileConnectionsService = (*AssetFileConnections)(nil)
+var _ client.AssetTypesService = (*AssetTypes)(nil)
+var _ client.AssetsService = (*Assets)(nil)
+var _ client.AttendanceTransactionsService = (*AttendanceTransactions)(nil)
+var _ client.CompanyInformationService = (*CompanyInformation)(nil)
+var _ client.CompanySettingsService = (*CompanySettings)(nil)
+var _ client.ContractAccrualsService = (*ContractAccruals)(nil)
+var _ client.ContractTemplatesService = (*ContractTemplates)(nil)
+var _ client.ContractsService = (*Contracts)(nil)
+var _ client.CostCentersService = (*CostCenters)(nil)
+var _ client.CurrenciesService = (*Currencies)(nil)
+var _ client.CustomerReferencesService = (*CustomerReferences)(nil)
+var _ client.CustomersService = (*Customers)(nil)
+var _ client.EUVatLimitRegulationService = (*EUVatLimitRegulation)(nil)
+var _ client.EmployeesService = (*Employees)(nil)
+var _ client.ExpensesService = (*Expenses)(nil)
+var _ client.FinancialYearsService = (*FinancialYears)(nil)
+var _ client.InboxService = (*Inbox)(nil)
+var _ client.InvoiceAccrualsService = (*InvoiceAccruals)(nil)
+var _ client.InvoicePaymentsService = (*InvoicePayments)(nil)
+var _ client.InvoicesService = (*Invoices)(nil)
+var _ client.LabelsService = (*Labels)(nil)
+var _ client.LockedPeriodService = (*LockedPeriod)(nil)
+var _ client.MeService = (*Me)(nil)
+var _ client.ModesOfPaymentsService = (*ModesOfPayments)(nil)
+var _ client.OffersService = (*Offers)(nil)
+var _ client.OrdersService = (*Orders)(nil)
+var _ client.PredefinedAccountsService = (*PredefinedAccounts)(nil)
+var _ client.PredefinedVoucherSeriesService = (*PredefinedVoucherSeries)(nil)
+var _ client.PriceListsService = (*PriceLists)(nil)
+var _ client.PricesService = (*Prices)(nil)
+var _ client.PrintTemplatesService = (*PrintTemplates)(nil)
+var _ client.ProjectsService = (*Projects)(nil)
+var _ client.SIEService = (*SIE)(nil)
+var _ client.SalaryTransactionsService = (*SalaryTransactions)(nil)
+var _ client.ScheduleTimesService = (*ScheduleTimes)(nil)
+var _ client.SupplierInvoiceAccrualsService = (*SupplierInvoiceAccruals)(nil)
+var _ client.SupplierInvoiceExternalUrlConnectionsService = (*SupplierInvoiceExternalUrlConnections)(nil)
+var _ client.SupplierInvoiceFileConnectionsService = (*SupplierInvoiceFileConnections)(nil)
+var _ client.SupplierInvoicePaymentsService = (*SupplierInvoicePayments)(nil)
+var _ client.SupplierInvoicesService = (*SupplierInvoices)(nil)
+var _ client.SuppliersService = (*Suppliers)(nil)
+var _ client.TaxReductionsService = (*TaxReductions)(nil)
+var _ client.TermsOfDeliveriesService = (*TermsOfDeliveries)(nil)
+var _ client.TermsOfPaymentsService = (*TermsOfPayments)(nil)
+var _ client.TrustedEmailSendersService = (*TrustedEmailSenders)(nil)
+var _ client.UnitsService = (*Units)(nil)
+var _ client.VoucherFileConnectionsService = (*VoucherFileConnections)(nil)
+var _ client.VoucherSeriesService = (*VoucherSeries)(nil)
+var _ client.VouchersService = (*Vouchers)(nil)
+var _ client.WayOfDeliveriesService = (*WayOfDeliveries)(nil)
+
+// AbsenceTransactions fakes client.AbsenceTransactionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type AbsenceTransactions struct {
+	GetAllAbsenceTransactionsFunc        func(ctx context.Context, filter *client.GetAbsenceTransactionsFilter) ([]client.AbsenceTransaction, error)
+	GetAbsenceTransactionsPageFunc       func(ctx context.Context, filter *client.GetAbsenceTransactionsFilter, page *client.PageOptions) ([]client.AbsenceTransaction, *client.MetaInformation, error)
+	IterateAbsenceTransactionsFunc       func(filter *client.GetAbsenceTransactionsFilter) *client.Iterator[client.AbsenceTransaction]
+	CreateNewAbsenceTransactionFunc      func(ctx context.Context, at *client.AbsenceTransaction) (*client.AbsenceTransaction, error)
+	GetAbsenceTransactionByIDFunc        func(ctx context.Context, id string) (*client.AbsenceTransaction, error)
+	UpdateAbsenceTransactionByIDFunc     func(ctx context.Context, id string, at *client.AbsenceTransaction) (*client.AbsenceTransaction, error)
+	DeleteAbsenceTransactionByIDFunc     func(ctx context.Context, id string) (*client.AbsenceTransaction, error)
+	GetAbsenceTransactionForEmployeeFunc func(ctx context.Context, employeeID string, date client.Date, code client.AbsenceTransactionStatusCode) ([]client.AbsenceTransaction, error)
+}
+
+func (f *AbsenceTransactions) GetAllAbsenceTransactions(ctx context.Context, filter *client.GetAbsenceTransactionsFilter) ([]client.AbsenceTransaction, error) {
+	if f.GetAllAbsenceTransactionsFunc == nil {
+		panic(notImplemented("AbsenceTransactions", "GetAllAbsenceTransactions"))
+	}
+	return f.GetAllAbsenceTransactionsFunc(ctx, filter)
+}
+
+func (f *AbsenceTransactions) GetAbsenceTransactionsPage(ctx context.Context, filter *client.GetAbsenceTransactionsFilter, page *client.PageOptions) ([]client.AbsenceTransaction, *client.MetaInformation, error) {
+	if f.GetAbsenceTransactionsPageFunc == nil {
+		panic(notImplemented("AbsenceTransactions", "GetAbsenceTransactionsPage"))
+	}
+	return f.GetAbsenceTransactionsPageFunc(ctx, filter, page)
+}
+
+func (f *AbsenceTransactions) IterateAbsenceTransactions(filter *client.GetAbsenceTransactionsFilter) *client.Iterator[client.AbsenceTransaction] {
+	if f.IterateAbsenceTransactionsFunc == nil {
+		panic(notImplemented("AbsenceTransactions", "IterateAbsenceTransactions"))
+	}
+	return f.IterateAbsenceTransactionsFunc(filter)
+}
+
+func (f *AbsenceTransactions) CreateNewAbsenceTransaction(ctx context.Context, at *client.AbsenceTransaction) (*client.AbsenceTransaction, error) {
+	if f.CreateNewAbsenceTransactionFunc == nil {
+		panic(notImplemented("AbsenceTransactions", "CreateNewAbsenceTransaction"))
+	}
+	return f.CreateNewAbsenceTransactionFunc(ctx, at)
+}
+
+func (f *AbsenceTransactions) GetAbsenceTransactionByID(ctx context.Context, id string) (*client.AbsenceTransaction, error) {
+	if f.GetAbsenceTransactionByIDFunc == nil {
+		panic(notImplemented("AbsenceTransactions", "GetAbsenceTransactionByID"))
+	}
+	return f.GetAbsenceTransactionByIDFunc(ctx, id)
+}
+
+func (f *AbsenceTransactions) UpdateAbsenceTransactionByID(ctx context.Context, id string, at *client.AbsenceTransaction) (*client.AbsenceTransaction, error) {
+	if f.UpdateAbsenceTransactionByIDFunc == nil {
+		panic(notImplemented("AbsenceTransactions", "UpdateAbsenceTransactionByID"))
+	}
+	return f.UpdateAbsenceTransactionByIDFunc(ctx, id, at)
+}
+
+func (f *AbsenceTransactions) DeleteAbsenceTransactionByID(ctx context.Context, id string) (*client.AbsenceTransaction, error) {
+	if f.DeleteAbsenceTransactionByIDFunc == nil {
+		panic(notImplemented("AbsenceTransactions", "DeleteAbsenceTransactionByID"))
+	}
+	return f.DeleteAbsenceTransactionByIDFunc(ctx, id)
+}
+
+func (f *AbsenceTransactions) GetAbsenceTransactionForEmployee(ctx context.Context, employeeID string, date client.Date, code client.AbsenceTransactionStatusCode) ([]client.AbsenceTransaction, error) {
+	if f.GetAbsenceTransactionForEmployeeFunc == nil {
+		panic(notImplemented("AbsenceTransactions", "GetAbsenceTransactionForEmployee"))
+	}
+	return f.GetAbsenceTransactionForEmployeeFunc(ctx, employeeID, date, code)
+}
+
+// AccountCharts fakes client.AccountChartsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type AccountCharts struct {
+	GetAccountChartsFunc func(ctx context.Context) ([]client.AccountChart, error)
+}
+
+func (f *AccountCharts) GetAccountCharts(ctx context.Context) ([]client.AccountChart, error) {
+	if f.GetAccountChartsFunc == nil {
+		panic(notImplemented("AccountCharts", "GetAccountCharts"))
+	}
+	return f.GetAccountChartsFunc(ctx)
+}
+
+// Accounts fakes client.AccountsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Accounts struct {
+	GetAccountFunc      func(ctx context.Context, accountID int) (*client.Account, error)
+	UpdateAccountFunc   func(ctx context.Context, accountID int, a *client.Account, filter *client.FinancialYearFilter) (*client.Account, error)
+	GetAllAccountsFunc  func(ctx context.Context, filter *client.GetAllAccountsFilter) ([]client.Account, error)
+	GetAccountsPageFunc func(ctx context.Context, filter *client.GetAllAccountsFilter, page *client.PageOptions) ([]client.Account, *client.MetaInformation, error)
+	IterateAccountsFunc func(filter *client.GetAllAccountsFilter) *client.Iterator[client.Account]
+	CreateAccountFunc   func(ctx context.Context, a *client.Account, filter *client.FinancialYearFilter) (*client.Account, error)
+}
+
+func (f *Accounts) GetAccount(ctx context.Context, accountID int) (*client.Account, error) {
+	if f.GetAccountFunc == nil {
+		panic(notImplemented("Accounts", "GetAccount"))
+	}
+	return f.GetAccountFunc(ctx, accountID)
+}
+
+func (f *Accounts) UpdateAccount(ctx context.Context, accountID int, a *client.Account, filter *client.FinancialYearFilter) (*client.Account, error) {
+	if f.UpdateAccountFunc == nil {
+		panic(notImplemented("Accounts", "UpdateAccount"))
+	}
+	return f.UpdateAccountFunc(ctx, accountID, a, filter)
+}
+
+func (f *Accounts) GetAllAccounts(ctx context.Context, filter *client.GetAllAccountsFilter) ([]client.Account, error) {
+	if f.GetAllAccountsFunc == nil {
+		panic(notImplemented("Accounts", "GetAllAccounts"))
+	}
+	return f.GetAllAccountsFunc(ctx, filter)
+}
+
+func (f *Accounts) GetAccountsPage(ctx context.Context, filter *client.GetAllAccountsFilter, page *client.PageOptions) ([]client.Account, *client.MetaInformation, error) {
+	if f.GetAccountsPageFunc == nil {
+		panic(notImplemented("Accounts", "GetAccountsPage"))
+	}
+	return f.GetAccountsPageFunc(ctx, filter, page)
+}
+
+func (f *Accounts) IterateAccounts(filter *client.GetAllAccountsFilter) *client.Iterator[client.Account] {
+	if f.IterateAccountsFunc == nil {
+		panic(notImplemented("Accounts", "IterateAccounts"))
+	}
+	return f.IterateAccountsFunc(filter)
+}
+
+func (f *Accounts) CreateAccount(ctx context.Context, a *client.Account, filter *client.FinancialYearFilter) (*client.Account, error) {
+	if f.CreateAccountFunc == nil {
+		panic(notImplemented("Accounts", "CreateAccount"))
+	}
+	return f.CreateAccountFunc(ctx, a, filter)
+}
+
+// Archive fakes client.ArchiveService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Archive struct {
+	GetFileOrFolderFunc func(ctx context.Context, filter *client.PathFileIDFilter) (*client.Folder, error)
+	UploadFileToDirFunc func(ctx context.Context, filter *client.PathFileIDFilter, fileName string, r io.Reader) (*client.File, error)
+	RemoveFilesFunc     func(ctx context.Context, path string) error
+	GetFileFunc         func(ctx context.Context, id, fileID string) (*client.Download, error)
+	DeleteFileFunc      func(ctx context.Context, id string) error
+}
+
+func (f *Archive) GetFileOrFolder(ctx context.Context, filter *client.PathFileIDFilter) (*client.Folder, error) {
+	if f.GetFileOrFolderFunc == nil {
+		panic(notImplemented("Archive", "GetFileOrFolder"))
+	}
+	return f.GetFileOrFolderFunc(ctx, filter)
+}
+
+func (f *Archive) UploadFileToDir(ctx context.Context, filter *client.PathFileIDFilter, fileName string, r io.Reader) (*client.File, error) {
+	if f.UploadFileToDirFunc == nil {
+		panic(notImplemented("Archive", "UploadFileToDir"))
+	}
+	return f.UploadFileToDirFunc(ctx, filter, fileName, r)
+}
+
+func (f *Archive) RemoveFiles(ctx context.Context, path string) error {
+	if f.RemoveFilesFunc == nil {
+		panic(notImplemented("Archive", "RemoveFiles"))
+	}
+	return f.RemoveFilesFunc(ctx, path)
+}
+
+func (f *Archive) GetFile(ctx context.Context, id, fileID string) (*client.Download, error) {
+	if f.GetFileFunc == nil {
+		panic(notImplemented("Archive", "GetFile"))
+	}
+	return f.GetFileFunc(ctx, id, fileID)
+}
+
+func (f *Archive) DeleteFile(ctx context.Context, id string) error {
+	if f.DeleteFileFunc == nil {
+		panic(notImplemented("Archive", "DeleteFile"))
+	}
+	return f.DeleteFileFunc(ctx, id)
+}
+
+// ArticleFileConnections fakes client.ArticleFileConnectionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type ArticleFileConnections struct {
+	GetAllArticleFileConnectionsFunc  func(ctx context.Context) ([]client.ArticleFileConnection, error)
+	GetArticleFileConnectionsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.ArticleFileConnection, *client.MetaInformation, error)
+	IterateArticleFileConnectionsFunc func() *client.Iterator[client.ArticleFileConnection]
+	CreateArticleFileConnectionFunc   func(ctx context.Context, afc *client.ArticleFileConnection) (*client.ArticleFileConnection, error)
+	GetArticleFileConnectionByIDFunc  func(ctx context.Context, fileID string) (*client.ArticleFileConnection, error)
+	DeleteArticleFileConnectionFunc   func(ctx context.Context, fileID string) error
+}
+
+func (f *ArticleFileConnections) GetAllArticleFileConnections(ctx context.Context) ([]client.ArticleFileConnection, error) {
+	if f.GetAllArticleFileConnectionsFunc == nil {
+		panic(notImplemented("ArticleFileConnections", "GetAllArticleFileConnections"))
+	}
+	return f.GetAllArticleFileConnectionsFunc(ctx)
+}
+
+func (f *ArticleFileConnections) GetArticleFileConnectionsPage(ctx context.Context, page *client.PageOptions) ([]client.ArticleFileConnection, *client.MetaInformation, error) {
+	if f.GetArticleFileConnectionsPageFunc == nil {
+		panic(notImplemented("ArticleFileConnections", "GetArticleFileConnectionsPage"))
+	}
+	return f.GetArticleFileConnectionsPageFunc(ctx, page)
+}
+
+func (f *ArticleFileConnections) IterateArticleFileConnections() *client.Iterator[client.ArticleFileConnection] {
+	if f.IterateArticleFileConnectionsFunc == nil {
+		panic(notImplemented("ArticleFileConnections", "IterateArticleFileConnections"))
+	}
+	return f.IterateArticleFileConnectionsFunc()
+}
+
+func (f *ArticleFileConnections) CreateArticleFileConnection(ctx context.Context, afc *client.ArticleFileConnection) (*client.ArticleFileConnection, error) {
+	if f.CreateArticleFileConnectionFunc == nil {
+		panic(notImplemented("ArticleFileConnections", "CreateArticleFileConnection"))
+	}
+	return f.CreateArticleFileConnectionFunc(ctx, afc)
+}
+
+func (f *ArticleFileConnections) GetArticleFileConnectionByID(ctx context.Context, fileID string) (*client.ArticleFileConnection, error) {
+	if f.GetArticleFileConnectionByIDFunc == nil {
+		panic(notImplemented("ArticleFileConnections", "GetArticleFileConnectionByID"))
+	}
+	return f.GetArticleFileConnectionByIDFunc(ctx, fileID)
+}
+
+func (f *ArticleFileConnections) DeleteArticleFileConnection(ctx context.Context, fileID string) error {
+	if f.DeleteArticleFileConnectionFunc == nil {
+		panic(notImplemented("ArticleFileConnections", "DeleteArticleFileConnection"))
+	}
+	return f.DeleteArticleFileConnectionFunc(ctx, fileID)
+}
+
+// Articles fakes client.ArticlesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Articles struct {
+	GetArticleFunc      func(ctx context.Context, articleNumber int) (*client.Article, error)
+	UpdateArticleFunc   func(ctx context.Context, articleNumber int, req *client.UpdateArticleReq) (*client.UpdateArticleResp, error)
+	PatchArticleFunc    func(ctx context.Context, original, edited *client.Article) (*client.Article, error)
+	DeleteArticleFunc   func(ctx context.Context, articleNumber int) error
+	GetArticlesFunc     func(ctx context.Context, filter *client.ArticleFilter) (*client.GetArticlesResp, error)
+	GetArticlesPageFunc func(ctx context.Context, filter *client.ArticleFilter, page *client.PageOptions) ([]client.Article, *client.MetaInformation, error)
+	IterateArticlesFunc func(filter *client.ArticleFilter) *client.Iterator[client.Article]
+	CreateArticleFunc   func(ctx context.Context, req *client.CreateArticleReq) (*client.CreateArticleResp, error)
+}
+
+func (f *Articles) GetArticle(ctx context.Context, articleNumber int) (*client.Article, error) {
+	if f.GetArticleFunc == nil {
+		panic(notImplemented("Articles", "GetArticle"))
+	}
+	return f.GetArticleFunc(ctx, articleNumber)
+}
+
+func (f *Articles) UpdateArticle(ctx context.Context, articleNumber int, req *client.UpdateArticleReq) (*client.UpdateArticleResp, error) {
+	if f.UpdateArticleFunc == nil {
+		panic(notImplemented("Articles", "UpdateArticle"))
+	}
+	return f.UpdateArticleFunc(ctx, articleNumber, req)
+}
+
+func (f *Articles) PatchArticle(ctx context.Context, original, edited *client.Article) (*client.Article, error) {
+	if f.PatchArticleFunc == nil {
+		panic(notImplemented("Articles", "PatchArticle"))
+	}
+	return f.PatchArticleFunc(ctx, original, edited)
+}
+
+func (f *Articles) DeleteArticle(ctx context.Context, articleNumber int) error {
+	if f.DeleteArticleFunc == nil {
+		panic(notImplemented("Articles", "DeleteArticle"))
+	}
+	return f.DeleteArticleFunc(ctx, articleNumber)
+}
+
+func (f *Articles) GetArticles(ctx context.Context, filter *client.ArticleFilter) (*client.GetArticlesResp, error) {
+	if f.GetArticlesFunc == nil {
+		panic(notImplemented("Articles", "GetArticles"))
+	}
+	return f.GetArticlesFunc(ctx, filter)
+}
+
+func (f *Articles) GetArticlesPage(ctx context.Context, filter *client.ArticleFilter, page *client.PageOptions) ([]client.Article, *client.MetaInformation, error) {
+	if f.GetArticlesPageFunc == nil {
+		panic(notImplemented("Articles", "GetArticlesPage"))
+	}
+	return f.GetArticlesPageFunc(ctx, filter, page)
+}
+
+func (f *Articles) IterateArticles(filter *client.ArticleFilter) *client.Iterator[client.Article] {
+	if f.IterateArticlesFunc == nil {
+		panic(notImplemented("Articles", "IterateArticles"))
+	}
+	return f.IterateArticlesFunc(filter)
+}
+
+func (f *Articles) CreateArticle(ctx context.Context, req *client.CreateArticleReq) (*client.CreateArticleResp, error) {
+	if f.CreateArticleFunc == nil {
+		panic(notImplemented("Articles", "CreateArticle"))
+	}
+	return f.CreateArticleFunc(ctx, req)
+}
+
+// AssetFileConnections fakes client.AssetFileConnectionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type AssetFileConnections struct {
+	GetAllAssetFileConnectionsFunc  func(ctx context.Context) (*client.GetAllAssetFileConnectionsResp, error)
+	GetAssetFileConnectionsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.AssetFileConnection, *client.MetaInformation, error)
+	IterateAssetFileConnectionsFunc func() *client.Iterator[client.AssetFileConnection]
+	CreateAssetFileConnectionFunc   func(ctx context.Context, req *client.CreateAssetFileConnectionReq) (*client.AssetFileConnection, error)
+	DeleteAssetFileConnectionFunc   func(ctx context.Context, fileID string) error
+}
+
+func (f *AssetFileConnections) GetAllAssetFileConnections(ctx context.Context) (*client.GetAllAssetFileConnectionsResp, error) {
+	if f.GetAllAssetFileConnectionsFunc == nil {
+		panic(notImplemented("AssetFileConnections", "GetAllAssetFileConnections"))
+	}
+	return f.GetAllAssetFileConnectionsFunc(ctx)
+}
+
+func (f *AssetFileConnections) GetAssetFileConnectionsPage(ctx context.Context, page *client.PageOptions) ([]client.AssetFileConnection, *client.MetaInformation, error) {
+	if f.GetAssetFileConnectionsPageFunc == nil {
+		panic(notImplemented("AssetFileConnections", "GetAssetFileConnectionsPage"))
+	}
+	return f.GetAssetFileConnectionsPageFunc(ctx, page)
+}
+
+func (f *AssetFileConnections) IterateAssetFileConnections() *client.Iterator[client.AssetFileConnection] {
+	if f.IterateAssetFileConnectionsFunc == nil {
+		panic(notImplemented("AssetFileConnections", "IterateAssetFileConnections"))
+	}
+	return f.IterateAssetFileConnectionsFunc()
+}
+
+func (f *AssetFileConnections) CreateAssetFileConnection(ctx context.Context, req *client.CreateAssetFileConnectionReq) (*client.AssetFileConnection, error) {
+	if f.CreateAssetFileConnectionFunc == nil {
+		panic(notImplemented("AssetFileConnections", "CreateAssetFileConnection"))
+	}
+	return f.CreateAssetFileConnectionFunc(ctx, req)
+}
+
+func (f *AssetFileConnections) DeleteAssetFileConnection(ctx context.Context, fileID string) error {
+	if f.DeleteAssetFileConnectionFunc == nil {
+		panic(notImplemented("AssetFileConnections", "DeleteAssetFileConnection"))
+	}
+	return f.DeleteAssetFileConnectionFunc(ctx, fileID)
+}
+
+// AssetTypes fakes client.AssetTypesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type AssetTypes struct {
+	GetAssetTypeFunc      func(ctx context.Context, id int) (*client.AssetType, error)
+	CreateAssetTypeFunc   func(ctx context.Context, at *client.AssetType) (*client.AssetType, error)
+	UpdateAssetTypeFunc   func(ctx context.Context, id int, at *client.AssetType) (*client.AssetType, error)
+	DeleteAssetTypeFunc   func(ctx context.Context, id int) error
+	GetAllAssetTypesFunc  func(ctx context.Context) ([]client.AssetType, error)
+	GetAssetTypesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.AssetType, *client.MetaInformation, error)
+	IterateAssetTypesFunc func() *client.Iterator[client.AssetType]
+}
+
+func (f *AssetTypes) GetAssetType(ctx context.Context, id int) (*client.AssetType, error) {
+	if f.GetAssetTypeFunc == nil {
+		panic(notImplemented("AssetTypes", "GetAssetType"))
+	}
+	return f.GetAssetTypeFunc(ctx, id)
+}
+
+func (f *AssetTypes) CreateAssetType(ctx context.Context, at *client.AssetType) (*client.AssetType, error) {
+	if f.CreateAssetTypeFunc == nil {
+		panic(notImplemented("AssetTypes", "CreateAssetType"))
+	}
+	return f.CreateAssetTypeFunc(ctx, at)
+}
+
+func (f *AssetTypes) UpdateAssetType(ctx context.Context, id int, at *client.AssetType) (*client.AssetType, error) {
+	if f.UpdateAssetTypeFunc == nil {
+		panic(notImplemented("AssetTypes", "UpdateAssetType"))
+	}
+	return f.UpdateAssetTypeFunc(ctx, id, at)
+}
+
+func (f *AssetTypes) DeleteAssetType(ctx context.Context, id int) error {
+	if f.DeleteAssetTypeFunc == nil {
+		panic(notImplemented("AssetTypes", "DeleteAssetType"))
+	}
+	return f.DeleteAssetTypeFunc(ctx, id)
+}
+
+func (f *AssetTypes) GetAllAssetTypes(ctx context.Context) ([]client.AssetType, error) {
+	if f.GetAllAssetTypesFunc == nil {
+		panic(notImplemented("AssetTypes", "GetAllAssetTypes"))
+	}
+	return f.GetAllAssetTypesFunc(ctx)
+}
+
+func (f *AssetTypes) GetAssetTypesPage(ctx context.Context, page *client.PageOptions) ([]client.AssetType, *client.MetaInformation, error) {
+	if f.GetAssetTypesPageFunc == nil {
+		panic(notImplemented("AssetTypes", "GetAssetTypesPage"))
+	}
+	return f.GetAssetTypesPageFunc(ctx, page)
+}
+
+func (f *AssetTypes) IterateAssetTypes() *client.Iterator[client.AssetType] {
+	if f.IterateAssetTypesFunc == nil {
+		panic(notImplemented("AssetTypes", "IterateAssetTypes"))
+	}
+	return f.IterateAssetTypesFunc()
+}
+
+// Assets fakes client.AssetsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Assets struct {
+	GetAllAssetsFunc              func(ctx context.Context) ([]client.Asset, error)
+	GetAssetsPageFunc             func(ctx context.Context, page *client.PageOptions) ([]client.Asset, *client.MetaInformation, error)
+	IterateAssetsFunc             func() *client.Iterator[client.Asset]
+	CreateAssetFunc               func(ctx context.Context, a *client.Asset) (*client.Asset, error)
+	GetAssetFunc                  func(ctx context.Context, givenNumber string) (*client.Asset, error)
+	ChangeManualAssetOBValueFunc  func(ctx context.Context, givenNumber int, req *client.ChangeManualAssetOBValueReq) (*client.Asset, error)
+	DeleteOrVoidAssetFunc         func(ctx context.Context, givenNumber int, req *client.DeleteOrVoidAssetReq) error
+	GetAssetsDepreciationListFunc func(ctx context.Context, toDate client.Date) ([]client.Asset, error)
+	WriteUpAssetFunc              func(ctx context.Context, givenNumber string, req *client.WriteUpAssetReq) (*client.Asset, error)
+	WriteDownAssetFunc            func(ctx context.Context, givenNumber string, req *client.WriteDownAssetReq) (*client.Asset, error)
+	ScrapAssetFunc                func(ctx context.Context, givenNumber string, req *client.ScrapAssetReq) (*client.Asset, error)
+	SellAssetFunc                 func(ctx context.Context, givenNumber string, req *client.SellAssetReq) (*client.Asset, error)
+	PerformAssetDepreciationFunc  func(ctx context.Context, req *client.PerformAssetDepreciationReq) (*client.PerformAssetDepreciationResp, error)
+}
+
+func (f *Assets) GetAllAssets(ctx context.Context) ([]client.Asset, error) {
+	if f.GetAllAssetsFunc == nil {
+		panic(notImplemented("Assets", "GetAllAssets"))
+	}
+	return f.GetAllAssetsFunc(ctx)
+}
+
+func (f *Assets) GetAssetsPage(ctx context.Context, page *client.PageOptions) ([]client.Asset, *client.MetaInformation, error) {
+	if f.GetAssetsPageFunc == nil {
+		panic(notImplemented("Assets", "GetAssetsPage"))
+	}
+	return f.GetAssetsPageFunc(ctx, page)
+}
+
+func (f *Assets) IterateAssets() *client.Iterator[client.Asset] {
+	if f.IterateAssetsFunc == nil {
+		panic(notImplemented("Assets", "IterateAssets"))
+	}
+	return f.IterateAssetsFunc()
+}
+
+func (f *Assets) CreateAsset(ctx context.Context, a *client.Asset) (*client.Asset, error) {
+	if f.CreateAssetFunc == nil {
+		panic(notImplemented("Assets", "CreateAsset"))
+	}
+	return f.CreateAssetFunc(ctx, a)
+}
+
+func (f *Assets) GetAsset(ctx context.Context, givenNumber string) (*client.Asset, error) {
+	if f.GetAssetFunc == nil {
+		panic(notImplemented("Assets", "GetAsset"))
+	}
+	return f.GetAssetFunc(ctx, givenNumber)
+}
+
+func (f *Assets) ChangeManualAssetOBValue(ctx context.Context, givenNumber int, req *client.ChangeManualAssetOBValueReq) (*client.Asset, error) {
+	if f.ChangeManualAssetOBValueFunc == nil {
+		panic(notImplemented("Assets", "ChangeManualAssetOBValue"))
+	}
+	return f.ChangeManualAssetOBValueFunc(ctx, givenNumber, req)
+}
+
+func (f *Assets) DeleteOrVoidAsset(ctx context.Context, givenNumber int, req *client.DeleteOrVoidAssetReq) error {
+	if f.DeleteOrVoidAssetFunc == nil {
+		panic(notImplemented("Assets", "DeleteOrVoidAsset"))
+	}
+	return f.DeleteOrVoidAssetFunc(ctx, givenNumber, req)
+}
+
+func (f *Assets) GetAssetsDepreciationList(ctx context.Context, toDate client.Date) ([]client.Asset, error) {
+	if f.GetAssetsDepreciationListFunc == nil {
+		panic(notImplemented("Assets", "GetAssetsDepreciationList"))
+	}
+	return f.GetAssetsDepreciationListFunc(ctx, toDate)
+}
+
+func (f *Assets) WriteUpAsset(ctx context.Context, givenNumber string, req *client.WriteUpAssetReq) (*client.Asset, error) {
+	if f.WriteUpAssetFunc == nil {
+		panic(notImplemented("Assets", "WriteUpAsset"))
+	}
+	return f.WriteUpAssetFunc(ctx, givenNumber, req)
+}
+
+func (f *Assets) WriteDownAsset(ctx context.Context, givenNumber string, req *client.WriteDownAssetReq) (*client.Asset, error) {
+	if f.WriteDownAssetFunc == nil {
+		panic(notImplemented("Assets", "WriteDownAsset"))
+	}
+	return f.WriteDownAssetFunc(ctx, givenNumber, req)
+}
+
+func (f *Assets) ScrapAsset(ctx context.Context, givenNumber string, req *client.ScrapAssetReq) (*client.Asset, error) {
+	if f.ScrapAssetFunc == nil {
+		panic(notImplemented("Assets", "ScrapAsset"))
+	}
+	return f.ScrapAssetFunc(ctx, givenNumber, req)
+}
+
+func (f *Assets) SellAsset(ctx context.Context, givenNumber string, req *client.SellAssetReq) (*client.Asset, error) {
+	if f.SellAssetFunc == nil {
+		panic(notImplemented("Assets", "SellAsset"))
+	}
+	return f.SellAssetFunc(ctx, givenNumber, req)
+}
+
+func (f *Assets) PerformAssetDepreciation(ctx context.Context, req *client.PerformAssetDepreciationReq) (*client.PerformAssetDepreciationResp, error) {
+	if f.PerformAssetDepreciationFunc == nil {
+		panic(notImplemented("Assets", "PerformAssetDepreciation"))
+	}
+	return f.PerformAssetDepreciationFunc(ctx, req)
+}
+
+// AttendanceTransactions fakes client.AttendanceTransactionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type AttendanceTransactions struct {
+	GetAllAttendanceTransactionsFunc  func(ctx context.Context, filter *client.GetAllAttendanceTransactionsFilter) ([]client.AttendanceTransaction, error)
+	GetAttendanceTransactionsPageFunc func(ctx context.Context, filter *client.GetAllAttendanceTransactionsFilter, page *client.PageOptions) ([]client.AttendanceTransaction, *client.MetaInformation, error)
+	IterateAttendanceTransactionsFunc func(filter *client.GetAllAttendanceTransactionsFilter) *client.Iterator[client.AttendanceTransaction]
+	CreateAttendanceTransactionFunc   func(ctx context.Context, at *client.AttendanceTransaction) (*client.AttendanceTransaction, error)
+	GetAttendanceTransactionFunc      func(ctx context.Context, id string) (*client.AttendanceTransaction, error)
+	UpdateAttendanceTransactionFunc   func(ctx context.Context, id string, at *client.AttendanceTransaction) (*client.AttendanceTransaction, error)
+}
+
+func (f *AttendanceTransactions) GetAllAttendanceTransactions(ctx context.Context, filter *client.GetAllAttendanceTransactionsFilter) ([]client.AttendanceTransaction, error) {
+	if f.GetAllAttendanceTransactionsFunc == nil {
+		panic(notImplemented("AttendanceTransactions", "GetAllAttendanceTransactions"))
+	}
+	return f.GetAllAttendanceTransactionsFunc(ctx, filter)
+}
+
+func (f *AttendanceTransactions) GetAttendanceTransactionsPage(ctx context.Context, filter *client.GetAllAttendanceTransactionsFilter, page *client.PageOptions) ([]client.AttendanceTransaction, *client.MetaInformation, error) {
+	if f.GetAttendanceTransactionsPageFunc == nil {
+		panic(notImplemented("AttendanceTransactions", "GetAttendanceTransactionsPage"))
+	}
+	return f.GetAttendanceTransactionsPageFunc(ctx, filter, page)
+}
+
+func (f *AttendanceTransactions) IterateAttendanceTransactions(filter *client.GetAllAttendanceTransactionsFilter) *client.Iterator[client.AttendanceTransaction] {
+	if f.IterateAttendanceTransactionsFunc == nil {
+		panic(notImplemented("AttendanceTransactions", "IterateAttendanceTransactions"))
+	}
+	return f.IterateAttendanceTransactionsFunc(filter)
+}
+
+func (f *AttendanceTransactions) CreateAttendanceTransaction(ctx context.Context, at *client.AttendanceTransaction) (*client.AttendanceTransaction, error) {
+	if f.CreateAttendanceTransactionFunc == nil {
+		panic(notImplemented("AttendanceTransactions", "CreateAttendanceTransaction"))
+	}
+	return f.CreateAttendanceTransactionFunc(ctx, at)
+}
+
+func (f *AttendanceTransactions) GetAttendanceTransaction(ctx context.Context, id string) (*client.AttendanceTransaction, error) {
+	if f.GetAttendanceTransactionFunc == nil {
+		panic(notImplemented("AttendanceTransactions", "GetAttendanceTransaction"))
+	}
+	return f.GetAttendanceTransactionFunc(ctx, id)
+}
+
+func (f *AttendanceTransactions) UpdateAttendanceTransaction(ctx context.Context, id string, at *client.AttendanceTransaction) (*client.AttendanceTransaction, error) {
+	if f.UpdateAttendanceTransactionFunc == nil {
+		panic(notImplemented("AttendanceTransactions", "UpdateAttendanceTransaction"))
+	}
+	return f.UpdateAttendanceTransactionFunc(ctx, id, at)
+}
+
+// CompanyInformation fakes client.CompanyInformationService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type CompanyInformation struct {
+	GetCompanyInformationFunc func(ctx context.Context) (*client.CompanyInformation, error)
+}
+
+func (f *CompanyInformation) GetCompanyInformation(ctx context.Context) (*client.CompanyInformation, error) {
+	if f.GetCompanyInformationFunc == nil {
+		panic(notImplemented("CompanyInformation", "GetCompanyInformation"))
+	}
+	return f.GetCompanyInformationFunc(ctx)
+}
+
+// CompanySettings fakes client.CompanySettingsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type CompanySettings struct {
+	GetCompanySettingsFunc func(ctx context.Context) (*client.CompanySettings, error)
+}
+
+func (f *CompanySettings) GetCompanySettings(ctx context.Context) (*client.CompanySettings, error) {
+	if f.GetCompanySettingsFunc == nil {
+		panic(notImplemented("CompanySettings", "GetCompanySettings"))
+	}
+	return f.GetCompanySettingsFunc(ctx)
+}
+
+// ContractAccruals fakes client.ContractAccrualsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type ContractAccruals struct {
+	GetAllContractAccrualsFunc  func(ctx context.Context) ([]client.ShortContractAccrual, error)
+	GetContractAccrualsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.ShortContractAccrual, *client.MetaInformation, error)
+	IterateContractAccrualsFunc func() *client.Iterator[client.ShortContractAccrual]
+	CreateContractAccrualFunc   func(ctx context.Context, fca *client.FullContractAccrual) (*client.FullContractAccrual, error)
+	GetContractAccrualFunc      func(ctx context.Context, documentNumber int) (*client.FullContractAccrual, error)
+	UpdateContractAccrualFunc   func(ctx context.Context, documentNumber int, fca *client.FullContractAccrual) (*client.FullContractAccrual, error)
+	RemoveContractAccrualFunc   func(ctx context.Context, documentNumber int) error
+}
+
+func (f *ContractAccruals) GetAllContractAccruals(ctx context.Context) ([]client.ShortContractAccrual, error) {
+	if f.GetAllContractAccrualsFunc == nil {
+		panic(notImplemented("ContractAccruals", "GetAllContractAccruals"))
+	}
+	return f.GetAllContractAccrualsFunc(ctx)
+}
+
+func (f *ContractAccruals) GetContractAccrualsPage(ctx context.Context, page *client.PageOptions) ([]client.ShortContractAccrual, *client.MetaInformation, error) {
+	if f.GetContractAccrualsPageFunc == nil {
+		panic(notImplemented("ContractAccruals", "GetContractAccrualsPage"))
+	}
+	return f.GetContractAccrualsPageFunc(ctx, page)
+}
+
+func (f *ContractAccruals) IterateContractAccruals() *client.Iterator[client.ShortContractAccrual] {
+	if f.IterateContractAccrualsFunc == nil {
+		panic(notImplemented("ContractAccruals", "IterateContractAccruals"))
+	}
+	return f.IterateContractAccrualsFunc()
+}
+
+func (f *ContractAccruals) CreateContractAccrual(ctx context.Context, fca *client.FullContractAccrual) (*client.FullContractAccrual, error) {
+	if f.CreateContractAccrualFunc == nil {
+		panic(notImplemented("ContractAccruals", "CreateContractAccrual"))
+	}
+	return f.CreateContractAccrualFunc(ctx, fca)
+}
+
+func (f *ContractAccruals) GetContractAccrual(ctx context.Context, documentNumber int) (*client.FullContractAccrual, error) {
+	if f.GetContractAccrualFunc == nil {
+		panic(notImplemented("ContractAccruals", "GetContractAccrual"))
+	}
+	return f.GetContractAccrualFunc(ctx, documentNumber)
+}
+
+func (f *ContractAccruals) UpdateContractAccrual(ctx context.Context, documentNumber int, fca *client.FullContractAccrual) (*client.FullContractAccrual, error) {
+	if f.UpdateContractAccrualFunc == nil {
+		panic(notImplemented("ContractAccruals", "UpdateContractAccrual"))
+	}
+	return f.UpdateContractAccrualFunc(ctx, documentNumber, fca)
+}
+
+func (f *ContractAccruals) RemoveContractAccrual(ctx context.Context, documentNumber int) error {
+	if f.RemoveContractAccrualFunc == nil {
+		panic(notImplemented("ContractAccruals", "RemoveContractAccrual"))
+	}
+	return f.RemoveContractAccrualFunc(ctx, documentNumber)
+}
+
+// ContractTemplates fakes client.ContractTemplatesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type ContractTemplates struct {
+	GetAllContractTemplatesFunc  func(ctx context.Context) (*client.GetAllContractTemplatesResp, error)
+	GetContractTemplatesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.ShortContractTemplate, *client.MetaInformation, error)
+	IterateContractTemplatesFunc func() *client.Iterator[client.ShortContractTemplate]
+	CreateContractTemplateFunc   func(ctx context.Context, req *client.CreateContractTemplateReq) (*client.CreateContractTemplateResp, error)
+	GetContractTemplateFunc      func(ctx context.Context, templateNumber int) (*client.GetContractTemplateResp, error)
+	UpdateContractTemplateFunc   func(ctx context.Context, templateNumber int, req *client.UpdateContractTemplateReq) (*client.UpdateContractTemplateResp, error)
+}
+
+func (f *ContractTemplates) GetAllContractTemplates(ctx context.Context) (*client.GetAllContractTemplatesResp, error) {
+	if f.GetAllContractTemplatesFunc == nil {
+		panic(notImplemented("ContractTemplates", "GetAllContractTemplates"))
+	}
+	return f.GetAllContractTemplatesFunc(ctx)
+}
+
+func (f *ContractTemplates) GetContractTemplatesPage(ctx context.Context, page *client.PageOptions) ([]client.ShortContractTemplate, *client.MetaInformation, error) {
+	if f.GetContractTemplatesPageFunc == nil {
+		panic(notImplemented("ContractTemplates", "GetContractTemplatesPage"))
+	}
+	return f.GetContractTemplatesPageFunc(ctx, page)
+}
+
+func (f *ContractTemplates) IterateContractTemplates() *client.Iterator[client.ShortContractTemplate] {
+	if f.IterateContractTemplatesFunc == nil {
+		panic(notImplemented("ContractTemplates", "IterateContractTemplates"))
+	}
+	return f.IterateContractTemplatesFunc()
+}
+
+func (f *ContractTemplates) CreateContractTemplate(ctx context.Context, req *client.CreateContractTemplateReq) (*client.CreateContractTemplateResp, error) {
+	if f.CreateContractTemplateFunc == nil {
+		panic(notImplemented("ContractTemplates", "CreateContractTemplate"))
+	}
+	return f.CreateContractTemplateFunc(ctx, req)
+}
+
+func (f *ContractTemplates) GetContractTemplate(ctx context.Context, templateNumber int) (*client.GetContractTemplateResp, error) {
+	if f.GetContractTemplateFunc == nil {
+		panic(notImplemented("ContractTemplates", "GetContractTemplate"))
+	}
+	return f.GetContractTemplateFunc(ctx, templateNumber)
+}
+
+func (f *ContractTemplates) UpdateContractTemplate(ctx context.Context, templateNumber int, req *client.UpdateContractTemplateReq) (*client.UpdateContractTemplateResp, error) {
+	if f.UpdateContractTemplateFunc == nil {
+		panic(notImplemented("ContractTemplates", "UpdateContractTemplate"))
+	}
+	return f.UpdateContractTemplateFunc(ctx, templateNumber, req)
+}
+
+// Contracts fakes client.ContractsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Contracts struct {
+	GetContractFunc               func(ctx context.Context, documentNumber string) (*client.Contract, error)
+	UpdateContractFunc            func(ctx context.Context, documentNumber int, con *client.Contract) (*client.Contract, error)
+	GetAllContractFunc            func(ctx context.Context, filter client.GetAllContractFilter) ([]client.Contract, error)
+	GetContractsPageFunc          func(ctx context.Context, filter client.GetAllContractFilter, page *client.PageOptions) ([]client.Contract, *client.MetaInformation, error)
+	IterateContractsFunc          func(filter client.GetAllContractFilter) *client.Iterator[client.Contract]
+	CreateContractFunc            func(ctx context.Context, con *client.Contract) (*client.Contract, error)
+	SetContractAsFinishedFunc     func(ctx context.Context, documentNumber string) (*client.Contract, error)
+	CreateInvoiceFromContractFunc func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	IncreaseInvoiceCountFunc      func(ctx context.Context, documentNumber string) (*client.Contract, error)
+}
+
+func (f *Contracts) GetContract(ctx context.Context, documentNumber string) (*client.Contract, error) {
+	if f.GetContractFunc == nil {
+		panic(notImplemented("Contracts", "GetContract"))
+	}
+	return f.GetContractFunc(ctx, documentNumber)
+}
+
+func (f *Contracts) UpdateContract(ctx context.Context, documentNumber int, con *client.Contract) (*client.Contract, error) {
+	if f.UpdateContractFunc == nil {
+		panic(notImplemented("Contracts", "UpdateContract"))
+	}
+	return f.UpdateContractFunc(ctx, documentNumber, con)
+}
+
+func (f *Contracts) GetAllContract(ctx context.Context, filter client.GetAllContractFilter) ([]client.Contract, error) {
+	if f.GetAllContractFunc == nil {
+		panic(notImplemented("Contracts", "GetAllContract"))
+	}
+	return f.GetAllContractFunc(ctx, filter)
+}
+
+func (f *Contracts) GetContractsPage(ctx context.Context, filter client.GetAllContractFilter, page *client.PageOptions) ([]client.Contract, *client.MetaInformation, error) {
+	if f.GetContractsPageFunc == nil {
+		panic(notImplemented("Contracts", "GetContractsPage"))
+	}
+	return f.GetContractsPageFunc(ctx, filter, page)
+}
+
+func (f *Contracts) IterateContracts(filter client.GetAllContractFilter) *client.Iterator[client.Contract] {
+	if f.IterateContractsFunc == nil {
+		panic(notImplemented("Contracts", "IterateContracts"))
+	}
+	return f.IterateContractsFunc(filter)
+}
+
+func (f *Contracts) CreateContract(ctx context.Context, con *client.Contract) (*client.Contract, error) {
+	if f.CreateContractFunc == nil {
+		panic(notImplemented("Contracts", "CreateContract"))
+	}
+	return f.CreateContractFunc(ctx, con)
+}
+
+func (f *Contracts) SetContractAsFinished(ctx context.Context, documentNumber string) (*client.Contract, error) {
+	if f.SetContractAsFinishedFunc == nil {
+		panic(notImplemented("Contracts", "SetContractAsFinished"))
+	}
+	return f.SetContractAsFinishedFunc(ctx, documentNumber)
+}
+
+func (f *Contracts) CreateInvoiceFromContract(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.CreateInvoiceFromContractFunc == nil {
+		panic(notImplemented("Contracts", "CreateInvoiceFromContract"))
+	}
+	return f.CreateInvoiceFromContractFunc(ctx, documentNumber)
+}
+
+func (f *Contracts) IncreaseInvoiceCount(ctx context.Context, documentNumber string) (*client.Contract, error) {
+	if f.IncreaseInvoiceCountFunc == nil {
+		panic(notImplemented("Contracts", "IncreaseInvoiceCount"))
+	}
+	return f.IncreaseInvoiceCountFunc(ctx, documentNumber)
+}
+
+// CostCenters fakes client.CostCentersService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type CostCenters struct {
+	GetAllCostCentersFunc  func(ctx context.Context) ([]client.CostCenter, error)
+	GetCostCentersPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.CostCenter, *client.MetaInformation, error)
+	IterateCostCentersFunc func() *client.Iterator[client.CostCenter]
+	CreateCostCenterFunc   func(ctx context.Context, cc *client.CostCenter) (*client.CostCenter, error)
+	GetCostCenterFunc      func(ctx context.Context, code string) (*client.CostCenter, error)
+	UpdateCostCenterFunc   func(ctx context.Context, code string, cc *client.CostCenter) (*client.CostCenter, error)
+	RemoveCostCenterFunc   func(ctx context.Context, code string) error
+}
+
+func (f *CostCenters) GetAllCostCenters(ctx context.Context) ([]client.CostCenter, error) {
+	if f.GetAllCostCentersFunc == nil {
+		panic(notImplemented("CostCenters", "GetAllCostCenters"))
+	}
+	return f.GetAllCostCentersFunc(ctx)
+}
+
+func (f *CostCenters) GetCostCentersPage(ctx context.Context, page *client.PageOptions) ([]client.CostCenter, *client.MetaInformation, error) {
+	if f.GetCostCentersPageFunc == nil {
+		panic(notImplemented("CostCenters", "GetCostCentersPage"))
+	}
+	return f.GetCostCentersPageFunc(ctx, page)
+}
+
+func (f *CostCenters) IterateCostCenters() *client.Iterator[client.CostCenter] {
+	if f.IterateCostCentersFunc == nil {
+		panic(notImplemented("CostCenters", "IterateCostCenters"))
+	}
+	return f.IterateCostCentersFunc()
+}
+
+func (f *CostCenters) CreateCostCenter(ctx context.Context, cc *client.CostCenter) (*client.CostCenter, error) {
+	if f.CreateCostCenterFunc == nil {
+		panic(notImplemented("CostCenters", "CreateCostCenter"))
+	}
+	return f.CreateCostCenterFunc(ctx, cc)
+}
+
+func (f *CostCenters) GetCostCenter(ctx context.Context, code string) (*client.CostCenter, error) {
+	if f.GetCostCenterFunc == nil {
+		panic(notImplemented("CostCenters", "GetCostCenter"))
+	}
+	return f.GetCostCenterFunc(ctx, code)
+}
+
+func (f *CostCenters) UpdateCostCenter(ctx context.Context, code string, cc *client.CostCenter) (*client.CostCenter, error) {
+	if f.UpdateCostCenterFunc == nil {
+		panic(notImplemented("CostCenters", "UpdateCostCenter"))
+	}
+	return f.UpdateCostCenterFunc(ctx, code, cc)
+}
+
+func (f *CostCenters) RemoveCostCenter(ctx context.Context, code string) error {
+	if f.RemoveCostCenterFunc == nil {
+		panic(notImplemented("CostCenters", "RemoveCostCenter"))
+	}
+	return f.RemoveCostCenterFunc(ctx, code)
+}
+
+// Currencies fakes client.CurrenciesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Currencies struct {
+	GetAllCurrenciesFunc  func(ctx context.Context) ([]client.Currency, error)
+	GetCurrenciesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.Currency, *client.MetaInformation, error)
+	IterateCurrenciesFunc func() *client.Iterator[client.Currency]
+	CreateCurrencyFunc    func(ctx context.Context, cur *client.Currency) (*client.Currency, error)
+	GetCurrencyFunc       func(ctx context.Context, code string) (*client.Currency, error)
+	UpdateCurrencyFunc    func(ctx context.Context, code string, cur *client.Currency) (*client.Currency, error)
+	RemoveCurrencyFunc    func(ctx context.Context, code string) error
+}
+
+func (f *Currencies) GetAllCurrencies(ctx context.Context) ([]client.Currency, error) {
+	if f.GetAllCurrenciesFunc == nil {
+		panic(notImplemented("Currencies", "GetAllCurrencies"))
+	}
+	return f.GetAllCurrenciesFunc(ctx)
+}
+
+func (f *Currencies) GetCurrenciesPage(ctx context.Context, page *client.PageOptions) ([]client.Currency, *client.MetaInformation, error) {
+	if f.GetCurrenciesPageFunc == nil {
+		panic(notImplemented("Currencies", "GetCurrenciesPage"))
+	}
+	return f.GetCurrenciesPageFunc(ctx, page)
+}
+
+func (f *Currencies) IterateCurrencies() *client.Iterator[client.Currency] {
+	if f.IterateCurrenciesFunc == nil {
+		panic(notImplemented("Currencies", "IterateCurrencies"))
+	}
+	return f.IterateCurrenciesFunc()
+}
+
+func (f *Currencies) CreateCurrency(ctx context.Context, cur *client.Currency) (*client.Currency, error) {
+	if f.CreateCurrencyFunc == nil {
+		panic(notImplemented("Currencies", "CreateCurrency"))
+	}
+	return f.CreateCurrencyFunc(ctx, cur)
+}
+
+func (f *Currencies) GetCurrency(ctx context.Context, code string) (*client.Currency, error) {
+	if f.GetCurrencyFunc == nil {
+		panic(notImplemented("Currencies", "GetCurrency"))
+	}
+	return f.GetCurrencyFunc(ctx, code)
+}
+
+func (f *Currencies) UpdateCurrency(ctx context.Context, code string, cur *client.Currency) (*client.Currency, error) {
+	if f.UpdateCurrencyFunc == nil {
+		panic(notImplemented("Currencies", "UpdateCurrency"))
+	}
+	return f.UpdateCurrencyFunc(ctx, code, cur)
+}
+
+func (f *Currencies) RemoveCurrency(ctx context.Context, code string) error {
+	if f.RemoveCurrencyFunc == nil {
+		panic(notImplemented("Currencies", "RemoveCurrency"))
+	}
+	return f.RemoveCurrencyFunc(ctx, code)
+}
+
+// CustomerReferences fakes client.CustomerReferencesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type CustomerReferences struct {
+	GetAllCustomerReferencesFunc   func(ctx context.Context) (*client.GetAllCustomerReferencesResp, error)
+	GetCustomerReferencesPageFunc  func(ctx context.Context, page *client.PageOptions) ([]client.CustomerReferenceRow, *client.MetaInformation, error)
+	IterateCustomerReferencesFunc  func() *client.Iterator[client.CustomerReferenceRow]
+	CreateCustomerReferenceFunc    func(ctx context.Context, req *client.CreateCustomerReferenceReq) (*client.CreateCustomerReferenceResp, error)
+	GetCustomerReferenceFunc       func(ctx context.Context, customerReferenceRowID string) (*client.GetCustomerReferenceResp, error)
+	UpdateCustomerReferenceFunc    func(ctx context.Context, customerReferenceRowID string, req *client.UpdateCustomerReferenceReq) (*client.UpdateCustomerReferenceResp, error)
+	DeleteCustomerReferenceRowFunc func(ctx context.Context, customerReferenceRowID string) error
+}
+
+func (f *CustomerReferences) GetAllCustomerReferences(ctx context.Context) (*client.GetAllCustomerReferencesResp, error) {
+	if f.GetAllCustomerReferencesFunc == nil {
+		panic(notImplemented("CustomerReferences", "GetAllCustomerReferences"))
+	}
+	return f.GetAllCustomerReferencesFunc(ctx)
+}
+
+func (f *CustomerReferences) GetCustomerReferencesPage(ctx context.Context, page *client.PageOptions) ([]client.CustomerReferenceRow, *client.MetaInformation, error) {
+	if f.GetCustomerReferencesPageFunc == nil {
+		panic(notImplemented("CustomerReferences", "GetCustomerReferencesPage"))
+	}
+	return f.GetCustomerReferencesPageFunc(ctx, page)
+}
+
+func (f *CustomerReferences) IterateCustomerReferences() *client.Iterator[client.CustomerReferenceRow] {
+	if f.IterateCustomerReferencesFunc == nil {
+		panic(notImplemented("CustomerReferences", "IterateCustomerReferences"))
+	}
+	return f.IterateCustomerReferencesFunc()
+}
+
+func (f *CustomerReferences) CreateCustomerReference(ctx context.Context, req *client.CreateCustomerReferenceReq) (*client.CreateCustomerReferenceResp, error) {
+	if f.CreateCustomerReferenceFunc == nil {
+		panic(notImplemented("CustomerReferences", "CreateCustomerReference"))
+	}
+	return f.CreateCustomerReferenceFunc(ctx, req)
+}
+
+func (f *CustomerReferences) GetCustomerReference(ctx context.Context, customerReferenceRowID string) (*client.GetCustomerReferenceResp, error) {
+	if f.GetCustomerReferenceFunc == nil {
+		panic(notImplemented("CustomerReferences", "GetCustomerReference"))
+	}
+	return f.GetCustomerReferenceFunc(ctx, customerReferenceRowID)
+}
+
+func (f *CustomerReferences) UpdateCustomerReference(ctx context.Context, customerReferenceRowID string, req *client.UpdateCustomerReferenceReq) (*client.UpdateCustomerReferenceResp, error) {
+	if f.UpdateCustomerReferenceFunc == nil {
+		panic(notImplemented("CustomerReferences", "UpdateCustomerReference"))
+	}
+	return f.UpdateCustomerReferenceFunc(ctx, customerReferenceRowID, req)
+}
+
+func (f *CustomerReferences) DeleteCustomerReferenceRow(ctx context.Context, customerReferenceRowID string) error {
+	if f.DeleteCustomerReferenceRowFunc == nil {
+		panic(notImplemented("CustomerReferences", "DeleteCustomerReferenceRow"))
+	}
+	return f.DeleteCustomerReferenceRowFunc(ctx, customerReferenceRowID)
+}
+
+// Customers fakes client.CustomersService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Customers struct {
+	GetAllCustomersFunc  func(ctx context.Context) ([]client.Customer, error)
+	GetCustomersPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.Customer, *client.MetaInformation, error)
+	IterateCustomersFunc func() *client.Iterator[client.Customer]
+	CreateCustomerFunc   func(ctx context.Context, cus *client.Customer) (*client.Customer, error)
+	GetCustomerFunc      func(ctx context.Context, customerNumber string) (*client.Customer, error)
+	UpdateCustomerFunc   func(ctx context.Context, customerNumber string, cus *client.CustomerUpdate) (*client.Customer, error)
+	PatchCustomerFunc    func(ctx context.Context, original, edited *client.Customer) (*client.Customer, error)
+	DeleteCustomerFunc   func(ctx context.Context, customerNumber string) error
+}
+
+func (f *Customers) GetAllCustomers(ctx context.Context) ([]client.Customer, error) {
+	if f.GetAllCustomersFunc == nil {
+		panic(notImplemented("Customers", "GetAllCustomers"))
+	}
+	return f.GetAllCustomersFunc(ctx)
+}
+
+func (f *Customers) GetCustomersPage(ctx context.Context, page *client.PageOptions) ([]client.Customer, *client.MetaInformation, error) {
+	if f.GetCustomersPageFunc == nil {
+		panic(notImplemented("Customers", "GetCustomersPage"))
+	}
+	return f.GetCustomersPageFunc(ctx, page)
+}
+
+func (f *Customers) IterateCustomers() *client.Iterator[client.Customer] {
+	if f.IterateCustomersFunc == nil {
+		panic(notImplemented("Customers", "IterateCustomers"))
+	}
+	return f.IterateCustomersFunc()
+}
+
+func (f *Customers) CreateCustomer(ctx context.Context, cus *client.Customer) (*client.Customer, error) {
+	if f.CreateCustomerFunc == nil {
+		panic(notImplemented("Customers", "CreateCustomer"))
+	}
+	return f.CreateCustomerFunc(ctx, cus)
+}
+
+func (f *Customers) GetCustomer(ctx context.Context, customerNumber string) (*client.Customer, error) {
+	if f.GetCustomerFunc == nil {
+		panic(notImplemented("Customers", "GetCustomer"))
+	}
+	return f.GetCustomerFunc(ctx, customerNumber)
+}
+
+func (f *Customers) UpdateCustomer(ctx context.Context, customerNumber string, cus *client.CustomerUpdate) (*client.Customer, error) {
+	if f.UpdateCustomerFunc == nil {
+		panic(notImplemented("Customers", "UpdateCustomer"))
+	}
+	return f.UpdateCustomerFunc(ctx, customerNumber, cus)
+}
+
+func (f *Customers) PatchCustomer(ctx context.Context, original, edited *client.Customer) (*client.Customer, error) {
+	if f.PatchCustomerFunc == nil {
+		panic(notImplemented("Customers", "PatchCustomer"))
+	}
+	return f.PatchCustomerFunc(ctx, original, edited)
+}
+
+func (f *Customers) DeleteCustomer(ctx context.Context, customerNumber string) error {
+	if f.DeleteCustomerFunc == nil {
+		panic(notImplemented("Customers", "DeleteCustomer"))
+	}
+	return f.DeleteCustomerFunc(ctx, customerNumber)
+}
+
+// EUVatLimitRegulation fakes client.EUVatLimitRegulationService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type EUVatLimitRegulation struct {
+	GetEUVATLimitDetailsFunc func(ctx context.Context) (*client.EUVatLimitRegulation, error)
+}
+
+func (f *EUVatLimitRegulation) GetEUVATLimitDetails(ctx context.Context) (*client.EUVatLimitRegulation, error) {
+	if f.GetEUVATLimitDetailsFunc == nil {
+		panic(notImplemented("EUVatLimitRegulation", "GetEUVATLimitDetails"))
+	}
+	return f.GetEUVATLimitDetailsFunc(ctx)
+}
+
+// Employees fakes client.EmployeesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Employees struct {
+	GetAllEmployeesFunc  func(ctx context.Context) ([]client.Employee, error)
+	GetEmployeesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.Employee, *client.MetaInformation, error)
+	IterateEmployeesFunc func() *client.Iterator[client.Employee]
+	CreateEmployeeFunc   func(ctx context.Context, e *client.Employee) (*client.Employee, error)
+	GetEmployeeFunc      func(ctx context.Context, employeeID string) (*client.Employee, error)
+	UpdateEmployeeFunc   func(ctx context.Context, employeeID string, e *client.Employee) (*client.Employee, error)
+}
+
+func (f *Employees) GetAllEmployees(ctx context.Context) ([]client.Employee, error) {
+	if f.GetAllEmployeesFunc == nil {
+		panic(notImplemented("Employees", "GetAllEmployees"))
+	}
+	return f.GetAllEmployeesFunc(ctx)
+}
+
+func (f *Employees) GetEmployeesPage(ctx context.Context, page *client.PageOptions) ([]client.Employee, *client.MetaInformation, error) {
+	if f.GetEmployeesPageFunc == nil {
+		panic(notImplemented("Employees", "GetEmployeesPage"))
+	}
+	return f.GetEmployeesPageFunc(ctx, page)
+}
+
+func (f *Employees) IterateEmployees() *client.Iterator[client.Employee] {
+	if f.IterateEmployeesFunc == nil {
+		panic(notImplemented("Employees", "IterateEmployees"))
+	}
+	return f.IterateEmployeesFunc()
+}
+
+func (f *Employees) CreateEmployee(ctx context.Context, e *client.Employee) (*client.Employee, error) {
+	if f.CreateEmployeeFunc == nil {
+		panic(notImplemented("Employees", "CreateEmployee"))
+	}
+	return f.CreateEmployeeFunc(ctx, e)
+}
+
+func (f *Employees) GetEmployee(ctx context.Context, employeeID string) (*client.Employee, error) {
+	if f.GetEmployeeFunc == nil {
+		panic(notImplemented("Employees", "GetEmployee"))
+	}
+	return f.GetEmployeeFunc(ctx, employeeID)
+}
+
+func (f *Employees) UpdateEmployee(ctx context.Context, employeeID string, e *client.Employee) (*client.Employee, error) {
+	if f.UpdateEmployeeFunc == nil {
+		panic(notImplemented("Employees", "UpdateEmployee"))
+	}
+	return f.UpdateEmployeeFunc(ctx, employeeID, e)
+}
+
+// Expenses fakes client.ExpensesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Expenses struct {
+	GetAllExpensesFunc  func(ctx context.Context) ([]client.Expense, error)
+	GetExpensesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.Expense, *client.MetaInformation, error)
+	IterateExpensesFunc func() *client.Iterator[client.Expense]
+	CreateExpenseFunc   func(ctx context.Context, e *client.Expense) (*client.Expense, error)
+	GetExpenseFunc      func(ctx context.Context, expenseCode string) (*client.Expense, error)
+}
+
+func (f *Expenses) GetAllExpenses(ctx context.Context) ([]client.Expense, error) {
+	if f.GetAllExpensesFunc == nil {
+		panic(notImplemented("Expenses", "GetAllExpenses"))
+	}
+	return f.GetAllExpensesFunc(ctx)
+}
+
+func (f *Expenses) GetExpensesPage(ctx context.Context, page *client.PageOptions) ([]client.Expense, *client.MetaInformation, error) {
+	if f.GetExpensesPageFunc == nil {
+		panic(notImplemented("Expenses", "GetExpensesPage"))
+	}
+	return f.GetExpensesPageFunc(ctx, page)
+}
+
+func (f *Expenses) IterateExpenses() *client.Iterator[client.Expense] {
+	if f.IterateExpensesFunc == nil {
+		panic(notImplemented("Expenses", "IterateExpenses"))
+	}
+	return f.IterateExpensesFunc()
+}
+
+func (f *Expenses) CreateExpense(ctx context.Context, e *client.Expense) (*client.Expense, error) {
+	if f.CreateExpenseFunc == nil {
+		panic(notImplemented("Expenses", "CreateExpense"))
+	}
+	return f.CreateExpenseFunc(ctx, e)
+}
+
+func (f *Expenses) GetExpense(ctx context.Context, expenseCode string) (*client.Expense, error) {
+	if f.GetExpenseFunc == nil {
+		panic(notImplemented("Expenses", "GetExpense"))
+	}
+	return f.GetExpenseFunc(ctx, expenseCode)
+}
+
+// FinancialYears fakes client.FinancialYearsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type FinancialYears struct {
+	GetAllFinancialYearsFunc  func(ctx context.Context, date *client.GetAllFinancialYearsFilterDate) ([]client.FinancialYear, error)
+	GetFinancialYearsPageFunc func(ctx context.Context, date *client.GetAllFinancialYearsFilterDate, page *client.PageOptions) ([]client.FinancialYear, *client.MetaInformation, error)
+	IterateFinancialYearsFunc func(date *client.GetAllFinancialYearsFilterDate) *client.Iterator[client.FinancialYear]
+	CreateFinancialYearFunc   func(ctx context.Context, fy *client.FinancialYear) (*client.FinancialYear, error)
+	GetFinancialYearByIDFunc  func(ctx context.Context, id int) (*client.FinancialYear, error)
+}
+
+func (f *FinancialYears) GetAllFinancialYears(ctx context.Context, date *client.GetAllFinancialYearsFilterDate) ([]client.FinancialYear, error) {
+	if f.GetAllFinancialYearsFunc == nil {
+		panic(notImplemented("FinancialYears", "GetAllFinancialYears"))
+	}
+	return f.GetAllFinancialYearsFunc(ctx, date)
+}
+
+func (f *FinancialYears) GetFinancialYearsPage(ctx context.Context, date *client.GetAllFinancialYearsFilterDate, page *client.PageOptions) ([]client.FinancialYear, *client.MetaInformation, error) {
+	if f.GetFinancialYearsPageFunc == nil {
+		panic(notImplemented("FinancialYears", "GetFinancialYearsPage"))
+	}
+	return f.GetFinancialYearsPageFunc(ctx, date, page)
+}
+
+func (f *FinancialYears) IterateFinancialYears(date *client.GetAllFinancialYearsFilterDate) *client.Iterator[client.FinancialYear] {
+	if f.IterateFinancialYearsFunc == nil {
+		panic(notImplemented("FinancialYears", "IterateFinancialYears"))
+	}
+	return f.IterateFinancialYearsFunc(date)
+}
+
+func (f *FinancialYears) CreateFinancialYear(ctx context.Context, fy *client.FinancialYear) (*client.FinancialYear, error) {
+	if f.CreateFinancialYearFunc == nil {
+		panic(notImplemented("FinancialYears", "CreateFinancialYear"))
+	}
+	return f.CreateFinancialYearFunc(ctx, fy)
+}
+
+func (f *FinancialYears) GetFinancialYearByID(ctx context.Context, id int) (*client.FinancialYear, error) {
+	if f.GetFinancialYearByIDFunc == nil {
+		panic(notImplemented("FinancialYears", "GetFinancialYearByID"))
+	}
+	return f.GetFinancialYearByIDFunc(ctx, id)
+}
+
+// Inbox fakes client.InboxService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Inbox struct {
+	GetRootDirectoryFunc   func(ctx context.Context) (*client.InboxRootFolder, error)
+	UploadFileFunc         func(ctx context.Context, params *client.UploadFileParams, fileName string, r io.Reader) (*client.InboxFile, error)
+	GetInboxFileFunc       func(ctx context.Context, id string) (*client.Download, error)
+	RemoveFileOrFolderFunc func(ctx context.Context, id string) error
+}
+
+func (f *Inbox) GetRootDirectory(ctx context.Context) (*client.InboxRootFolder, error) {
+	if f.GetRootDirectoryFunc == nil {
+		panic(notImplemented("Inbox", "GetRootDirectory"))
+	}
+	return f.GetRootDirectoryFunc(ctx)
+}
+
+func (f *Inbox) UploadFile(ctx context.Context, params *client.UploadFileParams, fileName string, r io.Reader) (*client.InboxFile, error) {
+	if f.UploadFileFunc == nil {
+		panic(notImplemented("Inbox", "UploadFile"))
+	}
+	return f.UploadFileFunc(ctx, params, fileName, r)
+}
+
+func (f *Inbox) GetInboxFile(ctx context.Context, id string) (*client.Download, error) {
+	if f.GetInboxFileFunc == nil {
+		panic(notImplemented("Inbox", "GetInboxFile"))
+	}
+	return f.GetInboxFileFunc(ctx, id)
+}
+
+func (f *Inbox) RemoveFileOrFolder(ctx context.Context, id string) error {
+	if f.RemoveFileOrFolderFunc == nil {
+		panic(notImplemented("Inbox", "RemoveFileOrFolder"))
+	}
+	return f.RemoveFileOrFolderFunc(ctx, id)
+}
+
+// InvoiceAccruals fakes client.InvoiceAccrualsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type InvoiceAccruals struct {
+	GetAllInvoiceAccrualsFunc  func(ctx context.Context) (*client.GetAllInvoiceAccrualsResp, error)
+	GetInvoiceAccrualsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.ShortInvoiceAccrual, *client.MetaInformation, error)
+	IterateInvoiceAccrualsFunc func() *client.Iterator[client.ShortInvoiceAccrual]
+	CreateInvoiceAccrualFunc   func(ctx context.Context, req *client.CreateInvoiceAccrualReq) (*client.CreateInvoiceAccrualResp, error)
+	GetInvoiceAccrualFunc      func(ctx context.Context, invoiceNumber int) (*client.GetInvoiceAccrualResp, error)
+	UpdateInvoiceAccrualFunc   func(ctx context.Context, invoiceNumber int, req *client.UpdateInvoiceAccrualReq) (*client.UpdateInvoiceAccrualResp, error)
+	RemoveInvoiceAccrualFunc   func(ctx context.Context, invoiceNumber int) error
+}
+
+func (f *InvoiceAccruals) GetAllInvoiceAccruals(ctx context.Context) (*client.GetAllInvoiceAccrualsResp, error) {
+	if f.GetAllInvoiceAccrualsFunc == nil {
+		panic(notImplemented("InvoiceAccruals", "GetAllInvoiceAccruals"))
+	}
+	return f.GetAllInvoiceAccrualsFunc(ctx)
+}
+
+func (f *InvoiceAccruals) GetInvoiceAccrualsPage(ctx context.Context, page *client.PageOptions) ([]client.ShortInvoiceAccrual, *client.MetaInformation, error) {
+	if f.GetInvoiceAccrualsPageFunc == nil {
+		panic(notImplemented("InvoiceAccruals", "GetInvoiceAccrualsPage"))
+	}
+	return f.GetInvoiceAccrualsPageFunc(ctx, page)
+}
+
+func (f *InvoiceAccruals) IterateInvoiceAccruals() *client.Iterator[client.ShortInvoiceAccrual] {
+	if f.IterateInvoiceAccrualsFunc == nil {
+		panic(notImplemented("InvoiceAccruals", "IterateInvoiceAccruals"))
+	}
+	return f.IterateInvoiceAccrualsFunc()
+}
+
+func (f *InvoiceAccruals) CreateInvoiceAccrual(ctx context.Context, req *client.CreateInvoiceAccrualReq) (*client.CreateInvoiceAccrualResp, error) {
+	if f.CreateInvoiceAccrualFunc == nil {
+		panic(notImplemented("InvoiceAccruals", "CreateInvoiceAccrual"))
+	}
+	return f.CreateInvoiceAccrualFunc(ctx, req)
+}
+
+func (f *InvoiceAccruals) GetInvoiceAccrual(ctx context.Context, invoiceNumber int) (*client.GetInvoiceAccrualResp, error) {
+	if f.GetInvoiceAccrualFunc == nil {
+		panic(notImplemented("InvoiceAccruals", "GetInvoiceAccrual"))
+	}
+	return f.GetInvoiceAccrualFunc(ctx, invoiceNumber)
+}
+
+func (f *InvoiceAccruals) UpdateInvoiceAccrual(ctx context.Context, invoiceNumber int, req *client.UpdateInvoiceAccrualReq) (*client.UpdateInvoiceAccrualResp, error) {
+	if f.UpdateInvoiceAccrualFunc == nil {
+		panic(notImplemented("InvoiceAccruals", "UpdateInvoiceAccrual"))
+	}
+	return f.UpdateInvoiceAccrualFunc(ctx, invoiceNumber, req)
+}
+
+func (f *InvoiceAccruals) RemoveInvoiceAccrual(ctx context.Context, invoiceNumber int) error {
+	if f.RemoveInvoiceAccrualFunc == nil {
+		panic(notImplemented("InvoiceAccruals", "RemoveInvoiceAccrual"))
+	}
+	return f.RemoveInvoiceAccrualFunc(ctx, invoiceNumber)
+}
+
+// InvoicePayments fakes client.InvoicePaymentsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type InvoicePayments struct {
+	GetAllInvoicePaymentsFunc  func(ctx context.Context) ([]client.InvoicePayment, error)
+	GetInvoicePaymentsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.InvoicePayment, *client.MetaInformation, error)
+	IterateInvoicePaymentsFunc func() *client.Iterator[client.InvoicePayment]
+	CreateInvoicePaymentFunc   func(ctx context.Context, ip *client.InvoicePayment) (*client.InvoicePayment, error)
+	GetInvoicePaymentFunc      func(ctx context.Context, number string) (*client.InvoicePayment, error)
+	UpdateInvoicePaymentFunc   func(ctx context.Context, number string, ip *client.InvoicePayment) (*client.InvoicePayment, error)
+	RemoveInvoicePaymentFunc   func(ctx context.Context, number string) error
+	BookKeepInvoicePaymentFunc func(ctx context.Context, number string, ip *client.InvoicePayment) (*client.InvoicePayment, error)
+}
+
+func (f *InvoicePayments) GetAllInvoicePayments(ctx context.Context) ([]client.InvoicePayment, error) {
+	if f.GetAllInvoicePaymentsFunc == nil {
+		panic(notImplemented("InvoicePayments", "GetAllInvoicePayments"))
+	}
+	return f.GetAllInvoicePaymentsFunc(ctx)
+}
+
+func (f *InvoicePayments) GetInvoicePaymentsPage(ctx context.Context, page *client.PageOptions) ([]client.InvoicePayment, *client.MetaInformation, error) {
+	if f.GetInvoicePaymentsPageFunc == nil {
+		panic(notImplemented("InvoicePayments", "GetInvoicePaymentsPage"))
+	}
+	return f.GetInvoicePaymentsPageFunc(ctx, page)
+}
+
+func (f *InvoicePayments) IterateInvoicePayments() *client.Iterator[client.InvoicePayment] {
+	if f.IterateInvoicePaymentsFunc == nil {
+		panic(notImplemented("InvoicePayments", "IterateInvoicePayments"))
+	}
+	return f.IterateInvoicePaymentsFunc()
+}
+
+func (f *InvoicePayments) CreateInvoicePayment(ctx context.Context, ip *client.InvoicePayment) (*client.InvoicePayment, error) {
+	if f.CreateInvoicePaymentFunc == nil {
+		panic(notImplemented("InvoicePayments", "CreateInvoicePayment"))
+	}
+	return f.CreateInvoicePaymentFunc(ctx, ip)
+}
+
+func (f *InvoicePayments) GetInvoicePayment(ctx context.Context, number string) (*client.InvoicePayment, error) {
+	if f.GetInvoicePaymentFunc == nil {
+		panic(notImplemented("InvoicePayments", "GetInvoicePayment"))
+	}
+	return f.GetInvoicePaymentFunc(ctx, number)
+}
+
+func (f *InvoicePayments) UpdateInvoicePayment(ctx context.Context, number string, ip *client.InvoicePayment) (*client.InvoicePayment, error) {
+	if f.UpdateInvoicePaymentFunc == nil {
+		panic(notImplemented("InvoicePayments", "UpdateInvoicePayment"))
+	}
+	return f.UpdateInvoicePaymentFunc(ctx, number, ip)
+}
+
+func (f *InvoicePayments) RemoveInvoicePayment(ctx context.Context, number string) error {
+	if f.RemoveInvoicePaymentFunc == nil {
+		panic(notImplemented("InvoicePayments", "RemoveInvoicePayment"))
+	}
+	return f.RemoveInvoicePaymentFunc(ctx, number)
+}
+
+func (f *InvoicePayments) BookKeepInvoicePayment(ctx context.Context, number string, ip *client.InvoicePayment) (*client.InvoicePayment, error) {
+	if f.BookKeepInvoicePaymentFunc == nil {
+		panic(notImplemented("InvoicePayments", "BookKeepInvoicePayment"))
+	}
+	return f.BookKeepInvoicePaymentFunc(ctx, number, ip)
+}
+
+// Invoices fakes client.InvoicesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Invoices struct {
+	GetInvoiceFunc            func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	UpdateInvoiceFunc         func(ctx context.Context, documentNumber string, i *client.InvoiceUpdate) (*client.Invoice, error)
+	PatchInvoiceFunc          func(ctx context.Context, original, edited *client.Invoice) (*client.Invoice, error)
+	GetAllInvoicesFunc        func(ctx context.Context, queryParams *client.GetAllInvoicesQueryParams) ([]client.Invoice, error)
+	GetInvoicesPageFunc       func(ctx context.Context, queryParams *client.GetAllInvoicesQueryParams, page *client.PageOptions) ([]client.Invoice, *client.MetaInformation, error)
+	IterateInvoicesFunc       func(queryParams *client.GetAllInvoicesQueryParams) *client.Iterator[client.Invoice]
+	CreateInvoiceFunc         func(ctx context.Context, i *client.Invoice) (*client.Invoice, error)
+	BookKeepInvoiceFunc       func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	CancelInvoiceFunc         func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	CreditInvoiceFunc         func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	SetInvoiceAsSentFunc      func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	SetInvoiceAsDoneFunc      func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	PrintInvoiceFunc          func(ctx context.Context, documentNumber string) (*client.Download, error)
+	SendInvoiceAsEmailFunc    func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	SendInvoiceAsReminderFunc func(ctx context.Context, documentNumber string) (*client.Download, error)
+	PreviewInvoiceFunc        func(ctx context.Context, documentNumber string) (*client.Download, error)
+	SendInvoiceAsEPrintFunc   func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	SendInvoiceAsEInvoiceFunc func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+}
+
+func (f *Invoices) GetInvoice(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.GetInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "GetInvoice"))
+	}
+	return f.GetInvoiceFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) UpdateInvoice(ctx context.Context, documentNumber string, i *client.InvoiceUpdate) (*client.Invoice, error) {
+	if f.UpdateInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "UpdateInvoice"))
+	}
+	return f.UpdateInvoiceFunc(ctx, documentNumber, i)
+}
+
+func (f *Invoices) PatchInvoice(ctx context.Context, original, edited *client.Invoice) (*client.Invoice, error) {
+	if f.PatchInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "PatchInvoice"))
+	}
+	return f.PatchInvoiceFunc(ctx, original, edited)
+}
+
+func (f *Invoices) GetAllInvoices(ctx context.Context, queryParams *client.GetAllInvoicesQueryParams) ([]client.Invoice, error) {
+	if f.GetAllInvoicesFunc == nil {
+		panic(notImplemented("Invoices", "GetAllInvoices"))
+	}
+	return f.GetAllInvoicesFunc(ctx, queryParams)
+}
+
+func (f *Invoices) GetInvoicesPage(ctx context.Context, queryParams *client.GetAllInvoicesQueryParams, page *client.PageOptions) ([]client.Invoice, *client.MetaInformation, error) {
+	if f.GetInvoicesPageFunc == nil {
+		panic(notImplemented("Invoices", "GetInvoicesPage"))
+	}
+	return f.GetInvoicesPageFunc(ctx, queryParams, page)
+}
+
+func (f *Invoices) IterateInvoices(queryParams *client.GetAllInvoicesQueryParams) *client.Iterator[client.Invoice] {
+	if f.IterateInvoicesFunc == nil {
+		panic(notImplemented("Invoices", "IterateInvoices"))
+	}
+	return f.IterateInvoicesFunc(queryParams)
+}
+
+func (f *Invoices) CreateInvoice(ctx context.Context, i *client.Invoice) (*client.Invoice, error) {
+	if f.CreateInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "CreateInvoice"))
+	}
+	return f.CreateInvoiceFunc(ctx, i)
+}
+
+func (f *Invoices) BookKeepInvoice(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.BookKeepInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "BookKeepInvoice"))
+	}
+	return f.BookKeepInvoiceFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) CancelInvoice(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.CancelInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "CancelInvoice"))
+	}
+	return f.CancelInvoiceFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) CreditInvoice(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.CreditInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "CreditInvoice"))
+	}
+	return f.CreditInvoiceFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) SetInvoiceAsSent(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.SetInvoiceAsSentFunc == nil {
+		panic(notImplemented("Invoices", "SetInvoiceAsSent"))
+	}
+	return f.SetInvoiceAsSentFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) SetInvoiceAsDone(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.SetInvoiceAsDoneFunc == nil {
+		panic(notImplemented("Invoices", "SetInvoiceAsDone"))
+	}
+	return f.SetInvoiceAsDoneFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) PrintInvoice(ctx context.Context, documentNumber string) (*client.Download, error) {
+	if f.PrintInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "PrintInvoice"))
+	}
+	return f.PrintInvoiceFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) SendInvoiceAsEmail(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.SendInvoiceAsEmailFunc == nil {
+		panic(notImplemented("Invoices", "SendInvoiceAsEmail"))
+	}
+	return f.SendInvoiceAsEmailFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) SendInvoiceAsReminder(ctx context.Context, documentNumber string) (*client.Download, error) {
+	if f.SendInvoiceAsReminderFunc == nil {
+		panic(notImplemented("Invoices", "SendInvoiceAsReminder"))
+	}
+	return f.SendInvoiceAsReminderFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) PreviewInvoice(ctx context.Context, documentNumber string) (*client.Download, error) {
+	if f.PreviewInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "PreviewInvoice"))
+	}
+	return f.PreviewInvoiceFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) SendInvoiceAsEPrint(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.SendInvoiceAsEPrintFunc == nil {
+		panic(notImplemented("Invoices", "SendInvoiceAsEPrint"))
+	}
+	return f.SendInvoiceAsEPrintFunc(ctx, documentNumber)
+}
+
+func (f *Invoices) SendInvoiceAsEInvoice(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.SendInvoiceAsEInvoiceFunc == nil {
+		panic(notImplemented("Invoices", "SendInvoiceAsEInvoice"))
+	}
+	return f.SendInvoiceAsEInvoiceFunc(ctx, documentNumber)
+}
+
+// Labels fakes client.LabelsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Labels struct {
+	GetAllLabelsFunc  func(ctx context.Context) ([]client.Label, error)
+	GetLabelsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.Label, *client.MetaInformation, error)
+	IterateLabelsFunc func() *client.Iterator[client.Label]
+	CreateLabelsFunc  func(ctx context.Context, name string) (*client.Label, error)
+	UpdateLabelFunc   func(ctx context.Context, id int, name string) (*client.Label, error)
+	DeleteLabelFunc   func(ctx context.Context, id int) error
+}
+
+func (f *Labels) GetAllLabels(ctx context.Context) ([]client.Label, error) {
+	if f.GetAllLabelsFunc == nil {
+		panic(notImplemented("Labels", "GetAllLabels"))
+	}
+	return f.GetAllLabelsFunc(ctx)
+}
+
+func (f *Labels) GetLabelsPage(ctx context.Context, page *client.PageOptions) ([]client.Label, *client.MetaInformation, error) {
+	if f.GetLabelsPageFunc == nil {
+		panic(notImplemented("Labels", "GetLabelsPage"))
+	}
+	return f.GetLabelsPageFunc(ctx, page)
+}
+
+func (f *Labels) IterateLabels() *client.Iterator[client.Label] {
+	if f.IterateLabelsFunc == nil {
+		panic(notImplemented("Labels", "IterateLabels"))
+	}
+	return f.IterateLabelsFunc()
+}
+
+func (f *Labels) CreateLabels(ctx context.Context, name string) (*client.Label, error) {
+	if f.CreateLabelsFunc == nil {
+		panic(notImplemented("Labels", "CreateLabels"))
+	}
+	return f.CreateLabelsFunc(ctx, name)
+}
+
+func (f *Labels) UpdateLabel(ctx context.Context, id int, name string) (*client.Label, error) {
+	if f.UpdateLabelFunc == nil {
+		panic(notImplemented("Labels", "UpdateLabel"))
+	}
+	return f.UpdateLabelFunc(ctx, id, name)
+}
+
+func (f *Labels) DeleteLabel(ctx context.Context, id int) error {
+	if f.DeleteLabelFunc == nil {
+		panic(notImplemented("Labels", "DeleteLabel"))
+	}
+	return f.DeleteLabelFunc(ctx, id)
+}
+
+// LockedPeriod fakes client.LockedPeriodService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type LockedPeriod struct {
+	GetLockedPeriodFunc func(ctx context.Context) (*client.LockedPeriod, error)
+}
+
+func (f *LockedPeriod) GetLockedPeriod(ctx context.Context) (*client.LockedPeriod, error) {
+	if f.GetLockedPeriodFunc == nil {
+		panic(notImplemented("LockedPeriod", "GetLockedPeriod"))
+	}
+	return f.GetLockedPeriodFunc(ctx)
+}
+
+// Me fakes client.MeService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Me struct {
+	GetMeInformationFunc func(ctx context.Context) (*client.MeInformation, error)
+}
+
+func (f *Me) GetMeInformation(ctx context.Context) (*client.MeInformation, error) {
+	if f.GetMeInformationFunc == nil {
+		panic(notImplemented("Me", "GetMeInformation"))
+	}
+	return f.GetMeInformationFunc(ctx)
+}
+
+// ModesOfPayments fakes client.ModesOfPaymentsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type ModesOfPayments struct {
+	GetAllModesOfPaymentsFunc  func(ctx context.Context) ([]client.ModeOfPayment, error)
+	GetModesOfPaymentsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.ModeOfPayment, *client.MetaInformation, error)
+	IterateModesOfPaymentsFunc func() *client.Iterator[client.ModeOfPayment]
+	CreateModeOfPaymentFunc    func(ctx context.Context, si *client.ModeOfPayment) (*client.ModeOfPayment, error)
+	GetModeOfPaymentFunc       func(ctx context.Context, code string) (*client.ModeOfPayment, error)
+	UpdateModeOfPaymentFunc    func(ctx context.Context, code string, mop *client.ModeOfPayment) (*client.ModeOfPayment, error)
+}
+
+func (f *ModesOfPayments) GetAllModesOfPayments(ctx context.Context) ([]client.ModeOfPayment, error) {
+	if f.GetAllModesOfPaymentsFunc == nil {
+		panic(notImplemented("ModesOfPayments", "GetAllModesOfPayments"))
+	}
+	return f.GetAllModesOfPaymentsFunc(ctx)
+}
+
+func (f *ModesOfPayments) GetModesOfPaymentsPage(ctx context.Context, page *client.PageOptions) ([]client.ModeOfPayment, *client.MetaInformation, error) {
+	if f.GetModesOfPaymentsPageFunc == nil {
+		panic(notImplemented("ModesOfPayments", "GetModesOfPaymentsPage"))
+	}
+	return f.GetModesOfPaymentsPageFunc(ctx, page)
+}
+
+func (f *ModesOfPayments) IterateModesOfPayments() *client.Iterator[client.ModeOfPayment] {
+	if f.IterateModesOfPaymentsFunc == nil {
+		panic(notImplemented("ModesOfPayments", "IterateModesOfPayments"))
+	}
+	return f.IterateModesOfPaymentsFunc()
+}
+
+func (f *ModesOfPayments) CreateModeOfPayment(ctx context.Context, si *client.ModeOfPayment) (*client.ModeOfPayment, error) {
+	if f.CreateModeOfPaymentFunc == nil {
+		panic(notImplemented("ModesOfPayments", "CreateModeOfPayment"))
+	}
+	return f.CreateModeOfPaymentFunc(ctx, si)
+}
+
+func (f *ModesOfPayments) GetModeOfPayment(ctx context.Context, code string) (*client.ModeOfPayment, error) {
+	if f.GetModeOfPaymentFunc == nil {
+		panic(notImplemented("ModesOfPayments", "GetModeOfPayment"))
+	}
+	return f.GetModeOfPaymentFunc(ctx, code)
+}
+
+func (f *ModesOfPayments) UpdateModeOfPayment(ctx context.Context, code string, mop *client.ModeOfPayment) (*client.ModeOfPayment, error) {
+	if f.UpdateModeOfPaymentFunc == nil {
+		panic(notImplemented("ModesOfPayments", "UpdateModeOfPayment"))
+	}
+	return f.UpdateModeOfPaymentFunc(ctx, code, mop)
+}
+
+// Offers fakes client.OffersService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Offers struct {
+	GetAllOffersFunc          func(ctx context.Context, filter *client.GetAllOffersFilter) ([]client.Offer, error)
+	GetOffersPageFunc         func(ctx context.Context, filter *client.GetAllOffersFilter, page *client.PageOptions) ([]client.Offer, *client.MetaInformation, error)
+	IterateOffersFunc         func(filter *client.GetAllOffersFilter) *client.Iterator[client.Offer]
+	CreateOfferFunc           func(ctx context.Context, o *client.Offer) (*client.Offer, error)
+	GetOfferFunc              func(ctx context.Context, documentNumber string) (*client.Offer, error)
+	UpdateOfferFunc           func(ctx context.Context, documentNumber string, o *client.OfferUpdate) (*client.Offer, error)
+	PatchOfferFunc            func(ctx context.Context, original, edited *client.Offer) (*client.Offer, error)
+	PrintOfferFunc            func(ctx context.Context, documentNumber string) (*client.Download, error)
+	SendOfferAsEmailFunc      func(ctx context.Context, documentNumber string) (*client.Offer, error)
+	PreviewOfferFunc          func(ctx context.Context, documentNumber string) (*client.Download, error)
+	CreateOrderOutOfOfferFunc func(ctx context.Context, documentNumber string) (*client.Order, error)
+	CancelOfferFunc           func(ctx context.Context, documentNumber string) (*client.Offer, error)
+	SetOfferAsSentFunc        func(ctx context.Context, documentNumber string) (*client.Offer, error)
+}
+
+func (f *Offers) GetAllOffers(ctx context.Context, filter *client.GetAllOffersFilter) ([]client.Offer, error) {
+	if f.GetAllOffersFunc == nil {
+		panic(notImplemented("Offers", "GetAllOffers"))
+	}
+	return f.GetAllOffersFunc(ctx, filter)
+}
+
+func (f *Offers) GetOffersPage(ctx context.Context, filter *client.GetAllOffersFilter, page *client.PageOptions) ([]client.Offer, *client.MetaInformation, error) {
+	if f.GetOffersPageFunc == nil {
+		panic(notImplemented("Offers", "GetOffersPage"))
+	}
+	return f.GetOffersPageFunc(ctx, filter, page)
+}
+
+func (f *Offers) IterateOffers(filter *client.GetAllOffersFilter) *client.Iterator[client.Offer] {
+	if f.IterateOffersFunc == nil {
+		panic(notImplemented("Offers", "IterateOffers"))
+	}
+	return f.IterateOffersFunc(filter)
+}
+
+func (f *Offers) CreateOffer(ctx context.Context, o *client.Offer) (*client.Offer, error) {
+	if f.CreateOfferFunc == nil {
+		panic(notImplemented("Offers", "CreateOffer"))
+	}
+	return f.CreateOfferFunc(ctx, o)
+}
+
+func (f *Offers) GetOffer(ctx context.Context, documentNumber string) (*client.Offer, error) {
+	if f.GetOfferFunc == nil {
+		panic(notImplemented("Offers", "GetOffer"))
+	}
+	return f.GetOfferFunc(ctx, documentNumber)
+}
+
+func (f *Offers) UpdateOffer(ctx context.Context, documentNumber string, o *client.OfferUpdate) (*client.Offer, error) {
+	if f.UpdateOfferFunc == nil {
+		panic(notImplemented("Offers", "UpdateOffer"))
+	}
+	return f.UpdateOfferFunc(ctx, documentNumber, o)
+}
+
+func (f *Offers) PatchOffer(ctx context.Context, original, edited *client.Offer) (*client.Offer, error) {
+	if f.PatchOfferFunc == nil {
+		panic(notImplemented("Offers", "PatchOffer"))
+	}
+	return f.PatchOfferFunc(ctx, original, edited)
+}
+
+func (f *Offers) PrintOffer(ctx context.Context, documentNumber string) (*client.Download, error) {
+	if f.PrintOfferFunc == nil {
+		panic(notImplemented("Offers", "PrintOffer"))
+	}
+	return f.PrintOfferFunc(ctx, documentNumber)
+}
+
+func (f *Offers) SendOfferAsEmail(ctx context.Context, documentNumber string) (*client.Offer, error) {
+	if f.SendOfferAsEmailFunc == nil {
+		panic(notImplemented("Offers", "SendOfferAsEmail"))
+	}
+	return f.SendOfferAsEmailFunc(ctx, documentNumber)
+}
+
+func (f *Offers) PreviewOffer(ctx context.Context, documentNumber string) (*client.Download, error) {
+	if f.PreviewOfferFunc == nil {
+		panic(notImplemented("Offers", "PreviewOffer"))
+	}
+	return f.PreviewOfferFunc(ctx, documentNumber)
+}
+
+func (f *Offers) CreateOrderOutOfOffer(ctx context.Context, documentNumber string) (*client.Order, error) {
+	if f.CreateOrderOutOfOfferFunc == nil {
+		panic(notImplemented("Offers", "CreateOrderOutOfOffer"))
+	}
+	return f.CreateOrderOutOfOfferFunc(ctx, documentNumber)
+}
+
+func (f *Offers) CancelOffer(ctx context.Context, documentNumber string) (*client.Offer, error) {
+	if f.CancelOfferFunc == nil {
+		panic(notImplemented("Offers", "CancelOffer"))
+	}
+	return f.CancelOfferFunc(ctx, documentNumber)
+}
+
+func (f *Offers) SetOfferAsSent(ctx context.Context, documentNumber string) (*client.Offer, error) {
+	if f.SetOfferAsSentFunc == nil {
+		panic(notImplemented("Offers", "SetOfferAsSent"))
+	}
+	return f.SetOfferAsSentFunc(ctx, documentNumber)
+}
+
+// Orders fakes client.OrdersService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Orders struct {
+	GetAllOrdersFunc                 func(ctx context.Context, filter *client.GetAllOrdersFilter) ([]client.Order, error)
+	GetOrdersPageFunc                func(ctx context.Context, filter *client.GetAllOrdersFilter, page *client.PageOptions) ([]client.Order, *client.MetaInformation, error)
+	IterateOrdersFunc                func(filter *client.GetAllOrdersFilter) *client.Iterator[client.Order]
+	CreateOrderFunc                  func(ctx context.Context, o *client.Order) (*client.Order, error)
+	GetOrderFunc                     func(ctx context.Context, documentNumber string) (*client.Order, error)
+	UpdateOrderFunc                  func(ctx context.Context, documentNumber string, o *client.OrderUpdate) (*client.Order, error)
+	PatchOrderFunc                   func(ctx context.Context, original, edited *client.Order) (*client.Order, error)
+	PrintOrderFunc                   func(ctx context.Context, documentNumber string) (*client.Download, error)
+	SendOrderAsEmailFunc             func(ctx context.Context, documentNumber string) (*client.Order, error)
+	PreviewOrderFunc                 func(ctx context.Context, documentNumber string) (*client.Download, error)
+	CreateInvoiceOutOfGivenOrderFunc func(ctx context.Context, documentNumber string) (*client.Invoice, error)
+	CancelGivenOrderFunc             func(ctx context.Context, documentNumber string) (*client.Order, error)
+	SetGivenOrderAsSentFunc          func(ctx context.Context, documentNumber string) (*client.Order, error)
+}
+
+func (f *Orders) GetAllOrders(ctx context.Context, filter *client.GetAllOrdersFilter) ([]client.Order, error) {
+	if f.GetAllOrdersFunc == nil {
+		panic(notImplemented("Orders", "GetAllOrders"))
+	}
+	return f.GetAllOrdersFunc(ctx, filter)
+}
+
+func (f *Orders) GetOrdersPage(ctx context.Context, filter *client.GetAllOrdersFilter, page *client.PageOptions) ([]client.Order, *client.MetaInformation, error) {
+	if f.GetOrdersPageFunc == nil {
+		panic(notImplemented("Orders", "GetOrdersPage"))
+	}
+	return f.GetOrdersPageFunc(ctx, filter, page)
+}
+
+func (f *Orders) IterateOrders(filter *client.GetAllOrdersFilter) *client.Iterator[client.Order] {
+	if f.IterateOrdersFunc == nil {
+		panic(notImplemented("Orders", "IterateOrders"))
+	}
+	return f.IterateOrdersFunc(filter)
+}
+
+func (f *Orders) CreateOrder(ctx context.Context, o *client.Order) (*client.Order, error) {
+	if f.CreateOrderFunc == nil {
+		panic(notImplemented("Orders", "CreateOrder"))
+	}
+	return f.CreateOrderFunc(ctx, o)
+}
+
+func (f *Orders) GetOrder(ctx context.Context, documentNumber string) (*client.Order, error) {
+	if f.GetOrderFunc == nil {
+		panic(notImplemented("Orders", "GetOrder"))
+	}
+	return f.GetOrderFunc(ctx, documentNumber)
+}
+
+func (f *Orders) UpdateOrder(ctx context.Context, documentNumber string, o *client.OrderUpdate) (*client.Order, error) {
+	if f.UpdateOrderFunc == nil {
+		panic(notImplemented("Orders", "UpdateOrder"))
+	}
+	return f.UpdateOrderFunc(ctx, documentNumber, o)
+}
+
+func (f *Orders) PatchOrder(ctx context.Context, original, edited *client.Order) (*client.Order, error) {
+	if f.PatchOrderFunc == nil {
+		panic(notImplemented("Orders", "PatchOrder"))
+	}
+	return f.PatchOrderFunc(ctx, original, edited)
+}
+
+func (f *Orders) PrintOrder(ctx context.Context, documentNumber string) (*client.Download, error) {
+	if f.PrintOrderFunc == nil {
+		panic(notImplemented("Orders", "PrintOrder"))
+	}
+	return f.PrintOrderFunc(ctx, documentNumber)
+}
+
+func (f *Orders) SendOrderAsEmail(ctx context.Context, documentNumber string) (*client.Order, error) {
+	if f.SendOrderAsEmailFunc == nil {
+		panic(notImplemented("Orders", "SendOrderAsEmail"))
+	}
+	return f.SendOrderAsEmailFunc(ctx, documentNumber)
+}
+
+func (f *Orders) PreviewOrder(ctx context.Context, documentNumber string) (*client.Download, error) {
+	if f.PreviewOrderFunc == nil {
+		panic(notImplemented("Orders", "PreviewOrder"))
+	}
+	return f.PreviewOrderFunc(ctx, documentNumber)
+}
+
+func (f *Orders) CreateInvoiceOutOfGivenOrder(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+	if f.CreateInvoiceOutOfGivenOrderFunc == nil {
+		panic(notImplemented("Orders", "CreateInvoiceOutOfGivenOrder"))
+	}
+	return f.CreateInvoiceOutOfGivenOrderFunc(ctx, documentNumber)
+}
+
+func (f *Orders) CancelGivenOrder(ctx context.Context, documentNumber string) (*client.Order, error) {
+	if f.CancelGivenOrderFunc == nil {
+		panic(notImplemented("Orders", "CancelGivenOrder"))
+	}
+	return f.CancelGivenOrderFunc(ctx, documentNumber)
+}
+
+func (f *Orders) SetGivenOrderAsSent(ctx context.Context, documentNumber string) (*client.Order, error) {
+	if f.SetGivenOrderAsSentFunc == nil {
+		panic(notImplemented("Orders", "SetGivenOrderAsSent"))
+	}
+	return f.SetGivenOrderAsSentFunc(ctx, documentNumber)
+}
+
+// PredefinedAccounts fakes client.PredefinedAccountsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type PredefinedAccounts struct {
+	GetAllPredefinedAccountsFunc  func(ctx context.Context) ([]client.PreDefinedAccount, error)
+	GetPredefinedAccountsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.PreDefinedAccount, *client.MetaInformation, error)
+	IteratePredefinedAccountsFunc func() *client.Iterator[client.PreDefinedAccount]
+	GetPredefinedAccountFunc      func(ctx context.Context, name string) (*client.PreDefinedAccount, error)
+	UpdatePredefinedAccountFunc   func(ctx context.Context, name string, pda *client.PreDefinedAccount) (*client.PreDefinedAccount, error)
+}
+
+func (f *PredefinedAccounts) GetAllPredefinedAccounts(ctx context.Context) ([]client.PreDefinedAccount, error) {
+	if f.GetAllPredefinedAccountsFunc == nil {
+		panic(notImplemented("PredefinedAccounts", "GetAllPredefinedAccounts"))
+	}
+	return f.GetAllPredefinedAccountsFunc(ctx)
+}
+
+func (f *PredefinedAccounts) GetPredefinedAccountsPage(ctx context.Context, page *client.PageOptions) ([]client.PreDefinedAccount, *client.MetaInformation, error) {
+	if f.GetPredefinedAccountsPageFunc == nil {
+		panic(notImplemented("PredefinedAccounts", "GetPredefinedAccountsPage"))
+	}
+	return f.GetPredefinedAccountsPageFunc(ctx, page)
+}
+
+func (f *PredefinedAccounts) IteratePredefinedAccounts() *client.Iterator[client.PreDefinedAccount] {
+	if f.IteratePredefinedAccountsFunc == nil {
+		panic(notImplemented("PredefinedAccounts", "IteratePredefinedAccounts"))
+	}
+	return f.IteratePredefinedAccountsFunc()
+}
+
+func (f *PredefinedAccounts) GetPredefinedAccount(ctx context.Context, name string) (*client.PreDefinedAccount, error) {
+	if f.GetPredefinedAccountFunc == nil {
+		panic(notImplemented("PredefinedAccounts", "GetPredefinedAccount"))
+	}
+	return f.GetPredefinedAccountFunc(ctx, name)
+}
+
+func (f *PredefinedAccounts) UpdatePredefinedAccount(ctx context.Context, name string, pda *client.PreDefinedAccount) (*client.PreDefinedAccount, error) {
+	if f.UpdatePredefinedAccountFunc == nil {
+		panic(notImplemented("PredefinedAccounts", "UpdatePredefinedAccount"))
+	}
+	return f.UpdatePredefinedAccountFunc(ctx, name, pda)
+}
+
+// PredefinedVoucherSeries fakes client.PredefinedVoucherSeriesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type PredefinedVoucherSeries struct {
+	GetAllPredefinedVoucherSeriesFunc  func(ctx context.Context) ([]client.PreDefinedVoucherSeries, error)
+	GetPredefinedVoucherSeriesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.PreDefinedVoucherSeries, *client.MetaInformation, error)
+	IteratePredefinedVoucherSeriesFunc func() *client.Iterator[client.PreDefinedVoucherSeries]
+	GetPredefinedVoucherSeriesFunc     func(ctx context.Context, name string) (*client.PreDefinedVoucherSeries, error)
+	UpdatePredefinedVoucherSeriesFunc  func(ctx context.Context, name string, pdvs *client.PreDefinedVoucherSeries) (*client.PreDefinedVoucherSeries, error)
+}
+
+func (f *PredefinedVoucherSeries) GetAllPredefinedVoucherSeries(ctx context.Context) ([]client.PreDefinedVoucherSeries, error) {
+	if f.GetAllPredefinedVoucherSeriesFunc == nil {
+		panic(notImplemented("PredefinedVoucherSeries", "GetAllPredefinedVoucherSeries"))
+	}
+	return f.GetAllPredefinedVoucherSeriesFunc(ctx)
+}
+
+func (f *PredefinedVoucherSeries) GetPredefinedVoucherSeriesPage(ctx context.Context, page *client.PageOptions) ([]client.PreDefinedVoucherSeries, *client.MetaInformation, error) {
+	if f.GetPredefinedVoucherSeriesPageFunc == nil {
+		panic(notImplemented("PredefinedVoucherSeries", "GetPredefinedVoucherSeriesPage"))
+	}
+	return f.GetPredefinedVoucherSeriesPageFunc(ctx, page)
+}
+
+func (f *PredefinedVoucherSeries) IteratePredefinedVoucherSeries() *client.Iterator[client.PreDefinedVoucherSeries] {
+	if f.IteratePredefinedVoucherSeriesFunc == nil {
+		panic(notImplemented("PredefinedVoucherSeries", "IteratePredefinedVoucherSeries"))
+	}
+	return f.IteratePredefinedVoucherSeriesFunc()
+}
+
+func (f *PredefinedVoucherSeries) GetPredefinedVoucherSeries(ctx context.Context, name string) (*client.PreDefinedVoucherSeries, error) {
+	if f.GetPredefinedVoucherSeriesFunc == nil {
+		panic(notImplemented("PredefinedVoucherSeries", "GetPredefinedVoucherSeries"))
+	}
+	return f.GetPredefinedVoucherSeriesFunc(ctx, name)
+}
+
+func (f *PredefinedVoucherSeries) UpdatePredefinedVoucherSeries(ctx context.Context, name string, pdvs *client.PreDefinedVoucherSeries) (*client.PreDefinedVoucherSeries, error) {
+	if f.UpdatePredefinedVoucherSeriesFunc == nil {
+		panic(notImplemented("PredefinedVoucherSeries", "UpdatePredefinedVoucherSeries"))
+	}
+	return f.UpdatePredefinedVoucherSeriesFunc(ctx, name, pdvs)
+}
+
+// PriceLists fakes client.PriceListsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type PriceLists struct {
+	GetAllPriceListsFunc  func(ctx context.Context) ([]client.PriceList, error)
+	GetPriceListsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.PriceList, *client.MetaInformation, error)
+	IteratePriceListsFunc func() *client.Iterator[client.PriceList]
+	CreatePriceListFunc   func(ctx context.Context, pl *client.PriceList) (*client.PriceList, error)
+	GetPriceListFunc      func(ctx context.Context, code string) (*client.PriceList, error)
+	UpdatePriceListFunc   func(ctx context.Context, code string, pl *client.PriceList) (*client.PriceList, error)
+}
+
+func (f *PriceLists) GetAllPriceLists(ctx context.Context) ([]client.PriceList, error) {
+	if f.GetAllPriceListsFunc == nil {
+		panic(notImplemented("PriceLists", "GetAllPriceLists"))
+	}
+	return f.GetAllPriceListsFunc(ctx)
+}
+
+func (f *PriceLists) GetPriceListsPage(ctx context.Context, page *client.PageOptions) ([]client.PriceList, *client.MetaInformation, error) {
+	if f.GetPriceListsPageFunc == nil {
+		panic(notImplemented("PriceLists", "GetPriceListsPage"))
+	}
+	return f.GetPriceListsPageFunc(ctx, page)
+}
+
+func (f *PriceLists) IteratePriceLists() *client.Iterator[client.PriceList] {
+	if f.IteratePriceListsFunc == nil {
+		panic(notImplemented("PriceLists", "IteratePriceLists"))
+	}
+	return f.IteratePriceListsFunc()
+}
+
+func (f *PriceLists) CreatePriceList(ctx context.Context, pl *client.PriceList) (*client.PriceList, error) {
+	if f.CreatePriceListFunc == nil {
+		panic(notImplemented("PriceLists", "CreatePriceList"))
+	}
+	return f.CreatePriceListFunc(ctx, pl)
+}
+
+func (f *PriceLists) GetPriceList(ctx context.Context, code string) (*client.PriceList, error) {
+	if f.GetPriceListFunc == nil {
+		panic(notImplemented("PriceLists", "GetPriceList"))
+	}
+	return f.GetPriceListFunc(ctx, code)
+}
+
+func (f *PriceLists) UpdatePriceList(ctx context.Context, code string, pl *client.PriceList) (*client.PriceList, error) {
+	if f.UpdatePriceListFunc == nil {
+		panic(notImplemented("PriceLists", "UpdatePriceList"))
+	}
+	return f.UpdatePriceListFunc(ctx, code, pl)
+}
+
+// Prices fakes client.PricesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Prices struct {
+	GetPriceForArticleFunc                   func(ctx context.Context, priceList, articleNumber string, fromQuantity int) (*client.Price, error)
+	UpdatePriceFunc                          func(ctx context.Context, priceList, articleNumber string, fromQuantity int, p *client.Price) (*client.Price, error)
+	DeletePriceFunc                          func(ctx context.Context, priceList, articleNumber string, fromQuantity int) error
+	GetAllArticlesWithPricesInPriceListFunc  func(ctx context.Context, priceList, articleNumber string) ([]client.Price, error)
+	GetArticlesWithPricesInPriceListPageFunc func(ctx context.Context, priceList, articleNumber string, page *client.PageOptions) ([]client.Price, *client.MetaInformation, error)
+	IterateArticlesWithPricesInPriceListFunc func(priceList, articleNumber string) *client.Iterator[client.Price]
+	GetFirstPriceForArticleFunc              func(ctx context.Context, priceList, articleNumber string) (*client.Price, error)
+	UpdateFirstPriceForArticleFunc           func(ctx context.Context, priceList, articleNumber string, p *client.Price) (*client.Price, error)
+	CreatePriceFunc                          func(ctx context.Context, p *client.Price) (*client.Price, error)
+}
+
+func (f *Prices) GetPriceForArticle(ctx context.Context, priceList, articleNumber string, fromQuantity int) (*client.Price, error) {
+	if f.GetPriceForArticleFunc == nil {
+		panic(notImplemented("Prices", "GetPriceForArticle"))
+	}
+	return f.GetPriceForArticleFunc(ctx, priceList, articleNumber, fromQuantity)
+}
+
+func (f *Prices) UpdatePrice(ctx context.Context, priceList, articleNumber string, fromQuantity int, p *client.Price) (*client.Price, error) {
+	if f.UpdatePriceFunc == nil {
+		panic(notImplemented("Prices", "UpdatePrice"))
+	}
+	return f.UpdatePriceFunc(ctx, priceList, articleNumber, fromQuantity, p)
+}
+
+func (f *Prices) DeletePrice(ctx context.Context, priceList, articleNumber string, fromQuantity int) error {
+	if f.DeletePriceFunc == nil {
+		panic(notImplemented("Prices", "DeletePrice"))
+	}
+	return f.DeletePriceFunc(ctx, priceList, articleNumber, fromQuantity)
+}
+
+func (f *Prices) GetAllArticlesWithPricesInPriceList(ctx context.Context, priceList, articleNumber string) ([]client.Price, error) {
+	if f.GetAllArticlesWithPricesInPriceListFunc == nil {
+		panic(notImplemented("Prices", "GetAllArticlesWithPricesInPriceList"))
+	}
+	return f.GetAllArticlesWithPricesInPriceListFunc(ctx, priceList, articleNumber)
+}
+
+func (f *Prices) GetArticlesWithPricesInPriceListPage(ctx context.Context, priceList, articleNumber string, page *client.PageOptions) ([]client.Price, *client.MetaInformation, error) {
+	if f.GetArticlesWithPricesInPriceListPageFunc == nil {
+		panic(notImplemented("Prices", "GetArticlesWithPricesInPriceListPage"))
+	}
+	return f.GetArticlesWithPricesInPriceListPageFunc(ctx, priceList, articleNumber, page)
+}
+
+func (f *Prices) IterateArticlesWithPricesInPriceList(priceList, articleNumber string) *client.Iterator[client.Price] {
+	if f.IterateArticlesWithPricesInPriceListFunc == nil {
+		panic(notImplemented("Prices", "IterateArticlesWithPricesInPriceList"))
+	}
+	return f.IterateArticlesWithPricesInPriceListFunc(priceList, articleNumber)
+}
+
+func (f *Prices) GetFirstPriceForArticle(ctx context.Context, priceList, articleNumber string) (*client.Price, error) {
+	if f.GetFirstPriceForArticleFunc == nil {
+		panic(notImplemented("Prices", "GetFirstPriceForArticle"))
+	}
+	return f.GetFirstPriceForArticleFunc(ctx, priceList, articleNumber)
+}
+
+func (f *Prices) UpdateFirstPriceForArticle(ctx context.Context, priceList, articleNumber string, p *client.Price) (*client.Price, error) {
+	if f.UpdateFirstPriceForArticleFunc == nil {
+		panic(notImplemented("Prices", "UpdateFirstPriceForArticle"))
+	}
+	return f.UpdateFirstPriceForArticleFunc(ctx, priceList, articleNumber, p)
+}
+
+func (f *Prices) CreatePrice(ctx context.Context, p *client.Price) (*client.Price, error) {
+	if f.CreatePriceFunc == nil {
+		panic(notImplemented("Prices", "CreatePrice"))
+	}
+	return f.CreatePriceFunc(ctx, p)
+}
+
+// PrintTemplates fakes client.PrintTemplatesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type PrintTemplates struct {
+	GetAllPrintTemplatesFunc  func(ctx context.Context) ([]client.PrintTemplate, error)
+	GetPrintTemplatesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.PrintTemplate, *client.MetaInformation, error)
+	IteratePrintTemplatesFunc func() *client.Iterator[client.PrintTemplate]
+}
+
+func (f *PrintTemplates) GetAllPrintTemplates(ctx context.Context) ([]client.PrintTemplate, error) {
+	if f.GetAllPrintTemplatesFunc == nil {
+		panic(notImplemented("PrintTemplates", "GetAllPrintTemplates"))
+	}
+	return f.GetAllPrintTemplatesFunc(ctx)
+}
+
+func (f *PrintTemplates) GetPrintTemplatesPage(ctx context.Context, page *client.PageOptions) ([]client.PrintTemplate, *client.MetaInformation, error) {
+	if f.GetPrintTemplatesPageFunc == nil {
+		panic(notImplemented("PrintTemplates", "GetPrintTemplatesPage"))
+	}
+	return f.GetPrintTemplatesPageFunc(ctx, page)
+}
+
+func (f *PrintTemplates) IteratePrintTemplates() *client.Iterator[client.PrintTemplate] {
+	if f.IteratePrintTemplatesFunc == nil {
+		panic(notImplemented("PrintTemplates", "IteratePrintTemplates"))
+	}
+	return f.IteratePrintTemplatesFunc()
+}
+
+// Projects fakes client.ProjectsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Projects struct {
+	GetProjectFunc      func(ctx context.Context, projectNumber int) (*client.Project, error)
+	UpdateProjectFunc   func(ctx context.Context, projectNumber int, p *client.Project) (*client.Project, error)
+	RemoveProjectFunc   func(ctx context.Context, projectNumber int) error
+	GetAllProjectsFunc  func(ctx context.Context) ([]client.Project, error)
+	GetProjectsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.Project, *client.MetaInformation, error)
+	IterateProjectsFunc func() *client.Iterator[client.Project]
+	CreateProjectFunc   func(ctx context.Context, p *client.Project) (*client.Project, error)
+}
+
+func (f *Projects) GetProject(ctx context.Context, projectNumber int) (*client.Project, error) {
+	if f.GetProjectFunc == nil {
+		panic(notImplemented("Projects", "GetProject"))
+	}
+	return f.GetProjectFunc(ctx, projectNumber)
+}
+
+func (f *Projects) UpdateProject(ctx context.Context, projectNumber int, p *client.Project) (*client.Project, error) {
+	if f.UpdateProjectFunc == nil {
+		panic(notImplemented("Projects", "UpdateProject"))
+	}
+	return f.UpdateProjectFunc(ctx, projectNumber, p)
+}
+
+func (f *Projects) RemoveProject(ctx context.Context, projectNumber int) error {
+	if f.RemoveProjectFunc == nil {
+		panic(notImplemented("Projects", "RemoveProject"))
+	}
+	return f.RemoveProjectFunc(ctx, projectNumber)
+}
+
+func (f *Projects) GetAllProjects(ctx context.Context) ([]client.Project, error) {
+	if f.GetAllProjectsFunc == nil {
+		panic(notImplemented("Projects", "GetAllProjects"))
+	}
+	return f.GetAllProjectsFunc(ctx)
+}
+
+func (f *Projects) GetProjectsPage(ctx context.Context, page *client.PageOptions) ([]client.Project, *client.MetaInformation, error) {
+	if f.GetProjectsPageFunc == nil {
+		panic(notImplemented("Projects", "GetProjectsPage"))
+	}
+	return f.GetProjectsPageFunc(ctx, page)
+}
+
+func (f *Projects) IterateProjects() *client.Iterator[client.Project] {
+	if f.IterateProjectsFunc == nil {
+		panic(notImplemented("Projects", "IterateProjects"))
+	}
+	return f.IterateProjectsFunc()
+}
+
+func (f *Projects) CreateProject(ctx context.Context, p *client.Project) (*client.Project, error) {
+	if f.CreateProjectFunc == nil {
+		panic(notImplemented("Projects", "CreateProject"))
+	}
+	return f.CreateProjectFunc(ctx, p)
+}
+
+// SIE fakes client.SIEService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type SIE struct {
+	GetSIEFileFunc func(ctx context.Context, typ string, filter *client.FinancialYearFilter) (*client.Download, error)
+}
+
+func (f *SIE) GetSIEFile(ctx context.Context, typ string, filter *client.FinancialYearFilter) (*client.Download, error) {
+	if f.GetSIEFileFunc == nil {
+		panic(notImplemented("SIE", "GetSIEFile"))
+	}
+	return f.GetSIEFileFunc(ctx, typ, filter)
+}
+
+// SalaryTransactions fakes client.SalaryTransactionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type SalaryTransactions struct {
+	GetAllSalaryTransactionsForAllEmployeesFunc  func(ctx context.Context, filter *client.GetAllSalaryTransactionsForAllEmployeesFilter) ([]client.SalaryTransaction, error)
+	GetSalaryTransactionsForAllEmployeesPageFunc func(ctx context.Context, filter *client.GetAllSalaryTransactionsForAllEmployeesFilter, page *client.PageOptions) ([]client.SalaryTransaction, *client.MetaInformation, error)
+	IterateSalaryTransactionsForAllEmployeesFunc func(filter *client.GetAllSalaryTransactionsForAllEmployeesFilter) *client.Iterator[client.SalaryTransaction]
+	CreateSalaryTransactionsForEmployeeFunc      func(ctx context.Context, st *client.SalaryTransaction) (*client.SalaryTransaction, error)
+	GetSalaryTransactionForEmployeesFunc         func(ctx context.Context, salaryRow int) (*client.SalaryTransaction, error)
+	UpdateSalaryTransactionForEmployeeFunc       func(ctx context.Context, salaryRow int, st *client.SalaryTransaction) (*client.SalaryTransaction, error)
+	DeleteSalaryTransactionForEmployeeFunc       func(ctx context.Context, salaryRow int) (*client.SalaryTransaction, error)
+}
+
+func (f *SalaryTransactions) GetAllSalaryTransactionsForAllEmployees(ctx context.Context, filter *client.GetAllSalaryTransactionsForAllEmployeesFilter) ([]client.SalaryTransaction, error) {
+	if f.GetAllSalaryTransactionsForAllEmployeesFunc == nil {
+		panic(notImplemented("SalaryTransactions", "GetAllSalaryTransactionsForAllEmployees"))
+	}
+	return f.GetAllSalaryTransactionsForAllEmployeesFunc(ctx, filter)
+}
+
+func (f *SalaryTransactions) GetSalaryTransactionsForAllEmployeesPage(ctx context.Context, filter *client.GetAllSalaryTransactionsForAllEmployeesFilter, page *client.PageOptions) ([]client.SalaryTransaction, *client.MetaInformation, error) {
+	if f.GetSalaryTransactionsForAllEmployeesPageFunc == nil {
+		panic(notImplemented("SalaryTransactions", "GetSalaryTransactionsForAllEmployeesPage"))
+	}
+	return f.GetSalaryTransactionsForAllEmployeesPageFunc(ctx, filter, page)
+}
+
+func (f *SalaryTransactions) IterateSalaryTransactionsForAllEmployees(filter *client.GetAllSalaryTransactionsForAllEmployeesFilter) *client.Iterator[client.SalaryTransaction] {
+	if f.IterateSalaryTransactionsForAllEmployeesFunc == nil {
+		panic(notImplemented("SalaryTransactions", "IterateSalaryTransactionsForAllEmployees"))
+	}
+	return f.IterateSalaryTransactionsForAllEmployeesFunc(filter)
+}
+
+func (f *SalaryTransactions) CreateSalaryTransactionsForEmployee(ctx context.Context, st *client.SalaryTransaction) (*client.SalaryTransaction, error) {
+	if f.CreateSalaryTransactionsForEmployeeFunc == nil {
+		panic(notImplemented("SalaryTransactions", "CreateSalaryTransactionsForEmployee"))
+	}
+	return f.CreateSalaryTransactionsForEmployeeFunc(ctx, st)
+}
+
+func (f *SalaryTransactions) GetSalaryTransactionForEmployees(ctx context.Context, salaryRow int) (*client.SalaryTransaction, error) {
+	if f.GetSalaryTransactionForEmployeesFunc == nil {
+		panic(notImplemented("SalaryTransactions", "GetSalaryTransactionForEmployees"))
+	}
+	return f.GetSalaryTransactionForEmployeesFunc(ctx, salaryRow)
+}
+
+func (f *SalaryTransactions) UpdateSalaryTransactionForEmployee(ctx context.Context, salaryRow int, st *client.SalaryTransaction) (*client.SalaryTransaction, error) {
+	if f.UpdateSalaryTransactionForEmployeeFunc == nil {
+		panic(notImplemented("SalaryTransactions", "UpdateSalaryTransactionForEmployee"))
+	}
+	return f.UpdateSalaryTransactionForEmployeeFunc(ctx, salaryRow, st)
+}
+
+func (f *SalaryTransactions) DeleteSalaryTransactionForEmployee(ctx context.Context, salaryRow int) (*client.SalaryTransaction, error) {
+	if f.DeleteSalaryTransactionForEmployeeFunc == nil {
+		panic(notImplemented("SalaryTransactions", "DeleteSalaryTransactionForEmployee"))
+	}
+	return f.DeleteSalaryTransactionForEmployeeFunc(ctx, salaryRow)
+}
+
+// ScheduleTimes fakes client.ScheduleTimesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type ScheduleTimes struct {
+	GetScheduleTimeFunc    func(ctx context.Context, employeeID string, date client.Date) (*client.ScheduleTime, error)
+	UpdateScheduleTimeFunc func(ctx context.Context, employeeID string, date client.Date, st *client.ScheduleTime) (*client.ScheduleTime, error)
+	ResetScheduleTimeFunc  func(ctx context.Context, employeeID string, date client.Date) (*client.ScheduleTime, error)
+}
+
+func (f *ScheduleTimes) GetScheduleTime(ctx context.Context, employeeID string, date client.Date) (*client.ScheduleTime, error) {
+	if f.GetScheduleTimeFunc == nil {
+		panic(notImplemented("ScheduleTimes", "GetScheduleTime"))
+	}
+	return f.GetScheduleTimeFunc(ctx, employeeID, date)
+}
+
+func (f *ScheduleTimes) UpdateScheduleTime(ctx context.Context, employeeID string, date client.Date, st *client.ScheduleTime) (*client.ScheduleTime, error) {
+	if f.UpdateScheduleTimeFunc == nil {
+		panic(notImplemented("ScheduleTimes", "UpdateScheduleTime"))
+	}
+	return f.UpdateScheduleTimeFunc(ctx, employeeID, date, st)
+}
+
+func (f *ScheduleTimes) ResetScheduleTime(ctx context.Context, employeeID string, date client.Date) (*client.ScheduleTime, error) {
+	if f.ResetScheduleTimeFunc == nil {
+		panic(notImplemented("ScheduleTimes", "ResetScheduleTime"))
+	}
+	return f.ResetScheduleTimeFunc(ctx, employeeID, date)
+}
+
+// SupplierInvoiceAccruals fakes client.SupplierInvoiceAccrualsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type SupplierInvoiceAccruals struct {
+	GetAllSupplierInvoiceAccrualsFunc  func(ctx context.Context) ([]client.SupplierInvoiceAccrual, error)
+	GetSupplierInvoiceAccrualsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.SupplierInvoiceAccrual, *client.MetaInformation, error)
+	IterateSupplierInvoiceAccrualsFunc func() *client.Iterator[client.SupplierInvoiceAccrual]
+	CreateSupplierInvoiceAccrualsFunc  func(ctx context.Context, sia *client.SupplierInvoiceAccrual) (*client.SupplierInvoiceAccrual, error)
+	GetSupplierInvoiceAccrualsFunc     func(ctx context.Context, supplierInvoiceNumber int) (*client.SupplierInvoiceAccrual, error)
+	UpdateSupplierInvoiceAccrualsFunc  func(ctx context.Context, supplierInvoiceNumber int, sia *client.SupplierInvoiceAccrual) (*client.SupplierInvoiceAccrual, error)
+	DeleteSupplierInvoiceAccrualsFunc  func(ctx context.Context, supplierInvoiceNumber int) error
+}
+
+func (f *SupplierInvoiceAccruals) GetAllSupplierInvoiceAccruals(ctx context.Context) ([]client.SupplierInvoiceAccrual, error) {
+	if f.GetAllSupplierInvoiceAccrualsFunc == nil {
+		panic(notImplemented("SupplierInvoiceAccruals", "GetAllSupplierInvoiceAccruals"))
+	}
+	return f.GetAllSupplierInvoiceAccrualsFunc(ctx)
+}
+
+func (f *SupplierInvoiceAccruals) GetSupplierInvoiceAccrualsPage(ctx context.Context, page *client.PageOptions) ([]client.SupplierInvoiceAccrual, *client.MetaInformation, error) {
+	if f.GetSupplierInvoiceAccrualsPageFunc == nil {
+		panic(notImplemented("SupplierInvoiceAccruals", "GetSupplierInvoiceAccrualsPage"))
+	}
+	return f.GetSupplierInvoiceAccrualsPageFunc(ctx, page)
+}
+
+func (f *SupplierInvoiceAccruals) IterateSupplierInvoiceAccruals() *client.Iterator[client.SupplierInvoiceAccrual] {
+	if f.IterateSupplierInvoiceAccrualsFunc == nil {
+		panic(notImplemented("SupplierInvoiceAccruals", "IterateSupplierInvoiceAccruals"))
+	}
+	return f.IterateSupplierInvoiceAccrualsFunc()
+}
+
+func (f *SupplierInvoiceAccruals) CreateSupplierInvoiceAccruals(ctx context.Context, sia *client.SupplierInvoiceAccrual) (*client.SupplierInvoiceAccrual, error) {
+	if f.CreateSupplierInvoiceAccrualsFunc == nil {
+		panic(notImplemented("SupplierInvoiceAccruals", "CreateSupplierInvoiceAccruals"))
+	}
+	return f.CreateSupplierInvoiceAccrualsFunc(ctx, sia)
+}
+
+func (f *SupplierInvoiceAccruals) GetSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int) (*client.SupplierInvoiceAccrual, error) {
+	if f.GetSupplierInvoiceAccrualsFunc == nil {
+		panic(notImplemented("SupplierInvoiceAccruals", "GetSupplierInvoiceAccruals"))
+	}
+	return f.GetSupplierInvoiceAccrualsFunc(ctx, supplierInvoiceNumber)
+}
+
+func (f *SupplierInvoiceAccruals) UpdateSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int, sia *client.SupplierInvoiceAccrual) (*client.SupplierInvoiceAccrual, error) {
+	if f.UpdateSupplierInvoiceAccrualsFunc == nil {
+		panic(notImplemented("SupplierInvoiceAccruals", "UpdateSupplierInvoiceAccruals"))
+	}
+	return f.UpdateSupplierInvoiceAccrualsFunc(ctx, supplierInvoiceNumber, sia)
+}
+
+func (f *SupplierInvoiceAccruals) DeleteSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int) error {
+	if f.DeleteSupplierInvoiceAccrualsFunc == nil {
+		panic(notImplemented("SupplierInvoiceAccruals", "DeleteSupplierInvoiceAccruals"))
+	}
+	return f.DeleteSupplierInvoiceAccrualsFunc(ctx, supplierInvoiceNumber)
+}
+
+// SupplierInvoiceExternalUrlConnections fakes client.SupplierInvoiceExternalUrlConnectionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type SupplierInvoiceExternalUrlConnections struct {
+	GetSupplierInvoiceExternalUrlConnectionFunc    func(ctx context.Context, id int) (*client.SupplierInvoiceExternalURLConnection, error)
+	UpdateSupplierInvoiceExternalUrlConnectionFunc func(ctx context.Context, id int, req *client.UpdateSupplierInvoiceExternalUrlConnectionReq) (*client.SupplierInvoiceExternalURLConnection, error)
+	DeleteSupplierInvoiceExternalUrlConnectionFunc func(ctx context.Context, id int) error
+	CreateSupplierInvoiceExternalUrlConnectionFunc func(ctx context.Context, req *client.CreateSupplierInvoiceExternalUrlConnectionReq) (*client.SupplierInvoiceExternalURLConnection, error)
+}
+
+func (f *SupplierInvoiceExternalUrlConnections) GetSupplierInvoiceExternalUrlConnection(ctx context.Context, id int) (*client.SupplierInvoiceExternalURLConnection, error) {
+	if f.GetSupplierInvoiceExternalUrlConnectionFunc == nil {
+		panic(notImplemented("SupplierInvoiceExternalUrlConnections", "GetSupplierInvoiceExternalUrlConnection"))
+	}
+	return f.GetSupplierInvoiceExternalUrlConnectionFunc(ctx, id)
+}
+
+func (f *SupplierInvoiceExternalUrlConnections) UpdateSupplierInvoiceExternalUrlConnection(ctx context.Context, id int, req *client.UpdateSupplierInvoiceExternalUrlConnectionReq) (*client.SupplierInvoiceExternalURLConnection, error) {
+	if f.UpdateSupplierInvoiceExternalUrlConnectionFunc == nil {
+		panic(notImplemented("SupplierInvoiceExternalUrlConnections", "UpdateSupplierInvoiceExternalUrlConnection"))
+	}
+	return f.UpdateSupplierInvoiceExternalUrlConnectionFunc(ctx, id, req)
+}
+
+func (f *SupplierInvoiceExternalUrlConnections) DeleteSupplierInvoiceExternalUrlConnection(ctx context.Context, id int) error {
+	if f.DeleteSupplierInvoiceExternalUrlConnectionFunc == nil {
+		panic(notImplemented("SupplierInvoiceExternalUrlConnections", "DeleteSupplierInvoiceExternalUrlConnection"))
+	}
+	return f.DeleteSupplierInvoiceExternalUrlConnectionFunc(ctx, id)
+}
+
+func (f *SupplierInvoiceExternalUrlConnections) CreateSupplierInvoiceExternalUrlConnection(ctx context.Context, req *client.CreateSupplierInvoiceExternalUrlConnectionReq) (*client.SupplierInvoiceExternalURLConnection, error) {
+	if f.CreateSupplierInvoiceExternalUrlConnectionFunc == nil {
+		panic(notImplemented("SupplierInvoiceExternalUrlConnections", "CreateSupplierInvoiceExternalUrlConnection"))
+	}
+	return f.CreateSupplierInvoiceExternalUrlConnectionFunc(ctx, req)
+}
+
+// SupplierInvoiceFileConnections fakes client.SupplierInvoiceFileConnectionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type SupplierInvoiceFileConnections struct {
+	GetAllSupplierInvoiceFileConnectionsFunc  func(ctx context.Context) ([]client.SupplierInvoiceFileConnection, error)
+	GetSupplierInvoiceFileConnectionsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.SupplierInvoiceFileConnection, *client.MetaInformation, error)
+	IterateSupplierInvoiceFileConnectionsFunc func() *client.Iterator[client.SupplierInvoiceFileConnection]
+	CreateSupplierInvoiceFileConnectionFunc   func(ctx context.Context, sifc *client.SupplierInvoiceFileConnection) (*client.SupplierInvoiceFileConnection, error)
+	GetSupplierInvoiceFileConnectionFunc      func(ctx context.Context, fileID string) (*client.SupplierInvoiceFileConnection, error)
+	RemoveSupplierInvoiceFileConnectionsFunc  func(ctx context.Context, fieldID string) error
+}
+
+func (f *SupplierInvoiceFileConnections) GetAllSupplierInvoiceFileConnections(ctx context.Context) ([]client.SupplierInvoiceFileConnection, error) {
+	if f.GetAllSupplierInvoiceFileConnectionsFunc == nil {
+		panic(notImplemented("SupplierInvoiceFileConnections", "GetAllSupplierInvoiceFileConnections"))
+	}
+	return f.GetAllSupplierInvoiceFileConnectionsFunc(ctx)
+}
+
+func (f *SupplierInvoiceFileConnections) GetSupplierInvoiceFileConnectionsPage(ctx context.Context, page *client.PageOptions) ([]client.SupplierInvoiceFileConnection, *client.MetaInformation, error) {
+	if f.GetSupplierInvoiceFileConnectionsPageFunc == nil {
+		panic(notImplemented("SupplierInvoiceFileConnections", "GetSupplierInvoiceFileConnectionsPage"))
+	}
+	return f.GetSupplierInvoiceFileConnectionsPageFunc(ctx, page)
+}
+
+func (f *SupplierInvoiceFileConnections) IterateSupplierInvoiceFileConnections() *client.Iterator[client.SupplierInvoiceFileConnection] {
+	if f.IterateSupplierInvoiceFileConnectionsFunc == nil {
+		panic(notImplemented("SupplierInvoiceFileConnections", "IterateSupplierInvoiceFileConnections"))
+	}
+	return f.IterateSupplierInvoiceFileConnectionsFunc()
+}
+
+func (f *SupplierInvoiceFileConnections) CreateSupplierInvoiceFileConnection(ctx context.Context, sifc *client.SupplierInvoiceFileConnection) (*client.SupplierInvoiceFileConnection, error) {
+	if f.CreateSupplierInvoiceFileConnectionFunc == nil {
+		panic(notImplemented("SupplierInvoiceFileConnections", "CreateSupplierInvoiceFileConnection"))
+	}
+	return f.CreateSupplierInvoiceFileConnectionFunc(ctx, sifc)
+}
+
+func (f *SupplierInvoiceFileConnections) GetSupplierInvoiceFileConnection(ctx context.Context, fileID string) (*client.SupplierInvoiceFileConnection, error) {
+	if f.GetSupplierInvoiceFileConnectionFunc == nil {
+		panic(notImplemented("SupplierInvoiceFileConnections", "GetSupplierInvoiceFileConnection"))
+	}
+	return f.GetSupplierInvoiceFileConnectionFunc(ctx, fileID)
+}
+
+func (f *SupplierInvoiceFileConnections) RemoveSupplierInvoiceFileConnections(ctx context.Context, fieldID string) error {
+	if f.RemoveSupplierInvoiceFileConnectionsFunc == nil {
+		panic(notImplemented("SupplierInvoiceFileConnections", "RemoveSupplierInvoiceFileConnections"))
+	}
+	return f.RemoveSupplierInvoiceFileConnectionsFunc(ctx, fieldID)
+}
+
+// SupplierInvoicePayments fakes client.SupplierInvoicePaymentsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type SupplierInvoicePayments struct {
+	GetAllSupplierInvoicePaymentsFunc  func(ctx context.Context) (*client.GetAllSupplierInvoicePaymentsResp, error)
+	GetSupplierInvoicePaymentsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.SupplierInvoicePayment, *client.MetaInformation, error)
+	IterateSupplierInvoicePaymentsFunc func() *client.Iterator[client.SupplierInvoicePayment]
+	CreateSupplierInvoicePaymentFunc   func(ctx context.Context, req *client.CreateSupplierInvoicePaymentReq) (*client.CreateSupplierInvoicePaymentResp, error)
+	GetSupplierInvoicePaymentFunc      func(ctx context.Context, number int) (*client.GetSupplierInvoicePaymentResp, error)
+	UpdateSupplierInvoicePaymentFunc   func(ctx context.Context, number int, req *client.UpdateSupplierInvoicePaymentReq) (*client.UpdateSupplierInvoicePaymentResp, error)
+	RemoveSupplierInvoicePaymentFunc   func(ctx context.Context, number int) error
+	BookKeepSupplierInvoicePaymentFunc func(ctx context.Context, number int) (*client.BookKeepSupplierInvoicePaymentResp, error)
+}
+
+func (f *SupplierInvoicePayments) GetAllSupplierInvoicePayments(ctx context.Context) (*client.GetAllSupplierInvoicePaymentsResp, error) {
+	if f.GetAllSupplierInvoicePaymentsFunc == nil {
+		panic(notImplemented("SupplierInvoicePayments", "GetAllSupplierInvoicePayments"))
+	}
+	return f.GetAllSupplierInvoicePaymentsFunc(ctx)
+}
+
+func (f *SupplierInvoicePayments) GetSupplierInvoicePaymentsPage(ctx context.Context, page *client.PageOptions) ([]client.SupplierInvoicePayment, *client.MetaInformation, error) {
+	if f.GetSupplierInvoicePaymentsPageFunc == nil {
+		panic(notImplemented("SupplierInvoicePayments", "GetSupplierInvoicePaymentsPage"))
+	}
+	return f.GetSupplierInvoicePaymentsPageFunc(ctx, page)
+}
+
+func (f *SupplierInvoicePayments) IterateSupplierInvoicePayments() *client.Iterator[client.SupplierInvoicePayment] {
+	if f.IterateSupplierInvoicePaymentsFunc == nil {
+		panic(notImplemented("SupplierInvoicePayments", "IterateSupplierInvoicePayments"))
+	}
+	return f.IterateSupplierInvoicePaymentsFunc()
+}
+
+func (f *SupplierInvoicePayments) CreateSupplierInvoicePayment(ctx context.Context, req *client.CreateSupplierInvoicePaymentReq) (*client.CreateSupplierInvoicePaymentResp, error) {
+	if f.CreateSupplierInvoicePaymentFunc == nil {
+		panic(notImplemented("SupplierInvoicePayments", "CreateSupplierInvoicePayment"))
+	}
+	return f.CreateSupplierInvoicePaymentFunc(ctx, req)
+}
+
+func (f *SupplierInvoicePayments) GetSupplierInvoicePayment(ctx context.Context, number int) (*client.GetSupplierInvoicePaymentResp, error) {
+	if f.GetSupplierInvoicePaymentFunc == nil {
+		panic(notImplemented("SupplierInvoicePayments", "GetSupplierInvoicePayment"))
+	}
+	return f.GetSupplierInvoicePaymentFunc(ctx, number)
+}
+
+func (f *SupplierInvoicePayments) UpdateSupplierInvoicePayment(ctx context.Context, number int, req *client.UpdateSupplierInvoicePaymentReq) (*client.UpdateSupplierInvoicePaymentResp, error) {
+	if f.UpdateSupplierInvoicePaymentFunc == nil {
+		panic(notImplemented("SupplierInvoicePayments", "UpdateSupplierInvoicePayment"))
+	}
+	return f.UpdateSupplierInvoicePaymentFunc(ctx, number, req)
+}
+
+func (f *SupplierInvoicePayments) RemoveSupplierInvoicePayment(ctx context.Context, number int) error {
+	if f.RemoveSupplierInvoicePaymentFunc == nil {
+		panic(notImplemented("SupplierInvoicePayments", "RemoveSupplierInvoicePayment"))
+	}
+	return f.RemoveSupplierInvoicePaymentFunc(ctx, number)
+}
+
+func (f *SupplierInvoicePayments) BookKeepSupplierInvoicePayment(ctx context.Context, number int) (*client.BookKeepSupplierInvoicePaymentResp, error) {
+	if f.BookKeepSupplierInvoicePaymentFunc == nil {
+		panic(notImplemented("SupplierInvoicePayments", "BookKeepSupplierInvoicePayment"))
+	}
+	return f.BookKeepSupplierInvoicePaymentFunc(ctx, number)
+}
+
+// SupplierInvoices fakes client.SupplierInvoicesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type SupplierInvoices struct {
+	GetAllSupplierInvoicesFunc          func(ctx context.Context, filter *client.GetAllSupplierInvoicesFilter) ([]client.SupplierInvoice, error)
+	GetSupplierInvoicesPageFunc         func(ctx context.Context, filter *client.GetAllSupplierInvoicesFilter, page *client.PageOptions) ([]client.SupplierInvoice, *client.MetaInformation, error)
+	IterateSupplierInvoicesFunc         func(filter *client.GetAllSupplierInvoicesFilter) *client.Iterator[client.SupplierInvoice]
+	CreateSupplierInvoiceFunc           func(ctx context.Context, si *client.SupplierInvoice) (*client.SupplierInvoice, error)
+	GetSupplierInvoiceFunc              func(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error)
+	UpdateSupplierInvoiceFunc           func(ctx context.Context, givenNumber int, si *client.SupplierInvoice) (*client.SupplierInvoice, error)
+	BookKeepSupplierInvoiceFunc         func(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error)
+	CancelSupplierInvoiceFunc           func(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error)
+	CreditSupplierInvoicePaymentFunc    func(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error)
+	ApprovalSupplierInvoicePaymentFunc  func(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error)
+	ApprovalSupplierInvoiceBookKeepFunc func(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error)
+}
+
+func (f *SupplierInvoices) GetAllSupplierInvoices(ctx context.Context, filter *client.GetAllSupplierInvoicesFilter) ([]client.SupplierInvoice, error) {
+	if f.GetAllSupplierInvoicesFunc == nil {
+		panic(notImplemented("SupplierInvoices", "GetAllSupplierInvoices"))
+	}
+	return f.GetAllSupplierInvoicesFunc(ctx, filter)
+}
+
+func (f *SupplierInvoices) GetSupplierInvoicesPage(ctx context.Context, filter *client.GetAllSupplierInvoicesFilter, page *client.PageOptions) ([]client.SupplierInvoice, *client.MetaInformation, error) {
+	if f.GetSupplierInvoicesPageFunc == nil {
+		panic(notImplemented("SupplierInvoices", "GetSupplierInvoicesPage"))
+	}
+	return f.GetSupplierInvoicesPageFunc(ctx, filter, page)
+}
+
+func (f *SupplierInvoices) IterateSupplierInvoices(filter *client.GetAllSupplierInvoicesFilter) *client.Iterator[client.SupplierInvoice] {
+	if f.IterateSupplierInvoicesFunc == nil {
+		panic(notImplemented("SupplierInvoices", "IterateSupplierInvoices"))
+	}
+	return f.IterateSupplierInvoicesFunc(filter)
+}
+
+func (f *SupplierInvoices) CreateSupplierInvoice(ctx context.Context, si *client.SupplierInvoice) (*client.SupplierInvoice, error) {
+	if f.CreateSupplierInvoiceFunc == nil {
+		panic(notImplemented("SupplierInvoices", "CreateSupplierInvoice"))
+	}
+	return f.CreateSupplierInvoiceFunc(ctx, si)
+}
+
+func (f *SupplierInvoices) GetSupplierInvoice(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error) {
+	if f.GetSupplierInvoiceFunc == nil {
+		panic(notImplemented("SupplierInvoices", "GetSupplierInvoice"))
+	}
+	return f.GetSupplierInvoiceFunc(ctx, givenNumber)
+}
+
+func (f *SupplierInvoices) UpdateSupplierInvoice(ctx context.Context, givenNumber int, si *client.SupplierInvoice) (*client.SupplierInvoice, error) {
+	if f.UpdateSupplierInvoiceFunc == nil {
+		panic(notImplemented("SupplierInvoices", "UpdateSupplierInvoice"))
+	}
+	return f.UpdateSupplierInvoiceFunc(ctx, givenNumber, si)
+}
+
+func (f *SupplierInvoices) BookKeepSupplierInvoice(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error) {
+	if f.BookKeepSupplierInvoiceFunc == nil {
+		panic(notImplemented("SupplierInvoices", "BookKeepSupplierInvoice"))
+	}
+	return f.BookKeepSupplierInvoiceFunc(ctx, givenNumber)
+}
+
+func (f *SupplierInvoices) CancelSupplierInvoice(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error) {
+	if f.CancelSupplierInvoiceFunc == nil {
+		panic(notImplemented("SupplierInvoices", "CancelSupplierInvoice"))
+	}
+	return f.CancelSupplierInvoiceFunc(ctx, givenNumber)
+}
+
+func (f *SupplierInvoices) CreditSupplierInvoicePayment(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error) {
+	if f.CreditSupplierInvoicePaymentFunc == nil {
+		panic(notImplemented("SupplierInvoices", "CreditSupplierInvoicePayment"))
+	}
+	return f.CreditSupplierInvoicePaymentFunc(ctx, givenNumber)
+}
+
+func (f *SupplierInvoices) ApprovalSupplierInvoicePayment(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error) {
+	if f.ApprovalSupplierInvoicePaymentFunc == nil {
+		panic(notImplemented("SupplierInvoices", "ApprovalSupplierInvoicePayment"))
+	}
+	return f.ApprovalSupplierInvoicePaymentFunc(ctx, givenNumber)
+}
+
+func (f *SupplierInvoices) ApprovalSupplierInvoiceBookKeep(ctx context.Context, givenNumber int) (*client.SupplierInvoice, error) {
+	if f.ApprovalSupplierInvoiceBookKeepFunc == nil {
+		panic(notImplemented("SupplierInvoices", "ApprovalSupplierInvoiceBookKeep"))
+	}
+	return f.ApprovalSupplierInvoiceBookKeepFunc(ctx, givenNumber)
+}
+
+// Suppliers fakes client.SuppliersService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Suppliers struct {
+	GetAllSuppliersFunc  func(ctx context.Context) ([]client.Supplier, error)
+	GetSuppliersPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.Supplier, *client.MetaInformation, error)
+	IterateSuppliersFunc func() *client.Iterator[client.Supplier]
+	CreateSupplierFunc   func(ctx context.Context, s *client.Supplier) (*client.Supplier, error)
+	GetSupplierFunc      func(ctx context.Context, supplierNumber string) (*client.Supplier, error)
+	UpdateSupplierFunc   func(ctx context.Context, supplierNumber string, s *client.SupplierUpdate) (*client.Supplier, error)
+	PatchSupplierFunc    func(ctx context.Context, original, edited *client.Supplier) (*client.Supplier, error)
+}
+
+func (f *Suppliers) GetAllSuppliers(ctx context.Context) ([]client.Supplier, error) {
+	if f.GetAllSuppliersFunc == nil {
+		panic(notImplemented("Suppliers", "GetAllSuppliers"))
+	}
+	return f.GetAllSuppliersFunc(ctx)
+}
+
+func (f *Suppliers) GetSuppliersPage(ctx context.Context, page *client.PageOptions) ([]client.Supplier, *client.MetaInformation, error) {
+	if f.GetSuppliersPageFunc == nil {
+		panic(notImplemented("Suppliers", "GetSuppliersPage"))
+	}
+	return f.GetSuppliersPageFunc(ctx, page)
+}
+
+func (f *Suppliers) IterateSuppliers() *client.Iterator[client.Supplier] {
+	if f.IterateSuppliersFunc == nil {
+		panic(notImplemented("Suppliers", "IterateSuppliers"))
+	}
+	return f.IterateSuppliersFunc()
+}
+
+func (f *Suppliers) CreateSupplier(ctx context.Context, s *client.Supplier) (*client.Supplier, error) {
+	if f.CreateSupplierFunc == nil {
+		panic(notImplemented("Suppliers", "CreateSupplier"))
+	}
+	return f.CreateSupplierFunc(ctx, s)
+}
+
+func (f *Suppliers) GetSupplier(ctx context.Context, supplierNumber string) (*client.Supplier, error) {
+	if f.GetSupplierFunc == nil {
+		panic(notImplemented("Suppliers", "GetSupplier"))
+	}
+	return f.GetSupplierFunc(ctx, supplierNumber)
+}
+
+func (f *Suppliers) UpdateSupplier(ctx context.Context, supplierNumber string, s *client.SupplierUpdate) (*client.Supplier, error) {
+	if f.UpdateSupplierFunc == nil {
+		panic(notImplemented("Suppliers", "UpdateSupplier"))
+	}
+	return f.UpdateSupplierFunc(ctx, supplierNumber, s)
+}
+
+func (f *Suppliers) PatchSupplier(ctx context.Context, original, edited *client.Supplier) (*client.Supplier, error) {
+	if f.PatchSupplierFunc == nil {
+		panic(notImplemented("Suppliers", "PatchSupplier"))
+	}
+	return f.PatchSupplierFunc(ctx, original, edited)
+}
+
+// TaxReductions fakes client.TaxReductionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type TaxReductions struct {
+	GetAllTaxReductionsFunc  func(ctx context.Context, filter *client.GetAllTaxReductionsFilter) ([]client.TaxReduction, error)
+	GetTaxReductionsPageFunc func(ctx context.Context, filter *client.GetAllTaxReductionsFilter, page *client.PageOptions) ([]client.TaxReduction, *client.MetaInformation, error)
+	IterateTaxReductionsFunc func(filter *client.GetAllTaxReductionsFilter) *client.Iterator[client.TaxReduction]
+	CreateTaxReductionFunc   func(ctx context.Context, tr *client.TaxReduction) (*client.TaxReduction, error)
+	GetTaxReductionFunc      func(ctx context.Context, id int) (*client.TaxReduction, error)
+	UpdateTaxReductionFunc   func(ctx context.Context, id int, tr *client.TaxReduction) (*client.TaxReduction, error)
+	RemoveTaxReductionFunc   func(ctx context.Context, id int) error
+}
+
+func (f *TaxReductions) GetAllTaxReductions(ctx context.Context, filter *client.GetAllTaxReductionsFilter) ([]client.TaxReduction, error) {
+	if f.GetAllTaxReductionsFunc == nil {
+		panic(notImplemented("TaxReductions", "GetAllTaxReductions"))
+	}
+	return f.GetAllTaxReductionsFunc(ctx, filter)
+}
+
+func (f *TaxReductions) GetTaxReductionsPage(ctx context.Context, filter *client.GetAllTaxReductionsFilter, page *client.PageOptions) ([]client.TaxReduction, *client.MetaInformation, error) {
+	if f.GetTaxReductionsPageFunc == nil {
+		panic(notImplemented("TaxReductions", "GetTaxReductionsPage"))
+	}
+	return f.GetTaxReductionsPageFunc(ctx, filter, page)
+}
+
+func (f *TaxReductions) IterateTaxReductions(filter *client.GetAllTaxReductionsFilter) *client.Iterator[client.TaxReduction] {
+	if f.IterateTaxReductionsFunc == nil {
+		panic(notImplemented("TaxReductions", "IterateTaxReductions"))
+	}
+	return f.IterateTaxReductionsFunc(filter)
+}
+
+func (f *TaxReductions) CreateTaxReduction(ctx context.Context, tr *client.TaxReduction) (*client.TaxReduction, error) {
+	if f.CreateTaxReductionFunc == nil {
+		panic(notImplemented("TaxReductions", "CreateTaxReduction"))
+	}
+	return f.CreateTaxReductionFunc(ctx, tr)
+}
+
+func (f *TaxReductions) GetTaxReduction(ctx context.Context, id int) (*client.TaxReduction, error) {
+	if f.GetTaxReductionFunc == nil {
+		panic(notImplemented("TaxReductions", "GetTaxReduction"))
+	}
+	return f.GetTaxReductionFunc(ctx, id)
+}
+
+func (f *TaxReductions) UpdateTaxReduction(ctx context.Context, id int, tr *client.TaxReduction) (*client.TaxReduction, error) {
+	if f.UpdateTaxReductionFunc == nil {
+		panic(notImplemented("TaxReductions", "UpdateTaxReduction"))
+	}
+	return f.UpdateTaxReductionFunc(ctx, id, tr)
+}
+
+func (f *TaxReductions) RemoveTaxReduction(ctx context.Context, id int) error {
+	if f.RemoveTaxReductionFunc == nil {
+		panic(notImplemented("TaxReductions", "RemoveTaxReduction"))
+	}
+	return f.RemoveTaxReductionFunc(ctx, id)
+}
+
+// TermsOfDeliveries fakes client.TermsOfDeliveriesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type TermsOfDeliveries struct {
+	GetAllTermsOfDeliveriesFunc  func(ctx context.Context) ([]client.TermsOfDelivery, error)
+	GetTermsOfDeliveriesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.TermsOfDelivery, *client.MetaInformation, error)
+	IterateTermsOfDeliveriesFunc func() *client.Iterator[client.TermsOfDelivery]
+	CreateTermsOfDeliveriesFunc  func(ctx context.Context, tod *client.TermsOfDelivery) (*client.TermsOfDelivery, error)
+	GetTermOfDeliveryFunc        func(ctx context.Context, code string) (*client.TermsOfDelivery, error)
+	UpdateTermOfDeliveryFunc     func(ctx context.Context, code string, tod *client.TermsOfDelivery) (*client.TermsOfDelivery, error)
+}
+
+func (f *TermsOfDeliveries) GetAllTermsOfDeliveries(ctx context.Context) ([]client.TermsOfDelivery, error) {
+	if f.GetAllTermsOfDeliveriesFunc == nil {
+		panic(notImplemented("TermsOfDeliveries", "GetAllTermsOfDeliveries"))
+	}
+	return f.GetAllTermsOfDeliveriesFunc(ctx)
+}
+
+func (f *TermsOfDeliveries) GetTermsOfDeliveriesPage(ctx context.Context, page *client.PageOptions) ([]client.TermsOfDelivery, *client.MetaInformation, error) {
+	if f.GetTermsOfDeliveriesPageFunc == nil {
+		panic(notImplemented("TermsOfDeliveries", "GetTermsOfDeliveriesPage"))
+	}
+	return f.GetTermsOfDeliveriesPageFunc(ctx, page)
+}
+
+func (f *TermsOfDeliveries) IterateTermsOfDeliveries() *client.Iterator[client.TermsOfDelivery] {
+	if f.IterateTermsOfDeliveriesFunc == nil {
+		panic(notImplemented("TermsOfDeliveries", "IterateTermsOfDeliveries"))
+	}
+	return f.IterateTermsOfDeliveriesFunc()
+}
+
+func (f *TermsOfDeliveries) CreateTermsOfDeliveries(ctx context.Context, tod *client.TermsOfDelivery) (*client.TermsOfDelivery, error) {
+	if f.CreateTermsOfDeliveriesFunc == nil {
+		panic(notImplemented("TermsOfDeliveries", "CreateTermsOfDeliveries"))
+	}
+	return f.CreateTermsOfDeliveriesFunc(ctx, tod)
+}
+
+func (f *TermsOfDeliveries) GetTermOfDelivery(ctx context.Context, code string) (*client.TermsOfDelivery, error) {
+	if f.GetTermOfDeliveryFunc == nil {
+		panic(notImplemented("TermsOfDeliveries", "GetTermOfDelivery"))
+	}
+	return f.GetTermOfDeliveryFunc(ctx, code)
+}
+
+func (f *TermsOfDeliveries) UpdateTermOfDelivery(ctx context.Context, code string, tod *client.TermsOfDelivery) (*client.TermsOfDelivery, error) {
+	if f.UpdateTermOfDeliveryFunc == nil {
+		panic(notImplemented("TermsOfDeliveries", "UpdateTermOfDelivery"))
+	}
+	return f.UpdateTermOfDeliveryFunc(ctx, code, tod)
+}
+
+// TermsOfPayments fakes client.TermsOfPaymentsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type TermsOfPayments struct {
+	GetAllTermsOfPaymentsFunc  func(ctx context.Context) ([]client.TermsOfPayment, error)
+	GetTermsOfPaymentsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.TermsOfPayment, *client.MetaInformation, error)
+	IterateTermsOfPaymentsFunc func() *client.Iterator[client.TermsOfPayment]
+	CreateTermOfPaymentFunc    func(ctx context.Context, top *client.TermsOfPayment) (*client.TermsOfPayment, error)
+	GetTermOfPaymentFunc       func(ctx context.Context, code string) (*client.TermsOfPayment, error)
+	UpdateTermOfPaymentFunc    func(ctx context.Context, code string, top *client.TermsOfPayment) (*client.TermsOfPayment, error)
+	RemoveTermOfPaymentFunc    func(ctx context.Context, code string) error
+}
+
+func (f *TermsOfPayments) GetAllTermsOfPayments(ctx context.Context) ([]client.TermsOfPayment, error) {
+	if f.GetAllTermsOfPaymentsFunc == nil {
+		panic(notImplemented("TermsOfPayments", "GetAllTermsOfPayments"))
+	}
+	return f.GetAllTermsOfPaymentsFunc(ctx)
+}
+
+func (f *TermsOfPayments) GetTermsOfPaymentsPage(ctx context.Context, page *client.PageOptions) ([]client.TermsOfPayment, *client.MetaInformation, error) {
+	if f.GetTermsOfPaymentsPageFunc == nil {
+		panic(notImplemented("TermsOfPayments", "GetTermsOfPaymentsPage"))
+	}
+	return f.GetTermsOfPaymentsPageFunc(ctx, page)
+}
+
+func (f *TermsOfPayments) IterateTermsOfPayments() *client.Iterator[client.TermsOfPayment] {
+	if f.IterateTermsOfPaymentsFunc == nil {
+		panic(notImplemented("TermsOfPayments", "IterateTermsOfPayments"))
+	}
+	return f.IterateTermsOfPaymentsFunc()
+}
+
+func (f *TermsOfPayments) CreateTermOfPayment(ctx context.Context, top *client.TermsOfPayment) (*client.TermsOfPayment, error) {
+	if f.CreateTermOfPaymentFunc == nil {
+		panic(notImplemented("TermsOfPayments", "CreateTermOfPayment"))
+	}
+	return f.CreateTermOfPaymentFunc(ctx, top)
+}
+
+func (f *TermsOfPayments) GetTermOfPayment(ctx context.Context, code string) (*client.TermsOfPayment, error) {
+	if f.GetTermOfPaymentFunc == nil {
+		panic(notImplemented("TermsOfPayments", "GetTermOfPayment"))
+	}
+	return f.GetTermOfPaymentFunc(ctx, code)
+}
+
+func (f *TermsOfPayments) UpdateTermOfPayment(ctx context.Context, code string, top *client.TermsOfPayment) (*client.TermsOfPayment, error) {
+	if f.UpdateTermOfPaymentFunc == nil {
+		panic(notImplemented("TermsOfPayments", "UpdateTermOfPayment"))
+	}
+	return f.UpdateTermOfPaymentFunc(ctx, code, top)
+}
+
+func (f *TermsOfPayments) RemoveTermOfPayment(ctx context.Context, code string) error {
+	if f.RemoveTermOfPaymentFunc == nil {
+		panic(notImplemented("TermsOfPayments", "RemoveTermOfPayment"))
+	}
+	return f.RemoveTermOfPaymentFunc(ctx, code)
+}
+
+// TrustedEmailSenders fakes client.TrustedEmailSendersService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type TrustedEmailSenders struct {
+	GetAllTrustedAndRejectedEmailSendersFunc func(ctx context.Context) (*client.EmailSenders, error)
+	CreateTrustedEmailAddressFunc            func(ctx context.Context, ts *client.TrustedSender) (*client.TrustedSender, error)
+	RemoveTrustedEmailAddressFunc            func(ctx context.Context, id int) error
+}
+
+func (f *TrustedEmailSenders) GetAllTrustedAndRejectedEmailSenders(ctx context.Context) (*client.EmailSenders, error) {
+	if f.GetAllTrustedAndRejectedEmailSendersFunc == nil {
+		panic(notImplemented("TrustedEmailSenders", "GetAllTrustedAndRejectedEmailSenders"))
+	}
+	return f.GetAllTrustedAndRejectedEmailSendersFunc(ctx)
+}
+
+func (f *TrustedEmailSenders) CreateTrustedEmailAddress(ctx context.Context, ts *client.TrustedSender) (*client.TrustedSender, error) {
+	if f.CreateTrustedEmailAddressFunc == nil {
+		panic(notImplemented("TrustedEmailSenders", "CreateTrustedEmailAddress"))
+	}
+	return f.CreateTrustedEmailAddressFunc(ctx, ts)
+}
+
+func (f *TrustedEmailSenders) RemoveTrustedEmailAddress(ctx context.Context, id int) error {
+	if f.RemoveTrustedEmailAddressFunc == nil {
+		panic(notImplemented("TrustedEmailSenders", "RemoveTrustedEmailAddress"))
+	}
+	return f.RemoveTrustedEmailAddressFunc(ctx, id)
+}
+
+// Units fakes client.UnitsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Units struct {
+	GetAllUnitsFunc  func(ctx context.Context) ([]client.Unit, error)
+	GetUnitsPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.Unit, *client.MetaInformation, error)
+	IterateUnitsFunc func() *client.Iterator[client.Unit]
+	CreateUnitFunc   func(ctx context.Context, u *client.Unit) (*client.Unit, error)
+	GetUnitFunc      func(ctx context.Context, code string) (*client.Unit, error)
+	UpdateUnitFunc   func(ctx context.Context, code string, u *client.Unit) (*client.Unit, error)
+	RemoveUnitFunc   func(ctx context.Context, code string) error
+}
+
+func (f *Units) GetAllUnits(ctx context.Context) ([]client.Unit, error) {
+	if f.GetAllUnitsFunc == nil {
+		panic(notImplemented("Units", "GetAllUnits"))
+	}
+	return f.GetAllUnitsFunc(ctx)
+}
+
+func (f *Units) GetUnitsPage(ctx context.Context, page *client.PageOptions) ([]client.Unit, *client.MetaInformation, error) {
+	if f.GetUnitsPageFunc == nil {
+		panic(notImplemented("Units", "GetUnitsPage"))
+	}
+	return f.GetUnitsPageFunc(ctx, page)
+}
+
+func (f *Units) IterateUnits() *client.Iterator[client.Unit] {
+	if f.IterateUnitsFunc == nil {
+		panic(notImplemented("Units", "IterateUnits"))
+	}
+	return f.IterateUnitsFunc()
+}
+
+func (f *Units) CreateUnit(ctx context.Context, u *client.Unit) (*client.Unit, error) {
+	if f.CreateUnitFunc == nil {
+		panic(notImplemented("Units", "CreateUnit"))
+	}
+	return f.CreateUnitFunc(ctx, u)
+}
+
+func (f *Units) GetUnit(ctx context.Context, code string) (*client.Unit, error) {
+	if f.GetUnitFunc == nil {
+		panic(notImplemented("Units", "GetUnit"))
+	}
+	return f.GetUnitFunc(ctx, code)
+}
+
+func (f *Units) UpdateUnit(ctx context.Context, code string, u *client.Unit) (*client.Unit, error) {
+	if f.UpdateUnitFunc == nil {
+		panic(notImplemented("Units", "UpdateUnit"))
+	}
+	return f.UpdateUnitFunc(ctx, code, u)
+}
+
+func (f *Units) RemoveUnit(ctx context.Context, code string) error {
+	if f.RemoveUnitFunc == nil {
+		panic(notImplemented("Units", "RemoveUnit"))
+	}
+	return f.RemoveUnitFunc(ctx, code)
+}
+
+// VoucherFileConnections fakes client.VoucherFileConnectionsService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type VoucherFileConnections struct {
+	GetAllVoucherFileConnectionsFunc     func(ctx context.Context) ([]client.VoucherFileConnection, error)
+	GetVoucherFileConnectionsPageFunc    func(ctx context.Context, page *client.PageOptions) ([]client.VoucherFileConnection, *client.MetaInformation, error)
+	IterateVoucherFileConnectionsFunc    func() *client.Iterator[client.VoucherFileConnection]
+	CreateVoucherFileConnectionFunc      func(ctx context.Context, vfc *client.VoucherFileConnection) (*client.VoucherFileConnection, error)
+	GetVoucherFileConnectionByFileIDFunc func(ctx context.Context, fileID string) (*client.VoucherFileConnection, error)
+	RemoveVoucherFileConnectionsFunc     func(ctx context.Context, fieldID string) error
+}
+
+func (f *VoucherFileConnections) GetAllVoucherFileConnections(ctx context.Context) ([]client.VoucherFileConnection, error) {
+	if f.GetAllVoucherFileConnectionsFunc == nil {
+		panic(notImplemented("VoucherFileConnections", "GetAllVoucherFileConnections"))
+	}
+	return f.GetAllVoucherFileConnectionsFunc(ctx)
+}
+
+func (f *VoucherFileConnections) GetVoucherFileConnectionsPage(ctx context.Context, page *client.PageOptions) ([]client.VoucherFileConnection, *client.MetaInformation, error) {
+	if f.GetVoucherFileConnectionsPageFunc == nil {
+		panic(notImplemented("VoucherFileConnections", "GetVoucherFileConnectionsPage"))
+	}
+	return f.GetVoucherFileConnectionsPageFunc(ctx, page)
+}
+
+func (f *VoucherFileConnections) IterateVoucherFileConnections() *client.Iterator[client.VoucherFileConnection] {
+	if f.IterateVoucherFileConnectionsFunc == nil {
+		panic(notImplemented("VoucherFileConnections", "IterateVoucherFileConnections"))
+	}
+	return f.IterateVoucherFileConnectionsFunc()
+}
+
+func (f *VoucherFileConnections) CreateVoucherFileConnection(ctx context.Context, vfc *client.VoucherFileConnection) (*client.VoucherFileConnection, error) {
+	if f.CreateVoucherFileConnectionFunc == nil {
+		panic(notImplemented("VoucherFileConnections", "CreateVoucherFileConnection"))
+	}
+	return f.CreateVoucherFileConnectionFunc(ctx, vfc)
+}
+
+func (f *VoucherFileConnections) GetVoucherFileConnectionByFileID(ctx context.Context, fileID string) (*client.VoucherFileConnection, error) {
+	if f.GetVoucherFileConnectionByFileIDFunc == nil {
+		panic(notImplemented("VoucherFileConnections", "GetVoucherFileConnectionByFileID"))
+	}
+	return f.GetVoucherFileConnectionByFileIDFunc(ctx, fileID)
+}
+
+func (f *VoucherFileConnections) RemoveVoucherFileConnections(ctx context.Context, fieldID string) error {
+	if f.RemoveVoucherFileConnectionsFunc == nil {
+		panic(notImplemented("VoucherFileConnections", "RemoveVoucherFileConnections"))
+	}
+	return f.RemoveVoucherFileConnectionsFunc(ctx, fieldID)
+}
+
+// VoucherSeries fakes client.VoucherSeriesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type VoucherSeries struct {
+	GetAllVoucherSeriesFunc    func(ctx context.Context) ([]client.VoucherSeries, error)
+	GetVoucherSeriesPageFunc   func(ctx context.Context, page *client.PageOptions) ([]client.VoucherSeries, *client.MetaInformation, error)
+	IterateVoucherSeriesFunc   func() *client.Iterator[client.VoucherSeries]
+	CreateVoucherSeriesFunc    func(ctx context.Context, vs *client.VoucherSeries) (*client.VoucherSeries, error)
+	GetVoucherSeriesByCodeFunc func(ctx context.Context, code string) (*client.VoucherSeries, error)
+	UpdateVoucherSeriesFunc    func(ctx context.Context, code string, vs *client.VoucherSeries) (*client.VoucherSeries, error)
+}
+
+func (f *VoucherSeries) GetAllVoucherSeries(ctx context.Context) ([]client.VoucherSeries, error) {
+	if f.GetAllVoucherSeriesFunc == nil {
+		panic(notImplemented("VoucherSeries", "GetAllVoucherSeries"))
+	}
+	return f.GetAllVoucherSeriesFunc(ctx)
+}
+
+func (f *VoucherSeries) GetVoucherSeriesPage(ctx context.Context, page *client.PageOptions) ([]client.VoucherSeries, *client.MetaInformation, error) {
+	if f.GetVoucherSeriesPageFunc == nil {
+		panic(notImplemented("VoucherSeries", "GetVoucherSeriesPage"))
+	}
+	return f.GetVoucherSeriesPageFunc(ctx, page)
+}
+
+func (f *VoucherSeries) IterateVoucherSeries() *client.Iterator[client.VoucherSeries] {
+	if f.IterateVoucherSeriesFunc == nil {
+		panic(notImplemented("VoucherSeries", "IterateVoucherSeries"))
+	}
+	return f.IterateVoucherSeriesFunc()
+}
+
+func (f *VoucherSeries) CreateVoucherSeries(ctx context.Context, vs *client.VoucherSeries) (*client.VoucherSeries, error) {
+	if f.CreateVoucherSeriesFunc == nil {
+		panic(notImplemented("VoucherSeries", "CreateVoucherSeries"))
+	}
+	return f.CreateVoucherSeriesFunc(ctx, vs)
+}
+
+func (f *VoucherSeries) GetVoucherSeriesByCode(ctx context.Context, code string) (*client.VoucherSeries, error) {
+	if f.GetVoucherSeriesByCodeFunc == nil {
+		panic(notImplemented("VoucherSeries", "GetVoucherSeriesByCode"))
+	}
+	return f.GetVoucherSeriesByCodeFunc(ctx, code)
+}
+
+func (f *VoucherSeries) UpdateVoucherSeries(ctx context.Context, code string, vs *client.VoucherSeries) (*client.VoucherSeries, error) {
+	if f.UpdateVoucherSeriesFunc == nil {
+		panic(notImplemented("VoucherSeries", "UpdateVoucherSeries"))
+	}
+	return f.UpdateVoucherSeriesFunc(ctx, code, vs)
+}
+
+// Vouchers fakes client.VouchersService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type Vouchers struct {
+	GetVoucherFunc              func(ctx context.Context, voucherSeries, voucherNumber string, filter *client.FinancialYearFilter) (*client.Voucher, error)
+	GetAllVouchersFunc          func(ctx context.Context, filter *client.FinancialYearFilter) ([]client.Voucher, error)
+	GetVouchersPageFunc         func(ctx context.Context, filter *client.FinancialYearFilter, page *client.PageOptions) ([]client.Voucher, *client.MetaInformation, error)
+	IterateVouchersFunc         func(filter *client.FinancialYearFilter) *client.Iterator[client.Voucher]
+	CreateVoucherFunc           func(ctx context.Context, filter client.FinancialYearFilter, v *client.Voucher) (*client.Voucher, error)
+	GetVouchersBySeriesFunc     func(ctx context.Context, voucherSeries string, filter client.FinancialYearFilter) ([]client.Voucher, error)
+	GetVouchersBySeriesPageFunc func(ctx context.Context, voucherSeries string, filter client.FinancialYearFilter, page *client.PageOptions) ([]client.Voucher, *client.MetaInformation, error)
+	IterateVouchersBySeriesFunc func(voucherSeries string, filter client.FinancialYearFilter) *client.Iterator[client.Voucher]
+}
+
+func (f *Vouchers) GetVoucher(ctx context.Context, voucherSeries, voucherNumber string, filter *client.FinancialYearFilter) (*client.Voucher, error) {
+	if f.GetVoucherFunc == nil {
+		panic(notImplemented("Vouchers", "GetVoucher"))
+	}
+	return f.GetVoucherFunc(ctx, voucherSeries, voucherNumber, filter)
+}
+
+func (f *Vouchers) GetAllVouchers(ctx context.Context, filter *client.FinancialYearFilter) ([]client.Voucher, error) {
+	if f.GetAllVouchersFunc == nil {
+		panic(notImplemented("Vouchers", "GetAllVouchers"))
+	}
+	return f.GetAllVouchersFunc(ctx, filter)
+}
+
+func (f *Vouchers) GetVouchersPage(ctx context.Context, filter *client.FinancialYearFilter, page *client.PageOptions) ([]client.Voucher, *client.MetaInformation, error) {
+	if f.GetVouchersPageFunc == nil {
+		panic(notImplemented("Vouchers", "GetVouchersPage"))
+	}
+	return f.GetVouchersPageFunc(ctx, filter, page)
+}
+
+func (f *Vouchers) IterateVouchers(filter *client.FinancialYearFilter) *client.Iterator[client.Voucher] {
+	if f.IterateVouchersFunc == nil {
+		panic(notImplemented("Vouchers", "IterateVouchers"))
+	}
+	return f.IterateVouchersFunc(filter)
+}
+
+func (f *Vouchers) CreateVoucher(ctx context.Context, filter client.FinancialYearFilter, v *client.Voucher) (*client.Voucher, error) {
+	if f.CreateVoucherFunc == nil {
+		panic(notImplemented("Vouchers", "CreateVoucher"))
+	}
+	return f.CreateVoucherFunc(ctx, filter, v)
+}
+
+func (f *Vouchers) GetVouchersBySeries(ctx context.Context, voucherSeries string, filter client.FinancialYearFilter) ([]client.Voucher, error) {
+	if f.GetVouchersBySeriesFunc == nil {
+		panic(notImplemented("Vouchers", "GetVouchersBySeries"))
+	}
+	return f.GetVouchersBySeriesFunc(ctx, voucherSeries, filter)
+}
+
+func (f *Vouchers) GetVouchersBySeriesPage(ctx context.Context, voucherSeries string, filter client.FinancialYearFilter, page *client.PageOptions) ([]client.Voucher, *client.MetaInformation, error) {
+	if f.GetVouchersBySeriesPageFunc == nil {
+		panic(notImplemented("Vouchers", "GetVouchersBySeriesPage"))
+	}
+	return f.GetVouchersBySeriesPageFunc(ctx, voucherSeries, filter, page)
+}
+
+func (f *Vouchers) IterateVouchersBySeries(voucherSeries string, filter client.FinancialYearFilter) *client.Iterator[client.Voucher] {
+	if f.IterateVouchersBySeriesFunc == nil {
+		panic(notImplemented("Vouchers", "IterateVouchersBySeries"))
+	}
+	return f.IterateVouchersBySeriesFunc(voucherSeries, filter)
+}
+
+// WayOfDeliveries fakes client.WayOfDeliveriesService, each method calls the field named after it with Func appended
+// and panics when that field is nil
+type WayOfDeliveries struct {
+	GetAllWayOfDeliveriesFunc  func(ctx context.Context) ([]client.WayOfDelivery, error)
+	GetWayOfDeliveriesPageFunc func(ctx context.Context, page *client.PageOptions) ([]client.WayOfDelivery, *client.MetaInformation, error)
+	IterateWayOfDeliveriesFunc func() *client.Iterator[client.WayOfDelivery]
+	CreateWayOfDeliveriesFunc  func(ctx context.Context, wod *client.WayOfDelivery) (*client.WayOfDelivery, error)
+	GetWayOfDeliveryByCodeFunc func(ctx context.Context, code string) (*client.WayOfDelivery, error)
+	UpdateWayOfDeliveryFunc    func(ctx context.Context, code string, wod *client.WayOfDelivery) (*client.WayOfDelivery, error)
+	RemoveWayOfDeliveryFunc    func(ctx context.Context, code string) error
+}
+
+func (f *WayOfDeliveries) GetAllWayOfDeliveries(ctx context.Context) ([]client.WayOfDelivery, error) {
+	if f.GetAllWayOfDeliveriesFunc == nil {
+		panic(notImplemented("WayOfDeliveries", "GetAllWayOfDeliveries"))
+	}
+	return f.GetAllWayOfDeliveriesFunc(ctx)
+}
+
+func (f *WayOfDeliveries) GetWayOfDeliveriesPage(ctx context.Context, page *client.PageOptions) ([]client.WayOfDelivery, *client.MetaInformation, error) {
+	if f.GetWayOfDeliveriesPageFunc == nil {
+		panic(notImplemented("WayOfDeliveries", "GetWayOfDeliveriesPage"))
+	}
+	return f.GetWayOfDeliveriesPageFunc(ctx, page)
+}
+
+func (f *WayOfDeliveries) IterateWayOfDeliveries() *client.Iterator[client.WayOfDelivery] {
+	if f.IterateWayOfDeliveriesFunc == nil {
+		panic(notImplemented("WayOfDeliveries", "IterateWayOfDeliveries"))
+	}
+	return f.IterateWayOfDeliveriesFunc()
+}
+
+func (f *WayOfDeliveries) CreateWayOfDeliveries(ctx context.Context, wod *client.WayOfDelivery) (*client.WayOfDelivery, error) {
+	if f.CreateWayOfDeliveriesFunc == nil {
+		panic(notImplemented("WayOfDeliveries", "CreateWayOfDeliveries"))
+	}
+	return f.CreateWayOfDeliveriesFunc(ctx, wod)
+}
+
+func (f *WayOfDeliveries) GetWayOfDeliveryByCode(ctx context.Context, code string) (*client.WayOfDelivery, error) {
+	if f.GetWayOfDeliveryByCodeFunc == nil {
+		panic(notImplemented("WayOfDeliveries", "GetWayOfDeliveryByCode"))
+	}
+	return f.GetWayOfDeliveryByCodeFunc(ctx, code)
+}
+
+func (f *WayOfDeliveries) UpdateWayOfDelivery(ctx context.Context, code string, wod *client.WayOfDelivery) (*client.WayOfDelivery, error) {
+	if f.UpdateWayOfDeliveryFunc == nil {
+		panic(notImplemented("WayOfDeliveries", "UpdateWayOfDelivery"))
+	}
+	return f.UpdateWayOfDeliveryFunc(ctx, code, wod)
+}
+
+func (f *WayOfDeliveries) RemoveWayOfDelivery(ctx context.Context, code string) error {
+	if f.RemoveWayOfDeliveryFunc == nil {
+		panic(notImplemented("WayOfDeliveries", "RemoveWayOfDelivery"))
+	}
+	return f.RemoveWayOfDeliveryFunc(ctx, code)
+}
@@ -0,0 +1,34 @@
+// Package fortnoxfake provides fakes of the services of package client for unit tests.
+//
+// Every fake has a func field per method, named after the method with Func appended.
+// Set the ones the code under test calls, the other methods panic:
+//
+//	c := client.NewClient()
+//	c.Invoices = &fortnoxfake.Invoices{
+//		GetInvoiceFunc: func(ctx context.Context, documentNumber string) (*client.Invoice, error) {
+//			return &client.Invoice{DocumentNumber: documentNumber}, nil
+//		},
+//	}
+package fortnoxfake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// Iterator returns a client.Iterator over items as a single page, for the IterateX methods of the fakes
+func Iterator[T any](items ...T) *client.Iterator[T] {
+	return client.NewIterator(func(ctx context.Context, page *client.PageOptions) ([]T, *client.MetaInformation, error) {
+		if page.Page > 1 {
+			return nil, &client.MetaInformation{CurrentPage: page.Page, TotalPages: 1, TotalResources: len(items)}, nil
+		}
+
+		return items, &client.MetaInformation{CurrentPage: 1, TotalPages: 1, TotalResources: len(items)}, nil
+	})
+}
+
+func notImplemented(service, method string) string {
+	return fmt.Sprintf("fortnoxfake: %s.%sFunc is not set", service, method)
+}
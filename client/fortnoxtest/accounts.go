@@ -0,0 +1,138 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// accounts of the BAS chart used when booking invoices and payments
+const (
+	accountReceivables = 1510
+	accountBank        = 1930
+	accountPayables    = 2440
+	accountOutputVAT   = 2611
+	accountInputVAT    = 2641
+	accountSales       = 3001
+	accountRoundOff    = 3740
+	accountPurchases   = 4010
+)
+
+// chartOfAccounts is the part of the BAS chart of accounts a new Server has
+var chartOfAccounts = []client.Account{
+	{Number: 1510, Description: "Kundfordringar", SRU: 7251},
+	{Number: 1910, Description: "Kassa", SRU: 7281},
+	{Number: 1930, Description: "Företagskonto / checkkonto / affärskonto", SRU: 7281},
+	{Number: 2440, Description: "Leverantörsskulder", SRU: 7365},
+	{Number: 2611, Description: "Utgående moms på försäljning inom Sverige, 25 %", SRU: 7369},
+	{Number: 2641, Description: "Debiterad ingående moms", SRU: 7369},
+	{Number: 2650, Description: "Redovisningskonto för moms", SRU: 7369},
+	{Number: 3001, Description: "Försäljning inom Sverige, 25 % moms", SRU: 7410},
+	{Number: 3740, Description: "Öres- och kronutjämning", SRU: 7410},
+	{Number: 4010, Description: "Inköp material och varor", SRU: 7511},
+	{Number: 5010, Description: "Lokalhyra", SRU: 7513},
+	{Number: 6110, Description: "Kontorsmateriel", SRU: 7513},
+}
+
+// seedBookkeeping creates the financial year of today and the chart of accounts
+func (s *Server) seedBookkeeping() {
+	today := s.now()
+
+	year := client.FinancialYear{
+		Id:               s.nextNumber("financialyears"),
+		FromDate:         client.MustParseDate(strconv.Itoa(today.Year()) + "-01-01"),
+		ToDate:           client.MustParseDate(strconv.Itoa(today.Year()) + "-12-31"),
+		AccountingMethod: "ACCRUAL",
+		AccountCharts:    "Bas 2024",
+	}
+	s.financialYears.put(strconv.Itoa(year.Id), &year)
+
+	for _, account := range chartOfAccounts {
+		account := account
+		account.Active = true
+		account.Year = year.Id
+		s.accounts.put(strconv.Itoa(account.Number), &account)
+	}
+}
+
+// serveAccounts serves accounts and accounts/{Number}
+func (s *Server) serveAccounts(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			keep := func(a *client.Account) bool {
+				return !req.query.Has("sru") || strconv.Itoa(a.SRU) == req.query.Get("sru")
+			}
+
+			accounts, meta, err := page(req, s.accounts.list(keep))
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.GetAllAccountsResp{Accounts: accounts, MetaInformation: meta}, nil
+		case http.MethodPost:
+			return s.createAccount(req)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	account, ok := s.accounts.get(req.parts[0])
+	if !ok || len(req.parts) > 1 {
+		return nil, notFound(codeAccountNotFound, "Kontot hittades inte.")
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return &client.GetAccountResp{Account: *account}, nil
+	case http.MethodPut:
+		updated := *account
+		if err := merge(req, "Account", &updated); err != nil {
+			return nil, err
+		}
+		updated.Url = account.Url
+		updated.Number = account.Number
+		updated.Year = account.Year
+
+		*account = updated
+
+		return &client.UpdateAccountResp{Account: updated}, nil
+	}
+
+	return nil, errMethodNotAllowed(req)
+}
+
+func (s *Server) createAccount(req *request) (interface{}, error) {
+	body := &client.CreateAccountReq{}
+	if err := decode(req, body); err != nil {
+		return nil, err
+	}
+
+	account := body.Account
+	if account.Number < 1000 || account.Number > 9999 {
+		return nil, invalid(codeInvalidData, "Kontonummer måste vara mellan 1000 och 9999.")
+	}
+	if account.Description == "" {
+		return nil, invalid(codeInvalidData, "Benämning måste anges.")
+	}
+
+	number := strconv.Itoa(account.Number)
+	if s.accounts.has(number) {
+		return nil, invalid(codeInvalidData, "Kontot %s finns redan.", number)
+	}
+
+	year, err := s.financialYearOf(s.today())
+	if err != nil {
+		return nil, err
+	}
+
+	// Fortnox creates accounts as active, Active is left out of the request when false
+	account.Active = true
+	account.Year = year.Id
+	account.Url = s.BaseURL() + "accounts/" + number
+
+	s.accounts.put(number, &account)
+
+	return &client.CreateAccountResp{Account: account}, nil
+}
@@ -0,0 +1,97 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveArticles serves articles and articles/{ArticleNumber}
+func (s *Server) serveArticles(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			return s.listArticles(req)
+		case http.MethodPost:
+			return s.createArticle(req)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	number := req.parts[0]
+	article, ok := s.articles.get(number)
+	if !ok || len(req.parts) > 1 {
+		return nil, notFound(codeArticleNotFound, "Kan inte hitta artikeln.")
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return &client.GetArticleResp{Article: *article}, nil
+	case http.MethodPut:
+		updated := *article
+		if err := merge(req, "Article", &updated); err != nil {
+			return nil, err
+		}
+		updated.ArticleNumber = article.ArticleNumber
+		updated.Url = article.Url
+
+		s.articles.put(number, &updated)
+
+		return &client.UpdateArticleResp{Article: updated}, nil
+	case http.MethodDelete:
+		s.articles.delete(number)
+		return nil, nil
+	}
+
+	return nil, errMethodNotAllowed(req)
+}
+
+func (s *Server) listArticles(req *request) (interface{}, error) {
+	keep, err := activeFilter[client.Article](req, func(a *client.Article) bool { return a.Active })
+	if err != nil {
+		return nil, err
+	}
+
+	articles, meta, err := page(req, s.articles.list(keep))
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.GetArticlesResp{Articles: articles, MetaInformation: meta}, nil
+}
+
+func (s *Server) createArticle(req *request) (interface{}, error) {
+	body := &client.CreateArticleReq{}
+	if err := decode(req, body); err != nil {
+		return nil, err
+	}
+
+	article := body.Article
+	if article.Description == "" {
+		return nil, invalid(codeInvalidData, "Benämning måste anges.")
+	}
+
+	if article.ArticleNumber == "" {
+		for article.ArticleNumber == "" || s.articles.has(article.ArticleNumber) {
+			article.ArticleNumber = strconv.Itoa(s.nextNumber("articles"))
+		}
+	}
+
+	if s.articles.has(article.ArticleNumber) {
+		return nil, invalid(codeNumberAlreadyUsed, "Artikelnummer %s används redan.", article.ArticleNumber)
+	}
+
+	if article.Type == "" {
+		article.Type = "STOCK"
+	}
+	if article.VAT == 0 {
+		article.VAT = defaultVAT
+	}
+	article.Url = s.BaseURL() + "articles/" + article.ArticleNumber
+
+	s.articles.put(article.ArticleNumber, &article)
+
+	return &client.CreateArticleResp{Article: article}, nil
+}
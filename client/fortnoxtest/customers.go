@@ -0,0 +1,132 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveCustomers serves customers and customers/{CustomerNumber}
+func (s *Server) serveCustomers(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			return s.listCustomers(req)
+		case http.MethodPost:
+			return s.createCustomer(req)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	number := req.parts[0]
+	customer, ok := s.customers.get(number)
+	if !ok || len(req.parts) > 1 {
+		return nil, notFound(codeCustomerNotFound, "Kan inte hitta kunden.")
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return &client.GetCustomerResp{Customer: *customer}, nil
+	case http.MethodPut:
+		updated := *customer
+		if err := merge(req, "Customer", &updated); err != nil {
+			return nil, err
+		}
+		updated.CustomerNumber = customer.CustomerNumber
+		updated.Url = customer.Url
+
+		s.customers.put(number, &updated)
+
+		return &client.UpdateCustomerResp{Customer: updated}, nil
+	case http.MethodDelete:
+		if s.customerHasDocuments(number) {
+			return nil, invalid(codeInvalidData, "Kunden kan inte tas bort eftersom den används i dokument.")
+		}
+		s.customers.delete(number)
+
+		return nil, nil
+	}
+
+	return nil, errMethodNotAllowed(req)
+}
+
+func (s *Server) listCustomers(req *request) (interface{}, error) {
+	keep, err := activeFilter[client.Customer](req, func(c *client.Customer) bool { return c.Active })
+	if err != nil {
+		return nil, err
+	}
+
+	customers, meta, err := page(req, s.customers.list(keep))
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.GetAllCustomersResp{Customers: customers, MetaInformation: meta}, nil
+}
+
+func (s *Server) createCustomer(req *request) (interface{}, error) {
+	body := &client.CreateCustomerReq{}
+	if err := decode(req, body); err != nil {
+		return nil, err
+	}
+
+	customer := body.Customer
+	if customer.Name == "" {
+		return nil, invalid(codeInvalidData, "Kundnamn måste anges.")
+	}
+
+	if customer.CustomerNumber == 0 {
+		for customer.CustomerNumber == 0 || s.customers.has(strconv.FormatInt(customer.CustomerNumber, 10)) {
+			customer.CustomerNumber = int64(s.nextNumber("customers"))
+		}
+	}
+
+	number := strconv.FormatInt(customer.CustomerNumber, 10)
+	if s.customers.has(number) {
+		return nil, invalid(codeNumberAlreadyUsed, "Kundnummer %s används redan.", number)
+	}
+
+	// Fortnox creates customers as active, Active is left out of the request when false
+	customer.Active = true
+	customer.Url = s.BaseURL() + "customers/" + number
+
+	s.customers.put(number, &customer)
+
+	return &client.CreateCustomerResp{Customer: customer}, nil
+}
+
+func (s *Server) customerHasDocuments(number string) bool {
+	for _, k := range s.invoices.keys {
+		if s.invoices.rows[k].CustomerNumber == number {
+			return true
+		}
+	}
+	for _, k := range s.orders.keys {
+		if s.orders.rows[k].CustomerNumber == number {
+			return true
+		}
+	}
+	for _, k := range s.offers.keys {
+		if s.offers.rows[k].CustomerNumber == number {
+			return true
+		}
+	}
+
+	return false
+}
+
+// activeFilter returns the filter selected by the active and inactive filter param
+func activeFilter[T any](req *request, active func(v *T) bool) (func(v *T) bool, error) {
+	switch filter := req.query.Get("filter"); filter {
+	case "":
+		return nil, nil
+	case "active":
+		return active, nil
+	case "inactive":
+		return func(v *T) bool { return !active(v) }, nil
+	default:
+		return nil, errUnknownFilter(filter)
+	}
+}
@@ -0,0 +1,102 @@
+package fortnoxtest
+
+import (
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// defaultVAT is the VAT percentage of rows that don't set one and whose article doesn't either
+const defaultVAT = 25
+
+var hundred = client.MoneyFromInt(100)
+
+// row is a row of an invoice, order or offer as far as its totals are concerned
+type row struct {
+	articleNumber string
+	quantity      string
+	price         client.Money
+	discount      client.Money
+	discountType  string
+	vat           *int
+	total         *client.Money
+}
+
+// totals are the amounts Fortnox computes for an invoice, order or offer
+type totals struct {
+	net      client.Money
+	gross    client.Money
+	vat      client.Money
+	roundOff client.Money
+	total    client.Money
+}
+
+// computeTotals sets the Total and VAT of every row and returns the totals of a document with them,
+// freight and administration fee are taxed with the default VAT.
+// Like Fortnox the total is rounded to whole kronor, the difference is the round off.
+func (s *Server) computeTotals(rows []row, freight, fee client.Money) (totals, error) {
+	var t totals
+
+	for _, r := range rows {
+		var quantity client.Money
+		if r.quantity != "" {
+			q, err := client.ParseMoney(r.quantity)
+			if err != nil {
+				return totals{}, invalid(codeInvalidData, "Ogiltigt antal: %s", r.quantity)
+			}
+			quantity = q
+		}
+
+		amount := quantity.Mul(r.price)
+		switch r.discountType {
+		case "PERCENT":
+			amount = amount.Sub(amount.Mul(r.discount).Div(hundred))
+		default:
+			amount = amount.Sub(r.discount)
+		}
+		amount = amount.Round(2, client.RoundHalfUp)
+		*r.total = amount
+
+		if *r.vat == 0 {
+			*r.vat = defaultVAT
+			if article, ok := s.articles.get(r.articleNumber); ok && article.VAT != 0 {
+				*r.vat = article.VAT
+			}
+		}
+
+		t.net = t.net.Add(amount)
+		t.vat = t.vat.Add(vatOf(amount, *r.vat))
+	}
+
+	t.gross = t.net.Add(freight).Add(fee)
+	t.vat = t.vat.Add(vatOf(freight, defaultVAT)).Add(vatOf(fee, defaultVAT))
+
+	exact := t.gross.Add(t.vat)
+	t.total = exact.Round(0, client.RoundHalfUp)
+	t.roundOff = t.total.Sub(exact)
+
+	return t, nil
+}
+
+func vatOf(amount client.Money, percent int) client.Money {
+	return amount.MulInt(int64(percent)).Div(hundred).Round(2, client.RoundHalfUp)
+}
+
+// checkCustomer returns the customer numbered number, the customer of a document must exist
+func (s *Server) checkCustomer(number string) (*client.Customer, error) {
+	customer, ok := s.customers.get(number)
+	if !ok {
+		return nil, invalid(codeCustomerNotFound, "Kan inte hitta kunden.")
+	}
+
+	return customer, nil
+}
+
+// checkArticles verifies the article numbers used in rows exist
+func (s *Server) checkArticles(rows []row) error {
+	for _, r := range rows {
+		if r.articleNumber != "" && !s.articles.has(r.articleNumber) {
+			return invalid(codeArticleNotFound, "Kan inte hitta artikeln.")
+		}
+	}
+
+	return nil
+}
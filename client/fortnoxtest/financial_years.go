@@ -0,0 +1,84 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveFinancialYears serves financialyears and financialyears/{Id}
+func (s *Server) serveFinancialYears(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			date, err := dateParam(req, "date")
+			if err != nil {
+				return nil, err
+			}
+
+			keep := func(fy *client.FinancialYear) bool {
+				return date.IsZero() || (!date.Before(fy.FromDate) && !date.After(fy.ToDate))
+			}
+
+			years, meta, err := page(req, s.financialYears.list(keep))
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.GetAllFinancialYearsResp{FinancialYears: years, MetaInformation: meta}, nil
+		case http.MethodPost:
+			return s.createFinancialYear(req)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	year, ok := s.financialYears.get(req.parts[0])
+	if !ok || len(req.parts) > 1 || req.method != http.MethodGet {
+		return nil, notFound(codeNotFound, "Kan inte hitta räkenskapsåret.")
+	}
+
+	return &client.GetFinancialYearByIDResp{FinancialYear: *year}, nil
+}
+
+func (s *Server) createFinancialYear(req *request) (interface{}, error) {
+	body := &client.CreateFinancialYearReq{}
+	if err := decode(req, body); err != nil {
+		return nil, err
+	}
+
+	year := body.FinancialYear
+	if !year.FromDate.Valid() || !year.ToDate.Valid() || !year.FromDate.Before(year.ToDate) {
+		return nil, invalid(codeInvalidData, "Räkenskapsårets start måste vara före dess slut.")
+	}
+
+	for _, k := range s.financialYears.keys {
+		other := s.financialYears.rows[k]
+		if !year.FromDate.After(other.ToDate) && !year.ToDate.Before(other.FromDate) {
+			return nil, invalid(codeInvalidData, "Räkenskapsåret överlappar räkenskapsår %d.", other.Id)
+		}
+	}
+
+	if year.AccountingMethod == "" {
+		year.AccountingMethod = "ACCRUAL"
+	}
+	year.Id = s.nextNumber("financialyears")
+	year.Url = s.BaseURL() + "financialyears/" + strconv.Itoa(year.Id)
+
+	s.financialYears.put(strconv.Itoa(year.Id), &year)
+
+	return &client.CreateFinancialYearResp{FinancialYear: year}, nil
+}
+
+// financialYearOf returns the financial year date is in
+func (s *Server) financialYearOf(date client.Date) (*client.FinancialYear, error) {
+	for _, k := range s.financialYears.keys {
+		year := s.financialYears.rows[k]
+		if !date.Before(year.FromDate) && !date.After(year.ToDate) {
+			return year, nil
+		}
+	}
+
+	return nil, invalid(codeInvalidData, "Det finns inget räkenskapsår för datumet %s.", date)
+}
@@ -0,0 +1,160 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveInvoicePayments serves invoicepayments, invoicepayments/{Number} and invoicepayments/{Number}/bookkeep
+func (s *Server) serveInvoicePayments(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			keep := func(p *client.InvoicePayment) bool {
+				return !req.query.Has("invoicenumber") || strconv.Itoa(p.InvoiceNumber) == req.query.Get("invoicenumber")
+			}
+
+			payments, meta, err := page(req, s.invoicePayments.list(keep))
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.GetAllInvoicePaymentsResp{InvoicePayments: payments, MetaInformation: meta}, nil
+		case http.MethodPost:
+			return s.createInvoicePayment(req)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	number := req.parts[0]
+	payment, ok := s.invoicePayments.get(number)
+	if !ok {
+		return nil, notFound(codeNotFound, "Kan inte hitta betalningen.")
+	}
+
+	if len(req.parts) == 2 && req.parts[1] == "bookkeep" {
+		if payment.Booked {
+			return nil, invalid(codeInvalidData, "Betalningen är redan bokförd.")
+		}
+
+		voucher, err := s.bookVoucher(&client.Voucher{
+			VoucherSeries:   "C",
+			TransactionDate: payment.PaymentDate,
+			Description:     "Inbetalning faktura " + strconv.Itoa(payment.InvoiceNumber),
+			ReferenceNumber: payment.Number,
+			ReferenceType:   "INVOICEPAYMENT",
+			VoucherRows: entries(
+				entry(payment.ModeOfPaymentAccount, payment.Amount),
+				entry(accountReceivables, payment.Amount.Neg()),
+			),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		payment.Booked = true
+		payment.VoucherSeries = voucher.VoucherSeries
+		payment.VoucherNumber = voucher.VoucherNumber
+		payment.VoucherYear = voucher.Year
+
+		return &client.BookKeepInvoicePaymentResp{InvoicePayment: *payment}, nil
+	}
+	if len(req.parts) > 1 {
+		return nil, notFound(codeNotFound, "Åtgärden %s finns inte.", req.parts[1])
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return &client.GetInvoicePaymentResp{InvoicePayment: *payment}, nil
+	case http.MethodPut:
+		if payment.Booked {
+			return nil, invalid(codeInvalidData, "Betalningen är bokförd och kan inte ändras.")
+		}
+
+		updated := *payment
+		if err := merge(req, "InvoicePayment", &updated); err != nil {
+			return nil, err
+		}
+		updated.Url = payment.Url
+		updated.Number = payment.Number
+		updated.InvoiceNumber = payment.InvoiceNumber
+
+		invoice, err := s.invoice(strconv.Itoa(payment.InvoiceNumber))
+		if err != nil {
+			return nil, err
+		}
+		applyInvoicePayment(invoice, updated.Amount.Sub(payment.Amount), updated.PaymentDate)
+
+		*payment = updated
+
+		return &client.UpdateInvoicePaymentResp{InvoicePayment: updated}, nil
+	case http.MethodDelete:
+		if payment.Booked {
+			return nil, invalid(codeInvalidData, "Betalningen är bokförd och kan inte tas bort.")
+		}
+
+		if invoice, ok := s.invoices.get(strconv.Itoa(payment.InvoiceNumber)); ok {
+			applyInvoicePayment(invoice, payment.Amount.Neg(), payment.PaymentDate)
+		}
+		s.invoicePayments.delete(number)
+
+		return nil, nil
+	}
+
+	return nil, errMethodNotAllowed(req)
+}
+
+func (s *Server) createInvoicePayment(req *request) (interface{}, error) {
+	body := &client.CreateInvoicePaymentReq{}
+	if err := decode(req, body); err != nil {
+		return nil, err
+	}
+
+	payment := body.InvoicePayment
+
+	invoice, ok := s.invoices.get(strconv.Itoa(payment.InvoiceNumber))
+	if !ok {
+		return nil, invalid(codeInvalidData, "Kan inte hitta fakturan %d.", payment.InvoiceNumber)
+	}
+	if invoice.Cancelled {
+		return nil, invalid(codeInvalidData, "Fakturan är makulerad och kan inte betalas.")
+	}
+	if payment.Amount.IsZero() {
+		return nil, invalid(codeInvalidData, "Belopp måste anges.")
+	}
+
+	payment.Number = strconv.Itoa(s.nextNumber("invoicepayments"))
+	payment.Url = s.BaseURL() + "invoicepayments/" + payment.Number
+	if payment.PaymentDate.IsZero() {
+		payment.PaymentDate = s.today()
+	}
+	if payment.ModeOfPaymentAccount == 0 {
+		payment.ModeOfPaymentAccount = accountBank
+	}
+	if payment.Currency == "" {
+		payment.Currency = invoice.Currency
+	}
+	payment.InvoiceCustomerName = invoice.CustomerName
+	payment.InvoiceCustomerNumber = invoice.CustomerNumber
+	payment.InvoiceDueDate = invoice.DueDate
+	payment.InvoiceOCR = invoice.OCR
+	payment.InvoiceTotal = invoice.Total
+
+	applyInvoicePayment(invoice, payment.Amount, payment.PaymentDate)
+	s.invoicePayments.put(payment.Number, &payment)
+
+	return &client.CreateInvoicePaymentResp{InvoicePayment: payment}, nil
+}
+
+// applyInvoicePayment reduces the balance of invoice by amount, the invoice is fully paid on date when nothing is left
+func applyInvoicePayment(invoice *client.Invoice, amount client.Money, date client.Date) {
+	invoice.Balance = invoice.Balance.Sub(amount)
+
+	invoice.FinalPayDate = ""
+	if invoice.Balance.IsZero() {
+		invoice.FinalPayDate = date
+	}
+}
@@ -0,0 +1,367 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveInvoices serves invoices, invoices/{DocumentNumber} and its actions
+func (s *Server) serveInvoices(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			return s.listInvoices(req)
+		case http.MethodPost:
+			body := &client.CreateInvoiceReq{}
+			if err := decode(req, body); err != nil {
+				return nil, err
+			}
+
+			invoice, err := s.createInvoice(body.Invoice)
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.CreateInvoiceResp{Invoice: *invoice}, nil
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	invoice, err := s.invoice(req.parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.parts) == 1 {
+		switch req.method {
+		case http.MethodGet:
+			return &client.GetInvoiceResp{Invoice: *invoice}, nil
+		case http.MethodPut:
+			return s.updateInvoice(req, invoice)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	// actions are answered on both GET and PUT, as the client uses either
+	switch action := req.parts[1]; action {
+	case "bookkeep":
+		if err := s.bookkeepInvoice(invoice); err != nil {
+			return nil, err
+		}
+		return &client.BookKeepInvoiceResp{Invoice: *invoice}, nil
+	case "cancel":
+		if invoice.Booked {
+			return nil, invalid(codeInvalidData, "Fakturan är bokförd och kan inte makuleras.")
+		}
+		if invoice.Cancelled {
+			return nil, invalid(codeInvalidData, "Fakturan är redan makulerad.")
+		}
+		invoice.Cancelled = true
+		invoice.Balance = client.Money{}
+		return &client.CancelInvoiceResp{Invoice: *invoice}, nil
+	case "credit":
+		if err := s.creditInvoice(invoice); err != nil {
+			return nil, err
+		}
+		return &client.CreditInvoiceResp{Invoice: *invoice}, nil
+	case "externalprint", "email", "eprint", "einvoice":
+		if invoice.Cancelled {
+			return nil, invalid(codeInvalidData, "Fakturan är makulerad och kan inte skickas.")
+		}
+		invoice.Sent = true
+		return &client.SetInvoiceAsSentResp{Invoice: *invoice}, nil
+	case "warehouseready":
+		invoice.WarehouseReady = true
+		return &client.SetInvoiceAsDoneResp{Invoice: *invoice}, nil
+	case "print":
+		invoice.Sent = true
+		return newDocument("invoice", invoice.DocumentNumber), nil
+	case "printreminder":
+		invoice.Reminders++
+		invoice.LastRemindDate = s.today()
+		return newDocument("reminder", invoice.DocumentNumber), nil
+	case "preview":
+		return newDocument("invoice", invoice.DocumentNumber), nil
+	default:
+		return nil, notFound(codeNotFound, "Åtgärden %s finns inte.", action)
+	}
+}
+
+// invoice returns the invoice numbered number
+func (s *Server) invoice(number string) (*client.Invoice, error) {
+	invoice, ok := s.invoices.get(number)
+	if !ok {
+		return nil, notFound(codeNotFound, "Kan inte hitta fakturan.")
+	}
+
+	return invoice, nil
+}
+
+func (s *Server) listInvoices(req *request) (interface{}, error) {
+	today := s.today()
+
+	var status func(i *client.Invoice) bool
+	switch filter := req.query.Get("filter"); filter {
+	case "":
+	case string(client.Cancelled):
+		status = func(i *client.Invoice) bool { return i.Cancelled }
+	case string(client.FullyPaid):
+		status = func(i *client.Invoice) bool { return !i.Cancelled && i.Balance.IsZero() }
+	case string(client.Unpaid):
+		status = func(i *client.Invoice) bool { return !i.Cancelled && !i.Balance.IsZero() }
+	case string(client.UnpaidOverDue):
+		status = func(i *client.Invoice) bool {
+			return !i.Cancelled && !i.Balance.IsZero() && i.DueDate.Before(today)
+		}
+	case string(client.Unbooked):
+		status = func(i *client.Invoice) bool { return !i.Cancelled && !i.Booked }
+	default:
+		return nil, errUnknownFilter(filter)
+	}
+
+	from, err := dateParam(req, "fromdate")
+	if err != nil {
+		return nil, err
+	}
+	to, err := dateParam(req, "todate")
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.query
+	keep := func(i *client.Invoice) bool {
+		switch {
+		case status != nil && !status(i),
+			q.Has("customernumber") && i.CustomerNumber != q.Get("customernumber"),
+			q.Has("documentnumber") && i.DocumentNumber != q.Get("documentnumber"),
+			q.Has("sent") && strconv.FormatBool(i.Sent) != q.Get("sent"),
+			q.Has("credit") && strconv.FormatBool(i.Credit == "true") != q.Get("credit"),
+			!from.IsZero() && i.InvoiceDate.Before(from),
+			!to.IsZero() && i.InvoiceDate.After(to):
+			return false
+		}
+		return true
+	}
+
+	invoices, meta, err := page(req, s.invoices.list(keep))
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.GetAllInvoicesResp{Invoices: invoices, MetaInformation: meta}, nil
+}
+
+// createInvoice validates and stores invoice, filling in what Fortnox does
+func (s *Server) createInvoice(invoice client.Invoice) (*client.Invoice, error) {
+	customer, err := s.checkCustomer(invoice.CustomerNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.DocumentNumber == "" {
+		for invoice.DocumentNumber == "" || s.invoices.has(invoice.DocumentNumber) {
+			invoice.DocumentNumber = strconv.Itoa(s.nextNumber("invoices"))
+		}
+	}
+	if s.invoices.has(invoice.DocumentNumber) {
+		return nil, invalid(codeInvalidData, "Fakturanummer %s används redan.", invoice.DocumentNumber)
+	}
+
+	if invoice.CustomerName == "" {
+		invoice.CustomerName = customer.Name
+	}
+	if invoice.InvoiceDate.IsZero() {
+		invoice.InvoiceDate = s.today()
+	}
+	if invoice.DueDate.IsZero() {
+		invoice.DueDate = invoice.InvoiceDate.AddDays(30)
+	}
+	if invoice.Currency == "" {
+		invoice.Currency = "SEK"
+	}
+	if invoice.InvoiceType == "" {
+		invoice.InvoiceType = "INVOICE"
+	}
+	invoice.Url = s.BaseURL() + "invoices/" + invoice.DocumentNumber
+
+	if err := s.computeInvoiceTotals(&invoice); err != nil {
+		return nil, err
+	}
+	invoice.Balance = invoice.Total
+
+	s.invoices.put(invoice.DocumentNumber, &invoice)
+
+	return &invoice, nil
+}
+
+func (s *Server) updateInvoice(req *request, invoice *client.Invoice) (interface{}, error) {
+	if invoice.Booked {
+		return nil, invalid(codeInvalidData, "Fakturan är bokförd och kan inte ändras.")
+	}
+	if invoice.Cancelled {
+		return nil, invalid(codeInvalidData, "Fakturan är makulerad och kan inte ändras.")
+	}
+
+	updated := *invoice
+	if err := merge(req, "Invoice", &updated); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkCustomer(updated.CustomerNumber); err != nil {
+		return nil, err
+	}
+
+	// read-only fields are kept as they are
+	updated.Url = invoice.Url
+	updated.DocumentNumber = invoice.DocumentNumber
+	updated.Booked = invoice.Booked
+	updated.Cancelled = invoice.Cancelled
+	updated.Credit = invoice.Credit
+	updated.CreditInvoiceReference = invoice.CreditInvoiceReference
+	updated.OrderReference = invoice.OrderReference
+	updated.OfferReference = invoice.OfferReference
+	updated.Sent = invoice.Sent
+	updated.FinalPayDate = invoice.FinalPayDate
+	updated.VoucherNumber = invoice.VoucherNumber
+	updated.VoucherSeries = invoice.VoucherSeries
+	updated.VoucherYear = invoice.VoucherYear
+
+	if err := s.computeInvoiceTotals(&updated); err != nil {
+		return nil, err
+	}
+	paid := invoice.Total.Sub(invoice.Balance)
+	updated.Balance = updated.Total.Sub(paid)
+
+	*invoice = updated
+
+	return &client.UpdateInvoiceResp{Invoice: updated}, nil
+}
+
+func (s *Server) computeInvoiceTotals(invoice *client.Invoice) error {
+	rows := make([]row, len(invoice.InvoiceRows))
+	for i := range invoice.InvoiceRows {
+		r := &invoice.InvoiceRows[i]
+		r.RowId = i + 1
+		rows[i] = row{
+			articleNumber: r.ArticleNumber,
+			quantity:      r.DeliveredQuantity,
+			price:         r.Price,
+			discount:      r.Discount,
+			discountType:  r.DiscountType,
+			vat:           &r.VAT,
+			total:         &r.Total,
+		}
+	}
+
+	if err := s.checkArticles(rows); err != nil {
+		return err
+	}
+
+	t, err := s.computeTotals(rows, invoice.Freight, invoice.AdministrationFee)
+	if err != nil {
+		return err
+	}
+
+	invoice.Net = t.net
+	invoice.Gross = t.gross
+	invoice.TotalVAT = t.vat
+	invoice.RoundOff = t.roundOff
+	invoice.Total = t.total
+	invoice.TotalToPay = t.total
+
+	return nil
+}
+
+// bookkeepInvoice books invoice with a voucher in the customer invoice series B
+func (s *Server) bookkeepInvoice(invoice *client.Invoice) error {
+	switch {
+	case invoice.Cancelled:
+		return invalid(codeInvalidData, "Fakturan är makulerad och kan inte bokföras.")
+	case invoice.Booked:
+		return invalid(codeInvalidData, "Fakturan är redan bokförd.")
+	}
+
+	voucher, err := s.bookVoucher(&client.Voucher{
+		VoucherSeries:   "B",
+		TransactionDate: invoice.InvoiceDate,
+		Description:     "Kundfaktura " + invoice.DocumentNumber,
+		ReferenceNumber: invoice.DocumentNumber,
+		ReferenceType:   "INVOICE",
+		VoucherRows: entries(
+			entry(accountReceivables, invoice.Total),
+			entry(accountSales, invoice.Gross.Neg()),
+			entry(accountOutputVAT, invoice.TotalVAT.Neg()),
+			entry(accountRoundOff, invoice.RoundOff.Neg()),
+		),
+	})
+	if err != nil {
+		return err
+	}
+
+	invoice.Booked = true
+	invoice.VoucherSeries = voucher.VoucherSeries
+	invoice.VoucherNumber = voucher.VoucherNumber
+	invoice.VoucherYear = voucher.Year
+
+	return nil
+}
+
+// creditInvoice creates a credit invoice of the booked invoice, which then has nothing left to pay
+func (s *Server) creditInvoice(invoice *client.Invoice) error {
+	switch {
+	case invoice.Cancelled:
+		return invalid(codeInvalidData, "Fakturan är makulerad och kan inte krediteras.")
+	case !invoice.Booked:
+		return invalid(codeInvalidData, "Fakturan måste vara bokförd för att kunna krediteras.")
+	case invoice.Credit == "true":
+		return invalid(codeInvalidData, "En kreditfaktura kan inte krediteras.")
+	case invoice.CreditInvoiceReference != "":
+		return invalid(codeInvalidData, "Fakturan är redan krediterad.")
+	}
+
+	credit := client.Invoice{
+		CustomerNumber:         invoice.CustomerNumber,
+		CustomerName:           invoice.CustomerName,
+		Currency:               invoice.Currency,
+		InvoiceType:            invoice.InvoiceType,
+		Credit:                 "true",
+		CreditInvoiceReference: invoice.DocumentNumber,
+		Freight:                invoice.Freight.Neg(),
+		AdministrationFee:      invoice.AdministrationFee.Neg(),
+		YourReference:          invoice.YourReference,
+		OurReference:           invoice.OurReference,
+	}
+	for _, r := range invoice.InvoiceRows {
+		r.DeliveredQuantity = negate(r.DeliveredQuantity)
+		r.RowId = 0
+		credit.InvoiceRows = append(credit.InvoiceRows, r)
+	}
+
+	created, err := s.createInvoice(credit)
+	if err != nil {
+		return err
+	}
+	created.Balance = client.Money{}
+
+	invoice.CreditInvoiceReference = created.DocumentNumber
+	invoice.Balance = client.Money{}
+
+	return nil
+}
+
+// negate negates a quantity
+func negate(quantity string) string {
+	switch {
+	case quantity == "" || quantity == "0":
+		return quantity
+	case strings.HasPrefix(quantity, "-"):
+		return quantity[1:]
+	default:
+		return "-" + quantity
+	}
+}
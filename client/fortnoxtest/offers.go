@@ -0,0 +1,257 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveOffers serves offers, offers/{DocumentNumber} and its actions
+func (s *Server) serveOffers(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			return s.listOffers(req)
+		case http.MethodPost:
+			return s.createOffer(req)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	offer, ok := s.offers.get(req.parts[0])
+	if !ok {
+		return nil, notFound(codeNotFound, "Kan inte hitta offerten.")
+	}
+
+	if len(req.parts) == 1 {
+		switch req.method {
+		case http.MethodGet:
+			return &client.GetOfferResp{Offer: *offer}, nil
+		case http.MethodPut:
+			return s.updateOffer(req, offer)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	switch action := req.parts[1]; action {
+	case "createorder":
+		order, err := s.createOrderFromOffer(offer)
+		if err != nil {
+			return nil, err
+		}
+		return &client.CreateOrderOutOfOfferResp{Order: *order}, nil
+	case "cancel":
+		if offer.OrderReference != "" {
+			return nil, invalid(codeInvalidData, "Offerten har blivit order och kan inte makuleras.")
+		}
+		if offer.Cancelled {
+			return nil, invalid(codeInvalidData, "Offerten är redan makulerad.")
+		}
+		offer.Cancelled = true
+		return &client.CancelOfferResp{Offer: *offer}, nil
+	case "externalprint":
+		offer.Sent = true
+		return &client.SetOfferAsSentResp{Offer: *offer}, nil
+	case "email":
+		offer.Sent = true
+		return &client.SendOfferAsEmailResp{Offer: *offer}, nil
+	case "print":
+		offer.Sent = true
+		return newDocument("offer", offer.DocumentNumber), nil
+	case "preview":
+		return newDocument("offer", offer.DocumentNumber), nil
+	default:
+		return nil, notFound(codeNotFound, "Åtgärden %s finns inte.", action)
+	}
+}
+
+func (s *Server) listOffers(req *request) (interface{}, error) {
+	today := s.today()
+
+	var keep func(o *client.Offer) bool
+	switch filter := req.query.Get("filter"); filter {
+	case "":
+	case string(client.CancelledGetAllOffersFilter):
+		keep = func(o *client.Offer) bool { return o.Cancelled }
+	case string(client.ExpiredGetAllOffersFilter):
+		keep = func(o *client.Offer) bool {
+			return !o.Cancelled && o.OrderReference == "" && !o.ExpireDate.IsZero() && o.ExpireDate.Before(today)
+		}
+	case string(client.OrderCreatedGetAllOffersFilter):
+		keep = func(o *client.Offer) bool { return o.OrderReference != "" }
+	case string(client.OrderNotCreatedGetAllOffersFilter):
+		keep = func(o *client.Offer) bool { return !o.Cancelled && o.OrderReference == "" }
+	default:
+		return nil, errUnknownFilter(filter)
+	}
+
+	offers, meta, err := page(req, s.offers.list(keep))
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.GetAllOffersResp{Offers: offers, MetaInformation: meta}, nil
+}
+
+func (s *Server) createOffer(req *request) (interface{}, error) {
+	body := &client.CreateOfferReq{}
+	if err := decode(req, body); err != nil {
+		return nil, err
+	}
+
+	offer := body.Offer
+
+	customer, err := s.checkCustomer(offer.CustomerNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if offer.DocumentNumber == "" {
+		for offer.DocumentNumber == "" || s.offers.has(offer.DocumentNumber) {
+			offer.DocumentNumber = strconv.Itoa(s.nextNumber("offers"))
+		}
+	}
+	if s.offers.has(offer.DocumentNumber) {
+		return nil, invalid(codeInvalidData, "Offertnummer %s används redan.", offer.DocumentNumber)
+	}
+
+	if offer.CustomerName == "" {
+		offer.CustomerName = customer.Name
+	}
+	if offer.OfferDate.IsZero() {
+		offer.OfferDate = s.today()
+	}
+	if offer.ExpireDate.IsZero() {
+		offer.ExpireDate = offer.OfferDate.AddDays(30)
+	}
+	if offer.Currency == "" {
+		offer.Currency = "SEK"
+	}
+	offer.Url = s.BaseURL() + "offers/" + offer.DocumentNumber
+
+	if err := s.computeOfferTotals(&offer); err != nil {
+		return nil, err
+	}
+
+	s.offers.put(offer.DocumentNumber, &offer)
+
+	return &client.CreateOfferResp{Offer: offer}, nil
+}
+
+func (s *Server) updateOffer(req *request, offer *client.Offer) (interface{}, error) {
+	if offer.Cancelled {
+		return nil, invalid(codeInvalidData, "Offerten är makulerad och kan inte ändras.")
+	}
+	if offer.OrderReference != "" {
+		return nil, invalid(codeInvalidData, "Offerten har blivit order och kan inte ändras.")
+	}
+
+	updated := *offer
+	if err := merge(req, "Offer", &updated); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkCustomer(updated.CustomerNumber); err != nil {
+		return nil, err
+	}
+
+	updated.Url = offer.Url
+	updated.DocumentNumber = offer.DocumentNumber
+	updated.Cancelled = offer.Cancelled
+	updated.OrderReference = offer.OrderReference
+	updated.InvoiceReference = offer.InvoiceReference
+	updated.Sent = offer.Sent
+
+	if err := s.computeOfferTotals(&updated); err != nil {
+		return nil, err
+	}
+
+	*offer = updated
+
+	return &client.UpdateOfferResp{Offer: updated}, nil
+}
+
+func (s *Server) computeOfferTotals(offer *client.Offer) error {
+	rows := make([]row, len(offer.OfferRows))
+	for i := range offer.OfferRows {
+		r := &offer.OfferRows[i]
+		r.RowId = i + 1
+		rows[i] = row{
+			articleNumber: r.ArticleNumber,
+			quantity:      r.Quantity,
+			price:         r.Price,
+			discount:      r.Discount,
+			discountType:  r.DiscountType,
+			vat:           &r.VAT,
+			total:         &r.Total,
+		}
+	}
+
+	if err := s.checkArticles(rows); err != nil {
+		return err
+	}
+
+	t, err := s.computeTotals(rows, offer.Freight, offer.AdministrationFee)
+	if err != nil {
+		return err
+	}
+
+	offer.Net = t.net
+	offer.Gross = t.gross
+	offer.TotalVAT = t.vat
+	offer.RoundOff = t.roundOff
+	offer.Total = t.total
+	offer.TotalToPay = t.total
+
+	return nil
+}
+
+// createOrderFromOffer creates an order of the quoted quantities of offer
+func (s *Server) createOrderFromOffer(offer *client.Offer) (*client.Order, error) {
+	switch {
+	case offer.Cancelled:
+		return nil, invalid(codeInvalidData, "Offerten är makulerad och kan inte bli order.")
+	case offer.OrderReference != "":
+		return nil, invalid(codeInvalidData, "Offerten har redan blivit order.")
+	}
+
+	order := client.Order{
+		CustomerNumber:    offer.CustomerNumber,
+		CustomerName:      offer.CustomerName,
+		Currency:          offer.Currency,
+		DeliveryDate:      offer.DeliveryDate,
+		Freight:           offer.Freight,
+		AdministrationFee: offer.AdministrationFee,
+		OfferReference:    offer.DocumentNumber,
+		OurReference:      offer.OurReference,
+		YourReference:     offer.YourReference,
+		Remarks:           offer.Remarks,
+	}
+	for _, r := range offer.OfferRows {
+		order.OrderRows = append(order.OrderRows, client.OrderRow{
+			AccountNumber:   r.AccountNumber,
+			ArticleNumber:   r.ArticleNumber,
+			CostCenter:      r.CostCenter,
+			OrderedQuantity: r.Quantity,
+			Description:     r.Description,
+			Discount:        r.Discount,
+			DiscountType:    r.DiscountType,
+			Price:           r.Price,
+			Project:         r.Project,
+			Unit:            r.Unit,
+			VAT:             r.VAT,
+		})
+	}
+
+	created, err := s.createOrder(order)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.OrderReference = created.DocumentNumber
+
+	return created, nil
+}
@@ -0,0 +1,264 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveOrders serves orders, orders/{DocumentNumber} and its actions
+func (s *Server) serveOrders(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			return s.listOrders(req)
+		case http.MethodPost:
+			body := &client.CreateOrderReq{}
+			if err := decode(req, body); err != nil {
+				return nil, err
+			}
+
+			order, err := s.createOrder(body.Order)
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.CreateOrderResp{Order: *order}, nil
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	order, ok := s.orders.get(req.parts[0])
+	if !ok {
+		return nil, notFound(codeNotFound, "Kan inte hitta ordern.")
+	}
+
+	if len(req.parts) == 1 {
+		switch req.method {
+		case http.MethodGet:
+			return &client.GetOrderResp{Order: *order}, nil
+		case http.MethodPut:
+			return s.updateOrder(req, order)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	switch action := req.parts[1]; action {
+	case "createinvoice":
+		invoice, err := s.createInvoiceFromOrder(order)
+		if err != nil {
+			return nil, err
+		}
+		return &client.CreateInvoiceOutOfGivenOrderResp{Invoice: *invoice}, nil
+	case "cancel":
+		if order.InvoiceReference != "" {
+			return nil, invalid(codeInvalidData, "Ordern är fakturerad och kan inte makuleras.")
+		}
+		if order.Cancelled {
+			return nil, invalid(codeInvalidData, "Ordern är redan makulerad.")
+		}
+		order.Cancelled = true
+		return &client.CancelGivenOrderResp{Order: *order}, nil
+	case "externalprint":
+		order.Sent = true
+		return &client.SetGivenOrderAsSentResp{Order: *order}, nil
+	case "email":
+		order.Sent = true
+		return &client.SendOrderAsEmailResp{Order: *order}, nil
+	case "print":
+		order.Sent = true
+		return newDocument("order", order.DocumentNumber), nil
+	case "preview":
+		return newDocument("order", order.DocumentNumber), nil
+	default:
+		return nil, notFound(codeNotFound, "Åtgärden %s finns inte.", action)
+	}
+}
+
+func (s *Server) listOrders(req *request) (interface{}, error) {
+	today := s.today()
+
+	var keep func(o *client.Order) bool
+	switch filter := req.query.Get("filter"); filter {
+	case "":
+	case string(client.CancelledGetAllOrdersFilter):
+		keep = func(o *client.Order) bool { return o.Cancelled }
+	case string(client.ExpiredGetAllOrdersFilter):
+		keep = func(o *client.Order) bool {
+			return !o.Cancelled && o.InvoiceReference == "" && !o.DeliveryDate.IsZero() && o.DeliveryDate.Before(today)
+		}
+	case string(client.InvoiceCreatedGetAllOrdersFilter):
+		keep = func(o *client.Order) bool { return o.InvoiceReference != "" }
+	case "invoicenotcreated":
+		keep = func(o *client.Order) bool { return !o.Cancelled && o.InvoiceReference == "" }
+	default:
+		return nil, errUnknownFilter(filter)
+	}
+
+	orders, meta, err := page(req, s.orders.list(keep))
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.GetAllOrdersResp{Orders: orders, MetaInformation: meta}, nil
+}
+
+// createOrder validates and stores order, filling in what Fortnox does
+func (s *Server) createOrder(order client.Order) (*client.Order, error) {
+	customer, err := s.checkCustomer(order.CustomerNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.DocumentNumber == "" {
+		for order.DocumentNumber == "" || s.orders.has(order.DocumentNumber) {
+			order.DocumentNumber = strconv.Itoa(s.nextNumber("orders"))
+		}
+	}
+	if s.orders.has(order.DocumentNumber) {
+		return nil, invalid(codeInvalidData, "Ordernummer %s används redan.", order.DocumentNumber)
+	}
+
+	if order.CustomerName == "" {
+		order.CustomerName = customer.Name
+	}
+	if order.OrderDate.IsZero() {
+		order.OrderDate = s.today()
+	}
+	if order.Currency == "" {
+		order.Currency = "SEK"
+	}
+	order.Url = s.BaseURL() + "orders/" + order.DocumentNumber
+
+	if err := s.computeOrderTotals(&order); err != nil {
+		return nil, err
+	}
+
+	s.orders.put(order.DocumentNumber, &order)
+
+	return &order, nil
+}
+
+func (s *Server) updateOrder(req *request, order *client.Order) (interface{}, error) {
+	if order.Cancelled {
+		return nil, invalid(codeInvalidData, "Ordern är makulerad och kan inte ändras.")
+	}
+	if order.InvoiceReference != "" {
+		return nil, invalid(codeInvalidData, "Ordern är fakturerad och kan inte ändras.")
+	}
+
+	updated := *order
+	if err := merge(req, "Order", &updated); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkCustomer(updated.CustomerNumber); err != nil {
+		return nil, err
+	}
+
+	updated.Url = order.Url
+	updated.DocumentNumber = order.DocumentNumber
+	updated.Cancelled = order.Cancelled
+	updated.InvoiceReference = order.InvoiceReference
+	updated.OfferReference = order.OfferReference
+	updated.Sent = order.Sent
+
+	if err := s.computeOrderTotals(&updated); err != nil {
+		return nil, err
+	}
+
+	*order = updated
+
+	return &client.UpdateOrderResp{Order: updated}, nil
+}
+
+func (s *Server) computeOrderTotals(order *client.Order) error {
+	rows := make([]row, len(order.OrderRows))
+	for i := range order.OrderRows {
+		r := &order.OrderRows[i]
+		r.RowId = i + 1
+		rows[i] = row{
+			articleNumber: r.ArticleNumber,
+			quantity:      r.OrderedQuantity,
+			price:         r.Price,
+			discount:      r.Discount,
+			discountType:  r.DiscountType,
+			vat:           &r.VAT,
+			total:         &r.Total,
+		}
+	}
+
+	if err := s.checkArticles(rows); err != nil {
+		return err
+	}
+
+	t, err := s.computeTotals(rows, order.Freight, order.AdministrationFee)
+	if err != nil {
+		return err
+	}
+
+	order.Net = t.net
+	order.Gross = t.gross
+	order.TotalVAT = t.vat
+	order.RoundOff = t.roundOff
+	order.Total = t.total
+	order.TotalToPay = t.total
+
+	return nil
+}
+
+// createInvoiceFromOrder invoices the delivered quantities of order, the ordered ones if nothing is delivered
+func (s *Server) createInvoiceFromOrder(order *client.Order) (*client.Invoice, error) {
+	switch {
+	case order.Cancelled:
+		return nil, invalid(codeInvalidData, "Ordern är makulerad och kan inte faktureras.")
+	case order.InvoiceReference != "":
+		return nil, invalid(codeInvalidData, "Ordern är redan fakturerad.")
+	}
+
+	invoice := client.Invoice{
+		CustomerNumber:    order.CustomerNumber,
+		CustomerName:      order.CustomerName,
+		Currency:          order.Currency,
+		DeliveryDate:      order.DeliveryDate,
+		Freight:           order.Freight,
+		AdministrationFee: order.AdministrationFee,
+		OrderReference:    order.DocumentNumber,
+		OfferReference:    order.OfferReference,
+		OurReference:      order.OurReference,
+		YourReference:     order.YourReference,
+		Remarks:           order.Remarks,
+	}
+	for _, r := range order.OrderRows {
+		quantity := r.DeliveredQuantity
+		if quantity == "" {
+			quantity = r.OrderedQuantity
+		}
+
+		invoice.InvoiceRows = append(invoice.InvoiceRows, client.InvoiceRow{
+			AccountNumber:     r.AccountNumber,
+			ArticleNumber:     r.ArticleNumber,
+			CostCenter:        r.CostCenter,
+			DeliveredQuantity: quantity,
+			Description:       r.Description,
+			Discount:          r.Discount,
+			DiscountType:      r.DiscountType,
+			Price:             r.Price,
+			Project:           r.Project,
+			Unit:              r.Unit,
+			VAT:               r.VAT,
+		})
+	}
+
+	created, err := s.createInvoice(invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	order.InvoiceReference = created.DocumentNumber
+
+	return created, nil
+}
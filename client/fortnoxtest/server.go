@@ -0,0 +1,521 @@
+// Package fortnoxtest provides an in-memory fake of the Fortnox API for integration tests that run offline.
+//
+// The fake keeps the state of customers, articles, suppliers, invoices, orders, offers, vouchers, accounts,
+// financial years, supplier invoices and payments, and applies the transitions Fortnox does: bookkeeping creates
+// a voucher, a cancelled or booked document can't be changed, crediting creates a credit invoice and payments
+// reduce the Balance of their invoice. Errors are answered with a Fortnox ErrorInformation body, lists are paged
+// with MetaInformation and requests can be rate limited with 429 Too Many Requests.
+//
+//	srv := fortnoxtest.NewServer()
+//	defer srv.Close()
+//
+//	c := srv.NewClient()
+//	customer, err := c.CreateCustomer(ctx, &client.Customer{Name: "Acme AB"})
+//
+// Clients built elsewhere reach the fake through client.WithURLOpt(srv.BaseURL()).
+package fortnoxtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+const (
+	// AccessToken and ClientSecret are the credentials NewClient uses
+	AccessToken  = "fortnoxtest-access-token"
+	ClientSecret = "fortnoxtest-client-secret"
+
+	apiPrefix = "/3/"
+)
+
+// Fortnox error codes the fake answers with, see client.CodeToLanguagesMapping
+const (
+	codeUnauthorized       = 2000311
+	codeCustomerNotFound   = 2000433
+	codeArticleNotFound    = 2001302
+	codeAccountNotFound    = 2001304
+	codeInvalidParameter   = 2000588
+	codeNumberAlreadyUsed  = 2000637
+	codeInvalidData        = 2001392
+	codeInvalidJSON        = 2002115
+	codeSupplierInvoiceBal = 2000755
+
+	// codeNotFound is used for resources Fortnox has no dedicated code for,
+	// client.IsNotFound matches it through the 404 status
+	codeNotFound = 2000000
+)
+
+// Server is a fake Fortnox API served by an httptest.Server
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	now         func() time.Time
+	accessToken string
+	limit       int
+	window      time.Duration
+	calls       map[string][]time.Time
+	failures    []*apiError
+
+	seq map[string]int
+
+	customers               *table[client.Customer]
+	articles                *table[client.Article]
+	suppliers               *table[client.Supplier]
+	invoices                *table[client.Invoice]
+	invoicePayments         *table[client.InvoicePayment]
+	orders                  *table[client.Order]
+	offers                  *table[client.Offer]
+	vouchers                *table[client.Voucher]
+	accounts                *table[client.Account]
+	financialYears          *table[client.FinancialYear]
+	supplierInvoices        *table[client.SupplierInvoice]
+	supplierInvoicePayments *table[client.SupplierInvoicePayment]
+}
+
+// Option configures a Server
+type Option func(s *Server)
+
+// WithClock makes the Server use now for today's date, e.g. default invoice dates and overdue filters
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// WithAccessToken makes the Server only accept requests with the bearer token token, any token is accepted by default
+func WithAccessToken(token string) Option {
+	return func(s *Server) {
+		s.accessToken = token
+	}
+}
+
+// WithRateLimit answers 429 Too Many Requests once an access token made limit requests within window.
+// Fortnox allows 25 requests per 5 seconds, the fake doesn't limit by default.
+func WithRateLimit(limit int, window time.Duration) Option {
+	return func(s *Server) {
+		s.limit = limit
+		s.window = window
+	}
+}
+
+// NewServer starts a fake Fortnox API holding a financial year for the current year and a basic chart of accounts.
+// Close it when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		now:                     time.Now,
+		calls:                   map[string][]time.Time{},
+		seq:                     map[string]int{},
+		customers:               newTable[client.Customer](),
+		articles:                newTable[client.Article](),
+		suppliers:               newTable[client.Supplier](),
+		invoices:                newTable[client.Invoice](),
+		invoicePayments:         newTable[client.InvoicePayment](),
+		orders:                  newTable[client.Order](),
+		offers:                  newTable[client.Offer](),
+		vouchers:                newTable[client.Voucher](),
+		accounts:                newTable[client.Account](),
+		financialYears:          newTable[client.FinancialYear](),
+		supplierInvoices:        newTable[client.SupplierInvoice](),
+		supplierInvoicePayments: newTable[client.SupplierInvoicePayment](),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.seedBookkeeping()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// BaseURL returns the URL to pass to client.WithURLOpt
+func (s *Server) BaseURL() string {
+	return s.URL + apiPrefix
+}
+
+// NewClient returns a client talking to the Server, without client side rate limiting.
+// opts are applied after the defaults so they can override them.
+func (s *Server) NewClient(opts ...client.OptionFunc) *client.Client {
+	defaults := []client.OptionFunc{
+		client.WithURLOpt(s.BaseURL()),
+		client.WithAuthOpt(AccessToken, ClientSecret),
+		client.WithRateLimitOpt(0, 0),
+	}
+
+	return client.NewClient(append(defaults, opts...)...)
+}
+
+// FailNext makes the next request fail with status and a Fortnox error of code and message,
+// e.g. to test how code handles a 500 or a locked period. Calls queue up.
+func (s *Server) FailNext(status, code int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, &apiError{status: status, code: code, message: message})
+}
+
+// today returns the current date in the Server's clock
+func (s *Server) today() client.Date {
+	return client.DateOf(s.now())
+}
+
+// nextNumber returns the next number of the sequence name, starting at 1
+func (s *Server) nextNumber(name string) int {
+	s.seq[name]++
+	return s.seq[name]
+}
+
+// request is an API call routed to a resource handler
+type request struct {
+	method string
+	// parts are the path segments after the resource, e.g. ["1", "bookkeep"] for invoices/1/bookkeep
+	parts []string
+	query url.Values
+	body  []byte
+}
+
+// handler serves the calls of a resource, a nil result answers 204 No Content
+type handler func(s *Server, req *request) (interface{}, error)
+
+var routes = map[string]handler{
+	"accounts":                (*Server).serveAccounts,
+	"articles":                (*Server).serveArticles,
+	"customers":               (*Server).serveCustomers,
+	"financialyears":          (*Server).serveFinancialYears,
+	"invoicepayments":         (*Server).serveInvoicePayments,
+	"invoices":                (*Server).serveInvoices,
+	"offers":                  (*Server).serveOffers,
+	"orders":                  (*Server).serveOrders,
+	"supplierinvoicepayments": (*Server).serveSupplierInvoicePayments,
+	"supplierinvoices":        (*Server).serveSupplierInvoices,
+	"suppliers":               (*Server).serveSuppliers,
+	"vouchers":                (*Server).serveVouchers,
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, invalid(codeInvalidData, "can't read body: %s", err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || (s.accessToken != "" && token != s.accessToken) {
+		writeError(w, &apiError{
+			status:  http.StatusUnauthorized,
+			code:    codeUnauthorized,
+			message: "Kan inte logga in, access-token eller client-secret saknas(2).",
+		})
+		return
+	}
+
+	if retryAfter, limited := s.rateLimited(token); limited {
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		writeError(w, &apiError{status: http.StatusTooManyRequests, message: "Too Many Requests"})
+		return
+	}
+
+	if len(s.failures) > 0 {
+		failure := s.failures[0]
+		s.failures = s.failures[1:]
+		writeError(w, failure)
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")
+	parts := strings.Split(path, "/")
+
+	serve, ok := routes[parts[0]]
+	if !ok || !strings.HasPrefix(r.URL.Path, apiPrefix) {
+		writeError(w, notFound(codeNotFound, "Resursen %s finns inte", r.URL.Path))
+		return
+	}
+
+	result, err := serve(s, &request{
+		method: r.Method,
+		parts:  parts[1:],
+		query:  r.URL.Query(),
+		body:   body,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	switch res := result.(type) {
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	case *document:
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.fileName))
+		_, _ = w.Write(res.content)
+	default:
+		status := http.StatusOK
+		if r.Method == http.MethodPost {
+			status = http.StatusCreated
+		}
+		writeJSON(w, status, res)
+	}
+}
+
+// rateLimited records a call of token and reports whether it is over the limit, along with the seconds to wait
+func (s *Server) rateLimited(token string) (int, bool) {
+	if s.limit <= 0 {
+		return 0, false
+	}
+
+	now := s.now()
+	since := now.Add(-s.window)
+
+	calls := s.calls[token][:0]
+	for _, t := range s.calls[token] {
+		if t.After(since) {
+			calls = append(calls, t)
+		}
+	}
+
+	if len(calls) >= s.limit {
+		s.calls[token] = calls
+		wait := calls[0].Add(s.window).Sub(now)
+		return int(math.Ceil(wait.Seconds())), true
+	}
+
+	s.calls[token] = append(calls, now)
+
+	return 0, false
+}
+
+// apiError is answered as a Fortnox ErrorInformation body
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%d %d: %s", e.status, e.code, e.message)
+}
+
+func notFound(code int, format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusNotFound, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func invalid(code int, format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func errMethodNotAllowed(req *request) *apiError {
+	return &apiError{
+		status:  http.StatusMethodNotAllowed,
+		code:    codeInvalidParameter,
+		message: fmt.Sprintf("Metoden %s stöds inte", req.method),
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = &apiError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+
+	writeJSON(w, e.status, map[string]interface{}{
+		"ErrorInformation": map[string]interface{}{
+			"error":   1,
+			"message": e.message,
+			"code":    e.code,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// decode decodes the body of req into v
+func decode(req *request, v interface{}) error {
+	if len(bytes.TrimSpace(req.body)) == 0 {
+		return invalid(codeInvalidData, "Ingen eller felaktig typ av data.")
+	}
+
+	if err := json.Unmarshal(req.body, v); err != nil {
+		return invalid(codeInvalidJSON, "Error deserializing JSON: %s", err)
+	}
+
+	return nil
+}
+
+// merge applies the fields of the object wrapped in key of the body of req to dst, as Fortnox does on PUT:
+// fields that are not sent are kept
+func merge[T any](req *request, key string, dst *T) error {
+	sent, err := changes(req, key)
+	if err != nil {
+		return err
+	}
+
+	current, err := json.Marshal(dst)
+	if err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(current, &fields); err != nil {
+		return err
+	}
+
+	for k, v := range sent {
+		fields[k] = v
+	}
+
+	merged, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	// decode into a zero value so replaced rows don't keep fields of the old ones
+	var updated T
+	if err := json.Unmarshal(merged, &updated); err != nil {
+		return invalid(codeInvalidJSON, "Error deserializing JSON: %s", err)
+	}
+	*dst = updated
+
+	return nil
+}
+
+// changes returns the fields sent in the object wrapped in key of the body of req
+func changes(req *request, key string) (map[string]json.RawMessage, error) {
+	var wrapper map[string]json.RawMessage
+	if err := decode(req, &wrapper); err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(wrapper[key], &fields); err != nil {
+		return nil, invalid(codeInvalidJSON, "Error deserializing JSON: %s", err)
+	}
+
+	return fields, nil
+}
+
+// document is a printed document, answered as a PDF
+type document struct {
+	fileName string
+	content  []byte
+}
+
+func newDocument(kind, number string) *document {
+	return &document{
+		fileName: fmt.Sprintf("%s_%s.pdf", kind, number),
+		content:  []byte(fmt.Sprintf("%%PDF-1.4\n%% fortnoxtest %s %s\n%%%%EOF\n", kind, number)),
+	}
+}
+
+// page returns the part of items selected by the page, limit and offset params, along with its MetaInformation
+func page[T any](req *request, items []T) ([]T, client.MetaInformation, error) {
+	limit, err := intParam(req, "limit", client.DefaultPageLimit)
+	if err != nil {
+		return nil, client.MetaInformation{}, err
+	}
+	if limit < 1 || limit > client.MaxPageLimit {
+		return nil, client.MetaInformation{}, invalid(codeInvalidParameter, "limit måste vara mellan 1 och %d", client.MaxPageLimit)
+	}
+
+	current, err := intParam(req, "page", 1)
+	if err != nil {
+		return nil, client.MetaInformation{}, err
+	}
+	if current < 0 {
+		return nil, client.MetaInformation{}, invalid(codeInvalidParameter, "Ogiltig parameter i anropet. (page)")
+	}
+	if current == 0 {
+		current = 1
+	}
+
+	offset, err := intParam(req, "offset", 0)
+	if err != nil {
+		return nil, client.MetaInformation{}, err
+	}
+	if offset < 0 {
+		return nil, client.MetaInformation{}, invalid(codeInvalidParameter, "Ogiltig parameter i anropet. (offset)")
+	}
+
+	meta := client.MetaInformation{
+		TotalResources: len(items),
+		TotalPages:     (len(items) + limit - 1) / limit,
+		CurrentPage:    current,
+	}
+	if meta.TotalPages == 0 {
+		meta.TotalPages = 1
+	}
+
+	// page and offset may be far past the end, compare before adding so the sum can't overflow
+	start := len(items)
+	if current-1 <= len(items)/limit {
+		start = (current - 1) * limit
+		if offset < len(items)-start {
+			start += offset
+		} else {
+			start = len(items)
+		}
+	}
+	end := len(items)
+	if limit < end-start {
+		end = start + limit
+	}
+
+	return items[start:end], meta, nil
+}
+
+func intParam(req *request, name string, def int) (int, error) {
+	v := req.query.Get(name)
+	if v == "" {
+		return def, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, invalid(codeInvalidParameter, "Ogiltig parameter i anropet. (%s)", name)
+	}
+
+	return i, nil
+}
+
+// dateParam returns the date param name, the zero Date if not given
+func dateParam(req *request, name string) (client.Date, error) {
+	d, err := client.ParseDate(req.query.Get(name))
+	if err != nil {
+		return "", invalid(codeInvalidParameter, "Ogiltig parameter i anropet. (%s)", name)
+	}
+
+	return d, nil
+}
+
+func errUnknownFilter(filter string) error {
+	return invalid(codeInvalidParameter, "Ogiltig parameter i anropet. (filter=%s)", filter)
+}
@@ -0,0 +1,253 @@
+package fortnoxtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// call sends a raw request to srv and decodes the JSON answer
+func call(t *testing.T, srv *Server, method, path, token string) (int, map[string]json.RawMessage) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, srv.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var body map[string]json.RawMessage
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+
+	return resp.StatusCode, body
+}
+
+// errorCode returns the code of the ErrorInformation of body, 0 if there is none
+func errorCode(t *testing.T, body map[string]json.RawMessage) int {
+	t.Helper()
+
+	var info struct {
+		Code int `json:"code"`
+	}
+	if raw, ok := body["ErrorInformation"]; ok {
+		if err := json.Unmarshal(raw, &info); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return info.Code
+}
+
+func seedCustomers(t *testing.T, srv *Server, n int) {
+	t.Helper()
+
+	c := srv.NewClient()
+	for i := 1; i <= n; i++ {
+		if _, err := c.CreateCustomer(context.Background(), &client.Customer{Name: fmt.Sprintf("Customer %d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestPaging(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	seedCustomers(t, srv, 7)
+
+	tests := []struct {
+		query     string
+		wantFirst int64
+		wantLen   int
+		wantPages int
+	}{
+		{"", 1, 7, 1},
+		{"limit=3", 1, 3, 3},
+		{"limit=3&page=2", 4, 3, 3},
+		{"limit=3&page=3", 7, 1, 3},
+		{"limit=3&page=4", 0, 0, 3},
+		{"limit=3&page=0", 1, 3, 3},
+		{"limit=3&offset=2", 3, 3, 3},
+		{"limit=3&page=3&offset=1", 0, 0, 3},
+		{"limit=3&offset=100", 0, 0, 3},
+		{"limit=500&page=9223372036854775807", 0, 0, 1},
+		{"limit=1&offset=9223372036854775807", 0, 0, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			status, body := call(t, srv, http.MethodGet, "/3/customers?"+tt.query, AccessToken)
+			if status != http.StatusOK {
+				t.Fatalf("status = %d, body %s", status, body["ErrorInformation"])
+			}
+
+			var customers []client.Customer
+			var meta client.MetaInformation
+			if err := json.Unmarshal(body["Customers"], &customers); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(body["MetaInformation"], &meta); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(customers) != tt.wantLen {
+				t.Fatalf("got %d customers, want %d", len(customers), tt.wantLen)
+			}
+			if tt.wantLen > 0 && customers[0].CustomerNumber != tt.wantFirst {
+				t.Errorf("first customer = %d, want %d", customers[0].CustomerNumber, tt.wantFirst)
+			}
+			if meta.TotalResources != 7 || meta.TotalPages != tt.wantPages {
+				t.Errorf("meta = %+v, want 7 resources on %d pages", meta, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestPagingIterator(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	seedCustomers(t, srv, 12)
+
+	customers, err := srv.NewClient().IterateCustomers().Limit(5).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(customers) != 12 {
+		t.Errorf("got %d customers, want 12", len(customers))
+	}
+}
+
+func TestFiltering(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := srv.NewClient()
+
+	customer, err := c.CreateCustomer(ctx, &client.Customer{Name: "Acme AB"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var numbers []string
+	for i := 0; i < 3; i++ {
+		invoice, err := c.CreateInvoice(ctx, &client.Invoice{
+			CustomerNumber: fmt.Sprint(customer.CustomerNumber),
+			InvoiceRows: []client.InvoiceRow{{
+				Description:       "Consulting",
+				DeliveredQuantity: "1",
+				Price:             client.MoneyFromInt(100),
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		numbers = append(numbers, invoice.DocumentNumber)
+	}
+
+	if _, err := c.CancelInvoice(ctx, numbers[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		filter client.GetAllInvoicesFilter
+		want   []string
+	}{
+		{"", numbers},
+		{client.Cancelled, numbers[:1]},
+		{client.Unpaid, numbers[1:]},
+		{client.Unbooked, numbers[1:]},
+		{client.FullyPaid, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.filter), func(t *testing.T) {
+			invoices, err := c.GetAllInvoices(ctx, &client.GetAllInvoicesQueryParams{Filter: tt.filter})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, invoice := range invoices {
+				got = append(got, invoice.DocumentNumber)
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("invoices = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorResponses(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       []Option
+		prepare    func(t *testing.T, srv *Server)
+		method     string
+		path       string
+		token      string
+		wantStatus int
+		wantCode   int
+	}{
+		{"no token", nil, nil, http.MethodGet, "/3/customers", "", http.StatusUnauthorized, codeUnauthorized},
+		{"wrong token", []Option{WithAccessToken("right")}, nil, http.MethodGet, "/3/customers", "wrong", http.StatusUnauthorized, codeUnauthorized},
+		{"unknown resource", nil, nil, http.MethodGet, "/3/nothing", AccessToken, http.StatusNotFound, codeNotFound},
+		{"unknown customer", nil, nil, http.MethodGet, "/3/customers/99", AccessToken, http.StatusNotFound, codeCustomerNotFound},
+		{"method not allowed", nil, nil, http.MethodPatch, "/3/customers", AccessToken, http.StatusMethodNotAllowed, codeInvalidParameter},
+		{"unknown filter", nil, nil, http.MethodGet, "/3/invoices?filter=lost", AccessToken, http.StatusBadRequest, codeInvalidParameter},
+		{"limit too big", nil, nil, http.MethodGet, "/3/customers?limit=501", AccessToken, http.StatusBadRequest, codeInvalidParameter},
+		{"negative limit", nil, nil, http.MethodGet, "/3/customers?limit=-1", AccessToken, http.StatusBadRequest, codeInvalidParameter},
+		{"negative page", nil, nil, http.MethodGet, "/3/customers?page=-1", AccessToken, http.StatusBadRequest, codeInvalidParameter},
+		{"negative offset", nil, nil, http.MethodGet, "/3/customers?offset=-5", AccessToken, http.StatusBadRequest, codeInvalidParameter},
+		{"not a number", nil, nil, http.MethodGet, "/3/customers?page=two", AccessToken, http.StatusBadRequest, codeInvalidParameter},
+		{"missing body", nil, nil, http.MethodPost, "/3/customers", AccessToken, http.StatusBadRequest, codeInvalidData},
+		{
+			"fail next",
+			nil,
+			func(t *testing.T, srv *Server) { srv.FailNext(http.StatusServiceUnavailable, 1, "down") },
+			http.MethodGet, "/3/customers", AccessToken, http.StatusServiceUnavailable, 1,
+		},
+		{
+			"rate limited",
+			[]Option{WithRateLimit(1, time.Minute)},
+			func(t *testing.T, srv *Server) { call(t, srv, http.MethodGet, "/3/customers", AccessToken) },
+			http.MethodGet, "/3/customers", AccessToken, http.StatusTooManyRequests, 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := NewServer(tt.opts...)
+			defer srv.Close()
+
+			if tt.prepare != nil {
+				tt.prepare(t, srv)
+			}
+
+			status, body := call(t, srv, tt.method, tt.path, tt.token)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			if code := errorCode(t, body); code != tt.wantCode {
+				t.Errorf("code = %d, want %d", code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package fortnoxtest
+
+// table keeps the resources of one kind by their number, in the order they were created
+type table[T any] struct {
+	keys []string
+	rows map[string]*T
+}
+
+func newTable[T any]() *table[T] {
+	return &table[T]{rows: map[string]*T{}}
+}
+
+func (t *table[T]) get(key string) (*T, bool) {
+	v, ok := t.rows[key]
+	return v, ok
+}
+
+func (t *table[T]) has(key string) bool {
+	_, ok := t.rows[key]
+	return ok
+}
+
+// put stores v under key, replacing the resource stored under it
+func (t *table[T]) put(key string, v *T) {
+	if !t.has(key) {
+		t.keys = append(t.keys, key)
+	}
+	t.rows[key] = v
+}
+
+func (t *table[T]) delete(key string) {
+	if !t.has(key) {
+		return
+	}
+
+	delete(t.rows, key)
+	for i, k := range t.keys {
+		if k == key {
+			t.keys = append(t.keys[:i], t.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// list returns copies of the resources keep reports true for, keep nil returns all
+func (t *table[T]) list(keep func(v *T) bool) []T {
+	items := make([]T, 0, len(t.keys))
+	for _, k := range t.keys {
+		v := t.rows[k]
+		if keep == nil || keep(v) {
+			items = append(items, *v)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,158 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveSupplierInvoicePayments serves supplierinvoicepayments, supplierinvoicepayments/{Number}
+// and supplierinvoicepayments/{Number}/bookkeep
+func (s *Server) serveSupplierInvoicePayments(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			keep := func(p *client.SupplierInvoicePayment) bool {
+				return !req.query.Has("invoicenumber") || p.InvoiceNumber == req.query.Get("invoicenumber")
+			}
+
+			payments, meta, err := page(req, s.supplierInvoicePayments.list(keep))
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.GetAllSupplierInvoicePaymentsResp{SupplierInvoicePayments: payments, MetaInformation: meta}, nil
+		case http.MethodPost:
+			return s.createSupplierInvoicePayment(req)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	number := req.parts[0]
+	payment, ok := s.supplierInvoicePayments.get(number)
+	if !ok {
+		return nil, notFound(codeNotFound, "Kan inte hitta betalningen.")
+	}
+
+	if len(req.parts) == 2 && req.parts[1] == "bookkeep" {
+		if payment.Booked {
+			return nil, invalid(codeInvalidData, "Betalningen är redan bokförd.")
+		}
+
+		voucher, err := s.bookVoucher(&client.Voucher{
+			VoucherSeries:   "E",
+			TransactionDate: payment.PaymentDate,
+			Description:     "Utbetalning leverantörsfaktura " + payment.InvoiceNumber,
+			ReferenceNumber: strconv.Itoa(payment.Number),
+			ReferenceType:   "SUPPLIERPAYMENT",
+			VoucherRows: entries(
+				entry(accountPayables, payment.Amount),
+				entry(accountBank, payment.Amount.Neg()),
+			),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		payment.Booked = true
+		payment.VoucherSeries = voucher.VoucherSeries
+		payment.VoucherNumber = voucher.VoucherNumber
+		payment.VoucherYear = voucher.Year
+
+		return &client.BookKeepSupplierInvoicePaymentResp{SupplierInvoicePayment: *payment}, nil
+	}
+	if len(req.parts) > 1 {
+		return nil, notFound(codeNotFound, "Åtgärden %s finns inte.", req.parts[1])
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return &client.GetSupplierInvoicePaymentResp{SupplierInvoicePayment: *payment}, nil
+	case http.MethodPut:
+		if payment.Booked {
+			return nil, invalid(codeInvalidData, "Betalningen är bokförd och kan inte ändras.")
+		}
+
+		updated := *payment
+		if err := merge(req, "SupplierInvoicePayment", &updated); err != nil {
+			return nil, err
+		}
+		updated.Url = payment.Url
+		updated.Number = payment.Number
+		updated.InvoiceNumber = payment.InvoiceNumber
+
+		invoice, err := s.supplierInvoice(payment.InvoiceNumber)
+		if err != nil {
+			return nil, err
+		}
+		applySupplierInvoicePayment(invoice, updated.Amount.Sub(payment.Amount), updated.PaymentDate)
+
+		*payment = updated
+
+		return &client.UpdateSupplierInvoicePaymentResp{SupplierInvoicePayment: updated}, nil
+	case http.MethodDelete:
+		if payment.Booked {
+			return nil, invalid(codeInvalidData, "Betalningen är bokförd och kan inte tas bort.")
+		}
+
+		if invoice, ok := s.supplierInvoices.get(payment.InvoiceNumber); ok {
+			applySupplierInvoicePayment(invoice, payment.Amount.Neg(), payment.PaymentDate)
+		}
+		s.supplierInvoicePayments.delete(number)
+
+		return nil, nil
+	}
+
+	return nil, errMethodNotAllowed(req)
+}
+
+func (s *Server) createSupplierInvoicePayment(req *request) (interface{}, error) {
+	body := &client.CreateSupplierInvoicePaymentReq{}
+	if err := decode(req, body); err != nil {
+		return nil, err
+	}
+
+	payment := body.SupplierInvoicePayment
+
+	invoice, ok := s.supplierInvoices.get(payment.InvoiceNumber)
+	if !ok {
+		return nil, invalid(codeInvalidData, "Kan inte hitta leverantörsfakturan %s.", payment.InvoiceNumber)
+	}
+	if invoice.Cancelled {
+		return nil, invalid(codeInvalidData, "Leverantörsfakturan är makulerad och kan inte betalas.")
+	}
+	if payment.Amount.IsZero() {
+		return nil, invalid(codeInvalidData, "Belopp måste anges.")
+	}
+
+	payment.Number = s.nextNumber("supplierinvoicepayments")
+	payment.Url = s.BaseURL() + "supplierinvoicepayments/" + strconv.Itoa(payment.Number)
+	if payment.PaymentDate.IsZero() {
+		payment.PaymentDate = s.today()
+	}
+	if payment.Currency == "" {
+		payment.Currency = invoice.Currency
+	}
+	payment.InvoiceSupplierName = invoice.SupplierName
+	payment.InvoiceSupplierNumber = invoice.SupplierNumber
+	payment.InvoiceDueDate = invoice.DueDate
+	payment.InvoiceOCR = invoice.OCR
+	payment.InvoiceTotal = invoice.Total
+
+	applySupplierInvoicePayment(invoice, payment.Amount, payment.PaymentDate)
+	s.supplierInvoicePayments.put(strconv.Itoa(payment.Number), &payment)
+
+	return &client.CreateSupplierInvoicePaymentResp{SupplierInvoicePayment: payment}, nil
+}
+
+// applySupplierInvoicePayment reduces the balance of invoice by amount, the invoice is fully paid on date when nothing is left
+func applySupplierInvoicePayment(invoice *client.SupplierInvoice, amount client.Money, date client.Date) {
+	invoice.Balance = invoice.Balance.Sub(amount)
+
+	invoice.FinalPayDate = ""
+	if invoice.Balance.IsZero() {
+		invoice.FinalPayDate = date
+	}
+}
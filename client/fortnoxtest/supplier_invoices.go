@@ -0,0 +1,337 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveSupplierInvoices serves supplierinvoices, supplierinvoices/{GivenNumber} and its actions
+func (s *Server) serveSupplierInvoices(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			return s.listSupplierInvoices(req)
+		case http.MethodPost:
+			body := &client.CreateSupplierInvoiceReq{}
+			if err := decode(req, body); err != nil {
+				return nil, err
+			}
+
+			invoice, err := s.createSupplierInvoice(body.SupplierInvoice)
+			if err != nil {
+				return nil, err
+			}
+
+			return &client.CreateSupplierInvoiceResp{SupplierInvoice: *invoice}, nil
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	invoice, err := s.supplierInvoice(req.parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.parts) == 1 {
+		switch req.method {
+		case http.MethodGet:
+			return &client.GetSupplierInvoiceResp{SupplierInvoice: *invoice}, nil
+		case http.MethodPut:
+			return s.updateSupplierInvoice(req, invoice)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	switch action := req.parts[1]; action {
+	case "bookkeep":
+		if err := s.bookkeepSupplierInvoice(invoice); err != nil {
+			return nil, err
+		}
+		return &client.BookKeepSupplierInvoiceResp{SupplierInvoice: *invoice}, nil
+	case "cancel":
+		if invoice.Booked {
+			return nil, invalid(codeInvalidData, "Leverantörsfakturan är bokförd och kan inte makuleras.")
+		}
+		if invoice.Cancelled {
+			return nil, invalid(codeInvalidData, "Leverantörsfakturan är redan makulerad.")
+		}
+		invoice.Cancelled = true
+		invoice.Balance = client.Money{}
+		return &client.CancelSupplierInvoiceResp{SupplierInvoice: *invoice}, nil
+	case "credit":
+		if err := s.creditSupplierInvoice(invoice); err != nil {
+			return nil, err
+		}
+		return &client.CreditSupplierInvoicePaymentResp{SupplierInvoice: *invoice}, nil
+	case "approvalpayment":
+		return &client.ApprovalSupplierInvoicePaymentResp{SupplierInvoice: *invoice}, nil
+	case "approvalbookkeep":
+		return &client.ApprovalSupplierInvoiceBookKeepResp{SupplierInvoice: *invoice}, nil
+	default:
+		return nil, notFound(codeNotFound, "Åtgärden %s finns inte.", action)
+	}
+}
+
+// supplierInvoice returns the supplier invoice numbered givenNumber
+func (s *Server) supplierInvoice(givenNumber string) (*client.SupplierInvoice, error) {
+	invoice, ok := s.supplierInvoices.get(givenNumber)
+	if !ok {
+		return nil, notFound(codeNotFound, "Kan inte hitta leverantörsfakturan.")
+	}
+
+	return invoice, nil
+}
+
+func (s *Server) listSupplierInvoices(req *request) (interface{}, error) {
+	today := s.today()
+
+	var keep func(i *client.SupplierInvoice) bool
+	switch filter := req.query.Get("filter"); filter {
+	case "":
+	case string(client.CancelledSupplierInvoiceFilter):
+		keep = func(i *client.SupplierInvoice) bool { return i.Cancelled }
+	case "fullypaid":
+		keep = func(i *client.SupplierInvoice) bool { return !i.Cancelled && i.Balance.IsZero() }
+	case string(client.UnpaidSupplierInvoiceFilter):
+		keep = func(i *client.SupplierInvoice) bool { return !i.Cancelled && !i.Balance.IsZero() }
+	case string(client.UnPaidOverdueSupplierInvoiceFilter):
+		keep = func(i *client.SupplierInvoice) bool {
+			return !i.Cancelled && !i.Balance.IsZero() && i.DueDate.Before(today)
+		}
+	case string(client.UnbookedSupplierInvoiceFilter):
+		keep = func(i *client.SupplierInvoice) bool { return !i.Cancelled && !i.Booked }
+	case string(client.PendingPaymentSupplierInvoiceFilter):
+		keep = func(i *client.SupplierInvoice) bool { return i.PaymentPending }
+	case string(client.AuthorizePendingSupplierInvoiceFilter):
+		// the fake has no attest flow, nothing waits for authorization
+		keep = func(i *client.SupplierInvoice) bool { return false }
+	default:
+		return nil, errUnknownFilter(filter)
+	}
+
+	invoices, meta, err := page(req, s.supplierInvoices.list(keep))
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.GetAllSupplierInvoicesResp{SupplierInvoices: invoices, MetaInformation: meta}, nil
+}
+
+// createSupplierInvoice validates and stores invoice, filling in what Fortnox does.
+// Without rows the invoice is accounted as a purchase of goods with its Total and VAT.
+func (s *Server) createSupplierInvoice(invoice client.SupplierInvoice) (*client.SupplierInvoice, error) {
+	supplier, ok := s.suppliers.get(invoice.SupplierNumber)
+	if !ok {
+		return nil, invalid(codeInvalidData, "Kan inte hitta leverantören.")
+	}
+
+	invoice.GivenNumber = strconv.Itoa(s.nextNumber("supplierinvoices"))
+	invoice.Url = s.BaseURL() + "supplierinvoices/" + invoice.GivenNumber
+
+	if invoice.SupplierName == "" {
+		invoice.SupplierName = supplier.Name
+	}
+	if invoice.InvoiceDate.IsZero() {
+		invoice.InvoiceDate = s.today()
+	}
+	if invoice.DueDate.IsZero() {
+		invoice.DueDate = invoice.InvoiceDate.AddDays(30)
+	}
+	if invoice.Currency == "" {
+		invoice.Currency = "SEK"
+	}
+
+	if err := s.accountSupplierInvoice(&invoice); err != nil {
+		return nil, err
+	}
+	invoice.Balance = invoice.Total
+
+	s.supplierInvoices.put(invoice.GivenNumber, &invoice)
+
+	return &invoice, nil
+}
+
+func (s *Server) updateSupplierInvoice(req *request, invoice *client.SupplierInvoice) (interface{}, error) {
+	if invoice.Booked {
+		return nil, invalid(codeInvalidData, "Leverantörsfakturan är bokförd och kan inte ändras.")
+	}
+	if invoice.Cancelled {
+		return nil, invalid(codeInvalidData, "Leverantörsfakturan är makulerad och kan inte ändras.")
+	}
+
+	updated := *invoice
+	if err := merge(req, "SupplierInvoice", &updated); err != nil {
+		return nil, err
+	}
+
+	if !s.suppliers.has(updated.SupplierNumber) {
+		return nil, invalid(codeInvalidData, "Kan inte hitta leverantören.")
+	}
+
+	updated.Url = invoice.Url
+	updated.GivenNumber = invoice.GivenNumber
+	updated.Booked = invoice.Booked
+	updated.Cancelled = invoice.Cancelled
+	updated.Credit = invoice.Credit
+	updated.CreditReference = invoice.CreditReference
+	updated.FinalPayDate = invoice.FinalPayDate
+	updated.VoucherNumber = invoice.VoucherNumber
+	updated.VoucherSeries = invoice.VoucherSeries
+	updated.VoucherYear = invoice.VoucherYear
+
+	sent, _ := changes(req, "SupplierInvoice")
+	_, rowsSent := sent["SupplierInvoiceRows"]
+	_, totalSent := sent["Total"]
+	_, vatSent := sent["VAT"]
+	switch {
+	case rowsSent && !totalSent:
+		// the Total is taken from the new rows
+		updated.Total = client.Money{}
+	case !rowsSent && (totalSent || vatSent):
+		// the rows are derived from the new Total and VAT
+		updated.SupplierInvoiceRows = nil
+	}
+	if err := s.accountSupplierInvoice(&updated); err != nil {
+		return nil, err
+	}
+	paid := invoice.Total.Sub(invoice.Balance)
+	updated.Balance = updated.Total.Sub(paid)
+
+	*invoice = updated
+
+	return &client.UpdateSupplierInvoiceResp{SupplierInvoice: updated}, nil
+}
+
+// accountSupplierInvoice checks the rows of invoice balance, or derives them from its Total and VAT when there are none
+func (s *Server) accountSupplierInvoice(invoice *client.SupplierInvoice) error {
+	if len(invoice.SupplierInvoiceRows) == 0 {
+		if invoice.Total.IsZero() {
+			return invalid(codeInvalidData, "Leverantörsfakturan saknar belopp.")
+		}
+
+		rows := entries(
+			entry(accountPayables, invoice.Total.Neg()),
+			entry(accountInputVAT, invoice.VAT),
+			entry(accountPurchases, invoice.Total.Sub(invoice.VAT)),
+		)
+		for _, r := range rows {
+			invoice.SupplierInvoiceRows = append(invoice.SupplierInvoiceRows, client.SupplierInvoiceRow{
+				Account: r.Account,
+				Debit:   r.Debit,
+				Credit:  r.Credit,
+			})
+		}
+
+		return nil
+	}
+
+	var debit, credit, payable client.Money
+	for _, r := range invoice.SupplierInvoiceRows {
+		if !s.accounts.has(strconv.Itoa(r.Account)) {
+			return invalid(codeAccountNotFound, "Kontot %d finns inte i kontoplanen.", r.Account)
+		}
+
+		debit = debit.Add(r.Debit)
+		credit = credit.Add(r.Credit)
+		if r.Account == accountPayables {
+			payable = payable.Add(r.Credit).Sub(r.Debit)
+		}
+	}
+
+	if debit.Cmp(credit) != 0 {
+		return invalid(codeSupplierInvoiceBal, "Leverantörsfakturan balanserar inte.")
+	}
+	if invoice.Total.IsZero() {
+		invoice.Total = payable
+	}
+
+	return nil
+}
+
+// bookkeepSupplierInvoice books invoice with a voucher in the supplier invoice series D
+func (s *Server) bookkeepSupplierInvoice(invoice *client.SupplierInvoice) error {
+	switch {
+	case invoice.Cancelled:
+		return invalid(codeInvalidData, "Leverantörsfakturan är makulerad och kan inte bokföras.")
+	case invoice.Booked:
+		return invalid(codeInvalidData, "Leverantörsfakturan är redan bokförd.")
+	}
+
+	voucher := &client.Voucher{
+		VoucherSeries:   "D",
+		TransactionDate: invoice.InvoiceDate,
+		Description:     "Leverantörsfaktura " + invoice.GivenNumber,
+		ReferenceNumber: invoice.GivenNumber,
+		ReferenceType:   "SUPPLIERINVOICE",
+	}
+	for _, r := range invoice.SupplierInvoiceRows {
+		voucher.VoucherRows = append(voucher.VoucherRows, client.VoucherRow{
+			Account:     r.Account,
+			CostCenter:  r.CostCenter,
+			Project:     r.Project,
+			Debit:       r.Debit,
+			Credit:      r.Credit,
+			Description: r.ItemDescription,
+		})
+	}
+
+	booked, err := s.bookVoucher(voucher)
+	if err != nil {
+		return err
+	}
+
+	invoice.Booked = true
+	invoice.VoucherSeries = booked.VoucherSeries
+	invoice.VoucherNumber = booked.VoucherNumber
+	invoice.VoucherYear = booked.Year
+	invoice.Vouchers = []client.Voucher{*booked}
+
+	return nil
+}
+
+// creditSupplierInvoice creates a credit invoice of the booked invoice, which then has nothing left to pay
+func (s *Server) creditSupplierInvoice(invoice *client.SupplierInvoice) error {
+	switch {
+	case invoice.Cancelled:
+		return invalid(codeInvalidData, "Leverantörsfakturan är makulerad och kan inte krediteras.")
+	case !invoice.Booked:
+		return invalid(codeInvalidData, "Leverantörsfakturan måste vara bokförd för att kunna krediteras.")
+	case invoice.Credit:
+		return invalid(codeInvalidData, "En kreditfaktura kan inte krediteras.")
+	}
+
+	for _, k := range s.supplierInvoices.keys {
+		if strconv.Itoa(s.supplierInvoices.rows[k].CreditReference) == invoice.GivenNumber {
+			return invalid(codeInvalidData, "Leverantörsfakturan är redan krediterad.")
+		}
+	}
+
+	reference, _ := strconv.Atoi(invoice.GivenNumber)
+	credit := client.SupplierInvoice{
+		SupplierNumber:  invoice.SupplierNumber,
+		SupplierName:    invoice.SupplierName,
+		Currency:        invoice.Currency,
+		Credit:          true,
+		CreditReference: reference,
+		Total:           invoice.Total.Neg(),
+		VAT:             invoice.VAT.Neg(),
+	}
+	for _, r := range invoice.SupplierInvoiceRows {
+		r.Debit, r.Credit = r.Credit, r.Debit
+		credit.SupplierInvoiceRows = append(credit.SupplierInvoiceRows, r)
+	}
+
+	created, err := s.createSupplierInvoice(credit)
+	if err != nil {
+		return err
+	}
+	created.Balance = client.Money{}
+
+	invoice.Balance = client.Money{}
+
+	return nil
+}
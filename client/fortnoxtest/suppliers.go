@@ -0,0 +1,90 @@
+package fortnoxtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveSuppliers serves suppliers and suppliers/{SupplierNumber}
+func (s *Server) serveSuppliers(req *request) (interface{}, error) {
+	if len(req.parts) == 0 {
+		switch req.method {
+		case http.MethodGet:
+			return s.listSuppliers(req)
+		case http.MethodPost:
+			return s.createSupplier(req)
+		}
+
+		return nil, errMethodNotAllowed(req)
+	}
+
+	number := req.parts[0]
+	supplier, ok := s.suppliers.get(number)
+	if !ok || len(req.parts) > 1 {
+		return nil, notFound(codeNotFound, "Kan inte hitta leverantören.")
+	}
+
+	switch req.method {
+	case http.MethodGet:
+		return &client.GetSupplierResp{Supplier: *supplier}, nil
+	case http.MethodPut:
+		updated := *supplier
+		if err := merge(req, "Supplier", &updated); err != nil {
+			return nil, err
+		}
+		updated.SupplierNumber = supplier.SupplierNumber
+		updated.Url = supplier.Url
+
+		s.suppliers.put(number, &updated)
+
+		return &client.UpdateSupplierResp{Supplier: updated}, nil
+	}
+
+	return nil, errMethodNotAllowed(req)
+}
+
+func (s *Server) listSuppliers(req *request) (interface{}, error) {
+	keep, err := activeFilter[client.Supplier](req, func(su *client.Supplier) bool { return su.Active })
+	if err != nil {
+		return nil, err
+	}
+
+	suppliers, meta, err := page(req, s.suppliers.list(keep))
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.GetAllSuppliersResp{Suppliers: suppliers, MetaInformation: meta}, nil
+}
+
+func (s *Server) createSupplier(req *request) (interface{}, error) {
+	body := &client.CreateSupplierReq{}
+	if err := decode(req, body); err != nil {
+		return nil, err
+	}
+
+	supplier := body.Supplier
+	if supplier.Name == "" {
+		return nil, invalid(codeInvalidData, "Leverantörsnamn måste anges.")
+	}
+
+	if supplier.SupplierNumber == "" {
+		for supplier.SupplierNumber == "" || s.suppliers.has(supplier.SupplierNumber) {
+			supplier.SupplierNumber = strconv.Itoa(s.nextNumber("suppliers"))
+		}
+	}
+
+	if s.suppliers.has(supplier.SupplierNumber) {
+		return nil, invalid(codeNumberAlreadyUsed, "Leverantörsnummer %s används redan.", supplier.SupplierNumber)
+	}
+
+	// Fortnox creates suppliers as active, Active is left out of the request when false
+	supplier.Active = true
+	supplier.Url = s.BaseURL() + "suppliers/" + supplier.SupplierNumber
+
+	s.suppliers.put(supplier.SupplierNumber, &supplier)
+
+	return &client.CreateSupplierResp{Supplier: supplier}, nil
+}
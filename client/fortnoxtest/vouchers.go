@@ -0,0 +1,151 @@
+package fortnoxtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+// serveVouchers serves vouchers, vouchers/{VoucherSeries}/{VoucherNumber} and vouchers/sublist/{VoucherSeries}
+func (s *Server) serveVouchers(req *request) (interface{}, error) {
+	year, err := s.voucherYear(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(req.parts) == 0 && req.method == http.MethodGet:
+		vouchers, meta, err := page(req, s.vouchers.list(func(v *client.Voucher) bool { return v.Year == year }))
+		if err != nil {
+			return nil, err
+		}
+
+		return &client.GetAllVouchersResp{Vouchers: vouchers, MetaInformation: meta}, nil
+	case len(req.parts) == 0 && req.method == http.MethodPost:
+		body := &client.CreateVoucherReq{}
+		if err := decode(req, body); err != nil {
+			return nil, err
+		}
+
+		voucher := body.Voucher
+		if voucher.VoucherSeries == "" {
+			return nil, invalid(codeInvalidData, "Verifikationsserie måste anges.")
+		}
+		if voucher.TransactionDate.IsZero() {
+			return nil, invalid(codeInvalidData, "Transaktionsdatum måste anges.")
+		}
+
+		created, err := s.bookVoucher(&voucher)
+		if err != nil {
+			return nil, err
+		}
+
+		return &client.CreateVoucherResp{Voucher: *created}, nil
+	case len(req.parts) == 2 && req.parts[0] == "sublist" && req.method == http.MethodGet:
+		series := req.parts[1]
+		keep := func(v *client.Voucher) bool { return v.Year == year && v.VoucherSeries == series }
+
+		vouchers, meta, err := page(req, s.vouchers.list(keep))
+		if err != nil {
+			return nil, err
+		}
+
+		return &client.GetVouchersBySeriesResp{Vouchers: vouchers, MetaInformation: meta}, nil
+	case len(req.parts) == 2 && req.method == http.MethodGet:
+		number, err := strconv.Atoi(req.parts[1])
+		if err != nil {
+			return nil, notFound(codeNotFound, "Kan inte hitta verifikationen.")
+		}
+
+		voucher, ok := s.vouchers.get(voucherKey(year, req.parts[0], number))
+		if !ok {
+			return nil, notFound(codeNotFound, "Kan inte hitta verifikationen.")
+		}
+
+		return &client.GetVoucherResp{Voucher: *voucher}, nil
+	}
+
+	return nil, errMethodNotAllowed(req)
+}
+
+// voucherYear returns the financial year of the financialyear param, the one of today when not given
+func (s *Server) voucherYear(req *request) (int, error) {
+	if req.query.Get("financialyear") == "" {
+		year, err := s.financialYearOf(s.today())
+		if err != nil {
+			return 0, err
+		}
+
+		return year.Id, nil
+	}
+
+	id, err := intParam(req, "financialyear", 0)
+	if err != nil {
+		return 0, err
+	}
+	if !s.financialYears.has(strconv.Itoa(id)) {
+		return 0, invalid(codeInvalidParameter, "Räkenskapsår %d finns inte.", id)
+	}
+
+	return id, nil
+}
+
+// bookVoucher validates voucher and stores it numbered next in its series of the financial year of its date
+func (s *Server) bookVoucher(voucher *client.Voucher) (*client.Voucher, error) {
+	year, err := s.financialYearOf(voucher.TransactionDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(voucher.VoucherRows) < 2 {
+		return nil, invalid(codeInvalidData, "En verifikation måste ha minst två rader.")
+	}
+
+	var debit, credit client.Money
+	for _, r := range voucher.VoucherRows {
+		if !s.accounts.has(strconv.Itoa(r.Account)) {
+			return nil, invalid(codeAccountNotFound, "Kontot %d finns inte i kontoplanen.", r.Account)
+		}
+		debit = debit.Add(r.Debit)
+		credit = credit.Add(r.Credit)
+	}
+	if debit.Cmp(credit) != 0 {
+		return nil, invalid(codeInvalidData, "Verifikationen balanserar inte, debet %s och kredit %s.", debit, credit)
+	}
+
+	v := *voucher
+	v.Year = year.Id
+	v.VoucherNumber = s.nextNumber(fmt.Sprintf("vouchers/%d/%s", year.Id, v.VoucherSeries))
+	v.Url = fmt.Sprintf("%svouchers/%s/%d?financialyear=%d", s.BaseURL(), v.VoucherSeries, v.VoucherNumber, v.Year)
+
+	s.vouchers.put(voucherKey(v.Year, v.VoucherSeries, v.VoucherNumber), &v)
+
+	return &v, nil
+}
+
+func voucherKey(year int, series string, number int) string {
+	return fmt.Sprintf("%d/%s/%d", year, series, number)
+}
+
+// entry is a voucher row on account, debit when amount is positive and credit when negative
+func entry(account int, amount client.Money) client.VoucherRow {
+	if amount.Sign() < 0 {
+		return client.VoucherRow{Account: account, Credit: amount.Neg()}
+	}
+
+	return client.VoucherRow{Account: account, Debit: amount}
+}
+
+// entries returns the rows that move an amount
+func entries(rows ...client.VoucherRow) []client.VoucherRow {
+	var moving []client.VoucherRow
+	for _, r := range rows {
+		if !r.Debit.IsZero() || !r.Credit.IsZero() {
+			moving = append(moving, r)
+		}
+	}
+
+	return moving
+}
@@ -3,8 +3,11 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -26,10 +29,10 @@ var (
 )
 
 // GetRootDirectory does _GET https://api.fortnox.se/3/inbox/
-func (c *Client) GetRootDirectory(ctx context.Context) (*InboxRootFolder, error) {
+func (c *inboxService) GetRootDirectory(ctx context.Context) (*InboxRootFolder, error) {
 	resp := GetRootDirectoryResp{}
 
-	err := c._GET(ctx, inboxURI, nil, resp)
+	err := c._GET(ctx, getRootDirectoryOp, inboxURI, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -43,14 +46,27 @@ func (c *Client) GetRootDirectory(ctx context.Context) (*InboxRootFolder, error)
 //
 // path - path
 //
-// f - file to upload
-func (c *Client) UploadFile(ctx context.Context, params *UploadFileParams, f *InboxFile) (*InboxFile, error) {
-	req := &UploadFileReq{File: *f}
+// fileName - name of the file in the inbox
+//
+// r - file contents, streamed as multipart/form-data
+func (c *inboxService) UploadFile(
+	ctx context.Context,
+	params *UploadFileParams,
+	fileName string,
+	r io.Reader) (*InboxFile, error) {
+
 	resp := &UploadFileResp{}
 
-	p := params.urlValues()
+	if strings.TrimSpace(fileName) == "" {
+		return nil, errors.New("can't upload file without name")
+	}
 
-	err := c._POST(ctx, inboxURI, p, req, resp)
+	p, err := params.urlValues()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c._POST(ctx, uploadFileOp, inboxURI, p, newFileUpload(fileName, r), resp)
 	if err != nil {
 		return nil, err
 	}
@@ -61,44 +77,27 @@ func (c *Client) UploadFile(ctx context.Context, params *UploadFileParams, f *In
 // GetInboxFile does _GET https://api.fortnox.se/3/inbox/{Id}
 //
 // id - identifies the folder
-func (c *Client) GetInboxFile(ctx context.Context, id string) (*[]byte, error) {
-	resp := []byte{}
-
+func (c *inboxService) GetInboxFile(ctx context.Context, id string) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s", inboxURI, id)
 
-	err := c._GET(ctx, uri, nil, &resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return &resp, nil
+	return c._GETDownload(ctx, getInboxFileOp, uri, nil)
 }
 
 // RemoveFileOrFolder does _DELETE https://api.fortnox.se/3/inbox/{Id}
 //
 // id - identifies the file to remove
-func (c *Client) RemoveFileOrFolder(ctx context.Context, id string) error {
+func (c *inboxService) RemoveFileOrFolder(ctx context.Context, id string) error {
 	uri := fmt.Sprintf("%s/%s", inboxURI, id)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeFileOrFolderOp, uri)
 }
 
 type UploadFileParams struct {
-	FolderID string
-	Path     string
+	FolderID string `fortnox:"folderId"`
+	Path     string `fortnox:"path"`
 }
 
-func (p *UploadFileParams) urlValues() url.Values {
-	urlValues := url.Values{}
-
-	if strings.TrimSpace(p.FolderID) != "" {
-		urlValues["folderId"] = []string{p.FolderID}
-	}
-
-	if strings.TrimSpace(p.Path) != "" {
-		urlValues["path"] = []string{p.Path}
-	}
-
-	return urlValues
+func (p *UploadFileParams) urlValues() (url.Values, error) {
+	return encodeQuery(p)
 }
 
 type GetRootDirectoryResp struct {
@@ -130,10 +129,6 @@ type InnerInboxFolder struct {
 	Name string `json:"Name,omitempty"`
 }
 
-type UploadFileReq struct {
-	File InboxFile `json:"File"`
-}
-
 type UploadFileResp struct {
 	File InboxFile `json:"File"`
 }
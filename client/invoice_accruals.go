@@ -10,26 +10,55 @@ const (
 )
 
 // GetAllInvoiceAccruals does _GET https://api.fortnox.se/3/invoiceaccruals/
-func (c *Client) GetAllInvoiceAccruals(ctx context.Context) (*GetAllInvoiceAccrualsResp, error) {
-	resp := &GetAllInvoiceAccrualsResp{}
+func (c *invoiceAccrualsService) GetAllInvoiceAccruals(ctx context.Context) (*GetAllInvoiceAccrualsResp, error) {
+	it := c.IterateInvoiceAccruals()
 
-	err := c._GET(ctx, invoiceAccrualsURI, nil, resp)
+	items, err := it.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp := &GetAllInvoiceAccrualsResp{InvoiceAccruals: items}
+	if meta := it.PageInfo(); meta != nil {
+		resp.MetaInformation = *meta
+	}
+
 	return resp, nil
 }
 
+// GetInvoiceAccrualsPage does _GET https://api.fortnox.se/3/invoiceaccruals/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *invoiceAccrualsService) GetInvoiceAccrualsPage(
+	ctx context.Context,
+	page *PageOptions) ([]ShortInvoiceAccrual, *MetaInformation, error) {
+
+	resp := &GetAllInvoiceAccrualsResp{}
+
+	err := c._GETPage(ctx, getInvoiceAccrualsPageOp, invoiceAccrualsURI, nil, page, resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.InvoiceAccruals, &resp.MetaInformation, nil
+}
+
+// IterateInvoiceAccruals lazily walks all pages of https://api.fortnox.se/3/invoiceaccruals/
+func (c *invoiceAccrualsService) IterateInvoiceAccruals() *Iterator[ShortInvoiceAccrual] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]ShortInvoiceAccrual, *MetaInformation, error) {
+		return c.GetInvoiceAccrualsPage(ctx, page)
+	})
+}
+
 // CreateInvoiceAccrual does _POST https://api.fortnox.se/3/invoiceaccruals/
 //
 // req - invoice accrual to create
-func (c *Client) CreateInvoiceAccrual(
+func (c *invoiceAccrualsService) CreateInvoiceAccrual(
 	ctx context.Context, req *CreateInvoiceAccrualReq) (*CreateInvoiceAccrualResp, error) {
 
 	resp := &CreateInvoiceAccrualResp{}
 
-	err := c._POST(ctx, invoiceAccrualsURI, nil, req, resp)
+	err := c._POST(ctx, createInvoiceAccrualOp, invoiceAccrualsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -40,12 +69,12 @@ func (c *Client) CreateInvoiceAccrual(
 // GetInvoiceAccrual does _GET https://api.fortnox.se/3/invoiceaccruals/{InvoiceNumber}
 //
 // invoiceNumber - identifies the invoice accrual
-func (c *Client) GetInvoiceAccrual(ctx context.Context, invoiceNumber int) (*GetInvoiceAccrualResp, error) {
+func (c *invoiceAccrualsService) GetInvoiceAccrual(ctx context.Context, invoiceNumber int) (*GetInvoiceAccrualResp, error) {
 	resp := &GetInvoiceAccrualResp{}
 
 	uri := fmt.Sprintf("%s/%d", invoiceAccrualsURI, invoiceNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getInvoiceAccrualOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +87,7 @@ func (c *Client) GetInvoiceAccrual(ctx context.Context, invoiceNumber int) (*Get
 // invoiceNumber - identifies the invoice accrual
 //
 // req - invoice accrual to update
-func (c *Client) UpdateInvoiceAccrual(
+func (c *invoiceAccrualsService) UpdateInvoiceAccrual(
 	ctx context.Context,
 	invoiceNumber int,
 	req *UpdateInvoiceAccrualReq) (*UpdateInvoiceAccrualResp, error) {
@@ -67,7 +96,7 @@ func (c *Client) UpdateInvoiceAccrual(
 
 	uri := fmt.Sprintf("%s/%d", invoiceAccrualsURI, invoiceNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateInvoiceAccrualOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -78,18 +107,21 @@ func (c *Client) UpdateInvoiceAccrual(
 // RemoveInvoiceAccrual does _DELETE https://api.fortnox.se/3/invoiceaccruals/{InvoiceNumber}
 //
 // invoiceNumber - identifies the invoice accrual
-func (c *Client) RemoveInvoiceAccrual(ctx context.Context, invoiceNumber int) error {
+func (c *invoiceAccrualsService) RemoveInvoiceAccrual(ctx context.Context, invoiceNumber int) error {
 	uri := fmt.Sprintf("%s/%d", invoiceAccrualsURI, invoiceNumber)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeInvoiceAccrualOp, uri)
 }
 
 type GetAllInvoiceAccrualsResp struct {
-	InvoiceAccruals []struct {
-		Url           string `json:"@url"`
-		Description   string `json:"Description"`
-		InvoiceNumber int    `json:"InvoiceNumber"`
-		Period        string `json:"Period"`
-	} `json:"InvoiceAccruals"`
+	InvoiceAccruals []ShortInvoiceAccrual `json:"InvoiceAccruals"`
+	MetaInformation MetaInformation       `json:"MetaInformation"`
+}
+
+type ShortInvoiceAccrual struct {
+	Url           string `json:"@url"`
+	Description   string `json:"Description"`
+	InvoiceNumber int    `json:"InvoiceNumber"`
+	Period        string `json:"Period"`
 }
 
 type CreateInvoiceAccrualReq struct {
@@ -97,14 +129,14 @@ type CreateInvoiceAccrualReq struct {
 		Url                string              `json:"@url"`
 		AccrualAccount     int                 `json:"AccrualAccount"`
 		Description        string              `json:"Description"`
-		EndDate            string              `json:"EndDate"`
+		EndDate            Date                `json:"EndDate"`
 		InvoiceAccrualRows []InvoiceAccrualRow `json:"InvoiceAccrualRows"`
 		InvoiceNumber      int                 `json:"InvoiceNumber"`
 		Period             string              `json:"Period"`
 		RevenueAccount     int                 `json:"RevenueAccount"`
-		StartDate          string              `json:"StartDate"`
+		StartDate          Date                `json:"StartDate"`
 		Times              int                 `json:"Times"`
-		Total              int                 `json:"Total"`
+		Total              Money               `json:"Total"`
 		VATIncluded        bool                `json:"VATIncluded"`
 	} `json:"InvoiceAccrual"`
 }
@@ -114,14 +146,14 @@ type CreateInvoiceAccrualResp struct {
 		Url                string              `json:"@url"`
 		AccrualAccount     int                 `json:"AccrualAccount"`
 		Description        string              `json:"Description"`
-		EndDate            string              `json:"EndDate"`
+		EndDate            Date                `json:"EndDate"`
 		InvoiceAccrualRows []InvoiceAccrualRow `json:"InvoiceAccrualRows"`
 		InvoiceNumber      int                 `json:"InvoiceNumber"`
 		Period             string              `json:"Period"`
 		RevenueAccount     int                 `json:"RevenueAccount"`
-		StartDate          string              `json:"StartDate"`
+		StartDate          Date                `json:"StartDate"`
 		Times              int                 `json:"Times"`
-		Total              int                 `json:"Total"`
+		Total              Money               `json:"Total"`
 		VATIncluded        bool                `json:"VATIncluded"`
 	} `json:"InvoiceAccrual"`
 }
@@ -131,14 +163,14 @@ type GetInvoiceAccrualResp struct {
 		Url                string              `json:"@url"`
 		AccrualAccount     int                 `json:"AccrualAccount"`
 		Description        string              `json:"Description"`
-		EndDate            string              `json:"EndDate"`
+		EndDate            Date                `json:"EndDate"`
 		InvoiceAccrualRows []InvoiceAccrualRow `json:"InvoiceAccrualRows"`
 		InvoiceNumber      int                 `json:"InvoiceNumber"`
 		Period             string              `json:"Period"`
 		RevenueAccount     int                 `json:"RevenueAccount"`
-		StartDate          string              `json:"StartDate"`
+		StartDate          Date                `json:"StartDate"`
 		Times              int                 `json:"Times"`
-		Total              int                 `json:"Total"`
+		Total              Money               `json:"Total"`
 		VATIncluded        bool                `json:"VATIncluded"`
 	} `json:"InvoiceAccrual"`
 }
@@ -148,14 +180,14 @@ type UpdateInvoiceAccrualReq struct {
 		Url                string              `json:"@url"`
 		AccrualAccount     int                 `json:"AccrualAccount"`
 		Description        string              `json:"Description"`
-		EndDate            string              `json:"EndDate"`
+		EndDate            Date                `json:"EndDate"`
 		InvoiceAccrualRows []InvoiceAccrualRow `json:"InvoiceAccrualRows"`
 		InvoiceNumber      int                 `json:"InvoiceNumber"`
 		Period             string              `json:"Period"`
 		RevenueAccount     int                 `json:"RevenueAccount"`
-		StartDate          string              `json:"StartDate"`
+		StartDate          Date                `json:"StartDate"`
 		Times              int                 `json:"Times"`
-		Total              int                 `json:"Total"`
+		Total              Money               `json:"Total"`
 		VATIncluded        bool                `json:"VATIncluded"`
 	} `json:"InvoiceAccrual"`
 }
@@ -165,14 +197,14 @@ type UpdateInvoiceAccrualResp struct {
 		Url                string              `json:"@url"`
 		AccrualAccount     int                 `json:"AccrualAccount"`
 		Description        string              `json:"Description"`
-		EndDate            string              `json:"EndDate"`
+		EndDate            Date                `json:"EndDate"`
 		InvoiceAccrualRows []InvoiceAccrualRow `json:"InvoiceAccrualRows"`
 		InvoiceNumber      int                 `json:"InvoiceNumber"`
 		Period             string              `json:"Period"`
 		RevenueAccount     int                 `json:"RevenueAccount"`
-		StartDate          string              `json:"StartDate"`
+		StartDate          Date                `json:"StartDate"`
 		Times              int                 `json:"Times"`
-		Total              int                 `json:"Total"`
+		Total              Money               `json:"Total"`
 		VATIncluded        bool                `json:"VATIncluded"`
 	} `json:"InvoiceAccrual"`
 }
@@ -180,8 +212,8 @@ type UpdateInvoiceAccrualResp struct {
 type InvoiceAccrualRow struct {
 	Account                int    `json:"Account,omitempty"`
 	CostCenter             string `json:"CostCenter,omitempty"`
-	Credit                 int    `json:"Credit,omitempty"`
-	Debit                  int    `json:"Debit,omitempty"`
+	Credit                 Money  `json:"Credit,omitzero"`
+	Debit                  Money  `json:"Debit,omitzero"`
 	Project                string `json:"Project,omitempty"`
 	TransactionInformation string `json:"TransactionInformation,omitempty"`
 }
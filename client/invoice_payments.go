@@ -10,25 +10,42 @@ const (
 )
 
 // GetAllInvoicePayments does _GET https://api.fortnox.se/3/invoicepayments/
-func (c *Client) GetAllInvoicePayments(ctx context.Context) ([]InvoicePayment, error) {
+func (c *invoicePaymentsService) GetAllInvoicePayments(ctx context.Context) ([]InvoicePayment, error) {
+	return c.IterateInvoicePayments().All(ctx)
+}
+
+// GetInvoicePaymentsPage does _GET https://api.fortnox.se/3/invoicepayments/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *invoicePaymentsService) GetInvoicePaymentsPage(
+	ctx context.Context,
+	page *PageOptions) ([]InvoicePayment, *MetaInformation, error) {
+
 	resp := &GetAllInvoicePaymentsResp{}
 
-	err := c._GET(ctx, invoicePaymentsURI, nil, resp)
+	err := c._GETPage(ctx, getInvoicePaymentsPageOp, invoicePaymentsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.InvoicePayments, nil
+	return resp.InvoicePayments, &resp.MetaInformation, nil
+}
+
+// IterateInvoicePayments lazily walks all pages of https://api.fortnox.se/3/invoicepayments/
+func (c *invoicePaymentsService) IterateInvoicePayments() *Iterator[InvoicePayment] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]InvoicePayment, *MetaInformation, error) {
+		return c.GetInvoicePaymentsPage(ctx, page)
+	})
 }
 
 // CreateInvoicePayment does _POST https://api.fortnox.se/3/invoicepayments/
 //
 // ip - invoice payment to create
-func (c *Client) CreateInvoicePayment(ctx context.Context, ip *InvoicePayment) (*InvoicePayment, error) {
+func (c *invoicePaymentsService) CreateInvoicePayment(ctx context.Context, ip *InvoicePayment) (*InvoicePayment, error) {
 	req := &CreateInvoicePaymentReq{InvoicePayment: *ip}
 	resp := &CreateInvoicePaymentResp{}
 
-	err := c._POST(ctx, invoicePaymentsURI, nil, req, resp)
+	err := c._POST(ctx, createInvoicePaymentOp, invoicePaymentsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +56,12 @@ func (c *Client) CreateInvoicePayment(ctx context.Context, ip *InvoicePayment) (
 // GetInvoicePayment does _GET https://api.fortnox.se/3/invoicepayments/{Number}
 //
 // number - identifies the invoice payment
-func (c *Client) GetInvoicePayment(ctx context.Context, number string) (*InvoicePayment, error) {
+func (c *invoicePaymentsService) GetInvoicePayment(ctx context.Context, number string) (*InvoicePayment, error) {
 	resp := &GetInvoicePaymentResp{}
 
 	uri := fmt.Sprintf("%s/%s", invoicePaymentsURI, number)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getInvoicePaymentOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,13 +74,13 @@ func (c *Client) GetInvoicePayment(ctx context.Context, number string) (*Invoice
 // number - identifies the invoice payment
 //
 // ip - invoice payment to update
-func (c *Client) UpdateInvoicePayment(ctx context.Context, number string, ip *InvoicePayment) (*InvoicePayment, error) {
+func (c *invoicePaymentsService) UpdateInvoicePayment(ctx context.Context, number string, ip *InvoicePayment) (*InvoicePayment, error) {
 	req := &UpdateInvoicePaymentReq{InvoicePayment: *ip}
 	resp := &UpdateInvoicePaymentResp{}
 
 	uri := fmt.Sprintf("%s/%s", invoicePaymentsURI, number)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateInvoicePaymentOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -74,15 +91,15 @@ func (c *Client) UpdateInvoicePayment(ctx context.Context, number string, ip *In
 // RemoveInvoicePayment does _DELETE https://api.fortnox.se/3/invoicepayments/{Number}
 //
 // number - identifies the invoice payment
-func (c *Client) RemoveInvoicePayment(ctx context.Context, number string) error {
+func (c *invoicePaymentsService) RemoveInvoicePayment(ctx context.Context, number string) error {
 	uri := fmt.Sprintf("%s/%s", invoicePaymentsURI, number)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeInvoicePaymentOp, uri)
 }
 
 // BookKeepInvoicePayment does _PUT https://api.fortnox.se/3/invoicepayments/{Number}/bookkeep
 //
 // number - identifies the invoice payment
-func (c *Client) BookKeepInvoicePayment(
+func (c *invoicePaymentsService) BookKeepInvoicePayment(
 	ctx context.Context,
 	number string,
 	ip *InvoicePayment) (*InvoicePayment, error) {
@@ -92,7 +109,7 @@ func (c *Client) BookKeepInvoicePayment(
 
 	uri := fmt.Sprintf("%s/%s/bookkeep", invoicePaymentsURI, number)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, bookKeepInvoicePaymentOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +119,7 @@ func (c *Client) BookKeepInvoicePayment(
 
 type GetAllInvoicePaymentsResp struct {
 	InvoicePayments []InvoicePayment `json:"InvoicePayments"`
+	MetaInformation MetaInformation  `json:"MetaInformation"`
 }
 
 type CreateInvoicePaymentReq struct {
@@ -134,24 +152,24 @@ type UpdateInvoicePaymentResp struct {
 
 type InvoicePayment struct {
 	Url                       string     `json:"@url,omitempty"`
-	Amount                    int        `json:"Amount,omitempty"`
-	AmountCurrency            int        `json:"AmountCurrency,omitempty"`
+	Amount                    Money      `json:"Amount,omitzero"`
+	AmountCurrency            Money      `json:"AmountCurrency,omitzero"`
 	Booked                    bool       `json:"Booked,omitempty"`
 	Currency                  string     `json:"Currency,omitempty"`
-	CurrencyRate              int        `json:"CurrencyRate,omitempty"`
+	CurrencyRate              Money      `json:"CurrencyRate,omitzero"`
 	CurrencyUnit              int        `json:"CurrencyUnit,omitempty"`
 	ExternalInvoiceReference1 string     `json:"ExternalInvoiceReference1,omitempty"`
 	ExternalInvoiceReference2 string     `json:"ExternalInvoiceReference2,omitempty"`
 	InvoiceCustomerName       string     `json:"InvoiceCustomerName,omitempty"`
 	InvoiceCustomerNumber     string     `json:"InvoiceCustomerNumber,omitempty"`
 	InvoiceNumber             int        `json:"InvoiceNumber,omitempty"`
-	InvoiceDueDate            string     `json:"InvoiceDueDate,omitempty"`
+	InvoiceDueDate            Date       `json:"InvoiceDueDate,omitempty"`
 	InvoiceOCR                string     `json:"InvoiceOCR,omitempty"`
-	InvoiceTotal              string     `json:"InvoiceTotal,omitempty"`
+	InvoiceTotal              Money      `json:"InvoiceTotal,omitzero"`
 	ModeOfPayment             string     `json:"ModeOfPayment,omitempty"`
 	ModeOfPaymentAccount      int        `json:"ModeOfPaymentAccount,omitempty"`
 	Number                    string     `json:"Number,omitempty"`
-	PaymentDate               string     `json:"PaymentDate,omitempty"`
+	PaymentDate               Date       `json:"PaymentDate,omitempty"`
 	VoucherNumber             int        `json:"VoucherNumber,omitempty"`
 	VoucherSeries             string     `json:"VoucherSeries,omitempty"`
 	VoucherYear               int        `json:"VoucherYear,omitempty"`
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
+	"time"
 )
 
 const (
@@ -14,12 +14,12 @@ const (
 // GetInvoice does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}
 //
 // documentNumber - identifies the invoice
-func (c *Client) GetInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) GetInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &GetInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%s", invoicesURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getInvoiceOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -27,22 +27,22 @@ func (c *Client) GetInvoice(ctx context.Context, documentNumber string) (*Invoic
 	return &resp.Invoice, nil
 }
 
-// UpdateInvoice odes _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}
+// UpdateInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}
 //
 // documentNumber - identifies the invoice
 //
-// req - payload
-func (c *Client) UpdateInvoice(
+// i - fields to update, only fields that are Set are sent
+func (c *invoicesService) UpdateInvoice(
 	ctx context.Context,
 	documentNumber string,
-	i *Invoice) (*Invoice, error) {
+	i *InvoiceUpdate) (*Invoice, error) {
 
 	req := &UpdateInvoiceReq{*i}
 	resp := &UpdateInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%s", invoicesURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateInvoiceOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -50,33 +50,71 @@ func (c *Client) UpdateInvoice(
 	return &resp.Invoice, nil
 }
 
+// PatchInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber} sending only the fields changed between original and edited
+//
+// original - invoice as returned by Fortnox
+//
+// edited - modified copy of original
+//
+// Nothing is sent when no writable field changed, original is returned then.
+func (c *invoicesService) PatchInvoice(ctx context.Context, original, edited *Invoice) (*Invoice, error) {
+	update := DiffInvoice(original, edited)
+	if !hasSetFields(update) {
+		return original, nil
+	}
+
+	return c.UpdateInvoice(ctx, original.DocumentNumber, update)
+}
+
 // GetAllInvoices does _GET https://api.fortnox.se/3/invoices
 //
 // queryParams - filters
-func (c *Client) GetAllInvoices(ctx context.Context, queryParams *GetAllInvoicesQueryParams) ([]Invoice, error) {
+func (c *invoicesService) GetAllInvoices(ctx context.Context, queryParams *GetAllInvoicesQueryParams) ([]Invoice, error) {
+	return c.IterateInvoices(queryParams).All(ctx)
+}
+
+// GetInvoicesPage does _GET https://api.fortnox.se/3/invoices and returns a single page along with its MetaInformation
+//
+// queryParams - filters
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *invoicesService) GetInvoicesPage(
+	ctx context.Context,
+	queryParams *GetAllInvoicesQueryParams,
+	page *PageOptions) ([]Invoice, *MetaInformation, error) {
+
 	resp := &GetAllInvoicesResp{}
 
-	var params url.Values
-	if queryParams != nil {
-		params = queryParams.urlValues()
+	params, err := queryParams.urlValues()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	err := c._GET(ctx, invoicesURI, params, resp)
+	err = c._GETPage(ctx, getInvoicesPageOp, invoicesURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Invoices, nil
+	return resp.Invoices, &resp.MetaInformation, nil
+}
+
+// IterateInvoices lazily walks all pages of https://api.fortnox.se/3/invoices
+//
+// queryParams - filters
+func (c *invoicesService) IterateInvoices(queryParams *GetAllInvoicesQueryParams) *Iterator[Invoice] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Invoice, *MetaInformation, error) {
+		return c.GetInvoicesPage(ctx, queryParams, page)
+	})
 }
 
 // CreateInvoice does _POST https://api.fortnox.se/3/invoices
 //
 // req - payload
-func (c *Client) CreateInvoice(ctx context.Context, i *Invoice) (*Invoice, error) {
+func (c *invoicesService) CreateInvoice(ctx context.Context, i *Invoice) (*Invoice, error) {
 	req := &CreateInvoiceReq{Invoice: *i}
 	resp := &CreateInvoiceResp{}
 
-	err := c._POST(ctx, invoicesURI, nil, req, resp)
+	err := c._POST(ctx, createInvoiceOp, invoicesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -87,12 +125,12 @@ func (c *Client) CreateInvoice(ctx context.Context, i *Invoice) (*Invoice, error
 // BookKeepInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/bookkeep
 //
 // documentNumber - identifies the invoice
-func (c *Client) BookKeepInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) BookKeepInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &BookKeepInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%s/bookkeep", invoicesURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, bookKeepInvoiceOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -103,12 +141,12 @@ func (c *Client) BookKeepInvoice(ctx context.Context, documentNumber string) (*I
 // CancelInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/cancel
 //
 // documentNumber - identifies the invoice
-func (c *Client) CancelInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) CancelInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &CancelInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%s/cancel", invoicesURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, cancelInvoiceOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -119,12 +157,12 @@ func (c *Client) CancelInvoice(ctx context.Context, documentNumber string) (*Inv
 // CreditInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/credit
 //
 // documentNumber - identifies the invoice
-func (c *Client) CreditInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) CreditInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &CreditInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%s/credit", invoicesURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, creditInvoiceOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -135,12 +173,12 @@ func (c *Client) CreditInvoice(ctx context.Context, documentNumber string) (*Inv
 // SetInvoiceAsSent does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/externalprint
 //
 // documentNumber - identifies the invoice
-func (c *Client) SetInvoiceAsSent(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) SetInvoiceAsSent(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &SetInvoiceAsSentResp{}
 
 	uri := fmt.Sprintf("%s/%s/externalprint", invoicesURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, setInvoiceAsSentOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -151,12 +189,12 @@ func (c *Client) SetInvoiceAsSent(ctx context.Context, documentNumber string) (*
 // SetInvoiceAsDone does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/warehouseready
 //
 // documentNumber - identifies the invoice
-func (c *Client) SetInvoiceAsDone(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) SetInvoiceAsDone(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &SetInvoiceAsDoneResp{}
 
 	uri := fmt.Sprintf("%s/%s/warehouseready", invoicesURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, setInvoiceAsDoneOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -167,28 +205,21 @@ func (c *Client) SetInvoiceAsDone(ctx context.Context, documentNumber string) (*
 // PrintInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/print
 //
 // documentNumber - identifies the invoice
-func (c *Client) PrintInvoice(ctx context.Context, documentNumber string) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *invoicesService) PrintInvoice(ctx context.Context, documentNumber string) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s/print", invoicesURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c._GETDownload(ctx, printInvoiceOp, uri, nil)
 }
 
 // SendInvoiceAsEmail does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/email
 //
 // documentNumber - identifies the invoice
-func (c *Client) SendInvoiceAsEmail(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) SendInvoiceAsEmail(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &SendInvoiceAsEmailResp{}
 
 	uri := fmt.Sprintf("%s/%s/email", invoicesURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, sendInvoiceAsEmailOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -199,44 +230,30 @@ func (c *Client) SendInvoiceAsEmail(ctx context.Context, documentNumber string)
 // SendInvoiceAsReminder does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}/printreminder
 //
 // documentNumber - identifies the invoice
-func (c *Client) SendInvoiceAsReminder(ctx context.Context, documentNumber string) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *invoicesService) SendInvoiceAsReminder(ctx context.Context, documentNumber string) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s/printreminder", invoicesURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c._GETDownload(ctx, sendInvoiceAsReminderOp, uri, nil)
 }
 
 // PreviewInvoice does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}/preview
 //
 // documentNumber - identifies the invoice
-func (c *Client) PreviewInvoice(ctx context.Context, documentNumber string) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *invoicesService) PreviewInvoice(ctx context.Context, documentNumber string) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s/preview", invoicesURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c._GETDownload(ctx, previewInvoiceOp, uri, nil)
 }
 
 // SendInvoiceAsEPrint does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}/eprint
 //
 // documentNumber - identifies the invoice
-func (c *Client) SendInvoiceAsEPrint(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) SendInvoiceAsEPrint(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &SendInvoiceAsEPrintResp{}
 
 	uri := fmt.Sprintf("%s/%s/eprint", invoicesURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, sendInvoiceAsEPrintOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -247,12 +264,12 @@ func (c *Client) SendInvoiceAsEPrint(ctx context.Context, documentNumber string)
 // SendInvoiceAsEInvoice does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}/einvoice
 //
 // documentNumber - identifies the invoice
-func (c *Client) SendInvoiceAsEInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *invoicesService) SendInvoiceAsEInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &SendInvoiceAsEInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%s/einvoice", invoicesURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, sendInvoiceAsEInvoiceOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -261,131 +278,38 @@ func (c *Client) SendInvoiceAsEInvoice(ctx context.Context, documentNumber strin
 }
 
 type GetAllInvoicesQueryParams struct {
-	Filter                    GetAllInvoicesFilter
-	CostCenter                string
-	CustomerName              string
-	CustomerNumber            string
-	Label                     string
-	DocumentNumber            string
-	FromDate                  string
-	ToDate                    string
-	FromFinalPayDate          string
-	ToFinalPayDate            string
-	LastModified              string
-	NotCompleted              string
-	Ocr                       string
-	OurReference              string
-	Project                   string
-	Sent                      string
-	ExternalInvoiceReference1 string
-	ExternalInvoiceReference2 string
-	YourReference             string
-	InvoiceType               string
-	ArticleNumber             string
-	ArticleDescription        string
-	Currency                  string
-	AccountNumberFrom         string
-	AccountNumberTo           string
-	YourOrderNumber           string
-	Credit                    string
-	SortBy                    GetAllInvoicesSortBy
-}
-
-// TODO: update param names
-func (p GetAllInvoicesQueryParams) urlValues() url.Values {
-	params := url.Values{}
-
-	filterStr := string(p.Filter)
-	if strings.TrimSpace(filterStr) != "" {
-		params["filter"] = []string{filterStr}
-	}
-	if strings.TrimSpace(p.CostCenter) != "" {
-		params["costcenter"] = []string{p.CostCenter}
-	}
-	if strings.TrimSpace(p.CustomerName) != "" {
-		params["customername"] = []string{p.CostCenter}
-	}
-	if strings.TrimSpace(p.CustomerNumber) != "" {
-		params["customername"] = []string{p.CustomerNumber}
-	}
-	if strings.TrimSpace(p.Label) != "" {
-		params["customername"] = []string{p.Label}
-	}
-	if strings.TrimSpace(p.DocumentNumber) != "" {
-		params["customername"] = []string{p.DocumentNumber}
-	}
-	if strings.TrimSpace(p.FromDate) != "" {
-		params["customername"] = []string{p.FromDate}
-	}
-	if strings.TrimSpace(p.ToDate) != "" {
-		params["filter"] = []string{p.ToDate}
-	}
-	if strings.TrimSpace(p.FromFinalPayDate) != "" {
-		params["costcenter"] = []string{p.FromFinalPayDate}
-	}
-	if strings.TrimSpace(p.ToFinalPayDate) != "" {
-		params["customername"] = []string{p.ToFinalPayDate}
-	}
-	if strings.TrimSpace(p.LastModified) != "" {
-		params["customername"] = []string{p.LastModified}
-	}
-	if strings.TrimSpace(p.NotCompleted) != "" {
-		params["customername"] = []string{p.NotCompleted}
-	}
-	if strings.TrimSpace(p.DocumentNumber) != "" {
-		params["customername"] = []string{p.DocumentNumber}
-	}
-	if strings.TrimSpace(p.Ocr) != "" {
-		params["customername"] = []string{p.Ocr}
-	}
-	if strings.TrimSpace(p.OurReference) != "" {
-		params["filter"] = []string{p.OurReference}
-	}
-	if strings.TrimSpace(p.Project) != "" {
-		params["costcenter"] = []string{p.Project}
-	}
-	if strings.TrimSpace(p.Sent) != "" {
-		params["customername"] = []string{p.Sent}
-	}
-	if strings.TrimSpace(p.ExternalInvoiceReference1) != "" {
-		params["customername"] = []string{p.ExternalInvoiceReference1}
-	}
-	if strings.TrimSpace(p.ExternalInvoiceReference2) != "" {
-		params["customername"] = []string{p.ExternalInvoiceReference2}
-	}
-	if strings.TrimSpace(p.YourReference) != "" {
-		params["customername"] = []string{p.YourReference}
-	}
-	if strings.TrimSpace(p.InvoiceType) != "" {
-		params["customername"] = []string{p.InvoiceType}
-	}
-	if strings.TrimSpace(p.ArticleNumber) != "" {
-		params["customername"] = []string{p.ArticleNumber}
-	}
-	if strings.TrimSpace(p.ArticleDescription) != "" {
-		params["customername"] = []string{p.ArticleDescription}
-	}
-	if strings.TrimSpace(p.Currency) != "" {
-		params["customername"] = []string{p.Currency}
-	}
-	if strings.TrimSpace(p.AccountNumberFrom) != "" {
-		params["customername"] = []string{p.AccountNumberFrom}
-	}
-	if strings.TrimSpace(p.AccountNumberTo) != "" {
-		params["customername"] = []string{p.AccountNumberTo}
-	}
-	if strings.TrimSpace(p.YourOrderNumber) != "" {
-		params["customername"] = []string{p.YourOrderNumber}
-	}
-	if strings.TrimSpace(p.Credit) != "" {
-		params["customername"] = []string{p.Credit}
-	}
-	sortBy := string(p.SortBy)
-	if strings.TrimSpace(sortBy) != "" {
-		params["customername"] = []string{sortBy}
-	}
-
-	return params
+	Filter                    GetAllInvoicesFilter `fortnox:"filter"`
+	CostCenter                string               `fortnox:"costcenter"`
+	CustomerName              string               `fortnox:"customername"`
+	CustomerNumber            string               `fortnox:"customernumber"`
+	Label                     string               `fortnox:"label"`
+	DocumentNumber            string               `fortnox:"documentnumber"`
+	FromDate                  Date                 `fortnox:"fromdate"`
+	ToDate                    Date                 `fortnox:"todate"`
+	FromFinalPayDate          Date                 `fortnox:"fromfinalpaydate"`
+	ToFinalPayDate            Date                 `fortnox:"tofinalpaydate"`
+	LastModified              time.Time            `fortnox:"lastmodified,datetime"`
+	NotCompleted              *bool                `fortnox:"notcompleted"`
+	Ocr                       string               `fortnox:"ocr"`
+	OurReference              string               `fortnox:"ourreference"`
+	Project                   string               `fortnox:"project"`
+	Sent                      *bool                `fortnox:"sent"`
+	ExternalInvoiceReference1 string               `fortnox:"externalinvoicereference1"`
+	ExternalInvoiceReference2 string               `fortnox:"externalinvoicereference2"`
+	YourReference             string               `fortnox:"yourreference"`
+	InvoiceType               string               `fortnox:"invoicetype"`
+	ArticleNumber             string               `fortnox:"articlenumber"`
+	ArticleDescription        string               `fortnox:"articledescription"`
+	Currency                  string               `fortnox:"currency"`
+	AccountNumberFrom         string               `fortnox:"accountnumberfrom"`
+	AccountNumberTo           string               `fortnox:"accountnumberto"`
+	YourOrderNumber           string               `fortnox:"yourordernumber"`
+	Credit                    *bool                `fortnox:"credit"`
+	SortBy                    GetAllInvoicesSortBy `fortnox:"sortby"`
+}
+
+func (p *GetAllInvoicesQueryParams) urlValues() (url.Values, error) {
+	return encodeQuery(p)
 }
 
 type GetAllInvoicesSortBy string
@@ -414,7 +338,7 @@ type GetInvoiceResp struct {
 }
 
 type UpdateInvoiceReq struct {
-	Invoice Invoice `json:"Invoice"`
+	Invoice InvoiceUpdate `json:"Invoice"`
 }
 
 type UpdateInvoiceResp struct {
@@ -422,7 +346,8 @@ type UpdateInvoiceResp struct {
 }
 
 type GetAllInvoicesResp struct {
-	Invoices []Invoice `json:"Invoices"`
+	Invoices        []Invoice       `json:"Invoices"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateInvoiceReq struct {
@@ -468,25 +393,25 @@ type SendInvoiceAsEInvoiceResp struct {
 type Invoice struct {
 	Url                       string           `json:"@url,omitempty"`
 	UrlTaxReductionList       string           `json:"@urlTaxReductionList,omitempty"`
-	AdministrationFee         int              `json:"AdministrationFee,omitempty"`
-	AdministrationFeeVAT      int              `json:"AdministrationFeeVAT,omitempty"`
+	AdministrationFee         Money            `json:"AdministrationFee,omitzero"`
+	AdministrationFeeVAT      Money            `json:"AdministrationFeeVAT,omitzero"`
 	Address1                  string           `json:"Address1,omitempty"`
 	Address2                  string           `json:"Address2,omitempty"`
-	Balance                   int              `json:"Balance,omitempty"`
-	BasisTaxReduction         int              `json:"BasisTaxReduction,omitempty"`
+	Balance                   Money            `json:"Balance,omitzero"`
+	BasisTaxReduction         Money            `json:"BasisTaxReduction,omitzero"`
 	Booked                    bool             `json:"Booked,omitempty"`
 	Cancelled                 bool             `json:"Cancelled,omitempty"`
 	City                      string           `json:"City,omitempty"`
 	Comments                  string           `json:"Comments,omitempty"`
 	ContractReference         int              `json:"ContractReference,omitempty"`
 	ContributionPercent       int              `json:"ContributionPercent,omitempty"`
-	ContributionValue         int              `json:"ContributionValue,omitempty"`
+	ContributionValue         Money            `json:"ContributionValue,omitzero"`
 	Country                   string           `json:"Country,omitempty"`
 	CostCenter                string           `json:"CostCenter,omitempty"`
 	Credit                    string           `json:"Credit,omitempty"`
 	CreditInvoiceReference    string           `json:"CreditInvoiceReference,omitempty"`
 	Currency                  string           `json:"Currency,omitempty"`
-	CurrencyRate              int              `json:"CurrencyRate,omitempty"`
+	CurrencyRate              Money            `json:"CurrencyRate,omitzero"`
 	CurrencyUnit              int              `json:"CurrencyUnit,omitempty"`
 	CustomerName              string           `json:"CustomerName,omitempty"`
 	CustomerNumber            string           `json:"CustomerNumber,omitempty"`
@@ -494,30 +419,30 @@ type Invoice struct {
 	DeliveryAddress2          string           `json:"DeliveryAddress2,omitempty"`
 	DeliveryCity              string           `json:"DeliveryCity,omitempty"`
 	DeliveryCountry           string           `json:"DeliveryCountry,omitempty"`
-	DeliveryDate              string           `json:"DeliveryDate,omitempty"`
+	DeliveryDate              Date             `json:"DeliveryDate,omitempty"`
 	DeliveryName              string           `json:"DeliveryName,omitempty"`
 	DeliveryZipCode           string           `json:"DeliveryZipCode,omitempty"`
 	DocumentNumber            string           `json:"DocumentNumber,omitempty"`
-	DueDate                   string           `json:"DueDate,omitempty"`
+	DueDate                   Date             `json:"DueDate,omitempty"`
 	EDIInformation            EDIInformation   `json:"EDIInformation,omitempty"`
 	EmailInformation          EmailInformation `json:"EmailInformation,omitempty"`
 	EUQuarterlyReport         bool             `json:"EUQuarterlyReport,omitempty"`
 	ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1,omitempty"`
 	ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2,omitempty"`
-	Freight                   int              `json:"Freight,omitempty"`
-	FreightVAT                int              `json:"FreightVAT,omitempty"`
-	Gross                     int              `json:"Gross,omitempty"`
+	Freight                   Money            `json:"Freight,omitzero"`
+	FreightVAT                Money            `json:"FreightVAT,omitzero"`
+	Gross                     Money            `json:"Gross,omitzero"`
 	HouseWork                 bool             `json:"HouseWork,omitempty"`
-	InvoiceDate               string           `json:"InvoiceDate,omitempty"`
-	InvoicePeriodStart        string           `json:"InvoicePeriodStart,omitempty"`
-	InvoicePeriodEnd          string           `json:"InvoicePeriodEnd,omitempty"`
+	InvoiceDate               Date             `json:"InvoiceDate,omitempty"`
+	InvoicePeriodStart        Date             `json:"InvoicePeriodStart,omitempty"`
+	InvoicePeriodEnd          Date             `json:"InvoicePeriodEnd,omitempty"`
 	InvoicePeriodReference    string           `json:"InvoicePeriodReference,omitempty"`
 	InvoiceRows               []InvoiceRow     `json:"InvoiceRows,omitempty"`
 	InvoiceType               string           `json:"InvoiceType,omitempty"`
 	Labels                    []Label          `json:"Labels,omitempty"`
 	Language                  string           `json:"Language,omitempty"`
-	LastRemindDate            string           `json:"LastRemindDate,omitempty"`
-	Net                       int              `json:"Net,omitempty"`
+	LastRemindDate            Date             `json:"LastRemindDate,omitempty"`
+	Net                       Money            `json:"Net,omitzero"`
 	NotCompleted              bool             `json:"NotCompleted,omitempty"`
 	NoxFinans                 bool             `json:"NoxFinans,omitempty"`
 	OCR                       string           `json:"OCR,omitempty"`
@@ -532,18 +457,18 @@ type Invoice struct {
 	PrintTemplate             string           `json:"PrintTemplate,omitempty"`
 	Project                   string           `json:"Project,omitempty"`
 	WarehouseReady            bool             `json:"WarehouseReady,omitempty"`
-	OutboundDate              string           `json:"OutboundDate,omitempty"`
+	OutboundDate              Date             `json:"OutboundDate,omitempty"`
 	Remarks                   string           `json:"Remarks,omitempty"`
 	Reminders                 int              `json:"Reminders,omitempty"`
-	RoundOff                  int              `json:"RoundOff,omitempty"`
+	RoundOff                  Money            `json:"RoundOff,omitzero"`
 	Sent                      bool             `json:"Sent,omitempty"`
-	TaxReduction              int              `json:"TaxReduction,omitempty"`
+	TaxReduction              Money            `json:"TaxReduction,omitzero"`
 	TermsOfDelivery           string           `json:"TermsOfDelivery,omitempty"`
 	TermsOfPayment            string           `json:"TermsOfPayment,omitempty"`
 	TimeBasisReference        int              `json:"TimeBasisReference,omitempty"`
-	Total                     int              `json:"Total,omitempty"`
-	TotalToPay                int              `json:"TotalToPay,omitempty"`
-	TotalVAT                  int              `json:"TotalVAT,omitempty"`
+	Total                     Money            `json:"Total,omitzero"`
+	TotalToPay                Money            `json:"TotalToPay,omitzero"`
+	TotalVAT                  Money            `json:"TotalVAT,omitzero"`
 	VATIncluded               bool             `json:"VATIncluded,omitempty"`
 	VoucherNumber             int              `json:"VoucherNumber,omitempty"`
 	VoucherSeries             string           `json:"VoucherSeries,omitempty"`
@@ -554,7 +479,147 @@ type Invoice struct {
 	ZipCode                   string           `json:"ZipCode,omitempty"`
 	AccountingMethod          string           `json:"AccountingMethod,omitempty"`
 	TaxReductionType          string           `json:"TaxReductionType,omitempty"`
-	FinalPayDate              string           `json:"FinalPayDate,omitempty"`
+	FinalPayDate              Date             `json:"FinalPayDate,omitempty"`
+}
+
+// InvoiceUpdate is the payload of UpdateInvoice, only fields that are Set are sent,
+// so they can be changed to their zero value
+type InvoiceUpdate struct {
+	AdministrationFee         Optional[Money]            `json:"AdministrationFee"`
+	AdministrationFeeVAT      Optional[Money]            `json:"AdministrationFeeVAT"`
+	Address1                  Optional[string]           `json:"Address1"`
+	Address2                  Optional[string]           `json:"Address2"`
+	Balance                   Optional[Money]            `json:"Balance"`
+	BasisTaxReduction         Optional[Money]            `json:"BasisTaxReduction"`
+	Booked                    Optional[bool]             `json:"Booked"`
+	Cancelled                 Optional[bool]             `json:"Cancelled"`
+	City                      Optional[string]           `json:"City"`
+	Comments                  Optional[string]           `json:"Comments"`
+	ContractReference         Optional[int]              `json:"ContractReference"`
+	ContributionPercent       Optional[int]              `json:"ContributionPercent"`
+	ContributionValue         Optional[Money]            `json:"ContributionValue"`
+	Country                   Optional[string]           `json:"Country"`
+	CostCenter                Optional[string]           `json:"CostCenter"`
+	Credit                    Optional[string]           `json:"Credit"`
+	CreditInvoiceReference    Optional[string]           `json:"CreditInvoiceReference"`
+	Currency                  Optional[string]           `json:"Currency"`
+	CurrencyRate              Optional[Money]            `json:"CurrencyRate"`
+	CurrencyUnit              Optional[int]              `json:"CurrencyUnit"`
+	CustomerName              Optional[string]           `json:"CustomerName"`
+	CustomerNumber            Optional[string]           `json:"CustomerNumber"`
+	DeliveryAddress1          Optional[string]           `json:"DeliveryAddress1"`
+	DeliveryAddress2          Optional[string]           `json:"DeliveryAddress2"`
+	DeliveryCity              Optional[string]           `json:"DeliveryCity"`
+	DeliveryCountry           Optional[string]           `json:"DeliveryCountry"`
+	DeliveryDate              Optional[Date]             `json:"DeliveryDate"`
+	DeliveryName              Optional[string]           `json:"DeliveryName"`
+	DeliveryZipCode           Optional[string]           `json:"DeliveryZipCode"`
+	DocumentNumber            Optional[string]           `json:"DocumentNumber"`
+	DueDate                   Optional[Date]             `json:"DueDate"`
+	EDIInformation            Optional[EDIInformation]   `json:"EDIInformation"`
+	EmailInformation          Optional[EmailInformation] `json:"EmailInformation"`
+	EUQuarterlyReport         Optional[bool]             `json:"EUQuarterlyReport"`
+	ExternalInvoiceReference1 Optional[string]           `json:"ExternalInvoiceReference1"`
+	ExternalInvoiceReference2 Optional[string]           `json:"ExternalInvoiceReference2"`
+	Freight                   Optional[Money]            `json:"Freight"`
+	FreightVAT                Optional[Money]            `json:"FreightVAT"`
+	Gross                     Optional[Money]            `json:"Gross"`
+	HouseWork                 Optional[bool]             `json:"HouseWork"`
+	InvoiceDate               Optional[Date]             `json:"InvoiceDate"`
+	InvoicePeriodStart        Optional[Date]             `json:"InvoicePeriodStart"`
+	InvoicePeriodEnd          Optional[Date]             `json:"InvoicePeriodEnd"`
+	InvoicePeriodReference    Optional[string]           `json:"InvoicePeriodReference"`
+	InvoiceRows               Optional[[]InvoiceRow]     `json:"InvoiceRows"`
+	InvoiceType               Optional[string]           `json:"InvoiceType"`
+	Labels                    Optional[[]Label]          `json:"Labels"`
+	Language                  Optional[string]           `json:"Language"`
+	LastRemindDate            Optional[Date]             `json:"LastRemindDate"`
+	Net                       Optional[Money]            `json:"Net"`
+	NotCompleted              Optional[bool]             `json:"NotCompleted"`
+	NoxFinans                 Optional[bool]             `json:"NoxFinans"`
+	OCR                       Optional[string]           `json:"OCR"`
+	OfferReference            Optional[string]           `json:"OfferReference"`
+	OrderReference            Optional[string]           `json:"OrderReference"`
+	OrganisationNumber        Optional[string]           `json:"OrganisationNumber"`
+	OurReference              Optional[string]           `json:"OurReference"`
+	PaymentWay                Optional[string]           `json:"PaymentWay"`
+	Phone1                    Optional[string]           `json:"Phone1"`
+	Phone2                    Optional[string]           `json:"Phone2"`
+	PriceList                 Optional[string]           `json:"PriceList"`
+	PrintTemplate             Optional[string]           `json:"PrintTemplate"`
+	Project                   Optional[string]           `json:"Project"`
+	WarehouseReady            Optional[bool]             `json:"WarehouseReady"`
+	OutboundDate              Optional[Date]             `json:"OutboundDate"`
+	Remarks                   Optional[string]           `json:"Remarks"`
+	Reminders                 Optional[int]              `json:"Reminders"`
+	RoundOff                  Optional[Money]            `json:"RoundOff"`
+	Sent                      Optional[bool]             `json:"Sent"`
+	TaxReduction              Optional[Money]            `json:"TaxReduction"`
+	TermsOfDelivery           Optional[string]           `json:"TermsOfDelivery"`
+	TermsOfPayment            Optional[string]           `json:"TermsOfPayment"`
+	TimeBasisReference        Optional[int]              `json:"TimeBasisReference"`
+	Total                     Optional[Money]            `json:"Total"`
+	TotalToPay                Optional[Money]            `json:"TotalToPay"`
+	TotalVAT                  Optional[Money]            `json:"TotalVAT"`
+	VATIncluded               Optional[bool]             `json:"VATIncluded"`
+	VoucherNumber             Optional[int]              `json:"VoucherNumber"`
+	VoucherSeries             Optional[string]           `json:"VoucherSeries"`
+	VoucherYear               Optional[int]              `json:"VoucherYear"`
+	WayOfDelivery             Optional[string]           `json:"WayOfDelivery"`
+	YourOrderNumber           Optional[string]           `json:"YourOrderNumber"`
+	YourReference             Optional[string]           `json:"YourReference"`
+	ZipCode                   Optional[string]           `json:"ZipCode"`
+	AccountingMethod          Optional[string]           `json:"AccountingMethod"`
+	TaxReductionType          Optional[string]           `json:"TaxReductionType"`
+	FinalPayDate              Optional[Date]             `json:"FinalPayDate"`
+}
+
+func (u InvoiceUpdate) MarshalJSON() ([]byte, error) {
+	return marshalSetFields(u)
+}
+
+// invoiceReadOnlyFields are computed or set by Fortnox, DiffInvoice never sends them
+var invoiceReadOnlyFields = map[string]bool{
+	"AdministrationFeeVAT":   true,
+	"Balance":                true,
+	"BasisTaxReduction":      true,
+	"Booked":                 true,
+	"Cancelled":              true,
+	"ContractReference":      true,
+	"ContributionPercent":    true,
+	"ContributionValue":      true,
+	"Credit":                 true,
+	"CreditInvoiceReference": true,
+	"DocumentNumber":         true,
+	"FinalPayDate":           true,
+	"FreightVAT":             true,
+	"Gross":                  true,
+	"InvoicePeriodReference": true,
+	"LastRemindDate":         true,
+	"Net":                    true,
+	"OfferReference":         true,
+	"OrderReference":         true,
+	"Reminders":              true,
+	"RoundOff":               true,
+	"Sent":                   true,
+	"TaxReduction":           true,
+	"TimeBasisReference":     true,
+	"Total":                  true,
+	"TotalToPay":             true,
+	"TotalVAT":               true,
+	"VoucherNumber":          true,
+	"VoucherSeries":          true,
+	"VoucherYear":            true,
+	"WarehouseReady":         true,
+}
+
+// DiffInvoice returns an update holding only the writable fields that differ between original, as returned by Fortnox,
+// and edited, a modified copy of it
+func DiffInvoice(original, edited *Invoice) *InvoiceUpdate {
+	u := &InvoiceUpdate{}
+	diffInto(original, edited, u, invoiceReadOnlyFields)
+
+	return u
 }
 
 type EDIInformation struct {
@@ -580,22 +645,22 @@ type InvoiceRow struct {
 	AccountNumber          int    `json:"AccountNumber,omitempty"`
 	ArticleNumber          string `json:"ArticleNumber,omitempty"`
 	ContributionPercent    string `json:"ContributionPercent,omitempty"`
-	ContributionValue      string `json:"ContributionValue,omitempty"`
+	ContributionValue      Money  `json:"ContributionValue,omitzero"`
 	CostCenter             string `json:"CostCenter,omitempty"`
 	DeliveredQuantity      string `json:"DeliveredQuantity,omitempty"`
 	Description            string `json:"Description,omitempty"`
-	Discount               int    `json:"Discount,omitempty"`
+	Discount               Money  `json:"Discount,omitzero"`
 	DiscountType           string `json:"DiscountType,omitempty"`
 	HouseWork              bool   `json:"HouseWork,omitempty"`
 	HouseWorkHoursToReport int    `json:"HouseWorkHoursToReport,omitempty"`
 	HouseWorkType          string `json:"HouseWorkType,omitempty"`
-	Price                  int    `json:"Price,omitempty"`
-	PriceExcludingVAT      int    `json:"PriceExcludingVAT,omitempty"`
+	Price                  Money  `json:"Price,omitzero"`
+	PriceExcludingVAT      Money  `json:"PriceExcludingVAT,omitzero"`
 	Project                string `json:"Project,omitempty"`
 	RowId                  int    `json:"RowId,omitempty"`
 	StockPointCode         string `json:"StockPointCode,omitempty"`
-	Total                  int    `json:"Total,omitempty"`
-	TotalExcludingVAT      int    `json:"TotalExcludingVAT,omitempty"`
+	Total                  Money  `json:"Total,omitzero"`
+	TotalExcludingVAT      Money  `json:"TotalExcludingVAT,omitzero"`
 	Unit                   string `json:"Unit,omitempty"`
 	VAT                    int    `json:"VAT,omitempty"`
 }
@@ -8,21 +8,35 @@ import (
 const labelsURI = "labels"
 
 // GetAllLabels does _GET https://api.fortnox.se/3/labels
-func (c Client) GetAllLabels(ctx context.Context) ([]Label, error) {
+func (c *labelsService) GetAllLabels(ctx context.Context) ([]Label, error) {
+	return c.IterateLabels().All(ctx)
+}
+
+// GetLabelsPage does _GET https://api.fortnox.se/3/labels and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *labelsService) GetLabelsPage(ctx context.Context, page *PageOptions) ([]Label, *MetaInformation, error) {
 	resp := &GetAllLabelsResp{}
 
-	err := c._GET(ctx, labelsURI, nil, resp)
+	err := c._GETPage(ctx, getLabelsPageOp, labelsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Labels, nil
+	return resp.Labels, &resp.MetaInformation, nil
+}
+
+// IterateLabels lazily walks all pages of https://api.fortnox.se/3/labels
+func (c *labelsService) IterateLabels() *Iterator[Label] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Label, *MetaInformation, error) {
+		return c.GetLabelsPage(ctx, page)
+	})
 }
 
 // CreateLabels does _POST https://api.fortnox.se/3/labels
 //
 // name - label to create
-func (c *Client) CreateLabels(ctx context.Context, name string) (*Label, error) {
+func (c *labelsService) CreateLabels(ctx context.Context, name string) (*Label, error) {
 	resp := &CreateLabelResp{}
 
 	req := &CreateLabelReq{
@@ -31,7 +45,7 @@ func (c *Client) CreateLabels(ctx context.Context, name string) (*Label, error)
 		},
 	}
 
-	err := c._POST(ctx, labelsURI, nil, &req, resp)
+	err := c._POST(ctx, createLabelsOp, labelsURI, nil, &req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +58,7 @@ func (c *Client) CreateLabels(ctx context.Context, name string) (*Label, error)
 // id - identifies the label
 //
 // name - to update
-func (c *Client) UpdateLabel(ctx context.Context, id int, name string) (*Label, error) {
+func (c *labelsService) UpdateLabel(ctx context.Context, id int, name string) (*Label, error) {
 	resp := &UpdateLabelResp{}
 
 	req := &UpdateLabelReq{
@@ -55,7 +69,7 @@ func (c *Client) UpdateLabel(ctx context.Context, id int, name string) (*Label,
 
 	uri := fmt.Sprintf("%s/%d", labelsURI, id)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateLabelOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -66,9 +80,9 @@ func (c *Client) UpdateLabel(ctx context.Context, id int, name string) (*Label,
 // DeleteLabel does _DELETE https://api.fortnox.se/3/labels/{Id}
 //
 // id - identifies the label
-func (c *Client) DeleteLabel(ctx context.Context, id int) error {
+func (c *labelsService) DeleteLabel(ctx context.Context, id int) error {
 	uri := fmt.Sprintf("%s/%d", labelsURI, id)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteLabelOp, uri)
 }
 
 type Label struct {
@@ -77,7 +91,8 @@ type Label struct {
 }
 
 type GetAllLabelsResp struct {
-	Labels []Label `json:"Labels"`
+	Labels          []Label         `json:"Labels"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateLabelReq struct {
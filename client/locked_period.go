@@ -9,10 +9,10 @@ const (
 // GetLockedPeriod does _GET https://api.fortnox.se/3/settings/lockedperiod/
 //
 // If no date is returned, no period is locked.
-func (c *Client) GetLockedPeriod(ctx context.Context) (*LockedPeriod, error) {
+func (c *lockedPeriodService) GetLockedPeriod(ctx context.Context) (*LockedPeriod, error) {
 	resp := &GetLockedPeriodResp{}
 
-	err := c._GET(ctx, lockedPeriodURI, nil, resp)
+	err := c._GET(ctx, getLockedPeriodOp, lockedPeriodURI, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -21,7 +21,7 @@ func (c *Client) GetLockedPeriod(ctx context.Context) (*LockedPeriod, error) {
 }
 
 type LockedPeriod struct {
-	EndDate string `json:"EndDate,omitempty"`
+	EndDate Date `json:"EndDate,omitempty"`
 }
 
 type GetLockedPeriodResp struct {
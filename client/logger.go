@@ -0,0 +1,291 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	redactedValue = "[REDACTED]"
+	// maxLoggedBody is how much of a request body is logged at debug level
+	maxLoggedBody = 2048
+)
+
+// sensitiveKeys are log attribute and JSON field names whose values are never logged, compared lower case
+var sensitiveKeys = map[string]bool{
+	"authorization":          true,
+	"client-secret":          true,
+	"client_secret":          true,
+	"clientsecret":           true,
+	"access_token":           true,
+	"accesstoken":            true,
+	"refresh_token":          true,
+	"refreshtoken":           true,
+	"personalidentitynumber": true,
+	"bankaccountno":          true,
+	"bankaccountnumber":      true,
+	"clearingno":             true,
+	"clearingnumber":         true,
+	"iban":                   true,
+	"bic":                    true,
+}
+
+// oauthFormKeys are the fields of OAuth form bodies whose values are never logged
+var oauthFormKeys = map[string]bool{
+	"code":          true,
+	"code_verifier": true,
+	"client_secret": true,
+	"refresh_token": true,
+	"access_token":  true,
+	"token":         true,
+}
+
+// personalIdentityNumberRe matches what looks like a Swedish personal identity number, e.g. 19900101-1234 or 900101+1234,
+// isPersonalIdentityNumber tells them apart from other 10 or 12 digit numbers
+var personalIdentityNumberRe = regexp.MustCompile(`\b(?:19|20)?\d{6}[-+]?\d{4}\b`)
+
+// newLogger wraps l so secrets and personal data are redacted, a nil l discards all logs
+func newLogger(l *slog.Logger) *slog.Logger {
+	if l == nil {
+		return slog.New(discardHandler{})
+	}
+
+	return slog.New(&redactingHandler{next: l.Handler()})
+}
+
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (d discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return d }
+func (d discardHandler) WithGroup(string) slog.Handler           { return d }
+
+// redactingHandler redacts sensitive attributes before passing records to the next handler
+type redactingHandler struct {
+	next slog.Handler
+}
+
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *redactingHandler) Handle(ctx context.Context, r slog.Record) error {
+	redacted := slog.NewRecord(r.Time, r.Level, redactString(r.Message), r.PC)
+
+	r.Attrs(func(a slog.Attr) bool {
+		redacted.AddAttrs(redactAttr(a))
+		return true
+	})
+
+	return h.next.Handle(ctx, redacted)
+}
+
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		redacted = append(redacted, redactAttr(a))
+	}
+
+	return &redactingHandler{next: h.next.WithAttrs(redacted)}
+}
+
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{next: h.next.WithGroup(name)}
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	// the key alone decides, so numbers, groups and LogValuers under a sensitive key are redacted too
+	if sensitiveKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, redactedValue)
+	}
+
+	v := a.Value.Resolve()
+
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, redactString(v.String()))
+	case slog.KindGroup:
+		group := v.Group()
+		redacted := make([]any, 0, len(group))
+		for _, ga := range group {
+			redacted = append(redacted, redactAttr(ga))
+		}
+		return slog.Group(a.Key, redacted...)
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			return slog.String(a.Key, redactString(err.Error()))
+		}
+	}
+
+	return slog.Attr{Key: a.Key, Value: v}
+}
+
+// IsSensitiveKey reports whether the values of the log attribute or JSON field key, compared lower case,
+// are never logged, e.g. Authorization, access_token, PersonalIdentityNumber or IBAN
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeys[strings.ToLower(key)]
+}
+
+// RedactPersonalIdentityNumbers returns s with every Swedish personal identity number,
+// including coordination numbers, replaced by [REDACTED]
+func RedactPersonalIdentityNumbers(s string) string {
+	return redactString(s)
+}
+
+func redactString(s string) string {
+	return personalIdentityNumberRe.ReplaceAllStringFunc(s, func(match string) string {
+		if !isPersonalIdentityNumber(match) {
+			return match
+		}
+
+		return redactedValue
+	})
+}
+
+// isPersonalIdentityNumber reports whether s, a match of personalIdentityNumberRe, has a valid date,
+// or a coordination number day (day + 60), and Luhn check digit
+func isPersonalIdentityNumber(s string) bool {
+	digits := make([]int, 0, 12)
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, int(r-'0'))
+		}
+	}
+
+	year := 2000
+	if len(digits) == 12 {
+		year = (digits[0]*10 + digits[1]) * 100
+		digits = digits[2:]
+	}
+	year += digits[0]*10 + digits[1]
+
+	month := time.Month(digits[2]*10 + digits[3])
+	day := digits[4]*10 + digits[5]
+	if day > 60 {
+		day -= 60
+	}
+
+	if month < time.January || month > time.December || day < 1 {
+		return false
+	}
+	// the day after the last of the month normalizes to the first of the next one
+	if time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Month() != month {
+		return false
+	}
+
+	sum := 0
+	for i, d := range digits {
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+// redactBody returns a loggable version of a JSON body with sensitive fields removed
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	return truncate(string(Redact(body)), maxLoggedBody)
+}
+
+// Redact returns body with the secrets and personal data the logger never logs replaced by [REDACTED]:
+// the values of sensitive JSON fields such as PersonalIdentityNumber or BankAccountNo and any Swedish
+// personal identity number. The code, tokens and secret of an OAuth form body are replaced as well,
+// any other body only has its personal identity numbers replaced.
+func Redact(body []byte) []byte {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		if form, ok := parseOAuthForm(body); ok {
+			return []byte(redactForm(form))
+		}
+
+		return []byte(redactString(string(body)))
+	}
+
+	bts, err := json.Marshal(redactJSONValue(v))
+	if err != nil {
+		return nil
+	}
+
+	return bts
+}
+
+func redactJSONValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, fv := range t {
+			if sensitiveKeys[strings.ToLower(k)] {
+				// whatever its type, e.g. a bank account number sent as a JSON number
+				t[k] = redactedValue
+				continue
+			}
+			t[k] = redactJSONValue(fv)
+		}
+		return t
+	case []interface{}:
+		for i, iv := range t {
+			t[i] = redactJSONValue(iv)
+		}
+		return t
+	case string:
+		return redactString(t)
+	default:
+		return v
+	}
+}
+
+// parseOAuthForm parses body as a form when it holds one of the oauthFormKeys
+func parseOAuthForm(body []byte) (url.Values, bool) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, false
+	}
+
+	for k := range form {
+		if oauthFormKeys[k] {
+			return form, true
+		}
+	}
+
+	return nil, false
+}
+
+// redactForm encodes form with the values of oauthFormKeys replaced
+func redactForm(form url.Values) string {
+	for k, vs := range form {
+		for i, v := range vs {
+			if oauthFormKeys[k] {
+				vs[i] = redactedValue
+			} else {
+				vs[i] = redactString(v)
+			}
+		}
+	}
+
+	return form.Encode()
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return s[:n] + "..."
+}
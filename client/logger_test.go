@@ -0,0 +1,94 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// accountNumber logs as the number it wraps
+type accountNumber int64
+
+func (n accountNumber) LogValue() slog.Value {
+	return slog.Int64Value(int64(n))
+}
+
+func TestLoggerRedactsAttrs(t *testing.T) {
+	tests := []struct {
+		name string
+		attr slog.Attr
+		want string
+	}{
+		{"int64", slog.Int64("bankaccountnumber", 12345678), "bankaccountnumber=[REDACTED]"},
+		{"int", slog.Int("BankAccountNo", 12345678), "BankAccountNo=[REDACTED]"},
+		{"uint64", slog.Uint64("ClearingNumber", 8327), "ClearingNumber=[REDACTED]"},
+		{"float", slog.Float64("bankaccountnumber", 12345678), "bankaccountnumber=[REDACTED]"},
+		{"bool", slog.Bool("access_token", true), "access_token=[REDACTED]"},
+		{"any number", slog.Any("bankaccountnumber", 12345678), "bankaccountnumber=[REDACTED]"},
+		{"log valuer", slog.Any("bankaccountnumber", accountNumber(12345678)), "bankaccountnumber=[REDACTED]"},
+		{"in group", slog.Group("supplier", slog.Int64("BankAccountNumber", 12345678)), "supplier.BankAccountNumber=[REDACTED]"},
+		{"group under sensitive key", slog.Group("iban", slog.String("country", "SE")), "iban=[REDACTED]"},
+		{"personal identity number", slog.String("note", "born 19900101-0017"), "note=\"born [REDACTED]\""},
+		{"other number", slog.Int64("DocumentNumber", 12345678), "DocumentNumber=12345678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := newLogger(slog.New(slog.NewTextHandler(buf, nil)))
+
+			logger.LogAttrs(context.Background(), slog.LevelInfo, "supplier", tt.attr)
+			logger.With(tt.attr).Info("with")
+
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				if !strings.Contains(line, tt.want) || strings.Contains(line, "12345678") && !strings.Contains(tt.want, "12345678") {
+					t.Errorf("logged %s, want %s", line, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			"numeric bank account",
+			`{"Supplier":{"BankAccountNumber":12345678,"ClearingNumber":8327,"Name":"Acme"}}`,
+			`{"Supplier":{"BankAccountNumber":"[REDACTED]","ClearingNumber":"[REDACTED]","Name":"Acme"}}`,
+		},
+		{
+			"string bank account",
+			`{"Supplier":{"BankAccountNo":"12345678","IBAN":"SE4550000000058398257466"}}`,
+			`{"Supplier":{"BankAccountNo":"[REDACTED]","IBAN":"[REDACTED]"}}`,
+		},
+		{
+			"personal identity number",
+			`{"Employee":{"PersonalIdentityNumber":199001010017,"Note":"19900101-0017"}}`,
+			`{"Employee":{"Note":"[REDACTED]","PersonalIdentityNumber":"[REDACTED]"}}`,
+		},
+		{
+			"error code",
+			`{"ErrorInformation":{"code":2000359,"message":"Kontonummer saknas"}}`,
+			`{"ErrorInformation":{"code":2000359,"message":"Kontonummer saknas"}}`,
+		},
+		{
+			"oauth form",
+			`grant_type=refresh_token&refresh_token=secret`,
+			`grant_type=refresh_token&refresh_token=%5BREDACTED%5D`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(Redact([]byte(tt.body))); got != tt.want {
+				t.Errorf("Redact() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
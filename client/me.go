@@ -7,10 +7,10 @@ const (
 )
 
 // GetMeInformation does _GET https://api.fortnox.se/3/me
-func (c *Client) GetMeInformation(ctx context.Context) (*MeInformation, error) {
+func (c *meService) GetMeInformation(ctx context.Context) (*MeInformation, error) {
 	resp := &GetMeInformationResp{}
 
-	err := c._GET(ctx, meURI, nil, resp)
+	err := c._GET(ctx, getMeInformationOp, meURI, nil, resp)
 	if err != nil {
 		return nil, err
 	}
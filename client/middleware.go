@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// Call is an API call passing through the middleware chain
+type Call struct {
+	// Operation is the Client method making the call, e.g. "GetInvoice" or "GetInvoicesPage" for each page of GetAllInvoices
+	Operation string
+	Method    string
+	// URI is the resource relative to the base URL, e.g. "invoices/1001"
+	URI    string
+	Params url.Values
+	// Body is the request body before it is encoded, e.g. a *CreateInvoiceReq, nil for GET and DELETE
+	Body interface{}
+	// Result is what the response is decoded into, e.g. a *GetInvoiceResp, it is filled in once the call returns
+	Result interface{}
+}
+
+// Handler handles a Call, returning the error of the call
+type Handler func(ctx context.Context, call *Call) error
+
+// Middleware wraps a Handler, it may change the Call before passing it on to next
+// and inspect call.Result and the error after next returned
+type Middleware func(next Handler) Handler
+
+// WithMiddlewareOpt adds middlewares around every API call, the first one given is the outermost.
+// A middleware sees each call once, rate limiting, retries and token refreshes happen inside next.
+func WithMiddlewareOpt(middlewares ...Middleware) OptionFunc {
+	return func(co *Options) {
+		co.Middlewares = append(co.Middlewares, middlewares...)
+	}
+}
+
+// chain wraps h in middlewares, the first one being the outermost
+func chain(h Handler, middlewares []Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+
+	return h
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCallOperation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"Invoices":[],"MetaInformation":{"@TotalResources":0,"@TotalPages":1,"@CurrentPage":1}}`)
+	}))
+	defer server.Close()
+
+	var got []string
+	c := NewClient(
+		WithAuthOpt("access-token", "client-secret"),
+		WithURLOpt(server.URL+"/3/"),
+		WithRateLimitOpt(0, 0),
+		WithRetryPolicyOpt(NoRetryPolicy),
+		WithMiddlewareOpt(func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) error {
+				got = append(got, call.Operation)
+				return next(ctx, call)
+			}
+		}))
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{
+			"client method",
+			func() error {
+				_, err := c.GetInvoice(ctx, "1")
+				return err
+			},
+			"GetInvoice",
+		},
+		{
+			"service method",
+			func() error {
+				_, err := c.Invoices.CancelInvoice(ctx, "1")
+				return err
+			},
+			"CancelInvoice",
+		},
+		{
+			"pages of GetAll",
+			func() error {
+				_, err := c.GetAllInvoices(ctx, nil)
+				return err
+			},
+			"GetInvoicesPage",
+		},
+		{
+			"patch",
+			func() error {
+				_, err := c.PatchInvoice(ctx, &Invoice{DocumentNumber: "1"}, &Invoice{DocumentNumber: "1", Comments: "paid"})
+				return err
+			},
+			"UpdateInvoice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+
+			if strings.Join(got, ",") != tt.want {
+				t.Errorf("operations = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,25 +10,42 @@ const (
 )
 
 // GetAllModesOfPayments does _GET https://api.fortnox.se/3/modesofpayments
-func (c *Client) GetAllModesOfPayments(ctx context.Context) ([]ModeOfPayment, error) {
+func (c *modesOfPaymentsService) GetAllModesOfPayments(ctx context.Context) ([]ModeOfPayment, error) {
+	return c.IterateModesOfPayments().All(ctx)
+}
+
+// GetModesOfPaymentsPage does _GET https://api.fortnox.se/3/modesofpayments and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *modesOfPaymentsService) GetModesOfPaymentsPage(
+	ctx context.Context,
+	page *PageOptions) ([]ModeOfPayment, *MetaInformation, error) {
+
 	resp := &GetAllModesOfPaymentsResp{}
 
-	err := c._GET(ctx, modesOfPaymentsURI, nil, resp)
+	err := c._GETPage(ctx, getModesOfPaymentsPageOp, modesOfPaymentsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.ModeOfPayments, nil
+	return resp.ModeOfPayments, &resp.MetaInformation, nil
+}
+
+// IterateModesOfPayments lazily walks all pages of https://api.fortnox.se/3/modesofpayments
+func (c *modesOfPaymentsService) IterateModesOfPayments() *Iterator[ModeOfPayment] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]ModeOfPayment, *MetaInformation, error) {
+		return c.GetModesOfPaymentsPage(ctx, page)
+	})
 }
 
 // CreateModeOfPayment does _POST https://api.fortnox.se/3/modesofpayments
 //
 // mop - mode of payment to create
-func (c *Client) CreateModeOfPayment(ctx context.Context, si *ModeOfPayment) (*ModeOfPayment, error) {
+func (c *modesOfPaymentsService) CreateModeOfPayment(ctx context.Context, si *ModeOfPayment) (*ModeOfPayment, error) {
 	req := &CreateModeOfPaymentReq{ModeOfPayment: *si}
 	resp := &CreateModeOfPaymentResp{}
 
-	err := c._POST(ctx, modesOfPaymentsURI, nil, req, resp)
+	err := c._POST(ctx, createModeOfPaymentOp, modesOfPaymentsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +56,12 @@ func (c *Client) CreateModeOfPayment(ctx context.Context, si *ModeOfPayment) (*M
 // GetModeOfPayment does _GET https://api.fortnox.se/3/modesofpayments/{Code}
 //
 // code - identifies the mode of payment
-func (c *Client) GetModeOfPayment(ctx context.Context, code string) (*ModeOfPayment, error) {
+func (c *modesOfPaymentsService) GetModeOfPayment(ctx context.Context, code string) (*ModeOfPayment, error) {
 	resp := &GetModeOfPaymentResp{}
 
 	uri := fmt.Sprintf("%s/%s", modesOfPaymentsURI, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getModeOfPaymentOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +74,7 @@ func (c *Client) GetModeOfPayment(ctx context.Context, code string) (*ModeOfPaym
 // code - identifies the mode of payment
 //
 // mop - mode of payment to update
-func (c *Client) UpdateModeOfPayment(
+func (c *modesOfPaymentsService) UpdateModeOfPayment(
 	ctx context.Context,
 	code string,
 	mop *ModeOfPayment) (*ModeOfPayment, error) {
@@ -67,7 +84,7 @@ func (c *Client) UpdateModeOfPayment(
 
 	uri := fmt.Sprintf("%s/%s", modesOfPaymentsURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateModeOfPaymentOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +93,8 @@ func (c *Client) UpdateModeOfPayment(
 }
 
 type GetAllModesOfPaymentsResp struct {
-	ModeOfPayments []ModeOfPayment `json:"ModeOfPayments"`
+	ModeOfPayments  []ModeOfPayment `json:"ModeOfPayments"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateModeOfPaymentReq struct {
@@ -0,0 +1,331 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MoneyDecimals is the number of decimal places Money keeps
+	MoneyDecimals = 6
+	moneyScale    = 1_000_000
+)
+
+var (
+	ErrInvalidMoney  = errors.New("invalid money amount")
+	ErrMoneyOverflow = errors.New("money amount out of range")
+)
+
+// Money is a fixed-point decimal with MoneyDecimals decimal places, used for all amounts, prices and rates.
+//
+// Fortnox sends amounts as JSON numbers (1234.5) or strings ("1234.50"), Money decodes both
+// and is always encoded as a number. The zero value is 0 and is left out by omitzero.
+//
+// Money is opaque, values come from the constructors, ParseMoney and JSON decoding.
+// Arithmetic panics with ErrMoneyOverflow when the result is out of range.
+type Money struct {
+	// millionths of a unit
+	v int64
+}
+
+// RoundingMode decides which way Money.Round goes when the value is not already rounded
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds halves away from zero, as Fortnox does for öresavrundning
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds halves to the nearest even digit, banker's rounding
+	RoundHalfEven
+	// RoundDown truncates towards zero
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+)
+
+// MoneyFromInt returns whole units, e.g. MoneyFromInt(100) is 100.00 kr.
+// It panics if units is out of range.
+func MoneyFromInt(units int64) Money {
+	return Money{mustMul(units, moneyScale)}
+}
+
+// MoneyFromMinor returns an amount given in hundredths, e.g. MoneyFromMinor(12350) is 123.50 kr.
+// It panics if minor is out of range.
+func MoneyFromMinor(minor int64) Money {
+	return Money{mustMul(minor, moneyScale/100)}
+}
+
+// MoneyFromFloat returns f rounded half up to MoneyDecimals places.
+// It panics if f is not finite or out of range.
+func MoneyFromFloat(f float64) Money {
+	scaled := math.Round(f * moneyScale)
+	// float64(math.MaxInt64) rounds up to 2^63, which is already out of range
+	if math.IsNaN(scaled) || scaled >= math.MaxInt64 || scaled < math.MinInt64 {
+		panic(ErrMoneyOverflow)
+	}
+
+	return Money{int64(scaled)}
+}
+
+// ParseMoney parses a decimal such as "1234.50", "-0.5", "1 234,50" or "1e3"
+func ParseMoney(s string) (Money, error) {
+	s = strings.Map(func(r rune) rune {
+		// thousands separators, including the non breaking space Swedish formatting uses
+		if r == ' ' || r == '\u00a0' || r == '_' {
+			return -1
+		}
+		return r
+	}, s)
+
+	if s == "" {
+		return Money{}, nil
+	}
+
+	if !strings.Contains(s, ".") {
+		s = strings.Replace(s, ",", ".", 1)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Money{}, errors.Wrapf(ErrInvalidMoney, "can't parse %q", s)
+	}
+
+	return moneyFromRat(r, RoundHalfUp)
+}
+
+// MustParseMoney is ParseMoney panicking on error, for constants and tests
+func MustParseMoney(s string) Money {
+	m, err := ParseMoney(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func moneyFromRat(r *big.Rat, mode RoundingMode) (Money, error) {
+	scaled := new(big.Rat).Mul(r, big.NewRat(moneyScale, 1))
+
+	i := roundRat(scaled, mode)
+	if !i.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{i.Int64()}, nil
+}
+
+// roundRat rounds r to an integer according to mode
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return q
+	}
+
+	// away is the direction of rounding away from zero
+	away := big.NewInt(int64(r.Sign()))
+
+	// compare 2*|rem| with the denominator to find out if the remainder is below, at or above one half
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	cmp := half.Cmp(r.Denom())
+
+	switch mode {
+	case RoundDown:
+		return q
+	case RoundUp:
+		return q.Add(q, away)
+	case RoundHalfEven:
+		if cmp > 0 || (cmp == 0 && q.Bit(0) == 1) {
+			return q.Add(q, away)
+		}
+		return q
+	default:
+		if cmp >= 0 {
+			return q.Add(q, away)
+		}
+		return q
+	}
+}
+
+// mustAdd returns a + b, panicking with ErrMoneyOverflow if it doesn't fit an int64
+func mustAdd(a, b int64) int64 {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		panic(ErrMoneyOverflow)
+	}
+
+	return sum
+}
+
+// mustMul returns a * b, panicking with ErrMoneyOverflow if it doesn't fit an int64
+func mustMul(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		panic(ErrMoneyOverflow)
+	}
+
+	return product
+}
+
+func (m Money) rat() *big.Rat {
+	return big.NewRat(m.v, moneyScale)
+}
+
+// Add returns m + o, it panics if the result is out of range
+func (m Money) Add(o Money) Money {
+	return Money{mustAdd(m.v, o.v)}
+}
+
+// Sub returns m - o, it panics if the result is out of range
+func (m Money) Sub(o Money) Money {
+	return m.Add(o.Neg())
+}
+
+// Neg returns -m, it panics if m is the smallest representable amount
+func (m Money) Neg() Money {
+	return Money{mustMul(m.v, -1)}
+}
+
+// Abs returns the absolute value of m
+func (m Money) Abs() Money {
+	if m.v < 0 {
+		return m.Neg()
+	}
+
+	return m
+}
+
+// MulInt returns m * n, e.g. a row price times a whole quantity.
+// It panics if the result is out of range.
+func (m Money) MulInt(n int64) Money {
+	return Money{mustMul(m.v, n)}
+}
+
+// Mul returns m * o rounded half up to MoneyDecimals places, e.g. a price times a quantity or rate.
+// It panics if the result is out of range.
+func (m Money) Mul(o Money) Money {
+	res, err := moneyFromRat(new(big.Rat).Mul(m.rat(), o.rat()), RoundHalfUp)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// Div returns m / o rounded half up to MoneyDecimals places.
+// It panics if o is zero or the result is out of range.
+func (m Money) Div(o Money) Money {
+	if o.v == 0 {
+		panic("fortnox: money division by zero")
+	}
+
+	res, err := moneyFromRat(new(big.Rat).Quo(m.rat(), o.rat()), RoundHalfUp)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// Round returns m rounded to places decimal places, 2 rounds to öre and 0 to whole kronor.
+// It panics if the result is out of range.
+func (m Money) Round(places int, mode RoundingMode) Money {
+	if places >= MoneyDecimals {
+		return m
+	}
+	if places < 0 {
+		places = 0
+	}
+
+	unit := int64(math.Pow10(MoneyDecimals - places))
+
+	rounded := roundRat(big.NewRat(m.v, unit), mode)
+	if !rounded.IsInt64() {
+		panic(ErrMoneyOverflow)
+	}
+
+	return Money{mustMul(rounded.Int64(), unit)}
+}
+
+// Cmp returns -1, 0 or 1 when m is less than, equal to or greater than o
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.v < o.v:
+		return -1
+	case m.v > o.v:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of m
+func (m Money) Sign() int {
+	return m.Cmp(Money{})
+}
+
+// IsZero reports whether m is 0, it makes omitzero leave out zero amounts
+func (m Money) IsZero() bool {
+	return m.v == 0
+}
+
+// Float64 returns m as a float, only use it for display or statistics
+func (m Money) Float64() float64 {
+	return float64(m.v) / moneyScale
+}
+
+// String formats m with at least two decimals, e.g. "1234.50" or "10.4567"
+func (m Money) String() string {
+	sign := ""
+	u := uint64(m.v)
+	if m.v < 0 {
+		sign = "-"
+		// two's complement negation, also right for the smallest int64
+		u = -u
+	}
+
+	frac := fmt.Sprintf("%0*d", MoneyDecimals, u%moneyScale)
+	frac = strings.TrimRight(frac, "0")
+	for len(frac) < 2 {
+		frac += "0"
+	}
+
+	return fmt.Sprintf("%s%d.%s", sign, u/moneyScale, frac)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	parsed, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
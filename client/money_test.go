@@ -0,0 +1,212 @@
+package client
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{"1234.50", "1234.50", nil},
+		{"-0.5", "-0.50", nil},
+		{"1 234,50", "1234.50", nil},
+		{"1\u00a0234,50", "1234.50", nil},
+		{"1_000", "1000.00", nil},
+		{"1e3", "1000.00", nil},
+		{"", "0.00", nil},
+		{"0.1234565", "0.123457", nil},
+		{"-0.1234565", "-0.123457", nil},
+		{"0.00000049", "0.00", nil},
+		{"12.3456789012345678901234567890", "12.345679", nil},
+		{"9223372036854.775807", "9223372036854.775807", nil},
+		{"-9223372036854.775808", "-9223372036854.775808", nil},
+		{"9223372036854.775808", "", ErrMoneyOverflow},
+		{"1e30", "", ErrMoneyOverflow},
+		{"12 kr", "", ErrInvalidMoney},
+		{"1,234.50", "", ErrInvalidMoney},
+		{"abc", "", ErrInvalidMoney},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMoney(tt.in)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseMoney() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseMoney() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyRound(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int
+		mode   RoundingMode
+		want   string
+	}{
+		{"2.345", 2, RoundHalfUp, "2.35"},
+		{"-2.345", 2, RoundHalfUp, "-2.35"},
+		{"2.344999", 2, RoundHalfUp, "2.34"},
+		{"2.345", 2, RoundHalfEven, "2.34"},
+		{"2.355", 2, RoundHalfEven, "2.36"},
+		{"-2.345", 2, RoundHalfEven, "-2.34"},
+		{"2.3451", 2, RoundHalfEven, "2.35"},
+		{"2.349", 2, RoundDown, "2.34"},
+		{"-2.349", 2, RoundDown, "-2.34"},
+		{"2.341", 2, RoundUp, "2.35"},
+		{"-2.341", 2, RoundUp, "-2.35"},
+		{"2.34", 2, RoundUp, "2.34"},
+		{"99.50", 0, RoundHalfUp, "100.00"},
+		{"-99.50", 0, RoundHalfUp, "-100.00"},
+		{"98.50", 0, RoundHalfEven, "98.00"},
+		{"1.234567", 6, RoundUp, "1.234567"},
+		{"1.234567", 9, RoundDown, "1.234567"},
+		{"1.5", -1, RoundHalfUp, "2.00"},
+	}
+
+	for _, tt := range tests {
+		if got := MustParseMoney(tt.in).Round(tt.places, tt.mode); got.String() != tt.want {
+			t.Errorf("%s.Round(%d, %d) = %s, want %s", tt.in, tt.places, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Money
+		want string
+	}{
+		{"add", MustParseMoney("0.1").Add(MustParseMoney("0.2")), "0.30"},
+		{"sub below zero", MustParseMoney("10").Sub(MustParseMoney("12.75")), "-2.75"},
+		{"neg", MustParseMoney("-3.5").Neg(), "3.50"},
+		{"abs", MustParseMoney("-3.5").Abs(), "3.50"},
+		{"mul int", MustParseMoney("19.99").MulInt(3), "59.97"},
+		{"mul negative", MustParseMoney("-19.99").MulInt(3), "-59.97"},
+		{"mul rate", MustParseMoney("100").Mul(MustParseMoney("0.25")), "25.00"},
+		{"mul rounds", MustParseMoney("0.000001").Mul(MustParseMoney("0.5")), "0.000001"},
+		{"div", MustParseMoney("100").Div(MustParseMoney("3")), "33.333333"},
+		{"div negative", MustParseMoney("-2").Div(MustParseMoney("3")), "-0.666667"},
+		{"from minor", MoneyFromMinor(12350), "123.50"},
+		{"from float", MoneyFromFloat(0.1 + 0.2), "0.30"},
+		{"from negative float", MoneyFromFloat(-1234.5678901), "-1234.56789"},
+	}
+
+	for _, tt := range tests {
+		if tt.got.String() != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if MustParseMoney("-1").Sign() != -1 || (Money{}).Sign() != 0 || !(Money{}).IsZero() {
+		t.Error("Sign or IsZero is wrong")
+	}
+	if MustParseMoney("1.5").Cmp(MustParseMoney("1.50")) != 0 || MustParseMoney("-2").Cmp(MustParseMoney("1")) != -1 {
+		t.Error("Cmp is wrong")
+	}
+}
+
+func TestMoneyOverflow(t *testing.T) {
+	max := Money{math.MaxInt64}
+	min := Money{math.MinInt64}
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"add", func() { max.Add(MustParseMoney("0.000001")) }},
+		{"sub", func() { min.Sub(MustParseMoney("0.000001")) }},
+		{"neg", func() { min.Neg() }},
+		{"abs", func() { min.Abs() }},
+		{"mul int", func() { MustParseMoney("10000000").MulInt(1_000_000_000) }},
+		{"mul", func() { MustParseMoney("10000000").Mul(MustParseMoney("10000000")) }},
+		{"div", func() { MustParseMoney("10000000000").Div(MustParseMoney("0.0001")) }},
+		{"round", func() { max.Round(0, RoundUp) }},
+		{"from int", func() { MoneyFromInt(math.MaxInt64 / 1000) }},
+		{"from float", func() { MoneyFromFloat(1e20) }},
+		{"from NaN", func() { MoneyFromFloat(math.NaN()) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, ErrMoneyOverflow) {
+					t.Errorf("panic = %v, want ErrMoneyOverflow", err)
+				}
+			}()
+
+			tt.fn()
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	type row struct {
+		Price Money `json:"Price,omitzero"`
+	}
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`{"Price":1234.5}`, `{"Price":1234.50}`},
+		{`{"Price":"1234.50"}`, `{"Price":1234.50}`},
+		{`{"Price":-0.125}`, `{"Price":-0.125}`},
+		{`{"Price":"-1 234,5"}`, `{"Price":-1234.50}`},
+		{`{"Price":0.1234567}`, `{"Price":0.123457}`},
+		{`{"Price":1e2}`, `{"Price":100.00}`},
+		{`{"Price":"9223372036854.775807"}`, `{"Price":9223372036854.775807}`},
+		{`{"Price":0}`, `{}`},
+		{`{"Price":""}`, `{}`},
+		{`{"Price":null}`, `{}`},
+		{`{}`, `{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var r row
+			if err := json.Unmarshal([]byte(tt.in), &r); err != nil {
+				t.Fatal(err)
+			}
+
+			bts, err := json.Marshal(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(bts) != tt.want {
+				t.Errorf("round trip = %s, want %s", bts, tt.want)
+			}
+
+			// and back again without loss
+			var again row
+			if err := json.Unmarshal(bts, &again); err != nil || again != r {
+				t.Errorf("second round trip = %+v, %v, want %+v", again, err, r)
+			}
+		})
+	}
+
+	for _, in := range []string{`{"Price":"ten"}`, `{"Price":true}`, `{"Price":1e40}`} {
+		var r row
+		if err := json.Unmarshal([]byte(in), &r); err == nil {
+			t.Errorf("Unmarshal(%s) = %+v, want an error", in, r)
+		}
+	}
+}
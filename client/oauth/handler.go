@@ -0,0 +1,232 @@
+package oauth
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+const (
+	defaultStateTTL = 10 * time.Minute
+	// stateCookie binds the state to the browser that started the flow,
+	// so a callback link can't be handed to somebody else to connect the wrong Fortnox account
+	stateCookie = "fortnox_oauth_state"
+)
+
+// ErrMissingCode is returned for a callback without an authorization code
+var ErrMissingCode = errors.New("oauth: callback has no code")
+
+// AuthorizationError is returned when Fortnox redirected back with an error, e.g. access_denied when the user declined
+type AuthorizationError struct {
+	Code        string
+	Description string
+}
+
+func (e *AuthorizationError) Error() string {
+	return fmt.Sprintf("oauth: authorization failed: %s <-> %s", e.Code, e.Description)
+}
+
+// SuccessFunc responds to the user once the token pair has been saved
+type SuccessFunc func(w http.ResponseWriter, r *http.Request, token *client.TokenInfo)
+
+// ErrorFunc responds to the user when the flow failed, err is one of ErrInvalidState, ErrMissingCode,
+// *AuthorizationError, or the error of the code exchange or token store
+type ErrorFunc func(w http.ResponseWriter, r *http.Request, err error)
+
+// Handler starts the authorization code flow and handles its callback
+type Handler struct {
+	config    Config
+	tokens    client.TokenStore
+	states    StateStore
+	stateTTL  time.Duration
+	now       func() time.Time
+	onSuccess SuccessFunc
+	onError   ErrorFunc
+}
+
+// Option configures a Handler
+type Option func(h *Handler)
+
+// WithStateStore sets where states are kept, a MemoryStateStore by default
+func WithStateStore(s StateStore) Option {
+	return func(h *Handler) {
+		h.states = s
+	}
+}
+
+// WithStateTTL sets how long the user has to authorize the integration, 10 minutes by default
+func WithStateTTL(d time.Duration) Option {
+	return func(h *Handler) {
+		h.stateTTL = d
+	}
+}
+
+// WithSuccessHandler sets the response once the token pair has been saved, a short plain text page by default
+func WithSuccessHandler(fn SuccessFunc) Option {
+	return func(h *Handler) {
+		h.onSuccess = fn
+	}
+}
+
+// WithErrorHandler sets the response when the flow failed, a plain text error by default
+func WithErrorHandler(fn ErrorFunc) Option {
+	return func(h *Handler) {
+		h.onError = fn
+	}
+}
+
+// NewHandler creates a Handler saving the token pair of a completed flow in tokens
+func NewHandler(config Config, tokens client.TokenStore, opts ...Option) *Handler {
+	h := &Handler{
+		config:    config,
+		tokens:    tokens,
+		states:    NewMemoryStateStore(),
+		stateTTL:  defaultStateTTL,
+		now:       time.Now,
+		onSuccess: defaultSuccess,
+		onError:   defaultError,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// ServeHTTP handles the callback when Fortnox redirected back, otherwise it starts the flow
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Has("state") || q.Has("code") || q.Has("error") {
+		h.Callback(w, r)
+		return
+	}
+
+	h.Start(w, r)
+}
+
+// Start redirects the user to Fortnox with a new state
+func (h *Handler) Start(w http.ResponseWriter, r *http.Request) {
+	state, err := newState()
+	if err != nil {
+		h.onError(w, r, err)
+		return
+	}
+
+	expiresAt := h.now().Add(h.stateTTL)
+	if err := h.states.Save(r.Context(), state, expiresAt); err != nil {
+		h.onError(w, r, errors.Wrap(err, "oauth: can't save state"))
+		return
+	}
+
+	http.SetCookie(w, h.cookie(state, expiresAt))
+	http.Redirect(w, r, h.config.AuthCodeURL(state), http.StatusFound)
+}
+
+// Callback verifies the state Fortnox redirected back with, exchanges the code and saves the token pair
+func (h *Handler) Callback(w http.ResponseWriter, r *http.Request) {
+	token, err := h.callback(r)
+
+	// the state is used up either way
+	expired := h.cookie("", time.Unix(0, 0))
+	expired.MaxAge = -1
+	http.SetCookie(w, expired)
+
+	if err != nil {
+		h.onError(w, r, err)
+		return
+	}
+
+	h.onSuccess(w, r, token)
+}
+
+func (h *Handler) callback(r *http.Request) (*client.TokenInfo, error) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	state := q.Get("state")
+	if state == "" {
+		return nil, errors.Wrap(ErrInvalidState, "callback has no state")
+	}
+
+	cookie, err := r.Cookie(stateCookie)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		return nil, errors.Wrap(ErrInvalidState, "state wasn't issued to this browser")
+	}
+
+	if err := h.states.Consume(ctx, state); err != nil {
+		return nil, err
+	}
+
+	if code := q.Get("error"); code != "" {
+		return nil, &AuthorizationError{Code: code, Description: q.Get("error_description")}
+	}
+
+	code := q.Get("code")
+	if code == "" {
+		return nil, ErrMissingCode
+	}
+
+	token, err := h.config.Exchange(ctx, code)
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth: code exchange failed")
+	}
+
+	if token.ExpiresAt.IsZero() && token.ExpiresIn > 0 {
+		token.ExpiresAt = h.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	if err := h.tokens.Save(ctx, token); err != nil {
+		return nil, errors.Wrap(err, "oauth: failed to persist token")
+	}
+
+	return token, nil
+}
+
+// cookie returns the state cookie, scoped to the path of the redirect URI
+func (h *Handler) cookie(state string, expiresAt time.Time) *http.Cookie {
+	path, secure := "/", false
+	if u, err := url.Parse(h.config.RedirectURI); err == nil {
+		if u.Path != "" {
+			path = u.Path
+		}
+		secure = u.Scheme == "https"
+	}
+
+	return &http.Cookie{
+		Name:     stateCookie,
+		Value:    state,
+		Path:     path,
+		Expires:  expiresAt,
+		Secure:   secure,
+		HttpOnly: true,
+		// Lax, the callback is a top level redirect from Fortnox
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func defaultSuccess(w http.ResponseWriter, _ *http.Request, _ *client.TokenInfo) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = io.WriteString(w, "Fortnox is connected, you can close this window.\n")
+}
+
+func defaultError(w http.ResponseWriter, _ *http.Request, err error) {
+	var authErr *AuthorizationError
+	switch {
+	case errors.Is(err, ErrInvalidState):
+		http.Error(w, "The authorization link has expired or was already used, please start again.", http.StatusBadRequest)
+	case errors.Is(err, ErrMissingCode):
+		http.Error(w, "Fortnox didn't send an authorization code.", http.StatusBadRequest)
+	case errors.As(err, &authErr):
+		http.Error(w, "Fortnox wasn't connected: "+authErr.Code, http.StatusForbidden)
+	default:
+		http.Error(w, "Fortnox couldn't be connected, please try again.", http.StatusBadGateway)
+	}
+}
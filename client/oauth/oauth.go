@@ -0,0 +1,63 @@
+// Package oauth runs the Fortnox authorization code flow for integrations that are connected by a user.
+//
+// The Handler is served on the redirect URI registered with the integration.
+// A request without a callback query starts the flow by redirecting to Fortnox with a random state,
+// the callback Fortnox redirects back with is verified against the state before the code is exchanged
+// and the token pair saved in a client.TokenStore:
+//
+//	h := oauth.NewHandler(oauth.Config{
+//		ClientID:     clientID,
+//		ClientSecret: clientSecret,
+//		RedirectURI:  "https://example.com/fortnox/callback",
+//		Scopes:       client.Scopes{client.ScopeInvoice, client.ScopeCustomer},
+//	}, tokenStore)
+//	http.Handle("/fortnox/callback", h)
+package oauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+const (
+	AuthURL  = "https://apps.fortnox.se/oauth-v1/auth"
+	TokenURL = "https://apps.fortnox.se/oauth-v1/token"
+)
+
+// Config is the integration as registered with Fortnox
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	// RedirectURI is where Fortnox redirects back to, it must match the one registered exactly
+	RedirectURI string
+	Scopes      client.Scopes
+	// IsService requests a service account, the integration then isn't tied to the user authorizing it
+	IsService bool
+	// AuthURL and TokenURL default to the Fortnox endpoints
+	AuthURL  string
+	TokenURL string
+	// HTTPClient exchanges the code, one with a 10 second timeout by default
+	HTTPClient *http.Client
+}
+
+// AuthCodeURL returns the link the user authorizes the integration on, state is echoed back on the callback
+func (c *Config) AuthCodeURL(state string) string {
+	authURL := c.AuthURL
+	if authURL == "" {
+		authURL = AuthURL
+	}
+
+	return client.AuthCodeURL(authURL, c.ClientID, c.RedirectURI, state, c.Scopes, c.IsService)
+}
+
+// Exchange exchanges the code of a callback for a token pair
+func (c *Config) Exchange(ctx context.Context, code string) (*client.TokenInfo, error) {
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = TokenURL
+	}
+
+	return client.AuthorizeContext(ctx, c.HTTPClient, tokenURL, c.ClientID, c.ClientSecret, code, c.RedirectURI)
+}
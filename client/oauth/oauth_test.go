@@ -0,0 +1,335 @@
+package oauth
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/thats4fun/go-fortnox-sdk/client"
+)
+
+const redirectURI = "https://example.com/fortnox/callback"
+
+// tokenServer answers the code exchange for "good-code" with a token pair and rejects any other code
+func tokenServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var exchanges atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exchanges.Add(1)
+
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("redirect_uri") != redirectURI {
+			t.Errorf("unexpected token request %v", r.PostForm)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"error":"invalid_grant","error_description":"Invalid authorization code"}`)
+			return
+		}
+
+		_, _ = io.WriteString(w, `{"access_token":"access","refresh_token":"refresh","scope":"invoice","expires_in":3600,"token_type":"bearer"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &exchanges
+}
+
+func newTestHandler(t *testing.T, tokens client.TokenStore, opts ...Option) (*Handler, *atomic.Int32) {
+	t.Helper()
+
+	server, exchanges := tokenServer(t)
+
+	h := NewHandler(Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURI:  redirectURI,
+		Scopes:       client.Scopes{client.ScopeInvoice},
+		TokenURL:     server.URL,
+	}, tokens, opts...)
+
+	return h, exchanges
+}
+
+// start runs the start of the flow and returns the state and the cookie binding it to the browser
+func start(t *testing.T, h *Handler) (string, *http.Cookie) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, redirectURI, nil))
+
+	if rec.Code != http.StatusFound {
+		t.Fatalf("start status = %d, want %d", rec.Code, http.StatusFound)
+	}
+
+	location, err := url.Parse(rec.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != stateCookie {
+		t.Fatalf("start cookies = %v", cookies)
+	}
+
+	return location.Query().Get("state"), cookies[0]
+}
+
+// callback sends the redirect Fortnox sends back with query, with cookie when it isn't nil
+func callback(h *Handler, query url.Values, cookie *http.Cookie) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, redirectURI+"?"+query.Encode(), nil)
+	if cookie != nil {
+		r.AddCookie(cookie)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+
+	return rec
+}
+
+func TestStart(t *testing.T) {
+	h, _ := newTestHandler(t, client.NewMemoryTokenStore(nil))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, redirectURI, nil))
+
+	location, err := url.Parse(rec.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := location.Query()
+	if location.Host != "apps.fortnox.se" || q.Get("client_id") != "client-id" || q.Get("redirect_uri") != redirectURI {
+		t.Errorf("redirected to %s", location)
+	}
+	if len(q.Get("state")) < 40 {
+		t.Errorf("state %q is too short to be random", q.Get("state"))
+	}
+
+	cookie := rec.Result().Cookies()[0]
+	if cookie.Value != q.Get("state") || !cookie.HttpOnly || !cookie.Secure || cookie.Path != "/fortnox/callback" {
+		t.Errorf("state cookie = %+v", cookie)
+	}
+}
+
+func TestCallback(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		// callback returns the query and cookie of the callback for the state and cookie of a started flow
+		callback      func(state string, cookie *http.Cookie) (url.Values, *http.Cookie)
+		wantStatus    int
+		wantErr       error
+		wantExchanges int32
+	}{
+		{
+			"happy path",
+			func(state string, cookie *http.Cookie) (url.Values, *http.Cookie) {
+				return url.Values{"state": {state}, "code": {"good-code"}}, cookie
+			},
+			http.StatusOK, nil, 1,
+		},
+		{
+			"no cookie",
+			func(state string, _ *http.Cookie) (url.Values, *http.Cookie) {
+				return url.Values{"state": {state}, "code": {"good-code"}}, nil
+			},
+			http.StatusBadRequest, ErrInvalidState, 0,
+		},
+		{
+			"cookie of another browser",
+			func(state string, cookie *http.Cookie) (url.Values, *http.Cookie) {
+				return url.Values{"state": {state}, "code": {"good-code"}}, &http.Cookie{Name: stateCookie, Value: "other"}
+			},
+			http.StatusBadRequest, ErrInvalidState, 0,
+		},
+		{
+			"state mismatch",
+			func(_ string, cookie *http.Cookie) (url.Values, *http.Cookie) {
+				return url.Values{"state": {"forged"}, "code": {"good-code"}}, &http.Cookie{Name: stateCookie, Value: "forged"}
+			},
+			http.StatusBadRequest, ErrInvalidState, 0,
+		},
+		{
+			"no state",
+			func(_ string, cookie *http.Cookie) (url.Values, *http.Cookie) {
+				return url.Values{"code": {"good-code"}}, cookie
+			},
+			http.StatusBadRequest, ErrInvalidState, 0,
+		},
+		{
+			"user declined",
+			func(state string, cookie *http.Cookie) (url.Values, *http.Cookie) {
+				return url.Values{"state": {state}, "error": {"access_denied"}, "error_description": {"User denied"}}, cookie
+			},
+			http.StatusForbidden, &AuthorizationError{}, 0,
+		},
+		{
+			"no code",
+			func(state string, cookie *http.Cookie) (url.Values, *http.Cookie) {
+				return url.Values{"state": {state}}, cookie
+			},
+			http.StatusBadRequest, ErrMissingCode, 0,
+		},
+		{
+			"code rejected",
+			func(state string, cookie *http.Cookie) (url.Values, *http.Cookie) {
+				return url.Values{"state": {state}, "code": {"bad-code"}}, cookie
+			},
+			http.StatusBadGateway, nil, 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := client.NewMemoryTokenStore(nil)
+			states := NewMemoryStateStore()
+			states.now = func() time.Time { return now }
+
+			var gotErr error
+			h, exchanges := newTestHandler(t, tokens, WithStateStore(states), WithErrorHandler(func(w http.ResponseWriter, r *http.Request, err error) {
+				gotErr = err
+				defaultError(w, r, err)
+			}))
+			h.now = func() time.Time { return now }
+
+			state, cookie := start(t, h)
+			query, cookie := tt.callback(state, cookie)
+			rec := callback(h, query, cookie)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if got := exchanges.Load(); got != tt.wantExchanges {
+				t.Errorf("code exchanged %d times, want %d", got, tt.wantExchanges)
+			}
+
+			var authErr *AuthorizationError
+			switch want := tt.wantErr.(type) {
+			case nil:
+			case *AuthorizationError:
+				if !errors.As(gotErr, &authErr) || authErr.Code != "access_denied" || authErr.Description != "User denied" {
+					t.Errorf("error = %v, want an AuthorizationError", gotErr)
+				}
+			default:
+				if !errors.Is(gotErr, want) {
+					t.Errorf("error = %v, want %v", gotErr, want)
+				}
+			}
+
+			// the state cookie is cleared whatever the outcome
+			cleared := rec.Result().Cookies()
+			if len(cleared) != 1 || cleared[0].Name != stateCookie || cleared[0].MaxAge >= 0 {
+				t.Errorf("callback cookies = %v, want the state cookie cleared", cleared)
+			}
+
+			token, err := tokens.Load(context.Background())
+			if tt.wantStatus != http.StatusOK {
+				if token != nil && token.AccessToken != "" {
+					t.Errorf("failed flow saved %+v", token)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token.AccessToken != "access" || token.RefreshToken != "refresh" || !token.ExpiresAt.Equal(now.Add(time.Hour)) {
+				t.Errorf("saved token = %+v", token)
+			}
+		})
+	}
+}
+
+func TestCallbackStateUsedOnce(t *testing.T) {
+	h, exchanges := newTestHandler(t, client.NewMemoryTokenStore(nil))
+
+	state, cookie := start(t, h)
+	query := url.Values{"state": {state}, "code": {"good-code"}}
+
+	if rec := callback(h, query, cookie); rec.Code != http.StatusOK {
+		t.Fatalf("first callback status = %d: %s", rec.Code, rec.Body)
+	}
+
+	if rec := callback(h, query, cookie); rec.Code != http.StatusBadRequest {
+		t.Errorf("replayed callback status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if got := exchanges.Load(); got != 1 {
+		t.Errorf("code exchanged %d times, want 1", got)
+	}
+}
+
+func TestCallbackStateExpired(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	states := NewMemoryStateStore()
+	states.now = func() time.Time { return now }
+
+	h, exchanges := newTestHandler(t, client.NewMemoryTokenStore(nil), WithStateStore(states), WithStateTTL(time.Minute))
+	h.now = func() time.Time { return now }
+
+	state, cookie := start(t, h)
+
+	now = now.Add(time.Minute)
+
+	if rec := callback(h, url.Values{"state": {state}, "code": {"good-code"}}, cookie); rec.Code != http.StatusBadRequest {
+		t.Errorf("expired callback status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if got := exchanges.Load(); got != 0 {
+		t.Errorf("code exchanged %d times, want 0", got)
+	}
+}
+
+func TestMemoryStateStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	s := NewMemoryStateStore()
+	s.now = func() time.Time { return now }
+
+	if err := s.Save(ctx, "a", now.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Save(ctx, "b", now.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Consume(ctx, "a"); err != nil {
+		t.Errorf("first Consume() = %v", err)
+	}
+	if err := s.Consume(ctx, "a"); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("second Consume() = %v, want ErrInvalidState", err)
+	}
+	if err := s.Consume(ctx, "unknown"); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Consume() of an unknown state = %v, want ErrInvalidState", err)
+	}
+
+	now = now.Add(time.Second)
+	if err := s.Consume(ctx, "b"); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Consume() of an expired state = %v, want ErrInvalidState", err)
+	}
+
+	// saving drops abandoned states
+	if err := s.Save(ctx, "c", now.Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Save(ctx, "d", now.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.states) != 1 {
+		t.Errorf("store keeps %d states, want 1", len(s.states))
+	}
+}
@@ -0,0 +1,83 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidState is returned, wrapped, for a callback whose state wasn't issued, has expired or was used already
+var ErrInvalidState = errors.New("oauth: invalid state")
+
+// StateStore keeps the states of flows in progress until their callback arrives.
+//
+// Consume must remove the state so it can be used only once,
+// and return ErrInvalidState when it is unknown or has expired.
+type StateStore interface {
+	Save(ctx context.Context, state string, expiresAt time.Time) error
+	Consume(ctx context.Context, state string) error
+}
+
+// MemoryStateStore keeps states in memory, it does for a single process,
+// a state store shared by all instances is needed when the callback may hit another one
+type MemoryStateStore struct {
+	mu     sync.Mutex
+	now    func() time.Time
+	states map[string]time.Time
+}
+
+// NewMemoryStateStore creates an empty MemoryStateStore
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{
+		now:    time.Now,
+		states: map[string]time.Time{},
+	}
+}
+
+func (s *MemoryStateStore) Save(_ context.Context, state string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// flows that were abandoned are dropped here, so the map doesn't grow
+	now := s.now()
+	for st, exp := range s.states {
+		if !now.Before(exp) {
+			delete(s.states, st)
+		}
+	}
+
+	s.states[state] = expiresAt
+
+	return nil
+}
+
+func (s *MemoryStateStore) Consume(_ context.Context, state string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt, ok := s.states[state]
+	if !ok {
+		return ErrInvalidState
+	}
+	delete(s.states, state)
+
+	if !s.now().Before(expiresAt) {
+		return errors.Wrap(ErrInvalidState, "expired")
+	}
+
+	return nil
+}
+
+// newState returns 32 bytes from crypto/rand, URL safe encoded
+func newState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "oauth: can't generate state")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 )
 
 const (
@@ -21,31 +20,56 @@ var offerStatusToDescription = map[GetAllOffersFilter]string{
 // GetAllOffers does _GET https://api.fortnox.se/3/offers/
 //
 // filter - GetAllOffersFilter
-func (c Client) GetAllOffers(ctx context.Context, filter *GetAllOffersFilter) ([]Offer, error) {
+func (c *offersService) GetAllOffers(ctx context.Context, filter *GetAllOffersFilter) ([]Offer, error) {
+	return c.IterateOffers(filter).All(ctx)
+}
+
+// GetOffersPage does _GET https://api.fortnox.se/3/offers/ and returns a single page along with its MetaInformation
+//
+// filter - GetAllOffersFilter
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *offersService) GetOffersPage(
+	ctx context.Context,
+	filter *GetAllOffersFilter,
+	page *PageOptions) ([]Offer, *MetaInformation, error) {
+
 	resp := &GetAllOffersResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, offersURI, params, resp)
+	err = c._GETPage(ctx, getOffersPageOp, offersURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Offers, nil
+	return resp.Offers, &resp.MetaInformation, nil
+}
+
+// IterateOffers lazily walks all pages of https://api.fortnox.se/3/offers/
+//
+// filter - GetAllOffersFilter
+func (c *offersService) IterateOffers(filter *GetAllOffersFilter) *Iterator[Offer] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Offer, *MetaInformation, error) {
+		return c.GetOffersPage(ctx, filter, page)
+	})
 }
 
 // CreateOffer does _POST https://api.fortnox.se/3/offers/
 //
 // Errors that can be raised by this endpoint.
 //
-// Error 	Code	HTTP Code	Description	Solution
+// # Error 	Code	HTTP Code	Description	Solution
 //
 // 2004167	400		An account must be provided when using a custom VAT rate and EasyVat has been enabled. Supply each row which has a custom VAT rate with an account.
-func (c *Client) CreateOffer(ctx context.Context, o *Offer) (*Offer, error) {
+func (c *offersService) CreateOffer(ctx context.Context, o *Offer) (*Offer, error) {
 	req := &CreateOfferReq{Offer: *o}
 	resp := &CreateOfferResp{}
 
-	err := c._POST(ctx, offersURI, nil, req, resp)
+	err := c._POST(ctx, createOfferOp, offersURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -56,12 +80,12 @@ func (c *Client) CreateOffer(ctx context.Context, o *Offer) (*Offer, error) {
 // GetOffer does _GET https://api.fortnox.se/3/offers/{DocumentNumber}
 //
 // documentNumber - identifies the offer
-func (c *Client) GetOffer(ctx context.Context, documentNumber string) (*Offer, error) {
+func (c *offersService) GetOffer(ctx context.Context, documentNumber string) (*Offer, error) {
 	resp := &GetOfferResp{}
 
 	uri := fmt.Sprintf("%s/%s", offersURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getOfferOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -73,14 +97,14 @@ func (c *Client) GetOffer(ctx context.Context, documentNumber string) (*Offer, e
 //
 // documentNumber - identifies the offer
 //
-// req - offer to update
-func (c *Client) UpdateOffer(ctx context.Context, documentNumber string, o *Offer) (*Offer, error) {
+// o - fields to update, only fields that are Set are sent
+func (c *offersService) UpdateOffer(ctx context.Context, documentNumber string, o *OfferUpdate) (*Offer, error) {
 	req := &UpdateOfferReq{Offer: *o}
 	resp := &UpdateOfferResp{}
 
 	uri := fmt.Sprintf("%s/%s", offersURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateOfferOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -88,31 +112,40 @@ func (c *Client) UpdateOffer(ctx context.Context, documentNumber string, o *Offe
 	return &resp.Offer, nil
 }
 
+// PatchOffer does _PUT https://api.fortnox.se/3/offers/{DocumentNumber} sending only the fields changed between original and edited
+//
+// original - offer as returned by Fortnox
+//
+// edited - modified copy of original
+//
+// Nothing is sent when no writable field changed, original is returned then.
+func (c *offersService) PatchOffer(ctx context.Context, original, edited *Offer) (*Offer, error) {
+	update := DiffOffer(original, edited)
+	if !hasSetFields(update) {
+		return original, nil
+	}
+
+	return c.UpdateOffer(ctx, original.DocumentNumber, update)
+}
+
 // PrintOffer does _GET https://api.fortnox.se/3/offers/{DocumentNumber}/print
 //
 // documentNumber - identifies the offer
-func (c *Client) PrintOffer(ctx context.Context, documentNumber string) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *offersService) PrintOffer(ctx context.Context, documentNumber string) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s/print", offersURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c._GETDownload(ctx, printOfferOp, uri, nil)
 }
 
 // SendOfferAsEmail does _GET https://api.fortnox.se/3/offers/{DocumentNumber}/email
 //
 // documentNumber - identifies the offer
-func (c *Client) SendOfferAsEmail(ctx context.Context, documentNumber string) (*Offer, error) {
+func (c *offersService) SendOfferAsEmail(ctx context.Context, documentNumber string) (*Offer, error) {
 	resp := &SendOfferAsEmailResp{}
 
 	uri := fmt.Sprintf("%s/%s/email", offersURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, sendOfferAsEmailOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -125,28 +158,21 @@ func (c *Client) SendOfferAsEmail(ctx context.Context, documentNumber string) (*
 // documentNumber - identifies the offer
 //
 // The difference between this and the print-endpoint is that property Sent is not set to TRUE.
-func (c *Client) PreviewOffer(ctx context.Context, documentNumber string) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *offersService) PreviewOffer(ctx context.Context, documentNumber string) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s/preview", offersURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c._GETDownload(ctx, previewOfferOp, uri, nil)
 }
 
 // CreateOrderOutOfOffer does _PUT https://api.fortnox.se/3/offers/{DocumentNumber}/createorder
 //
 // documentNumber - identifies the offer
-func (c *Client) CreateOrderOutOfOffer(ctx context.Context, documentNumber string) (*Order, error) {
+func (c *offersService) CreateOrderOutOfOffer(ctx context.Context, documentNumber string) (*Order, error) {
 	resp := &CreateOrderOutOfOfferResp{}
 
 	uri := fmt.Sprintf("%s/%s/createorder", offersURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, createOrderOutOfOfferOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -157,12 +183,12 @@ func (c *Client) CreateOrderOutOfOffer(ctx context.Context, documentNumber strin
 // CancelOffer does _PUT https://api.fortnox.se/3/offers/{DocumentNumber}/cancel
 //
 // documentNumber - identifies the offer
-func (c *Client) CancelOffer(ctx context.Context, documentNumber string) (*Offer, error) {
+func (c *offersService) CancelOffer(ctx context.Context, documentNumber string) (*Offer, error) {
 	resp := &CancelOfferResp{}
 
 	uri := fmt.Sprintf("%s/%s/cancel", offersURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, cancelOfferOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -175,12 +201,12 @@ func (c *Client) CancelOffer(ctx context.Context, documentNumber string) (*Offer
 // documentNumber - identifies the offer
 //
 // Use this endpoint to set offer as sent, without generating an offer.
-func (c *Client) SetOfferAsSent(ctx context.Context, documentNumber string) (*Offer, error) {
+func (c *offersService) SetOfferAsSent(ctx context.Context, documentNumber string) (*Offer, error) {
 	resp := &SetOfferAsSentResp{}
 
 	uri := fmt.Sprintf("%s/%s/externalprint", offersURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, setOfferAsSentOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -197,23 +223,19 @@ const (
 	OrderNotCreatedGetAllOffersFilter GetAllOffersFilter = "ordernotcreated"
 )
 
-func (f *GetAllOffersFilter) urlValues() url.Values {
+func (f *GetAllOffersFilter) urlValues() (url.Values, error) {
 	if f == nil {
-		return nil
-	}
-
-	params := url.Values{}
-
-	fStr := string(*f)
-	if strings.TrimSpace(fStr) != "" {
-		params["filter"] = []string{fStr}
+		return nil, nil
 	}
 
-	return params
+	return encodeQuery(struct {
+		Filter GetAllOffersFilter `fortnox:"filter"`
+	}{*f})
 }
 
 type GetAllOffersResp struct {
-	Offers []Offer `json:"Offers"`
+	Offers          []Offer         `json:"Offers"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateOfferReq struct {
@@ -229,7 +251,7 @@ type GetOfferResp struct {
 }
 
 type UpdateOfferReq struct {
-	Offer Offer `json:"Offer"`
+	Offer OfferUpdate `json:"Offer"`
 }
 
 type UpdateOfferResp struct {
@@ -256,19 +278,19 @@ type OfferRow struct {
 	AccountNumber          int    `json:"AccountNumber,omitempty"`
 	ArticleNumber          string `json:"ArticleNumber,omitempty"`
 	ContributionPercent    string `json:"ContributionPercent,omitempty"`
-	ContributionValue      string `json:"ContributionValue,omitempty"`
+	ContributionValue      Money  `json:"ContributionValue,omitzero"`
 	CostCenter             string `json:"CostCenter,omitempty"`
 	Description            string `json:"Description,omitempty"`
-	Discount               int    `json:"Discount,omitempty"`
+	Discount               Money  `json:"Discount,omitzero"`
 	DiscountType           string `json:"DiscountType,omitempty"`
 	HouseWork              bool   `json:"HouseWork,omitempty"`
 	HouseWorkHoursToReport int    `json:"HouseWorkHoursToReport,omitempty"`
 	HouseWorkType          string `json:"HouseWorkType,omitempty"`
-	Price                  int    `json:"Price,omitempty"`
+	Price                  Money  `json:"Price,omitzero"`
 	Project                string `json:"Project,omitempty"`
 	Quantity               string `json:"Quantity,omitempty"`
 	RowId                  int    `json:"RowId,omitempty"`
-	Total                  int    `json:"Total,omitempty"`
+	Total                  Money  `json:"Total,omitzero"`
 	Unit                   string `json:"Unit,omitempty"`
 	VAT                    int    `json:"VAT,omitempty"`
 }
@@ -276,21 +298,21 @@ type OfferRow struct {
 type Offer struct {
 	Url                  string           `json:"@url,omitempty"`
 	UrlTaxReductionList  string           `json:"@urlTaxReductionList,omitempty"`
-	AdministrationFee    int              `json:"AdministrationFee,omitempty"`
-	AdministrationFeeVAT int              `json:"AdministrationFeeVAT,omitempty"`
+	AdministrationFee    Money            `json:"AdministrationFee,omitzero"`
+	AdministrationFeeVAT Money            `json:"AdministrationFeeVAT,omitzero"`
 	Address1             string           `json:"Address1,omitempty"`
 	Address2             string           `json:"Address2,omitempty"`
-	BasisTaxReduction    int              `json:"BasisTaxReduction,omitempty"`
+	BasisTaxReduction    Money            `json:"BasisTaxReduction,omitzero"`
 	Cancelled            bool             `json:"Cancelled,omitempty"`
 	City                 string           `json:"City,omitempty"`
 	Comments             string           `json:"Comments,omitempty"`
 	ContributionPercent  int              `json:"ContributionPercent,omitempty"`
-	ContributionValue    int              `json:"ContributionValue,omitempty"`
+	ContributionValue    Money            `json:"ContributionValue,omitzero"`
 	CopyRemarks          bool             `json:"CopyRemarks,omitempty"`
 	Country              string           `json:"Country,omitempty"`
 	CostCenter           string           `json:"CostCenter,omitempty"`
 	Currency             string           `json:"Currency,omitempty"`
-	CurrencyRate         int              `json:"CurrencyRate,omitempty"`
+	CurrencyRate         Money            `json:"CurrencyRate,omitzero"`
 	CurrencyUnit         int              `json:"CurrencyUnit,omitempty"`
 	CustomerName         string           `json:"CustomerName,omitempty"`
 	CustomerNumber       string           `json:"CustomerNumber,omitempty"`
@@ -298,22 +320,22 @@ type Offer struct {
 	DeliveryAddress2     string           `json:"DeliveryAddress2,omitempty"`
 	DeliveryCity         string           `json:"DeliveryCity,omitempty"`
 	DeliveryCountry      string           `json:"DeliveryCountry,omitempty"`
-	DeliveryDate         string           `json:"DeliveryDate,omitempty"`
+	DeliveryDate         Date             `json:"DeliveryDate,omitempty"`
 	DeliveryName         string           `json:"DeliveryName,omitempty"`
 	DeliveryZipCode      string           `json:"DeliveryZipCode,omitempty"`
 	DocumentNumber       string           `json:"DocumentNumber,omitempty"`
 	EmailInformation     EmailInformation `json:"EmailInformation,omitempty"`
-	ExpireDate           string           `json:"ExpireDate,omitempty"`
-	Freight              int              `json:"Freight,omitempty"`
-	FreightVAT           int              `json:"FreightVAT,omitempty"`
-	Gross                int              `json:"Gross,omitempty"`
+	ExpireDate           Date             `json:"ExpireDate,omitempty"`
+	Freight              Money            `json:"Freight,omitzero"`
+	FreightVAT           Money            `json:"FreightVAT,omitzero"`
+	Gross                Money            `json:"Gross,omitzero"`
 	HouseWork            bool             `json:"HouseWork,omitempty"`
 	InvoiceReference     string           `json:"InvoiceReference,omitempty"`
 	Labels               []Label          `json:"Labels,omitempty"`
 	Language             string           `json:"Language,omitempty"`
-	Net                  int              `json:"Net,omitempty"`
+	Net                  Money            `json:"Net,omitzero"`
 	NotCompleted         bool             `json:"NotCompleted,omitempty"`
-	OfferDate            string           `json:"OfferDate,omitempty"`
+	OfferDate            Date             `json:"OfferDate,omitempty"`
 	OfferRows            []OfferRow       `json:"OfferRows,omitempty"`
 	OrderReference       string           `json:"OrderReference,omitempty"`
 	OrganisationNumber   string           `json:"OrganisationNumber,omitempty"`
@@ -324,14 +346,14 @@ type Offer struct {
 	PrintTemplate        string           `json:"PrintTemplate,omitempty"`
 	Project              string           `json:"Project,omitempty"`
 	Remarks              string           `json:"Remarks,omitempty"`
-	RoundOff             int              `json:"RoundOff,omitempty"`
+	RoundOff             Money            `json:"RoundOff,omitzero"`
 	Sent                 bool             `json:"Sent,omitempty"`
-	TaxReduction         int              `json:"TaxReduction,omitempty"`
+	TaxReduction         Money            `json:"TaxReduction,omitzero"`
 	TermsOfDelivery      string           `json:"TermsOfDelivery,omitempty"`
 	TermsOfPayment       string           `json:"TermsOfPayment,omitempty"`
-	Total                int              `json:"Total,omitempty"`
-	TotalToPay           int              `json:"TotalToPay,omitempty"`
-	TotalVAT             int              `json:"TotalVAT,omitempty"`
+	Total                Money            `json:"Total,omitzero"`
+	TotalToPay           Money            `json:"TotalToPay,omitzero"`
+	TotalVAT             Money            `json:"TotalVAT,omitzero"`
 	VATIncluded          bool             `json:"VATIncluded,omitempty"`
 	WayOfDelivery        string           `json:"WayOfDelivery,omitempty"`
 	YourReference        string           `json:"YourReference,omitempty"`
@@ -339,3 +361,104 @@ type Offer struct {
 	ZipCode              string           `json:"ZipCode,omitempty"`
 	TaxReductionType     string           `json:"TaxReductionType,omitempty"`
 }
+
+// OfferUpdate is the payload of UpdateOffer, only fields that are Set are sent,
+// so they can be changed to their zero value
+type OfferUpdate struct {
+	AdministrationFee    Optional[Money]            `json:"AdministrationFee"`
+	AdministrationFeeVAT Optional[Money]            `json:"AdministrationFeeVAT"`
+	Address1             Optional[string]           `json:"Address1"`
+	Address2             Optional[string]           `json:"Address2"`
+	BasisTaxReduction    Optional[Money]            `json:"BasisTaxReduction"`
+	Cancelled            Optional[bool]             `json:"Cancelled"`
+	City                 Optional[string]           `json:"City"`
+	Comments             Optional[string]           `json:"Comments"`
+	ContributionPercent  Optional[int]              `json:"ContributionPercent"`
+	ContributionValue    Optional[Money]            `json:"ContributionValue"`
+	CopyRemarks          Optional[bool]             `json:"CopyRemarks"`
+	Country              Optional[string]           `json:"Country"`
+	CostCenter           Optional[string]           `json:"CostCenter"`
+	Currency             Optional[string]           `json:"Currency"`
+	CurrencyRate         Optional[Money]            `json:"CurrencyRate"`
+	CurrencyUnit         Optional[int]              `json:"CurrencyUnit"`
+	CustomerName         Optional[string]           `json:"CustomerName"`
+	CustomerNumber       Optional[string]           `json:"CustomerNumber"`
+	DeliveryAddress1     Optional[string]           `json:"DeliveryAddress1"`
+	DeliveryAddress2     Optional[string]           `json:"DeliveryAddress2"`
+	DeliveryCity         Optional[string]           `json:"DeliveryCity"`
+	DeliveryCountry      Optional[string]           `json:"DeliveryCountry"`
+	DeliveryDate         Optional[Date]             `json:"DeliveryDate"`
+	DeliveryName         Optional[string]           `json:"DeliveryName"`
+	DeliveryZipCode      Optional[string]           `json:"DeliveryZipCode"`
+	DocumentNumber       Optional[string]           `json:"DocumentNumber"`
+	EmailInformation     Optional[EmailInformation] `json:"EmailInformation"`
+	ExpireDate           Optional[Date]             `json:"ExpireDate"`
+	Freight              Optional[Money]            `json:"Freight"`
+	FreightVAT           Optional[Money]            `json:"FreightVAT"`
+	Gross                Optional[Money]            `json:"Gross"`
+	HouseWork            Optional[bool]             `json:"HouseWork"`
+	InvoiceReference     Optional[string]           `json:"InvoiceReference"`
+	Labels               Optional[[]Label]          `json:"Labels"`
+	Language             Optional[string]           `json:"Language"`
+	Net                  Optional[Money]            `json:"Net"`
+	NotCompleted         Optional[bool]             `json:"NotCompleted"`
+	OfferDate            Optional[Date]             `json:"OfferDate"`
+	OfferRows            Optional[[]OfferRow]       `json:"OfferRows"`
+	OrderReference       Optional[string]           `json:"OrderReference"`
+	OrganisationNumber   Optional[string]           `json:"OrganisationNumber"`
+	OurReference         Optional[string]           `json:"OurReference"`
+	Phone1               Optional[string]           `json:"Phone1"`
+	Phone2               Optional[string]           `json:"Phone2"`
+	PriceList            Optional[string]           `json:"PriceList"`
+	PrintTemplate        Optional[string]           `json:"PrintTemplate"`
+	Project              Optional[string]           `json:"Project"`
+	Remarks              Optional[string]           `json:"Remarks"`
+	RoundOff             Optional[Money]            `json:"RoundOff"`
+	Sent                 Optional[bool]             `json:"Sent"`
+	TaxReduction         Optional[Money]            `json:"TaxReduction"`
+	TermsOfDelivery      Optional[string]           `json:"TermsOfDelivery"`
+	TermsOfPayment       Optional[string]           `json:"TermsOfPayment"`
+	Total                Optional[Money]            `json:"Total"`
+	TotalToPay           Optional[Money]            `json:"TotalToPay"`
+	TotalVAT             Optional[Money]            `json:"TotalVAT"`
+	VATIncluded          Optional[bool]             `json:"VATIncluded"`
+	WayOfDelivery        Optional[string]           `json:"WayOfDelivery"`
+	YourReference        Optional[string]           `json:"YourReference"`
+	YourReferenceNumber  Optional[string]           `json:"YourReferenceNumber"`
+	ZipCode              Optional[string]           `json:"ZipCode"`
+	TaxReductionType     Optional[string]           `json:"TaxReductionType"`
+}
+
+func (u OfferUpdate) MarshalJSON() ([]byte, error) {
+	return marshalSetFields(u)
+}
+
+// offerReadOnlyFields are computed or set by Fortnox, DiffOffer never sends them
+var offerReadOnlyFields = map[string]bool{
+	"AdministrationFeeVAT": true,
+	"BasisTaxReduction":    true,
+	"Cancelled":            true,
+	"ContributionPercent":  true,
+	"ContributionValue":    true,
+	"DocumentNumber":       true,
+	"FreightVAT":           true,
+	"Gross":                true,
+	"InvoiceReference":     true,
+	"Net":                  true,
+	"OrderReference":       true,
+	"RoundOff":             true,
+	"Sent":                 true,
+	"TaxReduction":         true,
+	"Total":                true,
+	"TotalToPay":           true,
+	"TotalVAT":             true,
+}
+
+// DiffOffer returns an update holding only the writable fields that differ between original, as returned by Fortnox,
+// and edited, a modified copy of it
+func DiffOffer(original, edited *Offer) *OfferUpdate {
+	u := &OfferUpdate{}
+	diffInto(original, edited, u, offerReadOnlyFields)
+
+	return u
+}
@@ -1,6 +1,9 @@
 package client
 
-import "net/http"
+import (
+	"log/slog"
+	"net/http"
+)
 
 type AccessTokenOptions struct {
 	BaseURL    string
@@ -13,8 +16,21 @@ type Options struct {
 	RefreshToken     string
 	ClientSecret     string
 	BaseURL          string
+	TokenURL         string
+	RevokeURL        string
 	AutoRefreshToken bool
 	HTTPClient       *http.Client
+	TenantID         string
+	RateLimit        float64
+	RateBurst        int
+	RateLimiter      *RateLimiter
+	RetryPolicy      RetryPolicy
+	TokenStore       TokenStore
+	TokenSource      TokenSource
+	OnTokenRefreshed TokenRefreshedFunc
+	Logger           *slog.Logger
+	Middlewares      []Middleware
+	RequiredScopes   Scopes
 }
 
 type OptionFunc func(co *Options)
@@ -51,8 +67,82 @@ func WithURLOpt(url string) OptionFunc {
 	}
 }
 
+// WithHTTPClientOpt makes the client send API requests with c, e.g. to set a timeout or a custom RoundTripper.
+// The timeout of c doesn't apply to downloads, see Download.
+func WithHTTPClientOpt(c *http.Client) OptionFunc {
+	return func(co *Options) {
+		co.HTTPClient = c
+	}
+}
+
 func WithAutoRefreshTokenOpt(autoRefresh bool) OptionFunc {
 	return func(co *Options) {
 		co.AutoRefreshToken = autoRefresh
 	}
 }
+
+// WithTenantIDOpt sets the Fortnox tenant the client talks to, Clients of the same tenant share a rate limiter.
+// Without it Clients created with the same token, or the same client ID, share one.
+func WithTenantIDOpt(tenantID string) OptionFunc {
+	return func(co *Options) {
+		co.TenantID = tenantID
+	}
+}
+
+// WithRateLimitOpt sets requests per second and burst of the rate limiter, requestsPerSecond <= 0 disables it
+func WithRateLimitOpt(requestsPerSecond float64, burst int) OptionFunc {
+	return func(co *Options) {
+		co.RateLimit = requestsPerSecond
+		co.RateBurst = burst
+	}
+}
+
+// WithRateLimiterOpt makes the client use the given rate limiter instead of the one shared per tenant
+func WithRateLimiterOpt(l *RateLimiter) OptionFunc {
+	return func(co *Options) {
+		co.RateLimiter = l
+	}
+}
+
+// WithRetryPolicyOpt sets how transient failures are retried, use NoRetryPolicy to disable retries
+func WithRetryPolicyOpt(p RetryPolicy) OptionFunc {
+	return func(co *Options) {
+		co.RetryPolicy = p
+	}
+}
+
+// WithTokenURLOpt sets the OAuth endpoint tokens are refreshed at, the Fortnox one by default
+func WithTokenURLOpt(url string) OptionFunc {
+	return func(co *Options) {
+		co.TokenURL = url
+	}
+}
+
+// WithRevokeURLOpt sets the OAuth endpoint Disconnect and RevokeToken revoke tokens at, the Fortnox one by default
+func WithRevokeURLOpt(url string) OptionFunc {
+	return func(co *Options) {
+		co.RevokeURL = url
+	}
+}
+
+// WithTokenStoreOpt persists refreshed tokens in store, the stored token is loaded when no access token was given
+func WithTokenStoreOpt(store TokenStore) OptionFunc {
+	return func(co *Options) {
+		co.TokenStore = store
+	}
+}
+
+// WithOnTokenRefreshedOpt sets a hook called after every successful token refresh
+func WithOnTokenRefreshedOpt(f TokenRefreshedFunc) OptionFunc {
+	return func(co *Options) {
+		co.OnTokenRefreshed = f
+	}
+}
+
+// WithLoggerOpt logs requests, responses, retries and token refreshes to l.
+// Tokens, secrets, personal identity numbers and bank account numbers are always redacted.
+func WithLoggerOpt(l *slog.Logger) OptionFunc {
+	return func(co *Options) {
+		co.Logger = l
+	}
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Optional is a field of an update payload that is only sent when set,
+// so a value can be set to its zero value, e.g. Active to false or Comments to "".
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// Set returns an Optional set to v
+func Set[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// Get returns the value and whether it is set
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// IsSet reports whether the value is sent
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// Value returns the value, the zero value if unset
+func (o Optional[T]) Value() T {
+	return o.value
+}
+
+// Set sets the value
+func (o *Optional[T]) Set(v T) {
+	o.value = v
+	o.set = true
+}
+
+// Unset makes the value not sent
+func (o *Optional[T]) Unset() {
+	var zero T
+	o.value = zero
+	o.set = false
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &o.value); err != nil {
+		return err
+	}
+	o.set = true
+
+	return nil
+}
+
+// setAny sets the value from an interface holding a T, used by diffInto
+func (o *Optional[T]) setAny(v interface{}) {
+	o.Set(v.(T))
+}
+
+// optionalField is implemented by every Optional
+type optionalField interface {
+	IsSet() bool
+}
+
+// settableField is implemented by every *Optional
+type settableField interface {
+	setAny(v interface{})
+}
+
+// marshalSetFields encodes a struct of Optional fields as a JSON object holding only the set fields,
+// in field order. Fields that are not Optional are encoded unless they are zero.
+func marshalSetFields(v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	rt := rv.Type()
+
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+
+	first := true
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fv := rv.Field(i)
+		if opt, ok := fv.Interface().(optionalField); ok {
+			if !opt.IsSet() {
+				continue
+			}
+		} else if fv.IsZero() {
+			continue
+		}
+
+		value, err := json.Marshal(fv.Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 )
 
 const (
@@ -14,17 +13,42 @@ const (
 // GetAllOrders does _GET https://api.fortnox.se/3/orders/
 //
 // filter - GetAllOffersFilter
-func (c Client) GetAllOrders(ctx context.Context, filter *GetAllOrdersFilter) ([]Order, error) {
+func (c *ordersService) GetAllOrders(ctx context.Context, filter *GetAllOrdersFilter) ([]Order, error) {
+	return c.IterateOrders(filter).All(ctx)
+}
+
+// GetOrdersPage does _GET https://api.fortnox.se/3/orders/ and returns a single page along with its MetaInformation
+//
+// filter - GetAllOffersFilter
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *ordersService) GetOrdersPage(
+	ctx context.Context,
+	filter *GetAllOrdersFilter,
+	page *PageOptions) ([]Order, *MetaInformation, error) {
+
 	resp := &GetAllOrdersResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, ordersURI, params, resp)
+	err = c._GETPage(ctx, getOrdersPageOp, ordersURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Orders, nil
+	return resp.Orders, &resp.MetaInformation, nil
+}
+
+// IterateOrders lazily walks all pages of https://api.fortnox.se/3/orders/
+//
+// filter - GetAllOffersFilter
+func (c *ordersService) IterateOrders(filter *GetAllOrdersFilter) *Iterator[Order] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Order, *MetaInformation, error) {
+		return c.GetOrdersPage(ctx, filter, page)
+	})
 }
 
 // CreateOrder does _POST https://api.fortnox.se/3/orders/
@@ -40,11 +64,11 @@ func (c Client) GetAllOrders(ctx context.Context, filter *GetAllOrdersFilter) ([
 // | Error Code |	HTTP Code  | Description	| Solution |
 // |------------|--------------|----------------|----------|
 // | 2004167	| 400	       | An account must be provided when using a custom VAT rate and EasyVat has been enabled.	| Supply each row which has a custom VAT rate with an account. |
-func (c Client) CreateOrder(ctx context.Context, o *Order) (*Order, error) {
+func (c *ordersService) CreateOrder(ctx context.Context, o *Order) (*Order, error) {
 	req := &CreateOrderReq{Order: *o}
 	resp := &CreateOrderResp{}
 
-	err := c._POST(ctx, ordersURI, nil, req, resp)
+	err := c._POST(ctx, createOrderOp, ordersURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -55,12 +79,12 @@ func (c Client) CreateOrder(ctx context.Context, o *Order) (*Order, error) {
 // GetOrder does _GET https://api.fortnox.se/3/orders/{DocumentNumber}
 //
 // documentNumber - identifies the order
-func (c Client) GetOrder(ctx context.Context, documentNumber string) (*Order, error) {
+func (c *ordersService) GetOrder(ctx context.Context, documentNumber string) (*Order, error) {
 	resp := &GetOrderResp{}
 
 	uri := fmt.Sprintf("%s/%s", ordersURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getOrderOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -72,20 +96,20 @@ func (c Client) GetOrder(ctx context.Context, documentNumber string) (*Order, er
 //
 // documentNumber - identifies the order
 //
-// req - to update
+// o - fields to update, only fields that are Set are sent
 //
 // Note that there are two approaches for updating the rows on an order.
 //
 // If RowId is not specified on any row, the rows will be mapped and updated in the order in which they are set in the array. All rows that should remain on the order needs to be provided.
 //
 // If RowId is specified on one or more rows the following goes: Corresponding row with that id will be updated. The rows without RowId will be interpreted as new rows. If a row should not be updated but remain on the order then specify only RowId like { "RowId": 123 }, otherwise it will be removed. Note that new RowIds are generated for all rows every time an order is updated.
-func (c Client) UpdateOrder(ctx context.Context, documentNumber string, o *Order) (*Order, error) {
+func (c *ordersService) UpdateOrder(ctx context.Context, documentNumber string, o *OrderUpdate) (*Order, error) {
 	req := &UpdateOrderReq{Order: *o}
 	resp := &UpdateOrderResp{}
 
 	uri := fmt.Sprintf("%s/%s", ordersURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateOrderOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -93,20 +117,29 @@ func (c Client) UpdateOrder(ctx context.Context, documentNumber string, o *Order
 	return &resp.Order, nil
 }
 
+// PatchOrder does _PUT https://api.fortnox.se/3/orders/{DocumentNumber} sending only the fields changed between original and edited
+//
+// original - order as returned by Fortnox
+//
+// edited - modified copy of original
+//
+// Nothing is sent when no writable field changed, original is returned then.
+func (c *ordersService) PatchOrder(ctx context.Context, original, edited *Order) (*Order, error) {
+	update := DiffOrder(original, edited)
+	if !hasSetFields(update) {
+		return original, nil
+	}
+
+	return c.UpdateOrder(ctx, original.DocumentNumber, update)
+}
+
 // PrintOrder does _GET https://api.fortnox.se/3/orders/{DocumentNumber}/print
 //
 // documentNumber - identifies the order
-func (c Client) PrintOrder(ctx context.Context, documentNumber string) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *ordersService) PrintOrder(ctx context.Context, documentNumber string) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s/print", ordersURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c._GETDownload(ctx, printOrderOp, uri, nil)
 }
 
 // SendOrderAsEmail does _GET https://api.fortnox.se/3/orders/{DocumentNumber}/email
@@ -114,12 +147,12 @@ func (c Client) PrintOrder(ctx context.Context, documentNumber string) (*[]byte,
 // documentNumber - identifies the order
 //
 // You can use the properties in the EmailInformation to customize the e-mail message on each order.
-func (c Client) SendOrderAsEmail(ctx context.Context, documentNumber string) (*Order, error) {
+func (c *ordersService) SendOrderAsEmail(ctx context.Context, documentNumber string) (*Order, error) {
 	resp := &SendOrderAsEmailResp{}
 
 	uri := fmt.Sprintf("%s/%s/email", ordersURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, sendOrderAsEmailOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -131,28 +164,21 @@ func (c Client) SendOrderAsEmail(ctx context.Context, documentNumber string) (*O
 // documentNumber - identifies the order
 //
 // The difference between this and the print-endpoint is that property Sent is not set to TRUE.
-func (c Client) PreviewOrder(ctx context.Context, documentNumber string) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *ordersService) PreviewOrder(ctx context.Context, documentNumber string) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s/preview", ordersURI, documentNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c._GETDownload(ctx, previewOrderOp, uri, nil)
 }
 
 // CreateInvoiceOutOfGivenOrder does _PUT https://api.fortnox.se/3/orders/{DocumentNumber}/createinvoice
 //
 // documentNumber - identifies the order
-func (c Client) CreateInvoiceOutOfGivenOrder(ctx context.Context, documentNumber string) (*Invoice, error) {
+func (c *ordersService) CreateInvoiceOutOfGivenOrder(ctx context.Context, documentNumber string) (*Invoice, error) {
 	resp := &CreateInvoiceOutOfGivenOrderResp{}
 
 	uri := fmt.Sprintf("%s/%s/createinvoice", ordersURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, createInvoiceOutOfGivenOrderOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -163,12 +189,12 @@ func (c Client) CreateInvoiceOutOfGivenOrder(ctx context.Context, documentNumber
 // CancelGivenOrder does _PUT https://api.fortnox.se/3/orders/{DocumentNumber}/cancel
 //
 // documentNumber - identifies the order
-func (c Client) CancelGivenOrder(ctx context.Context, documentNumber string) (*Order, error) {
+func (c *ordersService) CancelGivenOrder(ctx context.Context, documentNumber string) (*Order, error) {
 	resp := &CancelGivenOrderResp{}
 
 	uri := fmt.Sprintf("%s/%s/cancel", ordersURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, cancelGivenOrderOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -181,12 +207,12 @@ func (c Client) CancelGivenOrder(ctx context.Context, documentNumber string) (*O
 // documentNumber - identifies the order
 //
 // Use this endpoint to set order as sent, without generating an order.
-func (c Client) SetGivenOrderAsSent(ctx context.Context, documentNumber string) (*Order, error) {
+func (c *ordersService) SetGivenOrderAsSent(ctx context.Context, documentNumber string) (*Order, error) {
 	resp := &SetGivenOrderAsSentResp{}
 
 	uri := fmt.Sprintf("%s/%s/externalprint", ordersURI, documentNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, setGivenOrderAsSentOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -203,23 +229,19 @@ const (
 	invoiceNotCreatedGetAllOrdersFilter GetAllOrdersFilter = "invoicenotcreated"
 )
 
-func (f *GetAllOrdersFilter) urlValues() url.Values {
+func (f *GetAllOrdersFilter) urlValues() (url.Values, error) {
 	if f == nil {
-		return nil
-	}
-
-	params := url.Values{}
-
-	fStr := string(*f)
-	if strings.TrimSpace(fStr) != "" {
-		params["filter"] = []string{fStr}
+		return nil, nil
 	}
 
-	return params
+	return encodeQuery(struct {
+		Filter GetAllOrdersFilter `fortnox:"filter"`
+	}{*f})
 }
 
 type GetAllOrdersResp struct {
-	Orders []Order `json:"Orders"`
+	Orders          []Order         `json:"Orders"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateOrderReq struct {
@@ -235,7 +257,7 @@ type GetOrderResp struct {
 }
 
 type UpdateOrderReq struct {
-	Order Order `json:"Order"`
+	Order OrderUpdate `json:"Order"`
 }
 
 type UpdateOrderResp struct {
@@ -261,21 +283,21 @@ type SetGivenOrderAsSentResp struct {
 type Order struct {
 	Url                       string           `json:"@url,omitempty"`
 	UrlTaxReductionList       string           `json:"@urlTaxReductionList,omitempty"`
-	AdministrationFee         int              `json:"AdministrationFee,omitempty"`
-	AdministrationFeeVAT      int              `json:"AdministrationFeeVAT,omitempty"`
+	AdministrationFee         Money            `json:"AdministrationFee,omitzero"`
+	AdministrationFeeVAT      Money            `json:"AdministrationFeeVAT,omitzero"`
 	Address1                  string           `json:"Address1,omitempty"`
 	Address2                  string           `json:"Address2,omitempty"`
-	BasisTaxReduction         int              `json:"BasisTaxReduction,omitempty"`
+	BasisTaxReduction         Money            `json:"BasisTaxReduction,omitzero"`
 	Cancelled                 bool             `json:"Cancelled,omitempty"`
 	City                      string           `json:"City,omitempty"`
 	Comments                  string           `json:"Comments,omitempty"`
 	ContributionPercent       int              `json:"ContributionPercent,omitempty"`
-	ContributionValue         int              `json:"ContributionValue,omitempty"`
+	ContributionValue         Money            `json:"ContributionValue,omitzero"`
 	CopyRemarks               bool             `json:"CopyRemarks,omitempty"`
 	Country                   string           `json:"Country,omitempty"`
 	CostCenter                string           `json:"CostCenter,omitempty"`
 	Currency                  string           `json:"Currency,omitempty"`
-	CurrencyRate              int              `json:"CurrencyRate,omitempty"`
+	CurrencyRate              Money            `json:"CurrencyRate,omitzero"`
 	CurrencyUnit              int              `json:"CurrencyUnit,omitempty"`
 	CustomerName              string           `json:"CustomerName,omitempty"`
 	CustomerNumber            string           `json:"CustomerNumber,omitempty"`
@@ -284,24 +306,24 @@ type Order struct {
 	DeliveryAddress2          string           `json:"DeliveryAddress2,omitempty"`
 	DeliveryCity              string           `json:"DeliveryCity,omitempty"`
 	DeliveryCountry           string           `json:"DeliveryCountry,omitempty"`
-	DeliveryDate              string           `json:"DeliveryDate,omitempty"`
+	DeliveryDate              Date             `json:"DeliveryDate,omitempty"`
 	DeliveryName              string           `json:"DeliveryName,omitempty"`
 	DeliveryZipCode           string           `json:"DeliveryZipCode,omitempty"`
 	DocumentNumber            string           `json:"DocumentNumber,omitempty"`
 	EmailInformation          EmailInformation `json:"EmailInformation,omitempty"`
 	ExternalInvoiceReference1 string           `json:"ExternalInvoiceReference1,omitempty"`
 	ExternalInvoiceReference2 string           `json:"ExternalInvoiceReference2,omitempty"`
-	Freight                   int              `json:"Freight,omitempty"`
-	FreightVAT                int              `json:"FreightVAT,omitempty"`
-	Gross                     int              `json:"Gross,omitempty"`
+	Freight                   Money            `json:"Freight,omitzero"`
+	FreightVAT                Money            `json:"FreightVAT,omitzero"`
+	Gross                     Money            `json:"Gross,omitzero"`
 	HouseWork                 bool             `json:"HouseWork,omitempty"`
 	InvoiceReference          string           `json:"InvoiceReference,omitempty"`
 	Labels                    []Label          `json:"Labels,omitempty"`
 	Language                  string           `json:"Language,omitempty"`
-	Net                       int              `json:"Net,omitempty"`
+	Net                       Money            `json:"Net,omitzero"`
 	NotCompleted              bool             `json:"NotCompleted,omitempty"`
 	OfferReference            string           `json:"OfferReference,omitempty"`
-	OrderDate                 string           `json:"OrderDate,omitempty"`
+	OrderDate                 Date             `json:"OrderDate,omitempty"`
 	OrderRows                 []OrderRow       `json:"OrderRows,omitempty"`
 	OrderType                 string           `json:"OrderType,omitempty"`
 	OrganisationNumber        string           `json:"OrganisationNumber,omitempty"`
@@ -312,17 +334,17 @@ type Order struct {
 	PrintTemplate             string           `json:"PrintTemplate,omitempty"`
 	Project                   string           `json:"Project,omitempty"`
 	WarehouseReady            bool             `json:"WarehouseReady,omitempty"`
-	OutboundDate              string           `json:"OutboundDate,omitempty"`
+	OutboundDate              Date             `json:"OutboundDate,omitempty"`
 	Remarks                   string           `json:"Remarks,omitempty"`
-	RoundOff                  int              `json:"RoundOff,omitempty"`
+	RoundOff                  Money            `json:"RoundOff,omitzero"`
 	Sent                      bool             `json:"Sent,omitempty"`
-	TaxReduction              int              `json:"TaxReduction,omitempty"`
+	TaxReduction              Money            `json:"TaxReduction,omitzero"`
 	TermsOfDelivery           string           `json:"TermsOfDelivery,omitempty"`
 	TermsOfPayment            string           `json:"TermsOfPayment,omitempty"`
 	TimeBasisReference        int              `json:"TimeBasisReference,omitempty"`
-	Total                     int              `json:"Total,omitempty"`
-	TotalToPay                int              `json:"TotalToPay,omitempty"`
-	TotalVAT                  int              `json:"TotalVAT,omitempty"`
+	Total                     Money            `json:"Total,omitzero"`
+	TotalToPay                Money            `json:"TotalToPay,omitzero"`
+	TotalVAT                  Money            `json:"TotalVAT,omitzero"`
 	VATIncluded               bool             `json:"VATIncluded,omitempty"`
 	WayOfDelivery             string           `json:"WayOfDelivery,omitempty"`
 	YourReference             string           `json:"YourReference,omitempty"`
@@ -333,27 +355,140 @@ type Order struct {
 	TaxReductionType          string           `json:"TaxReductionType,omitempty"`
 }
 
+// OrderUpdate is the payload of UpdateOrder, only fields that are Set are sent,
+// so they can be changed to their zero value
+type OrderUpdate struct {
+	AdministrationFee         Optional[Money]            `json:"AdministrationFee"`
+	AdministrationFeeVAT      Optional[Money]            `json:"AdministrationFeeVAT"`
+	Address1                  Optional[string]           `json:"Address1"`
+	Address2                  Optional[string]           `json:"Address2"`
+	BasisTaxReduction         Optional[Money]            `json:"BasisTaxReduction"`
+	Cancelled                 Optional[bool]             `json:"Cancelled"`
+	City                      Optional[string]           `json:"City"`
+	Comments                  Optional[string]           `json:"Comments"`
+	ContributionPercent       Optional[int]              `json:"ContributionPercent"`
+	ContributionValue         Optional[Money]            `json:"ContributionValue"`
+	CopyRemarks               Optional[bool]             `json:"CopyRemarks"`
+	Country                   Optional[string]           `json:"Country"`
+	CostCenter                Optional[string]           `json:"CostCenter"`
+	Currency                  Optional[string]           `json:"Currency"`
+	CurrencyRate              Optional[Money]            `json:"CurrencyRate"`
+	CurrencyUnit              Optional[int]              `json:"CurrencyUnit"`
+	CustomerName              Optional[string]           `json:"CustomerName"`
+	CustomerNumber            Optional[string]           `json:"CustomerNumber"`
+	DeliveryState             Optional[string]           `json:"DeliveryState"`
+	DeliveryAddress1          Optional[string]           `json:"DeliveryAddress1"`
+	DeliveryAddress2          Optional[string]           `json:"DeliveryAddress2"`
+	DeliveryCity              Optional[string]           `json:"DeliveryCity"`
+	DeliveryCountry           Optional[string]           `json:"DeliveryCountry"`
+	DeliveryDate              Optional[Date]             `json:"DeliveryDate"`
+	DeliveryName              Optional[string]           `json:"DeliveryName"`
+	DeliveryZipCode           Optional[string]           `json:"DeliveryZipCode"`
+	DocumentNumber            Optional[string]           `json:"DocumentNumber"`
+	EmailInformation          Optional[EmailInformation] `json:"EmailInformation"`
+	ExternalInvoiceReference1 Optional[string]           `json:"ExternalInvoiceReference1"`
+	ExternalInvoiceReference2 Optional[string]           `json:"ExternalInvoiceReference2"`
+	Freight                   Optional[Money]            `json:"Freight"`
+	FreightVAT                Optional[Money]            `json:"FreightVAT"`
+	Gross                     Optional[Money]            `json:"Gross"`
+	HouseWork                 Optional[bool]             `json:"HouseWork"`
+	InvoiceReference          Optional[string]           `json:"InvoiceReference"`
+	Labels                    Optional[[]Label]          `json:"Labels"`
+	Language                  Optional[string]           `json:"Language"`
+	Net                       Optional[Money]            `json:"Net"`
+	NotCompleted              Optional[bool]             `json:"NotCompleted"`
+	OfferReference            Optional[string]           `json:"OfferReference"`
+	OrderDate                 Optional[Date]             `json:"OrderDate"`
+	OrderRows                 Optional[[]OrderRow]       `json:"OrderRows"`
+	OrderType                 Optional[string]           `json:"OrderType"`
+	OrganisationNumber        Optional[string]           `json:"OrganisationNumber"`
+	OurReference              Optional[string]           `json:"OurReference"`
+	Phone1                    Optional[string]           `json:"Phone1"`
+	Phone2                    Optional[string]           `json:"Phone2"`
+	PriceList                 Optional[string]           `json:"PriceList"`
+	PrintTemplate             Optional[string]           `json:"PrintTemplate"`
+	Project                   Optional[string]           `json:"Project"`
+	WarehouseReady            Optional[bool]             `json:"WarehouseReady"`
+	OutboundDate              Optional[Date]             `json:"OutboundDate"`
+	Remarks                   Optional[string]           `json:"Remarks"`
+	RoundOff                  Optional[Money]            `json:"RoundOff"`
+	Sent                      Optional[bool]             `json:"Sent"`
+	TaxReduction              Optional[Money]            `json:"TaxReduction"`
+	TermsOfDelivery           Optional[string]           `json:"TermsOfDelivery"`
+	TermsOfPayment            Optional[string]           `json:"TermsOfPayment"`
+	TimeBasisReference        Optional[int]              `json:"TimeBasisReference"`
+	Total                     Optional[Money]            `json:"Total"`
+	TotalToPay                Optional[Money]            `json:"TotalToPay"`
+	TotalVAT                  Optional[Money]            `json:"TotalVAT"`
+	VATIncluded               Optional[bool]             `json:"VATIncluded"`
+	WayOfDelivery             Optional[string]           `json:"WayOfDelivery"`
+	YourReference             Optional[string]           `json:"YourReference"`
+	YourOrderNumber           Optional[string]           `json:"YourOrderNumber"`
+	ZipCode                   Optional[string]           `json:"ZipCode"`
+	StockPointCode            Optional[string]           `json:"StockPointCode"`
+	StockPointId              Optional[string]           `json:"StockPointId"`
+	TaxReductionType          Optional[string]           `json:"TaxReductionType"`
+}
+
+func (u OrderUpdate) MarshalJSON() ([]byte, error) {
+	return marshalSetFields(u)
+}
+
+// orderReadOnlyFields are computed or set by Fortnox, DiffOrder never sends them
+var orderReadOnlyFields = map[string]bool{
+	"AdministrationFeeVAT": true,
+	"BasisTaxReduction":    true,
+	"Cancelled":            true,
+	"ContributionPercent":  true,
+	"ContributionValue":    true,
+	"DeliveryState":        true,
+	"DocumentNumber":       true,
+	"FreightVAT":           true,
+	"Gross":                true,
+	"InvoiceReference":     true,
+	"Net":                  true,
+	"OfferReference":       true,
+	"OrderType":            true,
+	"RoundOff":             true,
+	"Sent":                 true,
+	"TaxReduction":         true,
+	"TimeBasisReference":   true,
+	"Total":                true,
+	"TotalToPay":           true,
+	"TotalVAT":             true,
+	"WarehouseReady":       true,
+}
+
+// DiffOrder returns an update holding only the writable fields that differ between original, as returned by Fortnox,
+// and edited, a modified copy of it
+func DiffOrder(original, edited *Order) *OrderUpdate {
+	u := &OrderUpdate{}
+	diffInto(original, edited, u, orderReadOnlyFields)
+
+	return u
+}
+
 type OrderRow struct {
 	AccountNumber          int    `json:"AccountNumber,omitempty"`
 	ArticleNumber          string `json:"ArticleNumber,omitempty"`
 	ContributionPercent    string `json:"ContributionPercent,omitempty"`
-	ContributionValue      string `json:"ContributionValue,omitempty"`
+	ContributionValue      Money  `json:"ContributionValue,omitzero"`
 	CostCenter             string `json:"CostCenter,omitempty"`
 	DeliveredQuantity      string `json:"DeliveredQuantity,omitempty"`
 	Description            string `json:"Description,omitempty"`
-	Discount               int    `json:"Discount,omitempty"`
+	Discount               Money  `json:"Discount,omitzero"`
 	DiscountType           string `json:"DiscountType,omitempty"`
 	HouseWork              bool   `json:"HouseWork,omitempty"`
 	HouseWorkHoursToReport int    `json:"HouseWorkHoursToReport,omitempty"`
 	HouseWorkType          string `json:"HouseWorkType,omitempty"`
 	OrderedQuantity        string `json:"OrderedQuantity,omitempty"`
-	Price                  int    `json:"Price,omitempty"`
+	Price                  Money  `json:"Price,omitzero"`
 	Project                string `json:"Project,omitempty"`
 	ReservedQuantity       string `json:"ReservedQuantity,omitempty"`
 	RowId                  int    `json:"RowId,omitempty"`
 	StockPointCode         string `json:"StockPointCode,omitempty"`
 	StockPointId           string `json:"StockPointId,omitempty"`
-	Total                  int    `json:"Total,omitempty"`
+	Total                  Money  `json:"Total,omitzero"`
 	Unit                   string `json:"Unit,omitempty"`
 	VAT                    int    `json:"VAT,omitempty"`
 }
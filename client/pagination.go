@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	// DefaultPageLimit is the amount of resources Fortnox returns per page when no limit is given
+	DefaultPageLimit = 100
+	// MaxPageLimit is the biggest limit Fortnox accepts per page
+	MaxPageLimit = 500
+)
+
+// MetaInformation is the paging information Fortnox returns with every list response
+type MetaInformation struct {
+	TotalResources int `json:"@TotalResources"`
+	TotalPages     int `json:"@TotalPages"`
+	CurrentPage    int `json:"@CurrentPage"`
+}
+
+// HasNextPage reports whether there are more pages after the current one
+func (m *MetaInformation) HasNextPage() bool {
+	if m == nil {
+		return false
+	}
+
+	return m.CurrentPage > 0 && m.CurrentPage < m.TotalPages
+}
+
+// PageOptions selects which part of a list resource to fetch
+type PageOptions struct {
+	// Page is 1-based
+	Page int `fortnox:"page"`
+	// Limit is the amount of resources per page, up to MaxPageLimit
+	Limit int `fortnox:"limit"`
+	// Offset is the amount of resources to skip
+	Offset int `fortnox:"offset"`
+}
+
+func (p *PageOptions) urlValues() (url.Values, error) {
+	return encodeQuery(p)
+}
+
+// mergeURLValues returns a new url.Values with page params added on top of params
+func (p *PageOptions) mergeURLValues(params url.Values) (url.Values, error) {
+	pageParams, err := p.urlValues()
+	if err != nil {
+		return nil, err
+	}
+	if len(pageParams) == 0 {
+		return params, nil
+	}
+
+	merged := url.Values{}
+	for k, v := range params {
+		merged[k] = v
+	}
+	for k, v := range pageParams {
+		merged[k] = v
+	}
+
+	return merged, nil
+}
+
+// pageFetcher fetches a single page of a list resource
+type pageFetcher[T any] func(ctx context.Context, page *PageOptions) ([]T, *MetaInformation, error)
+
+// Iterator lazily walks all pages of a list resource, fetching the next page only when
+// the current one has been consumed:
+//
+//	it := client.IterateInvoices(nil)
+//	for it.Next(ctx) {
+//		invoice := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch pageFetcher[T]
+	limit int
+
+	nextPage int
+	items    []T
+	idx      int
+	current  T
+	meta     *MetaInformation
+	done     bool
+	err      error
+}
+
+func newIterator[T any](fetch pageFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{
+		fetch:    fetch,
+		limit:    MaxPageLimit,
+		nextPage: 1,
+	}
+}
+
+// Limit sets how many resources are requested per page, must be called before the first Next
+func (it *Iterator[T]) Limit(limit int) *Iterator[T] {
+	if limit > 0 {
+		it.limit = limit
+	}
+
+	return it
+}
+
+// Next advances to the next resource, fetching the next page when needed.
+// Returns false when all pages were consumed or an error occurred, see Err.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for it.idx >= len(it.items) {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		it.fetchNextPage(ctx)
+	}
+
+	it.current = it.items[it.idx]
+	it.idx++
+
+	return true
+}
+
+// Value returns the resource Next advanced to
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// PageInfo returns the MetaInformation of the last fetched page, nil before the first Next
+func (it *Iterator[T]) PageInfo() *MetaInformation {
+	return it.meta
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All consumes the remaining resources of all pages
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+
+	for it.Next(ctx) {
+		all = append(all, it.Value())
+	}
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	return all, nil
+}
+
+func (it *Iterator[T]) fetchNextPage(ctx context.Context) {
+	items, meta, err := it.fetch(ctx, &PageOptions{Page: it.nextPage, Limit: it.limit})
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.items = items
+	it.idx = 0
+	it.meta = meta
+	it.nextPage++
+
+	if len(items) == 0 || !meta.HasNextPage() {
+		it.done = true
+	}
+}
+
+// NewIterator returns an Iterator calling fetch for every page, e.g. to implement an IterateX method of a service in a fake
+func NewIterator[T any](fetch func(ctx context.Context, page *PageOptions) ([]T, *MetaInformation, error)) *Iterator[T] {
+	return newIterator(fetch)
+}
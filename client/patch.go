@@ -0,0 +1,52 @@
+package client
+
+import (
+	"reflect"
+)
+
+// diffInto sets every Optional field of update whose same named field differs between original and edited.
+// original and edited are pointers to the same struct type, update a pointer to its Update type.
+// Fields listed in readOnly are never set. Slices such as invoice rows are compared and sent as a whole.
+func diffInto(original, edited, update interface{}, readOnly map[string]bool) {
+	ov := reflect.ValueOf(original).Elem()
+	ev := reflect.ValueOf(edited).Elem()
+	uv := reflect.ValueOf(update).Elem()
+	ut := uv.Type()
+
+	for i := 0; i < ut.NumField(); i++ {
+		name := ut.Field(i).Name
+		if readOnly[name] {
+			continue
+		}
+
+		of := ov.FieldByName(name)
+		ef := ev.FieldByName(name)
+		if !of.IsValid() || !ef.IsValid() {
+			continue
+		}
+
+		if reflect.DeepEqual(of.Interface(), ef.Interface()) {
+			continue
+		}
+
+		if setter, ok := uv.Field(i).Addr().Interface().(settableField); ok {
+			setter.setAny(ef.Interface())
+		}
+	}
+}
+
+// hasSetFields reports whether any Optional field of the update struct u is set
+func hasSetFields(u interface{}) bool {
+	uv := reflect.ValueOf(u)
+	for uv.Kind() == reflect.Ptr {
+		uv = uv.Elem()
+	}
+
+	for i := 0; i < uv.NumField(); i++ {
+		if opt, ok := uv.Field(i).Interface().(optionalField); ok && opt.IsSet() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -10,26 +10,43 @@ const (
 )
 
 // GetAllPredefinedAccounts does _GET https://api.fortnox.se/3/predefinedaccounts/
-func (c *Client) GetAllPredefinedAccounts(ctx context.Context) ([]PreDefinedAccount, error) {
+func (c *predefinedAccountsService) GetAllPredefinedAccounts(ctx context.Context) ([]PreDefinedAccount, error) {
+	return c.IteratePredefinedAccounts().All(ctx)
+}
+
+// GetPredefinedAccountsPage does _GET https://api.fortnox.se/3/predefinedaccounts/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *predefinedAccountsService) GetPredefinedAccountsPage(
+	ctx context.Context,
+	page *PageOptions) ([]PreDefinedAccount, *MetaInformation, error) {
+
 	resp := &GetAllPredefinedAccountsResp{}
 
-	err := c._GET(ctx, predefinedAccountsURI, nil, resp)
+	err := c._GETPage(ctx, getPredefinedAccountsPageOp, predefinedAccountsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.PreDefinedAccounts, nil
+	return resp.PreDefinedAccounts, &resp.MetaInformation, nil
+}
+
+// IteratePredefinedAccounts lazily walks all pages of https://api.fortnox.se/3/predefinedaccounts/
+func (c *predefinedAccountsService) IteratePredefinedAccounts() *Iterator[PreDefinedAccount] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]PreDefinedAccount, *MetaInformation, error) {
+		return c.GetPredefinedAccountsPage(ctx, page)
+	})
 }
 
 // GetPredefinedAccount does _GET https://api.fortnox.se/3/predefinedaccounts/{name}
 //
 // name - identifies the predefined account
-func (c *Client) GetPredefinedAccount(ctx context.Context, name string) (*PreDefinedAccount, error) {
+func (c *predefinedAccountsService) GetPredefinedAccount(ctx context.Context, name string) (*PreDefinedAccount, error) {
 	resp := &GetPredefinedAccountResp{}
 
 	uri := fmt.Sprintf("%s/%s", predefinedAccountsURI, name)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getPredefinedAccountOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +59,7 @@ func (c *Client) GetPredefinedAccount(ctx context.Context, name string) (*PreDef
 // name - identifies the predefined account
 //
 // pda - predefined account to update
-func (c *Client) UpdatePredefinedAccount(
+func (c *predefinedAccountsService) UpdatePredefinedAccount(
 	ctx context.Context,
 	name string,
 	pda *PreDefinedAccount) (*PreDefinedAccount, error) {
@@ -52,7 +69,7 @@ func (c *Client) UpdatePredefinedAccount(
 
 	uri := fmt.Sprintf("%s/%s", predefinedAccountsURI, name)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updatePredefinedAccountOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +85,7 @@ type PreDefinedAccount struct {
 
 type GetAllPredefinedAccountsResp struct {
 	PreDefinedAccounts []PreDefinedAccount `json:"PreDefinedAccounts"`
+	MetaInformation    MetaInformation     `json:"MetaInformation"`
 }
 
 type GetPredefinedAccountResp struct {
@@ -10,26 +10,43 @@ const (
 )
 
 // GetAllPredefinedVoucherSeries does _GET https://api.fortnox.se/3/predefinedvoucherseries/
-func (c *Client) GetAllPredefinedVoucherSeries(ctx context.Context) ([]PreDefinedVoucherSeries, error) {
+func (c *predefinedVoucherSeriesService) GetAllPredefinedVoucherSeries(ctx context.Context) ([]PreDefinedVoucherSeries, error) {
+	return c.IteratePredefinedVoucherSeries().All(ctx)
+}
+
+// GetPredefinedVoucherSeriesPage does _GET https://api.fortnox.se/3/predefinedvoucherseries/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *predefinedVoucherSeriesService) GetPredefinedVoucherSeriesPage(
+	ctx context.Context,
+	page *PageOptions) ([]PreDefinedVoucherSeries, *MetaInformation, error) {
+
 	resp := &GetAllPredefinedVoucherSeriesResp{}
 
-	err := c._GET(ctx, predefinedVoucherSeriesURI, nil, resp)
+	err := c._GETPage(ctx, getPredefinedVoucherSeriesPageOp, predefinedVoucherSeriesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.PreDefinedVoucherSeriesCollection, nil
+	return resp.PreDefinedVoucherSeriesCollection, &resp.MetaInformation, nil
+}
+
+// IteratePredefinedVoucherSeries lazily walks all pages of https://api.fortnox.se/3/predefinedvoucherseries/
+func (c *predefinedVoucherSeriesService) IteratePredefinedVoucherSeries() *Iterator[PreDefinedVoucherSeries] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]PreDefinedVoucherSeries, *MetaInformation, error) {
+		return c.GetPredefinedVoucherSeriesPage(ctx, page)
+	})
 }
 
 // GetPredefinedVoucherSeries does _GET https://api.fortnox.se/3/predefinedvoucherseries/{Name}
 //
 // name - identifies the predefined voucher series
-func (c *Client) GetPredefinedVoucherSeries(ctx context.Context, name string) (*PreDefinedVoucherSeries, error) {
+func (c *predefinedVoucherSeriesService) GetPredefinedVoucherSeries(ctx context.Context, name string) (*PreDefinedVoucherSeries, error) {
 	resp := &GetPredefinedVoucherSeriesResp{}
 
 	uri := fmt.Sprintf("%s/%s", predefinedVoucherSeriesURI, name)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getPredefinedVoucherSeriesOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +59,7 @@ func (c *Client) GetPredefinedVoucherSeries(ctx context.Context, name string) (*
 // name - identifies the predefined voucher series
 //
 // pdvs - predefined voucher series to update
-func (c *Client) UpdatePredefinedVoucherSeries(
+func (c *predefinedVoucherSeriesService) UpdatePredefinedVoucherSeries(
 	ctx context.Context,
 	name string,
 	pdvs *PreDefinedVoucherSeries) (*PreDefinedVoucherSeries, error) {
@@ -52,7 +69,7 @@ func (c *Client) UpdatePredefinedVoucherSeries(
 
 	uri := fmt.Sprintf("%s/%s", predefinedVoucherSeriesURI, name)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updatePredefinedVoucherSeriesOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +85,7 @@ type PreDefinedVoucherSeries struct {
 
 type GetAllPredefinedVoucherSeriesResp struct {
 	PreDefinedVoucherSeriesCollection []PreDefinedVoucherSeries `json:"PreDefinedVoucherSeriesCollection"`
+	MetaInformation                   MetaInformation           `json:"MetaInformation"`
 }
 
 type GetPredefinedVoucherSeriesResp struct {
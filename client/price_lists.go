@@ -10,25 +10,39 @@ const (
 )
 
 // GetAllPriceLists does _GET https://api.fortnox.se/3/pricelists
-func (c *Client) GetAllPriceLists(ctx context.Context) ([]PriceList, error) {
+func (c *priceListsService) GetAllPriceLists(ctx context.Context) ([]PriceList, error) {
+	return c.IteratePriceLists().All(ctx)
+}
+
+// GetPriceListsPage does _GET https://api.fortnox.se/3/pricelists and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *priceListsService) GetPriceListsPage(ctx context.Context, page *PageOptions) ([]PriceList, *MetaInformation, error) {
 	resp := &GetAllPriceListsResp{}
 
-	err := c._GET(ctx, priceListURI, nil, resp)
+	err := c._GETPage(ctx, getPriceListsPageOp, priceListURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.PriceLists, nil
+	return resp.PriceLists, &resp.MetaInformation, nil
+}
+
+// IteratePriceLists lazily walks all pages of https://api.fortnox.se/3/pricelists
+func (c *priceListsService) IteratePriceLists() *Iterator[PriceList] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]PriceList, *MetaInformation, error) {
+		return c.GetPriceListsPage(ctx, page)
+	})
 }
 
 // CreatePriceList does _POST https://api.fortnox.se/3/pricelists
 //
 // req - price list to create
-func (c *Client) CreatePriceList(ctx context.Context, pl *PriceList) (*PriceList, error) {
+func (c *priceListsService) CreatePriceList(ctx context.Context, pl *PriceList) (*PriceList, error) {
 	req := CreatePriceListReq{PriceList: *pl}
 	resp := &CreatePriceListResp{}
 
-	err := c._POST(ctx, priceListURI, nil, req, resp)
+	err := c._POST(ctx, createPriceListOp, priceListURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +53,12 @@ func (c *Client) CreatePriceList(ctx context.Context, pl *PriceList) (*PriceList
 // GetPriceList does _GET https://api.fortnox.se/3/pricelists/{Code}
 //
 // code - identifies the price list
-func (c *Client) GetPriceList(ctx context.Context, code string) (*PriceList, error) {
+func (c *priceListsService) GetPriceList(ctx context.Context, code string) (*PriceList, error) {
 	resp := &GetPriceListResp{}
 
 	uri := fmt.Sprintf("%s/%s", priceListURI, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getPriceListOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +71,7 @@ func (c *Client) GetPriceList(ctx context.Context, code string) (*PriceList, err
 // code - identifies the price list
 //
 // req - price list to update
-func (c *Client) UpdatePriceList(
+func (c *priceListsService) UpdatePriceList(
 	ctx context.Context,
 	code string,
 	pl *PriceList) (*PriceList, error) {
@@ -67,7 +81,7 @@ func (c *Client) UpdatePriceList(
 
 	uri := fmt.Sprintf("%s/%s", priceListURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updatePriceListOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +98,8 @@ type PriceList struct {
 }
 
 type GetAllPriceListsResp struct {
-	PriceLists []PriceList `json:"PriceLists"`
+	PriceLists      []PriceList     `json:"PriceLists"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreatePriceListReq struct {
@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"fmt"
-	"time"
 )
 
 const (
@@ -17,7 +16,7 @@ const (
 // articleNumber - identifies the article
 //
 // fromQuantity - identifies from quantity
-func (c *Client) GetPriceForArticle(
+func (c *pricesService) GetPriceForArticle(
 	ctx context.Context,
 	priceList, articleNumber string,
 	fromQuantity int) (*Price, error) {
@@ -26,7 +25,7 @@ func (c *Client) GetPriceForArticle(
 
 	uri := fmt.Sprintf("%s/%s/%s/%d", pricesURI, priceList, articleNumber, fromQuantity)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getPriceForArticleOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +42,7 @@ func (c *Client) GetPriceForArticle(
 // fromQuantity - identifies from quantity
 //
 // p - price to update
-func (c *Client) UpdatePrice(
+func (c *pricesService) UpdatePrice(
 	ctx context.Context,
 	priceList, articleNumber string,
 	fromQuantity int,
@@ -54,7 +53,7 @@ func (c *Client) UpdatePrice(
 
 	uri := fmt.Sprintf("%s/%s/%s/%d", pricesURI, priceList, articleNumber, fromQuantity)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updatePriceOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +68,9 @@ func (c *Client) UpdatePrice(
 // articleNumber - identifies the article
 //
 // fromQuantity - identifies from quantity
-func (c *Client) DeletePrice(ctx context.Context, priceList, articleNumber string, fromQuantity int) error {
+func (c *pricesService) DeletePrice(ctx context.Context, priceList, articleNumber string, fromQuantity int) error {
 	uri := fmt.Sprintf("%s/%s/%s/%d", pricesURI, priceList, articleNumber, fromQuantity)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deletePriceOp, uri)
 }
 
 // GetAllArticlesWithPricesInPriceList does _GET https://api.fortnox.se/3/prices/sublist/{PriceList}/{ArticleNumber}
@@ -79,20 +78,45 @@ func (c *Client) DeletePrice(ctx context.Context, priceList, articleNumber strin
 // priceList - identifies the price list of the prices
 //
 // articleNumber - identifies the article number of the prices
-func (c *Client) GetAllArticlesWithPricesInPriceList(
+func (c *pricesService) GetAllArticlesWithPricesInPriceList(
 	ctx context.Context,
 	priceList, articleNumber string) ([]Price, error) {
+	return c.IterateArticlesWithPricesInPriceList(priceList, articleNumber).All(ctx)
+}
+
+// GetArticlesWithPricesInPriceListPage does _GET https://api.fortnox.se/3/prices/sublist/{PriceList}/{ArticleNumber} and returns a single page along with its MetaInformation
+//
+// priceList - identifies the price list of the prices
+//
+// articleNumber - identifies the article number of the prices
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *pricesService) GetArticlesWithPricesInPriceListPage(
+	ctx context.Context,
+	priceList, articleNumber string,
+	page *PageOptions) ([]Price, *MetaInformation, error) {
 
 	resp := &GetAllArticlesWithPricesInPriceListResp{}
 
 	uri := fmt.Sprintf("%s/sublist/%s/%s", pricesURI, priceList, articleNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GETPage(ctx, getArticlesWithPricesInPriceListPageOp, uri, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Prices, nil
+	return resp.Prices, &resp.MetaInformation, nil
+}
+
+// IterateArticlesWithPricesInPriceList lazily walks all pages of https://api.fortnox.se/3/prices/sublist/{PriceList}/{ArticleNumber}
+//
+// priceList - identifies the price list of the prices
+//
+// articleNumber - identifies the article number of the prices
+func (c *pricesService) IterateArticlesWithPricesInPriceList(priceList, articleNumber string) *Iterator[Price] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Price, *MetaInformation, error) {
+		return c.GetArticlesWithPricesInPriceListPage(ctx, priceList, articleNumber, page)
+	})
 }
 
 // GetFirstPriceForArticle does _GET https://api.fortnox.se/3/prices/{PriceList}/{ArticleNumber}
@@ -100,13 +124,13 @@ func (c *Client) GetAllArticlesWithPricesInPriceList(
 // priceList - identifies the price list of the prices
 //
 // articleNumber - identifies the article number of the prices
-func (c *Client) GetFirstPriceForArticle(ctx context.Context, priceList, articleNumber string) (*Price, error) {
+func (c *pricesService) GetFirstPriceForArticle(ctx context.Context, priceList, articleNumber string) (*Price, error) {
 
 	resp := &GetFirstPriceForArticleResp{}
 
 	uri := fmt.Sprintf("%s/%s/%s", pricesURI, priceList, articleNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getFirstPriceForArticleOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +145,7 @@ func (c *Client) GetFirstPriceForArticle(ctx context.Context, priceList, article
 // articleNumber - identifies the article number of the prices
 //
 // p - price to update
-func (c *Client) UpdateFirstPriceForArticle(
+func (c *pricesService) UpdateFirstPriceForArticle(
 	ctx context.Context,
 	priceList, articleNumber string,
 	p *Price) (*Price, error) {
@@ -131,7 +155,7 @@ func (c *Client) UpdateFirstPriceForArticle(
 
 	uri := fmt.Sprintf("%s/%s/%s", pricesURI, priceList, articleNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateFirstPriceForArticleOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -142,11 +166,11 @@ func (c *Client) UpdateFirstPriceForArticle(
 // CreatePrice does _POST https://api.fortnox.se/3/prices/
 //
 // p - price to create
-func (c *Client) CreatePrice(ctx context.Context, p *Price) (*Price, error) {
+func (c *pricesService) CreatePrice(ctx context.Context, p *Price) (*Price, error) {
 	req := &CreatePriceReq{Price: *p}
 	resp := &CreatePriceResp{}
 
-	err := c._POST(ctx, pricesURI, nil, req, resp)
+	err := c._POST(ctx, createPriceOp, pricesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -155,13 +179,13 @@ func (c *Client) CreatePrice(ctx context.Context, p *Price) (*Price, error) {
 }
 
 type Price struct {
-	Url           string    `json:"@url,omitempty"`
-	ArticleNumber string    `json:"ArticleNumber,omitempty"`
-	Date          time.Time `json:"Date,omitempty,omitempty"`
-	FromQuantity  int       `json:"FromQuantity,omitempty"`
-	Percent       int       `json:"Percent,omitempty,omitempty"`
-	Price         int       `json:"Price,omitempty"`
-	PriceList     string    `json:"PriceList,omitempty"`
+	Url           string `json:"@url,omitempty"`
+	ArticleNumber string `json:"ArticleNumber,omitempty"`
+	Date          Date   `json:"Date,omitempty,omitempty"`
+	FromQuantity  int    `json:"FromQuantity,omitempty"`
+	Percent       int    `json:"Percent,omitempty,omitempty"`
+	Price         Money  `json:"Price,omitzero"`
+	PriceList     string `json:"PriceList,omitempty"`
 }
 
 type GetPriceForArticleResp struct {
@@ -177,7 +201,8 @@ type UpdatePriceResp struct {
 }
 
 type GetAllArticlesWithPricesInPriceListResp struct {
-	Prices []Price `json:"Prices"`
+	Prices          []Price         `json:"Prices"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type GetFirstPriceForArticleResp struct {
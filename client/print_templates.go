@@ -7,19 +7,37 @@ const (
 )
 
 // GetAllPrintTemplates does _GET https://api.fortnox.se/3/printtemplates
-func (c *Client) GetAllPrintTemplates(ctx context.Context) ([]PrintTemplate, error) {
+func (c *printTemplatesService) GetAllPrintTemplates(ctx context.Context) ([]PrintTemplate, error) {
+	return c.IteratePrintTemplates().All(ctx)
+}
+
+// GetPrintTemplatesPage does _GET https://api.fortnox.se/3/printtemplates and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *printTemplatesService) GetPrintTemplatesPage(
+	ctx context.Context,
+	page *PageOptions) ([]PrintTemplate, *MetaInformation, error) {
+
 	resp := &GetAllPrintTemplatesResp{}
 
-	err := c._GET(ctx, printTemplatesURI, nil, resp)
+	err := c._GETPage(ctx, getPrintTemplatesPageOp, printTemplatesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.PrintTemplates, nil
+	return resp.PrintTemplates, &resp.MetaInformation, nil
+}
+
+// IteratePrintTemplates lazily walks all pages of https://api.fortnox.se/3/printtemplates
+func (c *printTemplatesService) IteratePrintTemplates() *Iterator[PrintTemplate] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]PrintTemplate, *MetaInformation, error) {
+		return c.GetPrintTemplatesPage(ctx, page)
+	})
 }
 
 type GetAllPrintTemplatesResp struct {
-	PrintTemplates []PrintTemplate `json:"PrintTemplates"`
+	PrintTemplates  []PrintTemplate `json:"PrintTemplates"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type PrintTemplate struct {
@@ -12,12 +12,12 @@ const (
 // GetProject does _GET https://api.fortnox.se/3/projects/{ProjectNumber}
 //
 // projectNumber - identifies the project
-func (c *Client) GetProject(ctx context.Context, projectNumber int) (*Project, error) {
+func (c *projectsService) GetProject(ctx context.Context, projectNumber int) (*Project, error) {
 	resp := &GetProjectResp{}
 
 	uri := fmt.Sprintf("%s/%d", projectsURI, projectNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getProjectOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -30,13 +30,13 @@ func (c *Client) GetProject(ctx context.Context, projectNumber int) (*Project, e
 // projectNumber - identifies the project
 //
 // p - project to update
-func (c *Client) UpdateProject(ctx context.Context, projectNumber int, p *Project) (*Project, error) {
+func (c *projectsService) UpdateProject(ctx context.Context, projectNumber int, p *Project) (*Project, error) {
 	req := &UpdateProjectReq{Project: *p}
 	resp := &UpdateProjectResp{}
 
 	uri := fmt.Sprintf("%s/%d", projectsURI, projectNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateProjectOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -47,31 +47,45 @@ func (c *Client) UpdateProject(ctx context.Context, projectNumber int, p *Projec
 // RemoveProject does _DELETE https://api.fortnox.se/3/projects/{ProjectNumber}
 //
 // projectNumber - identifies the project
-func (c *Client) RemoveProject(ctx context.Context, projectNumber int) error {
+func (c *projectsService) RemoveProject(ctx context.Context, projectNumber int) error {
 	uri := fmt.Sprintf("%s/%d", projectsURI, projectNumber)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeProjectOp, uri)
 }
 
 // GetAllProjects does _GET https://api.fortnox.se/3/projects
-func (c *Client) GetAllProjects(ctx context.Context) ([]Project, error) {
+func (c *projectsService) GetAllProjects(ctx context.Context) ([]Project, error) {
+	return c.IterateProjects().All(ctx)
+}
+
+// GetProjectsPage does _GET https://api.fortnox.se/3/projects and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *projectsService) GetProjectsPage(ctx context.Context, page *PageOptions) ([]Project, *MetaInformation, error) {
 	resp := &GetAllProjectsResp{}
 
-	err := c._GET(ctx, projectsURI, nil, resp)
+	err := c._GETPage(ctx, getProjectsPageOp, projectsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Projects, nil
+	return resp.Projects, &resp.MetaInformation, nil
+}
+
+// IterateProjects lazily walks all pages of https://api.fortnox.se/3/projects
+func (c *projectsService) IterateProjects() *Iterator[Project] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Project, *MetaInformation, error) {
+		return c.GetProjectsPage(ctx, page)
+	})
 }
 
 // CreateProject does POST https://api.fortnox.se/3/projects/
 //
 // project - project to create
-func (c *Client) CreateProject(ctx context.Context, p *Project) (*Project, error) {
+func (c *projectsService) CreateProject(ctx context.Context, p *Project) (*Project, error) {
 	req := &CreateProjectReq{Project: *p}
 	resp := &CreateProjectResp{}
 
-	err := c._POST(ctx, projectsURI, nil, req, resp)
+	err := c._POST(ctx, createProjectOp, projectsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,11 +98,11 @@ type Project struct {
 	Comments      string `json:"Comments,omitempty,omitempty"`
 	ContactPerson string `json:"ContactPerson,omitempty,omitempty"`
 	Description   string `json:"Description,omitempty"`
-	EndDate       string `json:"EndDate,omitempty"`
+	EndDate       Date   `json:"EndDate,omitempty"`
 	ProjectLeader string `json:"ProjectLeader,omitempty"`
 	ProjectNumber string `json:"ProjectNumber,omitempty"`
 	Status        string `json:"Status,omitempty"`
-	StartDate     string `json:"StartDate,omitempty"`
+	StartDate     Date   `json:"StartDate,omitempty"`
 }
 
 type GetProjectResp struct {
@@ -104,7 +118,8 @@ type UpdateProjectResp struct {
 }
 
 type GetAllProjectsResp struct {
-	Projects []Project `json:"Projects"`
+	Projects        []Project       `json:"Projects"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateProjectReq struct {
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	queryTagName = "fortnox"
+
+	// queryDateFormat is how Fortnox expects dates in query params, e.g. fromdate
+	queryDateFormat = "2006-01-02"
+	// queryDateTimeFormat is how Fortnox expects timestamps in query params, e.g. lastmodified
+	queryDateTimeFormat = "2006-01-02 15:04"
+)
+
+// Bool returns a pointer to b, for optional boolean filters such as GetAllInvoicesQueryParams.Sent
+func Bool(b bool) *bool {
+	return &b
+}
+
+// encodeQuery encodes the fields of a filter struct tagged `fortnox:"name"` into query params.
+//
+// Zero values are left out, use a *bool to filter on false.
+// A Date is sent as is, a time.Time is sent as a date, add the datetime option (`fortnox:"lastmodified,datetime"`) to send the time too.
+// Fields without tag or tagged "-" are skipped.
+// A field of a kind that can't be sent as a query param is an error.
+func encodeQuery(filter interface{}) (url.Values, error) {
+	v := reflect.ValueOf(filter)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, errors.Errorf("fortnox: can't encode %s as query params", v.Type())
+	}
+
+	params := url.Values{}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get(queryTagName)
+		if tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+
+		value, ok, err := encodeQueryValue(v.Field(i), opts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fortnox: can't encode %s.%s", t, field.Name)
+		}
+		if !ok {
+			continue
+		}
+
+		params[name] = []string{value}
+	}
+
+	return params, nil
+}
+
+// encodeQueryValue returns the query representation of v, false if v is unset
+func encodeQueryValue(v reflect.Value, opts string) (string, bool, error) {
+	if t, ok := v.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return "", false, nil
+		}
+
+		if opts == "datetime" {
+			return t.Format(queryDateTimeFormat), true, nil
+		}
+
+		return t.Format(queryDateFormat), true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return "", false, nil
+		}
+		if v.Elem().Kind() == reflect.Bool {
+			return strconv.FormatBool(v.Elem().Bool()), true, nil
+		}
+		return encodeQueryValue(v.Elem(), opts)
+	case reflect.String:
+		s := strings.TrimSpace(v.String())
+		return s, s != "", nil
+	case reflect.Bool:
+		// a plain bool can't tell false from unset, so only true is sent
+		return strconv.FormatBool(v.Bool()), v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), v.Int() != 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), v.Uint() != 0, nil
+	}
+
+	return "", false, errors.Errorf("unsupported kind %s", v.Type())
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestURLValues(t *testing.T) {
+	lastModified := time.Date(2023, 5, 17, 14, 30, 59, 0, time.UTC)
+
+	articleFilter := ActiveArticle
+	offersFilter := ExpiredGetAllOffersFilter
+	ordersFilter := InvoiceCreatedGetAllOrdersFilter
+	supplierInvoicesFilter := UnbookedSupplierInvoiceFilter
+	taxReductionsFilter := OrdersTaxReductionFilter
+
+	tests := []struct {
+		name   string
+		filter interface{ urlValues() (url.Values, error) }
+		want   string
+	}{
+		{"nil absence transactions", (*GetAbsenceTransactionsFilter)(nil), ""},
+		{"absence transactions", &GetAbsenceTransactionsFilter{EmployeeID: "7", Date: "2023-01-31"}, "date=2023-01-31&employeeid=7"},
+		{"accounts", &GetAllAccountsFilter{LastModified: lastModified, SortBy: "number", SRU: 7251}, "lastmodified=2023-05-17+14%3A30&sortby=number&sru=7251"},
+		{"empty accounts", &GetAllAccountsFilter{}, ""},
+		{"financial year", &FinancialYearFilter{FinancialYear: 3}, "financialyear=3"},
+		{"nil financial year", (*FinancialYearFilter)(nil), ""},
+		{"archive path", &PathFileIDFilter{Path: "inbox_v/Leverantörsfakturor"}, "path=inbox_v%2FLeverant%C3%B6rsfakturor"},
+		{"archive file id", &PathFileIDFilter{FileID: " 4b1c "}, "fileid=4b1c"},
+		{"articles", &articleFilter, "filter=active"},
+		{"nil articles", (*ArticleFilter)(nil), ""},
+		{"attendance transactions", &GetAllAttendanceTransactionsFilter{EmployeeID: "1", Date: "2023-02-01"}, "date=2023-02-01&employeeid=1"},
+		{"contracts", FinishedGetAllContractFilter, "filter=finished"},
+		{"empty contracts", GetAllContractFilter(""), ""},
+		{"financial years", GetAllFinancialYearsFilterDate{Date: "2020-06-30"}, "date=2020-06-30"},
+		{"inbox upload", &UploadFileParams{FolderID: "inbox_s", Path: "a b"}, "folderId=inbox_s&path=a+b"},
+		{"nil invoices", (*GetAllInvoicesQueryParams)(nil), ""},
+		{
+			"invoices",
+			&GetAllInvoicesQueryParams{
+				Filter:         UnpaidOverDue,
+				CustomerNumber: "10",
+				FromDate:       "2023-01-01",
+				ToDate:         "2023-01-31",
+				LastModified:   lastModified,
+				NotCompleted:   Bool(false),
+				Sent:           Bool(true),
+				Credit:         Bool(false),
+				SortBy:         DocumentNumber,
+			},
+			"credit=false&customernumber=10&filter=unpaidoverdue&fromdate=2023-01-01&lastmodified=2023-05-17+14%3A30&notcompleted=false&sent=true&sortby=documentnumber&todate=2023-01-31",
+		},
+		{"offers", &offersFilter, "filter=expired"},
+		{"nil offers", (*GetAllOffersFilter)(nil), ""},
+		{"orders", &ordersFilter, "filter=invoicecreated"},
+		{"nil orders", (*GetAllOrdersFilter)(nil), ""},
+		{"page", &PageOptions{Page: 2, Limit: 100, Offset: 5}, "limit=100&offset=5&page=2"},
+		{"nil page", (*PageOptions)(nil), ""},
+		{"salary transactions", &GetAllSalaryTransactionsForAllEmployeesFilter{EmployeeId: "3", Date: "2023-03-25"}, "date=2023-03-25&employeeId=3"},
+		{"supplier invoices", &supplierInvoicesFilter, "filter=unbooked"},
+		{"nil supplier invoices", (*GetAllSupplierInvoicesFilter)(nil), ""},
+		{"tax reductions", &taxReductionsFilter, "filter=orders"},
+		{"nil tax reductions", (*GetAllTaxReductionsFilter)(nil), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := tt.filter.urlValues()
+			if err != nil {
+				t.Fatalf("urlValues() error = %v", err)
+			}
+
+			if got := values.Encode(); got != tt.want {
+				t.Errorf("urlValues() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryUnsupportedKind(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter interface{}
+	}{
+		{"float", struct {
+			Amount float64 `fortnox:"amount"`
+		}{1.5}},
+		{"slice", struct {
+			Numbers []string `fortnox:"numbers"`
+		}{[]string{"1"}}},
+		{"not a struct", "filter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := encodeQuery(tt.filter)
+			if err == nil || !strings.Contains(err.Error(), "can't encode") {
+				t.Errorf("encodeQuery() error = %v, want can't encode", err)
+			}
+		})
+	}
+}
+
+func TestEncodeQuerySkipsUntagged(t *testing.T) {
+	values, err := encodeQuery(struct {
+		Name     string `fortnox:"name"`
+		Skipped  string `fortnox:"-"`
+		Untagged string
+		hidden   string `fortnox:"hidden"`
+		Active   bool   `fortnox:"active"`
+		Inactive bool   `fortnox:"inactive"`
+	}{"a", "b", "c", "d", true, false})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := values.Encode(), "active=true&name=a"; got != want {
+		t.Errorf("encodeQuery() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRateBurst = defaultRateLimit
+)
+
+var (
+	sharedRateLimitersMu sync.Mutex
+	sharedRateLimiters   = map[string]*RateLimiter{}
+)
+
+// RateLimiter is a token bucket limiting the requests sent to Fortnox.
+// Fortnox counts requests per access token (tenant), so all Clients talking to
+// the same tenant should share one RateLimiter, see WithRateLimitOpt and WithTenantIDOpt.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing requestsPerSecond on average and bursts of up to burst requests
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil || l.rate <= 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	l.refill(time.Now())
+	l.tokens--
+	missing := -l.tokens
+	l.mu.Unlock()
+
+	if missing <= 0 {
+		return nil
+	}
+
+	delay := time.Duration(missing / l.rate * float64(time.Second))
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		// give back the reserved token, the request will not be sent
+		l.mu.Lock()
+		l.tokens = math.Min(l.tokens+1, l.burst)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// Headroom returns how many requests can be sent right now without waiting.
+// A negative value means callers are already queued for that many requests.
+func (l *RateLimiter) Headroom() float64 {
+	if l == nil {
+		return math.Inf(1)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+
+	return l.tokens
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	l.tokens = math.Min(l.tokens+elapsed*l.rate, l.burst)
+	l.last = now
+}
+
+// sharedRateLimiter returns the RateLimiter registered for key, creating it on first use.
+// The first Client asking for a key decides its rate and burst.
+func sharedRateLimiter(key string, requestsPerSecond float64, burst int) *RateLimiter {
+	sharedRateLimitersMu.Lock()
+	defer sharedRateLimitersMu.Unlock()
+
+	l, ok := sharedRateLimiters[key]
+	if !ok {
+		l = NewRateLimiter(requestsPerSecond, burst)
+		sharedRateLimiters[key] = l
+	}
+
+	return l
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := NewRateLimiter(1, 3)
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		err := l.Wait(ctx)
+		cancel()
+		if err != nil {
+			t.Fatalf("request %d within the burst waited: %v", i+1, err)
+		}
+	}
+
+	if headroom := l.Headroom(); headroom > 0.1 {
+		t.Errorf("headroom after the burst = %v, want 0", headroom)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("request past the burst = %v, want DeadlineExceeded", err)
+	}
+
+	// the cancelled request gave back its token
+	if headroom := l.Headroom(); headroom < -0.1 {
+		t.Errorf("headroom after a cancelled wait = %v, want 0", headroom)
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	l := NewRateLimiter(100, 1)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// one request from the burst, four more at 10ms each
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("5 requests at 100/s with burst 1 took %v, want about 40ms", elapsed)
+	}
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	var nilLimiter *RateLimiter
+	if err := nilLimiter.Wait(context.Background()); err != nil {
+		t.Errorf("nil limiter Wait() = %v", err)
+	}
+	if !math.IsInf(nilLimiter.Headroom(), 1) {
+		t.Errorf("nil limiter Headroom() = %v, want +Inf", nilLimiter.Headroom())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := NewRateLimiter(0, 1).Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait() on a done context = %v, want Canceled", err)
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	a := sharedRateLimiter("test:shared", 5, 5)
+	b := sharedRateLimiter("test:shared", 10, 10)
+
+	if a != b {
+		t.Error("same key returned different limiters")
+	}
+	if a == sharedRateLimiter("test:other", 5, 5) {
+		t.Error("different keys returned the same limiter")
+	}
+}
+
+func TestClientsShareRateLimiter(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  []OptionFunc
+		share bool
+	}{
+		{"same tenant", []OptionFunc{WithTenantIDOpt("1"), WithAuthOpt("a", "s")}, []OptionFunc{WithTenantIDOpt("1"), WithAuthOpt("b", "s")}, true},
+		{"other tenant", []OptionFunc{WithTenantIDOpt("1")}, []OptionFunc{WithTenantIDOpt("2")}, false},
+		{"same access token", []OptionFunc{WithAuthOpt("token-a", "s")}, []OptionFunc{WithAuthOpt("token-a", "s")}, true},
+		{"other access token", []OptionFunc{WithAuthOpt("token-a", "s")}, []OptionFunc{WithAuthOpt("token-b", "s")}, false},
+		{"same refresh token", []OptionFunc{WithRefreshOpt("refresh-a")}, []OptionFunc{WithRefreshOpt("refresh-a")}, true},
+		{"same client id", []OptionFunc{WithClientIDOpt("client-a")}, []OptionFunc{WithClientIDOpt("client-a")}, true},
+		{"nothing in common", nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewClient(tt.a...), NewClient(tt.b...)
+
+			if share := a.rateLimiter() == b.rateLimiter(); share != tt.share {
+				t.Errorf("clients share a limiter = %v, want %v", share, tt.share)
+			}
+		})
+	}
+}
+
+func TestClientKeepsRateLimiterAfterRefresh(t *testing.T) {
+	c := NewClient(WithAuthOpt("token-before", "s"), WithRefreshOpt("refresh"))
+	before := c.rateLimiter()
+
+	c.creds.set("token-after", "refresh-after", time.Now().Add(time.Hour))
+
+	if c.rateLimiter() != before {
+		t.Error("refreshing the token changed the rate limiter")
+	}
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy decides how often and how long to wait before a failed request is sent again.
+//
+// Requests are retried on network errors and on HTTP 429, 500, 502 and 503.
+// Only idempotent methods (GET, HEAD, PUT, DELETE) are retried,
+// POST requests and action PUTs such as invoices/{n}/credit or orders/{n}/createinvoice
+// are retried only when the call's context was wrapped with WithRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one, 1 or less disables retries
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, doubled for every following retry
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts, Retry-After sent by Fortnox is honored regardless
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is used by clients created without WithRetryPolicyOpt
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// NoRetryPolicy disables retries
+var NoRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+var retryableStatuses = map[int]bool{
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+}
+
+type retryCtxKey struct{}
+
+// WithRetry returns a context allowing the request made with it to be retried even if its method is not idempotent,
+// use it for POST requests and action PUTs that are safe to send twice
+func WithRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryCtxKey{}, true)
+}
+
+// actionSegments are the last path segments of PUTs that trigger an action rather than replace a resource,
+// sending one of them twice may credit, bookkeep or invoice a document twice
+var actionSegments = map[string]bool{
+	"approvalbookkeep":     true,
+	"approvalpayment":      true,
+	"bookkeep":             true,
+	"cancel":               true,
+	"createinvoice":        true,
+	"createorder":          true,
+	"credit":               true,
+	"externalprint":        true,
+	"finish":               true,
+	"increaseinvoicecount": true,
+	"resetday":             true,
+	"warehouseready":       true,
+}
+
+// assetActions follow assets/ in the path, e.g. assets/sell/{id}
+var assetActions = map[string]bool{
+	"scrap":     true,
+	"sell":      true,
+	"writedown": true,
+	"writeup":   true,
+}
+
+// isActionURL tells if a PUT to rawURL triggers an action
+func isActionURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		// can't tell, don't risk sending it twice
+		return true
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if actionSegments[segments[len(segments)-1]] {
+		return true
+	}
+
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "assets" && assetActions[segments[i+1]] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRetryAllowed(ctx context.Context, method, rawURL string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete, http.MethodOptions:
+		return true
+	case http.MethodPut:
+		if !isActionURL(rawURL) {
+			return true
+		}
+	}
+
+	allowed, _ := ctx.Value(retryCtxKey{}).(bool)
+	return allowed
+}
+
+func isRetryableError(err error) bool {
+	if errors.Is(err, ErrSendRequest) {
+		return true
+	}
+
+	ferr := &FortnoxError{}
+	if errors.As(err, ferr) {
+		return retryableStatuses[ferr.HTTPStatus]
+	}
+
+	return false
+}
+
+// backoff returns the delay before the next attempt, attempt is the 1-based number of the failed one
+func (p RetryPolicy) backoff(attempt int, err error) time.Duration {
+	ferr := &FortnoxError{}
+	if errors.As(err, ferr) && ferr.RetryAfter > 0 {
+		return ferr.RetryAfter
+	}
+
+	shift := attempt - 1
+	if shift > 30 {
+		shift = 30
+	}
+
+	delay := p.BaseDelay << uint(shift)
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	// jitter between half and the full delay, so concurrent clients do not retry in lockstep
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses the Retry-After header, given either in seconds or as an HTTP date
+func parseRetryAfter(header string) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(header); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// flakyServer answers the first failures requests with status and every following one with body
+func flakyServer(t *testing.T, failures int32, status int, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) <= failures {
+			w.WriteHeader(status)
+			_, _ = io.WriteString(w, `{"ErrorInformation":{"error":1,"message":"try again","code":1}}`)
+			return
+		}
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func newRetryClient(server *httptest.Server) *Client {
+	return NewClient(
+		WithAuthOpt("access-token", "client-secret"),
+		WithURLOpt(server.URL+"/3/"),
+		WithRateLimitOpt(0, 0),
+		WithRetryPolicyOpt(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}))
+}
+
+func TestIsRetryAllowed(t *testing.T) {
+	const base = "https://api.fortnox.se/3/"
+
+	tests := []struct {
+		method string
+		url    string
+		want   bool
+	}{
+		{http.MethodGet, base + "invoices/1", true},
+		{http.MethodDelete, base + "customers/1", true},
+		{http.MethodPut, base + "invoices/1", true},
+		{http.MethodPut, base + "prices/A/1/0", true},
+		{http.MethodPut, base + "invoices/1/credit", false},
+		{http.MethodPut, base + "invoices/1/bookkeep", false},
+		{http.MethodPut, base + "invoices/1/cancel", false},
+		{http.MethodPut, base + "orders/1/createinvoice", false},
+		{http.MethodPut, base + "offers/1/createorder", false},
+		{http.MethodPut, base + "supplierinvoices/1/approvalpayment", false},
+		{http.MethodPut, base + "contracts/1/increaseinvoicecount", false},
+		{http.MethodPut, base + "schedule/7/2023-01-02/resetday", false},
+		{http.MethodPut, base + "assets/sell/1", false},
+		{http.MethodPut, base + "assets/1", true},
+		{http.MethodPost, base + "invoices", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.url, func(t *testing.T) {
+			if got := isRetryAllowed(context.Background(), tt.method, tt.url); got != tt.want {
+				t.Errorf("isRetryAllowed() = %v, want %v", got, tt.want)
+			}
+			if !isRetryAllowed(WithRetry(context.Background()), tt.method, tt.url) {
+				t.Error("isRetryAllowed() with WithRetry = false")
+			}
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	const invoice = `{"Invoice":{"DocumentNumber":"1"}}`
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		status       int
+		call         func(ctx context.Context, c *Client) error
+		wantErr      bool
+		wantRequests int32
+	}{
+		{
+			"get is retried",
+			context.Background(), http.StatusServiceUnavailable,
+			func(ctx context.Context, c *Client) error { _, err := c.GetInvoice(ctx, "1"); return err },
+			false, 3,
+		},
+		{
+			"update is retried",
+			context.Background(), http.StatusTooManyRequests,
+			func(ctx context.Context, c *Client) error {
+				_, err := c.UpdateInvoice(ctx, "1", &InvoiceUpdate{})
+				return err
+			},
+			false, 3,
+		},
+		{
+			"credit is not retried",
+			context.Background(), http.StatusServiceUnavailable,
+			func(ctx context.Context, c *Client) error { _, err := c.CreditInvoice(ctx, "1"); return err },
+			true, 1,
+		},
+		{
+			"credit is retried with WithRetry",
+			WithRetry(context.Background()), http.StatusServiceUnavailable,
+			func(ctx context.Context, c *Client) error { _, err := c.CreditInvoice(ctx, "1"); return err },
+			false, 3,
+		},
+		{
+			"create is not retried",
+			context.Background(), http.StatusInternalServerError,
+			func(ctx context.Context, c *Client) error { _, err := c.CreateInvoice(ctx, &Invoice{}); return err },
+			true, 1,
+		},
+		{
+			"client errors are not retried",
+			context.Background(), http.StatusBadRequest,
+			func(ctx context.Context, c *Client) error { _, err := c.GetInvoice(ctx, "1"); return err },
+			true, 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := flakyServer(t, 2, tt.status, invoice)
+
+			err := tt.call(tt.ctx, newRetryClient(server))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("server got %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	server, requests := flakyServer(t, 10, http.StatusBadGateway, "")
+
+	_, err := newRetryClient(server).GetInvoice(context.Background(), "1")
+
+	ferr := &FortnoxError{}
+	if !errors.As(err, ferr) || ferr.HTTPStatus != http.StatusBadGateway {
+		t.Errorf("error = %v, want the last FortnoxError", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("server got %d requests, want 3", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		header string
+		want   time.Duration
+	}{
+		{"", 0},
+		{"3", 3 * time.Second},
+		{" 1 ", time.Second},
+		{"-1", 0},
+		{"soon", 0},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.header); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt  int
+		err      error
+		min, max time.Duration
+	}{
+		{1, nil, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, nil, 100 * time.Millisecond, 200 * time.Millisecond},
+		{5, nil, 500 * time.Millisecond, time.Second},
+		{100, nil, 500 * time.Millisecond, time.Second},
+		{1, FortnoxError{HTTPStatus: http.StatusTooManyRequests, RetryAfter: 3 * time.Second}, 3 * time.Second, 3 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := p.backoff(tt.attempt, tt.err); got < tt.min || got > tt.max {
+			t.Errorf("backoff(%d, %v) = %v, want between %v and %v", tt.attempt, tt.err, got, tt.min, tt.max)
+		}
+	}
+}
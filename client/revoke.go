@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TokenTypeHint tells Fortnox which kind of token is revoked
+type TokenTypeHint string
+
+const (
+	TokenTypeHintAccessToken  TokenTypeHint = "access_token"
+	TokenTypeHintRefreshToken TokenTypeHint = "refresh_token"
+)
+
+// ErrTokenRevoked is matched by errors.Is when the token was already revoked or has expired
+var ErrTokenRevoked = errors.New("token already revoked")
+
+// RevokeError is returned when Fortnox doesn't know the token being revoked, it matches ErrTokenRevoked
+type RevokeError struct {
+	OAuthError
+}
+
+func (e *RevokeError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrTokenRevoked, e.OAuthError.Error())
+}
+
+func (e *RevokeError) Is(target error) bool {
+	return target == ErrTokenRevoked
+}
+
+func (e *RevokeError) Unwrap() error {
+	return &e.OAuthError
+}
+
+// RevokeToken does _POST https://apps.fortnox.se/oauth-v1/revoke
+//
+// token - access or refresh token to revoke
+//
+// hint - kind of token, may be empty
+func (c *Client) RevokeToken(ctx context.Context, token string, hint TokenTypeHint) error {
+	form := url.Values{}
+	form.Set("token", token)
+	if hint != "" {
+		form.Set("token_type_hint", string(hint))
+	}
+
+	clientID, clientSecret := c.clientCredentials()
+
+	_, err := postOAuthForm(ctx, c.clientOptions.HTTPClient, c.clientOptions.RevokeURL, clientID, clientSecret, form, nil)
+
+	oerr := &OAuthError{}
+	if errors.As(err, &oerr) && oerr.HTTPStatus == http.StatusBadRequest &&
+		(oerr.Code == "invalid_token" || oerr.Code == "invalid_grant") {
+		return &RevokeError{OAuthError: *oerr}
+	}
+
+	return err
+}
+
+// Disconnect revokes the tokens of the client and clears them from the client and the token store,
+// the integration has to be authorized again to be used.
+// When the client holds no tokens they are loaded from the token store first.
+// Tokens that were already revoked are not an error. The tokens are cleared even when revoking them fails,
+// so a disconnected integration is never used again, the error is returned after clearing them.
+func (c *Client) Disconnect(ctx context.Context) error {
+	accessToken, refreshToken := c.creds.get()
+
+	cc, isClientCredentials := c.clientOptions.TokenSource.(*ClientCredentials)
+	if isClientCredentials {
+		accessToken = cc.current()
+	} else if accessToken == "" && refreshToken == "" {
+		err := c.LoadToken(ctx)
+		if errors.Is(err, ErrTokenNotFound) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to load token")
+		}
+
+		accessToken, refreshToken = c.creds.get()
+	}
+
+	if accessToken == "" && refreshToken == "" {
+		return nil
+	}
+
+	var revokeErrs []error
+
+	// the refresh token first, if revoking the access token then fails it still expires within the hour
+	if refreshToken != "" {
+		err := c.RevokeToken(ctx, refreshToken, TokenTypeHintRefreshToken)
+		if err != nil && !errors.Is(err, ErrTokenRevoked) {
+			revokeErrs = append(revokeErrs, errors.Wrap(err, "failed to revoke refresh token"))
+		}
+	}
+
+	if accessToken != "" {
+		err := c.RevokeToken(ctx, accessToken, TokenTypeHintAccessToken)
+		if err != nil && !errors.Is(err, ErrTokenRevoked) {
+			revokeErrs = append(revokeErrs, errors.Wrap(err, "failed to revoke access token"))
+		}
+	}
+
+	if isClientCredentials {
+		cc.Expire(accessToken)
+	}
+	c.creds.set("", "", time.Time{})
+	c.creds.setScope("")
+
+	if store := c.clientOptions.TokenStore; store != nil {
+		if deleter, ok := store.(TokenDeleter); ok {
+			revokeErrs = append(revokeErrs, errors.Wrap(deleter.Delete(ctx), "failed to delete token"))
+		} else {
+			revokeErrs = append(revokeErrs, errors.Wrap(store.Save(ctx, &TokenInfo{}), "failed to clear token"))
+		}
+	}
+
+	return stderrors.Join(revokeErrs...)
+}
+
+// clientCredentials returns the client ID and secret of the integration
+func (c *Client) clientCredentials() (clientID, clientSecret string) {
+	if cc, ok := c.clientOptions.TokenSource.(*ClientCredentials); ok {
+		return cc.ClientID, cc.ClientSecret
+	}
+
+	return c.clientOptions.ClientID, c.clientOptions.ClientSecret
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// revokeServer answers revocations with the status and body answer returns for the token
+func revokeServer(t *testing.T, answer func(token string) (int, string)) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var revoked []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+
+		token := r.PostForm.Get("token")
+		mu.Lock()
+		revoked = append(revoked, r.PostForm.Get("token_type_hint")+":"+token)
+		mu.Unlock()
+
+		status, body := answer(token)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string(nil), revoked...)
+	}
+}
+
+func revokeOK(string) (int, string) { return http.StatusOK, "" }
+
+func TestDisconnect(t *testing.T) {
+	stored := &TokenInfo{AccessToken: "stored-access", RefreshToken: "stored-refresh"}
+
+	tests := []struct {
+		name        string
+		opts        []OptionFunc
+		store       *MemoryTokenStore
+		answer      func(token string) (int, string)
+		wantErr     bool
+		wantRevoked []string
+	}{
+		{
+			"tokens of the client",
+			[]OptionFunc{WithAuthOpt("access", "secret"), WithRefreshOpt("refresh")},
+			NewMemoryTokenStore(stored),
+			revokeOK,
+			false,
+			[]string{"refresh_token:refresh", "access_token:access"},
+		},
+		{
+			"tokens loaded from the store",
+			nil,
+			NewMemoryTokenStore(stored),
+			revokeOK,
+			false,
+			[]string{"refresh_token:stored-refresh", "access_token:stored-access"},
+		},
+		{
+			"nothing to revoke",
+			nil,
+			NewMemoryTokenStore(nil),
+			revokeOK,
+			false,
+			nil,
+		},
+		{
+			"already revoked",
+			[]OptionFunc{WithAuthOpt("access", "secret"), WithRefreshOpt("refresh")},
+			NewMemoryTokenStore(stored),
+			func(string) (int, string) {
+				return http.StatusBadRequest, `{"error":"invalid_token","error_description":"Token is revoked"}`
+			},
+			false,
+			[]string{"refresh_token:refresh", "access_token:access"},
+		},
+		{
+			"revocation fails",
+			[]OptionFunc{WithAuthOpt("access", "secret"), WithRefreshOpt("refresh")},
+			NewMemoryTokenStore(stored),
+			func(token string) (int, string) {
+				if token == "refresh" {
+					return http.StatusInternalServerError, `{"error":"server_error"}`
+				}
+				return http.StatusOK, ""
+			},
+			true,
+			[]string{"refresh_token:refresh", "access_token:access"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, revoked := revokeServer(t, tt.answer)
+
+			opts := append([]OptionFunc{
+				WithClientIDOpt("client-id"),
+				WithRevokeURLOpt(server.URL),
+				WithTokenStoreOpt(tt.store),
+			}, tt.opts...)
+			c := NewClient(opts...)
+
+			err := c.Disconnect(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Disconnect() = %v, want error %v", err, tt.wantErr)
+			}
+
+			got := revoked()
+			if len(got) != len(tt.wantRevoked) {
+				t.Fatalf("revoked %v, want %v", got, tt.wantRevoked)
+			}
+			for i := range got {
+				if got[i] != tt.wantRevoked[i] {
+					t.Errorf("revoked %v, want %v", got, tt.wantRevoked)
+				}
+			}
+
+			// the local tokens are gone whether or not Fortnox revoked them
+			if c.GetAccessToken() != "" || c.GetRefreshToken() != "" {
+				t.Errorf("client still holds %q, %q", c.GetAccessToken(), c.GetRefreshToken())
+			}
+			if _, err := tt.store.Load(context.Background()); !errors.Is(err, ErrTokenNotFound) {
+				t.Errorf("store Load() = %v, want ErrTokenNotFound", err)
+			}
+		})
+	}
+}
+
+func TestRevokeTokenAlreadyRevoked(t *testing.T) {
+	server, _ := revokeServer(t, func(string) (int, string) {
+		return http.StatusBadRequest, `{"error":"invalid_grant","error_description":"Token is expired"}`
+	})
+
+	c := NewClient(WithClientIDOpt("client-id"), WithRevokeURLOpt(server.URL))
+
+	err := c.RevokeToken(context.Background(), "token", TokenTypeHintAccessToken)
+
+	var rerr *RevokeError
+	if !errors.Is(err, ErrTokenRevoked) || !errors.As(err, &rerr) || rerr.Code != "invalid_grant" {
+		t.Errorf("RevokeToken() = %v, want a RevokeError", err)
+	}
+}
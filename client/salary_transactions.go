@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 )
 
 const (
@@ -14,32 +13,58 @@ const (
 // GetAllSalaryTransactionsForAllEmployees does _GET https://api.fortnox.se/3/salarytransactions
 //
 // filter - GetAllSalaryTransactionsForAllEmployeesFilter
-func (c *Client) GetAllSalaryTransactionsForAllEmployees(
+func (c *salaryTransactionsService) GetAllSalaryTransactionsForAllEmployees(
 	ctx context.Context,
 	filter *GetAllSalaryTransactionsForAllEmployeesFilter) ([]SalaryTransaction, error) {
+	return c.IterateSalaryTransactionsForAllEmployees(filter).All(ctx)
+}
+
+// GetSalaryTransactionsForAllEmployeesPage does _GET https://api.fortnox.se/3/salarytransactions and returns a single page along with its MetaInformation
+//
+// filter - GetAllSalaryTransactionsForAllEmployeesFilter
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *salaryTransactionsService) GetSalaryTransactionsForAllEmployeesPage(
+	ctx context.Context,
+	filter *GetAllSalaryTransactionsForAllEmployeesFilter,
+	page *PageOptions) ([]SalaryTransaction, *MetaInformation, error) {
 
 	resp := &GetAllSalaryTransactionsForAllEmployeesResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, salaryTransactionsURI, params, resp)
+	err = c._GETPage(ctx, getSalaryTransactionsForAllEmployeesPageOp, salaryTransactionsURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.SalaryTransactions, nil
+	return resp.SalaryTransactions, &resp.MetaInformation, nil
+}
+
+// IterateSalaryTransactionsForAllEmployees lazily walks all pages of https://api.fortnox.se/3/salarytransactions
+//
+// filter - GetAllSalaryTransactionsForAllEmployeesFilter
+func (c *salaryTransactionsService) IterateSalaryTransactionsForAllEmployees(
+	filter *GetAllSalaryTransactionsForAllEmployeesFilter) *Iterator[SalaryTransaction] {
+
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]SalaryTransaction, *MetaInformation, error) {
+		return c.GetSalaryTransactionsForAllEmployeesPage(ctx, filter, page)
+	})
 }
 
 // CreateSalaryTransactionsForEmployee does _POST https://api.fortnox.se/3/salarytransactions
 //
 // st - salary transaction to create
-func (c *Client) CreateSalaryTransactionsForEmployee(
+func (c *salaryTransactionsService) CreateSalaryTransactionsForEmployee(
 	ctx context.Context, st *SalaryTransaction) (*SalaryTransaction, error) {
 
 	req := CreateSalaryTransactionsForEmployeeReq{SalaryTransaction: *st}
 	resp := &CreateSalaryTransactionsForEmployeeResp{}
 
-	err := c._POST(ctx, salaryTransactionsURI, nil, req, resp)
+	err := c._POST(ctx, createSalaryTransactionsForEmployeeOp, salaryTransactionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -50,13 +75,13 @@ func (c *Client) CreateSalaryTransactionsForEmployee(
 // GetSalaryTransactionForEmployees does _GET https://api.fortnox.se/3/salarytransactions/{SalaryRow}
 //
 // salaryRow - identifies the salary transaction
-func (c *Client) GetSalaryTransactionForEmployees(ctx context.Context, salaryRow int) (*SalaryTransaction, error) {
+func (c *salaryTransactionsService) GetSalaryTransactionForEmployees(ctx context.Context, salaryRow int) (*SalaryTransaction, error) {
 
 	resp := &GetSalaryTransactionForEmployeesResp{}
 
 	uri := fmt.Sprintf("%s/%d", salaryTransactionsURI, salaryRow)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getSalaryTransactionForEmployeesOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +94,7 @@ func (c *Client) GetSalaryTransactionForEmployees(ctx context.Context, salaryRow
 // salaryRow - identifies the salary transaction
 //
 // st - salary transaction to update
-func (c *Client) UpdateSalaryTransactionForEmployee(
+func (c *salaryTransactionsService) UpdateSalaryTransactionForEmployee(
 	ctx context.Context,
 	salaryRow int,
 	st *SalaryTransaction) (*SalaryTransaction, error) {
@@ -79,7 +104,7 @@ func (c *Client) UpdateSalaryTransactionForEmployee(
 
 	uri := fmt.Sprintf("%s/%d", salaryTransactionsURI, salaryRow)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateSalaryTransactionForEmployeeOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -90,13 +115,13 @@ func (c *Client) UpdateSalaryTransactionForEmployee(
 // DeleteSalaryTransactionForEmployee does _DELETE https://api.fortnox.se/3/salarytransactions/{SalaryRow}
 //
 // salaryRow - identifies the salary transaction
-func (c *Client) DeleteSalaryTransactionForEmployee(ctx context.Context, salaryRow int) (*SalaryTransaction, error) {
+func (c *salaryTransactionsService) DeleteSalaryTransactionForEmployee(ctx context.Context, salaryRow int) (*SalaryTransaction, error) {
 
 	resp := &DeleteSalaryTransactionForEmployeeResp{}
 
 	uri := fmt.Sprintf("%s/%d", salaryTransactionsURI, salaryRow)
 
-	err := c._DELETEWithResult(ctx, uri, resp)
+	err := c._DELETEWithResult(ctx, deleteSalaryTransactionForEmployeeOp, uri, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -106,27 +131,13 @@ func (c *Client) DeleteSalaryTransactionForEmployee(ctx context.Context, salaryR
 
 type GetAllSalaryTransactionsForAllEmployeesFilter struct {
 	// filter on employeeId
-	EmployeeId string
+	EmployeeId string `fortnox:"employeeId"`
 	// filter on date
-	Date string
+	Date Date `fortnox:"date"`
 }
 
-func (f *GetAllSalaryTransactionsForAllEmployeesFilter) urlValues() url.Values {
-	if f == nil {
-		return nil
-	}
-
-	p := url.Values{}
-
-	if strings.TrimSpace(f.EmployeeId) != "" {
-		p["employeeId"] = []string{f.EmployeeId}
-	}
-
-	if strings.TrimSpace(f.Date) != "" {
-		p["date"] = []string{f.Date}
-	}
-
-	return p
+func (f *GetAllSalaryTransactionsForAllEmployeesFilter) urlValues() (url.Values, error) {
+	return encodeQuery(f)
 }
 
 type SalaryTransaction struct {
@@ -134,12 +145,12 @@ type SalaryTransaction struct {
 	EmployeeId string `json:"EmployeeId,omitempty"`
 	SalaryCode string `json:"SalaryCode,omitempty"`
 	SalaryRow  int    `json:"SalaryRow,omitempty"`
-	Date       string `json:"Date,omitempty"`
+	Date       Date   `json:"Date,omitempty"`
 	Number     string `json:"Number,omitempty"`
-	Amount     string `json:"Amount,omitempty"`
-	Total      string `json:"Total,omitempty"`
-	Expense    string `json:"Expense,omitempty"`
-	VAT        string `json:"VAT,omitempty"`
+	Amount     Money  `json:"Amount,omitzero"`
+	Total      Money  `json:"Total,omitzero"`
+	Expense    Money  `json:"Expense,omitzero"`
+	VAT        Money  `json:"VAT,omitzero"`
 	TextRow    string `json:"TextRow,omitempty"`
 	CostCenter string `json:"CostCenter,omitempty"`
 	Project    string `json:"Project,omitempty"`
@@ -147,6 +158,7 @@ type SalaryTransaction struct {
 
 type GetAllSalaryTransactionsForAllEmployeesResp struct {
 	SalaryTransactions []SalaryTransaction `json:"SalaryTransactions"`
+	MetaInformation    MetaInformation     `json:"MetaInformation"`
 }
 
 type CreateSalaryTransactionsForEmployeeReq struct {
@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -14,12 +16,16 @@ const (
 // employeeID - identifies the employee
 //
 // date - identifies the date
-func (c *Client) GetScheduleTime(ctx context.Context, employeeID, date string) (*ScheduleTime, error) {
+func (c *scheduleTimesService) GetScheduleTime(ctx context.Context, employeeID string, date Date) (*ScheduleTime, error) {
+	if err := validScheduleDate(date); err != nil {
+		return nil, err
+	}
+
 	resp := &GetScheduleTimeResp{}
 
 	uri := fmt.Sprintf("%s/%s/%s", scheduleTimesURI, employeeID, date)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getScheduleTimeOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -34,17 +40,22 @@ func (c *Client) GetScheduleTime(ctx context.Context, employeeID, date string) (
 // date - identifies the date
 //
 // st - schedule time to update
-func (c *Client) UpdateScheduleTime(
+func (c *scheduleTimesService) UpdateScheduleTime(
 	ctx context.Context,
-	employeeID, date string,
+	employeeID string,
+	date Date,
 	st *ScheduleTime) (*ScheduleTime, error) {
 
+	if err := validScheduleDate(date); err != nil {
+		return nil, err
+	}
+
 	req := &UpdateScheduleTimeReq{ScheduleTime: *st}
 	resp := &UpdateScheduleTimeResp{}
 
 	uri := fmt.Sprintf("%s/%s/%s", scheduleTimesURI, employeeID, date)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateScheduleTimeOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,12 +68,16 @@ func (c *Client) UpdateScheduleTime(
 // employeeID - identifies the employee
 //
 // date - identifies the date
-func (c *Client) ResetScheduleTime(ctx context.Context, employeeID, date string) (*ScheduleTime, error) {
+func (c *scheduleTimesService) ResetScheduleTime(ctx context.Context, employeeID string, date Date) (*ScheduleTime, error) {
+	if err := validScheduleDate(date); err != nil {
+		return nil, err
+	}
+
 	resp := &ResetScheduleTimeResp{}
 
 	uri := fmt.Sprintf("%s/%s/%s/resetday", scheduleTimesURI, employeeID, date)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, resetScheduleTimeOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -70,9 +85,18 @@ func (c *Client) ResetScheduleTime(ctx context.Context, employeeID, date string)
 	return &resp.ScheduleTime, nil
 }
 
+// validScheduleDate keeps an unset or malformed date from ending up in the path as another resource
+func validScheduleDate(date Date) error {
+	if date.IsZero() || !date.Valid() {
+		return errors.Wrapf(ErrInvalidDate, "can't address the schedule time of %q", string(date))
+	}
+
+	return nil
+}
+
 type ScheduleTime struct {
 	EmployeeId string `json:"EmployeeId,omitempty"`
-	Date       string `json:"Date,omitempty"`
+	Date       Date   `json:"Date,omitempty"`
 	ScheduleId string `json:"ScheduleId,omitempty"`
 	Hours      string `json:"Hours,omitempty"`
 	IWH1       string `json:"IWH1,omitempty"`
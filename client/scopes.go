@@ -1,8 +1,13 @@
 package client
 
-import "strings"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
 
-const urlEncodedDelim = "%20"
+	"github.com/pkg/errors"
+)
 
 type Scope string
 
@@ -12,6 +17,7 @@ const (
 	ScopeArchive            Scope = "archive"
 	ScopeFileConnection     Scope = "connectfile"
 	ScopeArticle            Scope = "article"
+	ScopeAssets             Scope = "assets"
 	ScopeCompanyInformation Scope = "companyinformation"
 	ScopeSettings           Scope = "settings"
 	ScopeInvoice            Scope = "invoice"
@@ -29,6 +35,8 @@ const (
 	ScopeProfile            Scope = "profile"
 	ScopeSupplierInvoice    Scope = "supplierinvoice"
 	ScopeSupplier           Scope = "supplier"
+	ScopeTimeReporting      Scope = "timereporting"
+	ScopeWarehouse          Scope = "warehouse"
 )
 
 type Scopes []Scope
@@ -39,6 +47,7 @@ var FullScope = Scopes{
 	ScopeArchive,
 	ScopeFileConnection,
 	ScopeArticle,
+	ScopeAssets,
 	ScopeCompanyInformation,
 	ScopeSettings,
 	ScopeInvoice,
@@ -56,6 +65,8 @@ var FullScope = Scopes{
 	ScopeProfile,
 	ScopeSupplierInvoice,
 	ScopeSupplier,
+	ScopeTimeReporting,
+	ScopeWarehouse,
 }
 
 func (s Scopes) toStrings() []string {
@@ -66,6 +77,218 @@ func (s Scopes) toStrings() []string {
 	return res
 }
 
-func (s Scopes) toString() string {
-	return strings.Join(s.toStrings(), urlEncodedDelim)
+// ParseScopes parses the space separated scopes of TokenInfo.Scope
+func ParseScopes(s string) Scopes {
+	var scopes Scopes
+	for _, f := range strings.Fields(s) {
+		scopes = append(scopes, Scope(f))
+	}
+
+	return scopes
+}
+
+// Union returns the scopes of s and other without duplicates, sorted
+func (s Scopes) Union(other ...Scope) Scopes {
+	seen := map[Scope]bool{}
+	var res Scopes
+	for _, scope := range append(append(Scopes{}, s...), other...) {
+		if !seen[scope] {
+			seen[scope] = true
+			res = append(res, scope)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res
+}
+
+// Missing returns the scopes of required that aren't in s
+func (s Scopes) Missing(required Scopes) Scopes {
+	granted := map[Scope]bool{}
+	for _, scope := range s {
+		granted[scope] = true
+	}
+
+	var missing Scopes
+	for _, scope := range required.Union() {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+
+	return missing
+}
+
+// uriScopes are the scopes the resources need, nested resources such as assets/types are matched before their parent
+var uriScopes = map[string]Scopes{
+	absenceTransactionsURI:                   {ScopeSalary},
+	accountChartsURI:                         {ScopeBookkeeping},
+	accountsURI:                              {ScopeBookkeeping},
+	archiveURI:                               {ScopeArchive},
+	articleFileConnectionsURI:                {ScopeFileConnection},
+	articlesURI:                              {ScopeArticle},
+	assetFileConnectionsURI:                  {ScopeFileConnection},
+	assetTypesURI:                            {ScopeAssets},
+	assetsURI:                                {ScopeAssets},
+	attendanceTransactionsURI:                {ScopeSalary},
+	companyInformationURI:                    {ScopeCompanyInformation},
+	companySettingsURI:                       {ScopeCompanyInformation},
+	contractAccrualsURI:                      {ScopeInvoice},
+	contractTemplatesURI:                     {ScopeInvoice},
+	contractsURI:                             {ScopeInvoice},
+	costCentersURI:                           {ScopeCostCenters},
+	currenciesURI:                            {ScopeCurrency},
+	customerReferencesURI:                    {ScopeCustomer},
+	customersURI:                             {ScopeCustomer},
+	emailSendersURI:                          {ScopeInbox},
+	employeesURI:                             {ScopeSalary},
+	euVatLimitRegulationURI:                  {ScopeInvoice},
+	expensesURI:                              {ScopeSalary},
+	financialYearsURI:                        {ScopeBookkeeping},
+	inboxURI:                                 {ScopeInbox},
+	invoiceAccrualsURI:                       {ScopeInvoice},
+	invoicePaymentsURI:                       {ScopePayments},
+	invoicesURI:                              {ScopeInvoice},
+	labelsURI:                                {ScopeSettings},
+	lockedPeriodURI:                          {ScopeSettings},
+	meURI:                                    {ScopeProfile},
+	modesOfPaymentsURI:                       {ScopeSettings},
+	offersURI:                                {ScopeOffer},
+	ordersURI:                                {ScopeOrder},
+	predefinedAccountsURI:                    {ScopeBookkeeping},
+	predefinedVoucherSeriesURI:               {ScopeBookkeeping},
+	priceListURI:                             {ScopePrice},
+	pricesURI:                                {ScopePrice},
+	printTemplatesURI:                        {ScopePrint},
+	projectsURI:                              {ScopeProject},
+	salaryTransactionsURI:                    {ScopeSalary},
+	scheduleTimesURI:                         {ScopeSalary},
+	sieURI:                                   {ScopeBookkeeping},
+	supplierInvoiceAccrualsURI:               {ScopeSupplierInvoice},
+	supplierInvoiceExternalUrlConnectionsURI: {ScopeSupplierInvoice},
+	supplierInvoiceFileConnectionsURI:        {ScopeFileConnection},
+	supplierInvoicePaymentsURI:               {ScopePayments},
+	supplierInvoiceURI:                       {ScopeSupplierInvoice},
+	suppliersURI:                             {ScopeSupplier},
+	taxReductionsURI:                         {ScopeInvoice},
+	termsOfDeliveriesURI:                     {ScopeSettings},
+	termsOfPaymentsURI:                       {ScopeSettings},
+	unitsURI:                                 {ScopeSettings},
+	voucherFileConnectionsURI:                {ScopeFileConnection},
+	voucherSeriesURI:                         {ScopeBookkeeping},
+	vouchersURI:                              {ScopeBookkeeping},
+	wayOfDeliveriesURI:                       {ScopeSettings},
+}
+
+// ScopesForURI returns the scopes a call to uri needs, e.g. "invoices/1001/bookkeep", nil for an unknown resource
+func ScopesForURI(uri string) Scopes {
+	uri = strings.Trim(uri, "/")
+	if i := strings.IndexAny(uri, "?#"); i >= 0 {
+		uri = uri[:i]
+	}
+
+	// the longest matching resource wins, assets/types over assets
+	best := ""
+	for resource := range uriScopes {
+		r := strings.Trim(resource, "/")
+		if (uri == r || strings.HasPrefix(uri, r+"/")) && len(r) > len(best) {
+			best = resource
+		}
+	}
+
+	if best == "" {
+		return nil
+	}
+
+	return append(Scopes{}, uriScopes[best]...)
+}
+
+// RequiredScopes returns the scopes the Client method named method needs, e.g. "GetInvoice", nil for an unknown method
+func RequiredScopes(method string) Scopes {
+	uri, ok := methodURIs[method]
+	if !ok {
+		return nil
+	}
+
+	return ScopesForURI(uri)
+}
+
+// ScopesFor returns the scopes the Client methods need, e.g. to request exactly those with GetAuthCodeLink
+func ScopesFor(methods ...string) (Scopes, error) {
+	var scopes Scopes
+	for _, method := range methods {
+		required := RequiredScopes(method)
+		if required == nil {
+			return nil, errors.Errorf("unknown method %s", method)
+		}
+		scopes = append(scopes, required...)
+	}
+
+	return scopes.Union(), nil
+}
+
+// MissingScopesError is returned by CheckScopes when scopes the client needs weren't granted, it matches ErrMissingScope
+type MissingScopesError struct {
+	Missing Scopes
+}
+
+func (e *MissingScopesError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrMissingScope, strings.Join(e.Missing.toStrings(), " "))
+}
+
+func (e *MissingScopesError) Is(target error) bool {
+	return target == ErrMissingScope
+}
+
+// WithRequiredScopesOpt sets the scopes the code using the client needs, CheckScopes verifies they were granted
+func WithRequiredScopesOpt(scopes ...Scope) OptionFunc {
+	return func(co *Options) {
+		co.RequiredScopes = append(co.RequiredScopes, scopes...)
+	}
+}
+
+// CheckScopes compares the scopes granted to the token, TokenInfo.Scope, against the ones set with WithRequiredScopesOpt
+// and returns a *MissingScopesError listing those that weren't granted, so a missing scope is found at startup
+// rather than as error 2000663 on the first call that needs it
+func (c *Client) CheckScopes(ctx context.Context) error {
+	required := c.clientOptions.RequiredScopes
+	if len(required) == 0 {
+		return errors.New("no required scopes set, see WithRequiredScopesOpt")
+	}
+
+	granted, err := c.grantedScopes(ctx)
+	if err != nil {
+		return err
+	}
+
+	if missing := granted.Missing(required); len(missing) > 0 {
+		return &MissingScopesError{Missing: missing}
+	}
+
+	return nil
+}
+
+// grantedScopes returns the scopes of the current token
+func (c *Client) grantedScopes(ctx context.Context) (Scopes, error) {
+	if src := c.clientOptions.TokenSource; src != nil {
+		token, err := src.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return ParseScopes(token.Scope), nil
+	}
+
+	if accessToken, _ := c.creds.get(); accessToken == "" && c.clientOptions.TokenStore != nil {
+		if err := c.LoadToken(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	scope := c.creds.getScope()
+	if scope == "" {
+		return nil, errors.New("granted scopes unknown, the token wasn't stored with its scope")
+	}
+
+	return ParseScopes(scope), nil
 }
@@ -0,0 +1,46 @@
+package client
+
+import "testing"
+
+func TestURIScopesMatchMethods(t *testing.T) {
+	used := map[string]bool{}
+	for method, uri := range methodURIs {
+		used[uri] = true
+
+		if len(RequiredScopes(method)) == 0 {
+			t.Errorf("%s calls %s, which has no scope", method, uri)
+		}
+	}
+
+	// a resource without a method would make CheckScopes ask for scopes no call needs
+	for uri := range uriScopes {
+		if !used[uri] {
+			t.Errorf("uriScopes has %s, no method calls it", uri)
+		}
+	}
+}
+
+func TestScopesForURI(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want Scope
+	}{
+		{"invoices/1001/bookkeep", ScopeInvoice},
+		{"/assets/types/3", ScopeAssets},
+		{"assetfileconnections/f1", ScopeFileConnection},
+		{"costcenters/CC1", ScopeCostCenters},
+		{"customerreferences/5", ScopeCustomer},
+		{"emailsenders/trusted/7", ScopeInbox},
+		{"voucherseries/A?financialyear=1", ScopeBookkeeping},
+	}
+
+	for _, tt := range tests {
+		if got := ScopesForURI(tt.uri); len(got) != 1 || got[0] != tt.want {
+			t.Errorf("ScopesForURI(%q) = %v, want %v", tt.uri, got, tt.want)
+		}
+	}
+
+	if got := ScopesForURI("nothing/1"); got != nil {
+		t.Errorf("ScopesForURI() of an unknown resource = %v, want nil", got)
+	}
+}
@@ -0,0 +1,16 @@
+package client
+
+//go:generate go run ../internal/cmd/genservices
+
+// The calls of Client are grouped in services, one per resource, e.g. Client.Invoices or Client.Vouchers.
+// Each service is an interface so code using it can be tested with the fakes of package fortnoxfake
+// or its own mocks:
+//
+//	c := client.NewClient(client.WithAuthOpt(token, secret))
+//	invoice, err := c.Invoices.GetInvoice(ctx, "1")
+//
+// The methods of Client such as c.GetInvoice forward to its services, so replacing a service,
+// e.g. c.Invoices = &fortnoxfake.Invoices{...}, also changes what they do.
+//
+// The interfaces, the forwarding methods and the fakes are generated from the methods of the
+// unexported xxxService types, run go generate after adding or changing one.
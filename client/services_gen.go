@@ -0,0 +1,4835 @@
+// Code generated by genservices. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"io"
+)
+
+// Services holds the services of a Client, it is embedded so they are reached as client.Invoices
+type Services struct {
+	AbsenceTransactions                   AbsenceTransactionsService
+	AccountCharts                         AccountChartsService
+	Accounts                              AccountsService
+	Archive                               ArchiveService
+	ArticleFileConnections                ArticleFileConnectionsService
+	Articles                              ArticlesService
+	AssetFileConnections                  AssetFileConnectionsService
+	AssetTypes                            AssetTypesService
+	Assets                                AssetsService
+	AttendanceTransactions                AttendanceTransactionsService
+	CompanyInformation                    CompanyInformationService
+	CompanySettings                       CompanySettingsService
+	ContractAccruals                      ContractAccrualsService
+	ContractTemplates                     ContractTemplatesService
+	Contracts                             ContractsService
+	CostCenters                           CostCentersService
+	Currencies                            CurrenciesService
+	CustomerReferences                    CustomerReferencesService
+	Customers                             CustomersService
+	EUVatLimitRegulation                  EUVatLimitRegulationService
+	Employees                             EmployeesService
+	Expenses                              ExpensesService
+	FinancialYears                        FinancialYearsService
+	Inbox                                 InboxService
+	InvoiceAccruals                       InvoiceAccrualsService
+	InvoicePayments                       InvoicePaymentsService
+	Invoices                              InvoicesService
+	Labels                                LabelsService
+	LockedPeriod                          LockedPeriodService
+	Me                                    MeService
+	ModesOfPayments                       ModesOfPaymentsService
+	Offers                                OffersService
+	Orders                                OrdersService
+	PredefinedAccounts                    PredefinedAccountsService
+	PredefinedVoucherSeries               PredefinedVoucherSeriesService
+	PriceLists                            PriceListsService
+	Prices                                PricesService
+	PrintTemplates                        PrintTemplatesService
+	Projects                              ProjectsService
+	SIE                                   SIEService
+	SalaryTransactions                    SalaryTransactionsService
+	ScheduleTimes                         ScheduleTimesService
+	SupplierInvoiceAccruals               SupplierInvoiceAccrualsService
+	SupplierInvoiceExternalUrlConnections SupplierInvoiceExternalUrlConnectionsService
+	SupplierInvoiceFileConnections        SupplierInvoiceFileConnectionsService
+	SupplierInvoicePayments               SupplierInvoicePaymentsService
+	SupplierInvoices                      SupplierInvoicesService
+	Suppliers                             SuppliersService
+	TaxReductions                         TaxReductionsService
+	TermsOfDeliveries                     TermsOfDeliveriesService
+	TermsOfPayments                       TermsOfPaymentsService
+	TrustedEmailSenders                   TrustedEmailSendersService
+	Units                                 UnitsService
+	VoucherFileConnections                VoucherFileConnectionsService
+	VoucherSeries                         VoucherSeriesService
+	Vouchers                              VouchersService
+	WayOfDeliveries                       WayOfDeliveriesService
+}
+
+func newServices(c *Client) Services {
+	return Services{
+		AbsenceTransactions:                   &absenceTransactionsService{c},
+		AccountCharts:                         &accountChartsService{c},
+		Accounts:                              &accountsService{c},
+		Archive:                               &archiveService{c},
+		ArticleFileConnections:                &articleFileConnectionsService{c},
+		Articles:                              &articlesService{c},
+		AssetFileConnections:                  &assetFileConnectionsService{c},
+		AssetTypes:                            &assetTypesService{c},
+		Assets:                                &assetsService{c},
+		AttendanceTransactions:                &attendanceTransactionsService{c},
+		CompanyInformation:                    &companyInformationService{c},
+		CompanySettings:                       &companySettingsService{c},
+		ContractAccruals:                      &contractAccrualsService{c},
+		ContractTemplates:                     &contractTemplatesService{c},
+		Contracts:                             &contractsService{c},
+		CostCenters:                           &costCentersService{c},
+		Currencies:                            &currenciesService{c},
+		CustomerReferences:                    &customerReferencesService{c},
+		Customers:                             &customersService{c},
+		EUVatLimitRegulation:                  &euVatLimitRegulationService{c},
+		Employees:                             &employeesService{c},
+		Expenses:                              &expensesService{c},
+		FinancialYears:                        &financialYearsService{c},
+		Inbox:                                 &inboxService{c},
+		InvoiceAccruals:                       &invoiceAccrualsService{c},
+		InvoicePayments:                       &invoicePaymentsService{c},
+		Invoices:                              &invoicesService{c},
+		Labels:                                &labelsService{c},
+		LockedPeriod:                          &lockedPeriodService{c},
+		Me:                                    &meService{c},
+		ModesOfPayments:                       &modesOfPaymentsService{c},
+		Offers:                                &offersService{c},
+		Orders:                                &ordersService{c},
+		PredefinedAccounts:                    &predefinedAccountsService{c},
+		PredefinedVoucherSeries:               &predefinedVoucherSeriesService{c},
+		PriceLists:                            &priceListsService{c},
+		Prices:                                &pricesService{c},
+		PrintTemplates:                        &printTemplatesService{c},
+		Projects:                              &projectsService{c},
+		SIE:                                   &sieService{c},
+		SalaryTransactions:                    &salaryTransactionsService{c},
+		ScheduleTimes:                         &scheduleTimesService{c},
+		SupplierInvoiceAccruals:               &supplierInvoiceAccrualsService{c},
+		SupplierInvoiceExternalUrlConnections: &supplierInvoiceExternalUrlConnectionsService{c},
+		SupplierInvoiceFileConnections:        &supplierInvoiceFileConnectionsService{c},
+		SupplierInvoicePayments:               &supplierInvoicePaymentsService{c},
+		SupplierInvoices:                      &supplierInvoicesService{c},
+		Suppliers:                             &suppliersService{c},
+		TaxReductions:                         &taxReductionsService{c},
+		TermsOfDeliveries:                     &termsOfDeliveriesService{c},
+		TermsOfPayments:                       &termsOfPaymentsService{c},
+		TrustedEmailSenders:                   &trustedEmailSendersService{c},
+		Units:                                 &unitsService{c},
+		VoucherFileConnections:                &voucherFileConnectionsService{c},
+		VoucherSeries:                         &voucherSeriesService{c},
+		Vouchers:                              &vouchersService{c},
+		WayOfDeliveries:                       &wayOfDeliveriesService{c},
+	}
+}
+
+// methodURIs maps the methods of Client to the resource they call, see RequiredScopes
+var methodURIs = map[string]string{
+	"GetAllAbsenceTransactions":                  absenceTransactionsURI,
+	"GetAbsenceTransactionsPage":                 absenceTransactionsURI,
+	"IterateAbsenceTransactions":                 absenceTransactionsURI,
+	"CreateNewAbsenceTransaction":                absenceTransactionsURI,
+	"GetAbsenceTransactionByID":                  absenceTransactionsURI,
+	"UpdateAbsenceTransactionByID":               absenceTransactionsURI,
+	"DeleteAbsenceTransactionByID":               absenceTransactionsURI,
+	"GetAbsenceTransactionForEmployee":           absenceTransactionsURI,
+	"GetAccountCharts":                           accountChartsURI,
+	"GetAccount":                                 accountsURI,
+	"UpdateAccount":                              accountsURI,
+	"GetAllAccounts":                             accountsURI,
+	"GetAccountsPage":                            accountsURI,
+	"IterateAccounts":                            accountsURI,
+	"CreateAccount":                              accountsURI,
+	"GetFileOrFolder":                            archiveURI,
+	"UploadFileToDir":                            archiveURI,
+	"RemoveFiles":                                archiveURI,
+	"GetFile":                                    archiveURI,
+	"DeleteFile":                                 archiveURI,
+	"GetAllArticleFileConnections":               articleFileConnectionsURI,
+	"GetArticleFileConnectionsPage":              articleFileConnectionsURI,
+	"IterateArticleFileConnections":              articleFileConnectionsURI,
+	"CreateArticleFileConnection":                articleFileConnectionsURI,
+	"GetArticleFileConnectionByID":               articleFileConnectionsURI,
+	"DeleteArticleFileConnection":                articleFileConnectionsURI,
+	"GetArticle":                                 articlesURI,
+	"UpdateArticle":                              articlesURI,
+	"PatchArticle":                               articlesURI,
+	"DeleteArticle":                              articlesURI,
+	"GetArticles":                                articlesURI,
+	"GetArticlesPage":                            articlesURI,
+	"IterateArticles":                            articlesURI,
+	"CreateArticle":                              articlesURI,
+	"GetAllAssetFileConnections":                 assetFileConnectionsURI,
+	"GetAssetFileConnectionsPage":                assetFileConnectionsURI,
+	"IterateAssetFileConnections":                assetFileConnectionsURI,
+	"CreateAssetFileConnection":                  assetFileConnectionsURI,
+	"DeleteAssetFileConnection":                  assetFileConnectionsURI,
+	"GetAssetType":                               assetTypesURI,
+	"CreateAssetType":                            assetTypesURI,
+	"UpdateAssetType":                            assetTypesURI,
+	"DeleteAssetType":                            assetTypesURI,
+	"GetAllAssetTypes":                           assetTypesURI,
+	"GetAssetTypesPage":                          assetTypesURI,
+	"IterateAssetTypes":                          assetTypesURI,
+	"GetAllAssets":                               assetsURI,
+	"GetAssetsPage":                              assetsURI,
+	"IterateAssets":                              assetsURI,
+	"CreateAsset":                                assetsURI,
+	"GetAsset":                                   assetsURI,
+	"ChangeManualAssetOBValue":                   assetsURI,
+	"DeleteOrVoidAsset":                          assetsURI,
+	"GetAssetsDepreciationList":                  assetsURI,
+	"WriteUpAsset":                               assetsURI,
+	"WriteDownAsset":                             assetsURI,
+	"ScrapAsset":                                 assetsURI,
+	"SellAsset":                                  assetsURI,
+	"PerformAssetDepreciation":                   assetsURI,
+	"GetAllAttendanceTransactions":               attendanceTransactionsURI,
+	"GetAttendanceTransactionsPage":              attendanceTransactionsURI,
+	"IterateAttendanceTransactions":              attendanceTransactionsURI,
+	"CreateAttendanceTransaction":                attendanceTransactionsURI,
+	"GetAttendanceTransaction":                   attendanceTransactionsURI,
+	"UpdateAttendanceTransaction":                attendanceTransactionsURI,
+	"GetCompanyInformation":                      companyInformationURI,
+	"GetCompanySettings":                         companySettingsURI,
+	"GetAllContractAccruals":                     contractAccrualsURI,
+	"GetContractAccrualsPage":                    contractAccrualsURI,
+	"IterateContractAccruals":                    contractAccrualsURI,
+	"CreateContractAccrual":                      contractAccrualsURI,
+	"GetContractAccrual":                         contractAccrualsURI,
+	"UpdateContractAccrual":                      contractAccrualsURI,
+	"RemoveContractAccrual":                      contractAccrualsURI,
+	"GetAllContractTemplates":                    contractTemplatesURI,
+	"GetContractTemplatesPage":                   contractTemplatesURI,
+	"IterateContractTemplates":                   contractTemplatesURI,
+	"CreateContractTemplate":                     contractTemplatesURI,
+	"GetContractTemplate":                        contractTemplatesURI,
+	"UpdateContractTemplate":                     contractTemplatesURI,
+	"GetContract":                                contractsURI,
+	"UpdateContract":                             contractsURI,
+	"GetAllContract":                             contractsURI,
+	"GetContractsPage":                           contractsURI,
+	"IterateContracts":                           contractsURI,
+	"CreateContract":                             contractsURI,
+	"SetContractAsFinished":                      contractsURI,
+	"CreateInvoiceFromContract":                  contractsURI,
+	"IncreaseInvoiceCount":                       contractsURI,
+	"GetAllCostCenters":                          costCentersURI,
+	"GetCostCentersPage":                         costCentersURI,
+	"IterateCostCenters":                         costCentersURI,
+	"CreateCostCenter":                           costCentersURI,
+	"GetCostCenter":                              costCentersURI,
+	"UpdateCostCenter":                           costCentersURI,
+	"RemoveCostCenter":                           costCentersURI,
+	"GetAllCurrencies":                           currenciesURI,
+	"GetCurrenciesPage":                          currenciesURI,
+	"IterateCurrencies":                          currenciesURI,
+	"CreateCurrency":                             currenciesURI,
+	"GetCurrency":                                currenciesURI,
+	"UpdateCurrency":                             currenciesURI,
+	"RemoveCurrency":                             currenciesURI,
+	"GetAllCustomerReferences":                   customerReferencesURI,
+	"GetCustomerReferencesPage":                  customerReferencesURI,
+	"IterateCustomerReferences":                  customerReferencesURI,
+	"CreateCustomerReference":                    customerReferencesURI,
+	"GetCustomerReference":                       customerReferencesURI,
+	"UpdateCustomerReference":                    customerReferencesURI,
+	"DeleteCustomerReferenceRow":                 customerReferencesURI,
+	"GetAllCustomers":                            customersURI,
+	"GetCustomersPage":                           customersURI,
+	"IterateCustomers":                           customersURI,
+	"CreateCustomer":                             customersURI,
+	"GetCustomer":                                customersURI,
+	"UpdateCustomer":                             customersURI,
+	"PatchCustomer":                              customersURI,
+	"DeleteCustomer":                             customersURI,
+	"GetEUVATLimitDetails":                       euVatLimitRegulationURI,
+	"GetAllEmployees":                            employeesURI,
+	"GetEmployeesPage":                           employeesURI,
+	"IterateEmployees":                           employeesURI,
+	"CreateEmployee":                             employeesURI,
+	"GetEmployee":                                employeesURI,
+	"UpdateEmployee":                             employeesURI,
+	"GetAllExpenses":                             expensesURI,
+	"GetExpensesPage":                            expensesURI,
+	"IterateExpenses":                            expensesURI,
+	"CreateExpense":                              expensesURI,
+	"GetExpense":                                 expensesURI,
+	"GetAllFinancialYears":                       financialYearsURI,
+	"GetFinancialYearsPage":                      financialYearsURI,
+	"IterateFinancialYears":                      financialYearsURI,
+	"CreateFinancialYear":                        financialYearsURI,
+	"GetFinancialYearByID":                       financialYearsURI,
+	"GetRootDirectory":                           inboxURI,
+	"UploadFile":                                 inboxURI,
+	"GetInboxFile":                               inboxURI,
+	"RemoveFileOrFolder":                         inboxURI,
+	"GetAllInvoiceAccruals":                      invoiceAccrualsURI,
+	"GetInvoiceAccrualsPage":                     invoiceAccrualsURI,
+	"IterateInvoiceAccruals":                     invoiceAccrualsURI,
+	"CreateInvoiceAccrual":                       invoiceAccrualsURI,
+	"GetInvoiceAccrual":                          invoiceAccrualsURI,
+	"UpdateInvoiceAccrual":                       invoiceAccrualsURI,
+	"RemoveInvoiceAccrual":                       invoiceAccrualsURI,
+	"GetAllInvoicePayments":                      invoicePaymentsURI,
+	"GetInvoicePaymentsPage":                     invoicePaymentsURI,
+	"IterateInvoicePayments":                     invoicePaymentsURI,
+	"CreateInvoicePayment":                       invoicePaymentsURI,
+	"GetInvoicePayment":                          invoicePaymentsURI,
+	"UpdateInvoicePayment":                       invoicePaymentsURI,
+	"RemoveInvoicePayment":                       invoicePaymentsURI,
+	"BookKeepInvoicePayment":                     invoicePaymentsURI,
+	"GetInvoice":                                 invoicesURI,
+	"UpdateInvoice":                              invoicesURI,
+	"PatchInvoice":                               invoicesURI,
+	"GetAllInvoices":                             invoicesURI,
+	"GetInvoicesPage":                            invoicesURI,
+	"IterateInvoices":                            invoicesURI,
+	"CreateInvoice":                              invoicesURI,
+	"BookKeepInvoice":                            invoicesURI,
+	"CancelInvoice":                              invoicesURI,
+	"CreditInvoice":                              invoicesURI,
+	"SetInvoiceAsSent":                           invoicesURI,
+	"SetInvoiceAsDone":                           invoicesURI,
+	"PrintInvoice":                               invoicesURI,
+	"SendInvoiceAsEmail":                         invoicesURI,
+	"SendInvoiceAsReminder":                      invoicesURI,
+	"PreviewInvoice":                             invoicesURI,
+	"SendInvoiceAsEPrint":                        invoicesURI,
+	"SendInvoiceAsEInvoice":                      invoicesURI,
+	"GetAllLabels":                               labelsURI,
+	"GetLabelsPage":                              labelsURI,
+	"IterateLabels":                              labelsURI,
+	"CreateLabels":                               labelsURI,
+	"UpdateLabel":                                labelsURI,
+	"DeleteLabel":                                labelsURI,
+	"GetLockedPeriod":                            lockedPeriodURI,
+	"GetMeInformation":                           meURI,
+	"GetAllModesOfPayments":                      modesOfPaymentsURI,
+	"GetModesOfPaymentsPage":                     modesOfPaymentsURI,
+	"IterateModesOfPayments":                     modesOfPaymentsURI,
+	"CreateModeOfPayment":                        modesOfPaymentsURI,
+	"GetModeOfPayment":                           modesOfPaymentsURI,
+	"UpdateModeOfPayment":                        modesOfPaymentsURI,
+	"GetAllOffers":                               offersURI,
+	"GetOffersPage":                              offersURI,
+	"IterateOffers":                              offersURI,
+	"CreateOffer":                                offersURI,
+	"GetOffer":                                   offersURI,
+	"UpdateOffer":                                offersURI,
+	"PatchOffer":                                 offersURI,
+	"PrintOffer":                                 offersURI,
+	"SendOfferAsEmail":                           offersURI,
+	"PreviewOffer":                               offersURI,
+	"CreateOrderOutOfOffer":                      offersURI,
+	"CancelOffer":                                offersURI,
+	"SetOfferAsSent":                             offersURI,
+	"GetAllOrders":                               ordersURI,
+	"GetOrdersPage":                              ordersURI,
+	"IterateOrders":                              ordersURI,
+	"CreateOrder":                                ordersURI,
+	"GetOrder":                                   ordersURI,
+	"UpdateOrder":                                ordersURI,
+	"PatchOrder":                                 ordersURI,
+	"PrintOrder":                                 ordersURI,
+	"SendOrderAsEmail":                           ordersURI,
+	"PreviewOrder":                               ordersURI,
+	"CreateInvoiceOutOfGivenOrder":               ordersURI,
+	"CancelGivenOrder":                           ordersURI,
+	"SetGivenOrderAsSent":                        ordersURI,
+	"GetAllPredefinedAccounts":                   predefinedAccountsURI,
+	"GetPredefinedAccountsPage":                  predefinedAccountsURI,
+	"IteratePredefinedAccounts":                  predefinedAccountsURI,
+	"GetPredefinedAccount":                       predefinedAccountsURI,
+	"UpdatePredefinedAccount":                    predefinedAccountsURI,
+	"GetAllPredefinedVoucherSeries":              predefinedVoucherSeriesURI,
+	"GetPredefinedVoucherSeriesPage":             predefinedVoucherSeriesURI,
+	"IteratePredefinedVoucherSeries":             predefinedVoucherSeriesURI,
+	"GetPredefinedVoucherSeries":                 predefinedVoucherSeriesURI,
+	"UpdatePredefinedVoucherSeries":              predefinedVoucherSeriesURI,
+	"GetAllPriceLists":                           priceListURI,
+	"GetPriceListsPage":                          priceListURI,
+	"IteratePriceLists":                          priceListURI,
+	"CreatePriceList":                            priceListURI,
+	"GetPriceList":                               priceListURI,
+	"UpdatePriceList":                            priceListURI,
+	"GetPriceForArticle":                         pricesURI,
+	"UpdatePrice":                                pricesURI,
+	"DeletePrice":                                pricesURI,
+	"GetAllArticlesWithPricesInPriceList":        pricesURI,
+	"GetArticlesWithPricesInPriceListPage":       pricesURI,
+	"IterateArticlesWithPricesInPriceList":       pricesURI,
+	"GetFirstPriceForArticle":                    pricesURI,
+	"UpdateFirstPriceForArticle":                 pricesURI,
+	"CreatePrice":                                pricesURI,
+	"GetAllPrintTemplates":                       printTemplatesURI,
+	"GetPrintTemplatesPage":                      printTemplatesURI,
+	"IteratePrintTemplates":                      printTemplatesURI,
+	"GetProject":                                 projectsURI,
+	"UpdateProject":                              projectsURI,
+	"RemoveProject":                              projectsURI,
+	"GetAllProjects":                             projectsURI,
+	"GetProjectsPage":                            projectsURI,
+	"IterateProjects":                            projectsURI,
+	"CreateProject":                              projectsURI,
+	"GetSIEFile":                                 sieURI,
+	"GetAllSalaryTransactionsForAllEmployees":    salaryTransactionsURI,
+	"GetSalaryTransactionsForAllEmployeesPage":   salaryTransactionsURI,
+	"IterateSalaryTransactionsForAllEmployees":   salaryTransactionsURI,
+	"CreateSalaryTransactionsForEmployee":        salaryTransactionsURI,
+	"GetSalaryTransactionForEmployees":           salaryTransactionsURI,
+	"UpdateSalaryTransactionForEmployee":         salaryTransactionsURI,
+	"DeleteSalaryTransactionForEmployee":         salaryTransactionsURI,
+	"GetScheduleTime":                            scheduleTimesURI,
+	"UpdateScheduleTime":                         scheduleTimesURI,
+	"ResetScheduleTime":                          scheduleTimesURI,
+	"GetAllSupplierInvoiceAccruals":              supplierInvoiceAccrualsURI,
+	"GetSupplierInvoiceAccrualsPage":             supplierInvoiceAccrualsURI,
+	"IterateSupplierInvoiceAccruals":             supplierInvoiceAccrualsURI,
+	"CreateSupplierInvoiceAccruals":              supplierInvoiceAccrualsURI,
+	"GetSupplierInvoiceAccruals":                 supplierInvoiceAccrualsURI,
+	"UpdateSupplierInvoiceAccruals":              supplierInvoiceAccrualsURI,
+	"DeleteSupplierInvoiceAccruals":              supplierInvoiceAccrualsURI,
+	"GetSupplierInvoiceExternalUrlConnection":    supplierInvoiceExternalUrlConnectionsURI,
+	"UpdateSupplierInvoiceExternalUrlConnection": supplierInvoiceExternalUrlConnectionsURI,
+	"DeleteSupplierInvoiceExternalUrlConnection": supplierInvoiceExternalUrlConnectionsURI,
+	"CreateSupplierInvoiceExternalUrlConnection": supplierInvoiceExternalUrlConnectionsURI,
+	"GetAllSupplierInvoiceFileConnections":       supplierInvoiceFileConnectionsURI,
+	"GetSupplierInvoiceFileConnectionsPage":      supplierInvoiceFileConnectionsURI,
+	"IterateSupplierInvoiceFileConnections":      supplierInvoiceFileConnectionsURI,
+	"CreateSupplierInvoiceFileConnection":        supplierInvoiceFileConnectionsURI,
+	"GetSupplierInvoiceFileConnection":           supplierInvoiceFileConnectionsURI,
+	"RemoveSupplierInvoiceFileConnections":       supplierInvoiceFileConnectionsURI,
+	"GetAllSupplierInvoicePayments":              supplierInvoicePaymentsURI,
+	"GetSupplierInvoicePaymentsPage":             supplierInvoicePaymentsURI,
+	"IterateSupplierInvoicePayments":             supplierInvoicePaymentsURI,
+	"CreateSupplierInvoicePayment":               supplierInvoicePaymentsURI,
+	"GetSupplierInvoicePayment":                  supplierInvoicePaymentsURI,
+	"UpdateSupplierInvoicePayment":               supplierInvoicePaymentsURI,
+	"RemoveSupplierInvoicePayment":               supplierInvoicePaymentsURI,
+	"BookKeepSupplierInvoicePayment":             supplierInvoicePaymentsURI,
+	"GetAllSupplierInvoices":                     supplierInvoiceURI,
+	"GetSupplierInvoicesPage":                    supplierInvoiceURI,
+	"IterateSupplierInvoices":                    supplierInvoiceURI,
+	"CreateSupplierInvoice":                      supplierInvoiceURI,
+	"GetSupplierInvoice":                         supplierInvoiceURI,
+	"UpdateSupplierInvoice":                      supplierInvoiceURI,
+	"BookKeepSupplierInvoice":                    supplierInvoiceURI,
+	"CancelSupplierInvoice":                      supplierInvoiceURI,
+	"CreditSupplierInvoicePayment":               supplierInvoiceURI,
+	"ApprovalSupplierInvoicePayment":             supplierInvoiceURI,
+	"ApprovalSupplierInvoiceBookKeep":            supplierInvoiceURI,
+	"GetAllSuppliers":                            suppliersURI,
+	"GetSuppliersPage":                           suppliersURI,
+	"IterateSuppliers":                           suppliersURI,
+	"CreateSupplier":                             suppliersURI,
+	"GetSupplier":                                suppliersURI,
+	"UpdateSupplier":                             suppliersURI,
+	"PatchSupplier":                              suppliersURI,
+	"GetAllTaxReductions":                        taxReductionsURI,
+	"GetTaxReductionsPage":                       taxReductionsURI,
+	"IterateTaxReductions":                       taxReductionsURI,
+	"CreateTaxReduction":                         taxReductionsURI,
+	"GetTaxReduction":                            taxReductionsURI,
+	"UpdateTaxReduction":                         taxReductionsURI,
+	"RemoveTaxReduction":                         taxReductionsURI,
+	"GetAllTermsOfDeliveries":                    termsOfDeliveriesURI,
+	"GetTermsOfDeliveriesPage":                   termsOfDeliveriesURI,
+	"IterateTermsOfDeliveries":                   termsOfDeliveriesURI,
+	"CreateTermsOfDeliveries":                    termsOfDeliveriesURI,
+	"GetTermOfDelivery":                          termsOfDeliveriesURI,
+	"UpdateTermOfDelivery":                       termsOfDeliveriesURI,
+	"GetAllTermsOfPayments":                      termsOfPaymentsURI,
+	"GetTermsOfPaymentsPage":                     termsOfPaymentsURI,
+	"IterateTermsOfPayments":                     termsOfPaymentsURI,
+	"CreateTermOfPayment":                        termsOfPaymentsURI,
+	"GetTermOfPayment":                           termsOfPaymentsURI,
+	"UpdateTermOfPayment":                        termsOfPaymentsURI,
+	"RemoveTermOfPayment":                        termsOfPaymentsURI,
+	"GetAllTrustedAndRejectedEmailSenders":       emailSendersURI,
+	"CreateTrustedEmailAddress":                  emailSendersURI,
+	"RemoveTrustedEmailAddress":                  emailSendersURI,
+	"GetAllUnits":                                unitsURI,
+	"GetUnitsPage":                               unitsURI,
+	"IterateUnits":                               unitsURI,
+	"CreateUnit":                                 unitsURI,
+	"GetUnit":                                    unitsURI,
+	"UpdateUnit":                                 unitsURI,
+	"RemoveUnit":                                 unitsURI,
+	"GetAllVoucherFileConnections":               voucherFileConnectionsURI,
+	"GetVoucherFileConnectionsPage":              voucherFileConnectionsURI,
+	"IterateVoucherFileConnections":              voucherFileConnectionsURI,
+	"CreateVoucherFileConnection":                voucherFileConnectionsURI,
+	"GetVoucherFileConnectionByFileID":           voucherFileConnectionsURI,
+	"RemoveVoucherFileConnections":               voucherFileConnectionsURI,
+	"GetAllVoucherSeries":                        voucherSeriesURI,
+	"GetVoucherSeriesPage":                       voucherSeriesURI,
+	"IterateVoucherSeries":                       voucherSeriesURI,
+	"CreateVoucherSeries":                        voucherSeriesURI,
+	"GetVoucherSeriesByCode":                     voucherSeriesURI,
+	"UpdateVoucherSeries":                        voucherSeriesURI,
+	"GetVoucher":                                 vouchersURI,
+	"GetAllVouchers":                             vouchersURI,
+	"GetVouchersPage":                            vouchersURI,
+	"IterateVouchers":                            vouchersURI,
+	"CreateVoucher":                              vouchersURI,
+	"GetVouchersBySeries":                        vouchersURI,
+	"GetVouchersBySeriesPage":                    vouchersURI,
+	"IterateVouchersBySeries":                    vouchersURI,
+	"GetAllWayOfDeliveries":                      wayOfDeliveriesURI,
+	"GetWayOfDeliveriesPage":                     wayOfDeliveriesURI,
+	"IterateWayOfDeliveries":                     wayOfDeliveriesURI,
+	"CreateWayOfDeliveries":                      wayOfDeliveriesURI,
+	"GetWayOfDeliveryByCode":                     wayOfDeliveriesURI,
+	"UpdateWayOfDelivery":                        wayOfDeliveriesURI,
+	"RemoveWayOfDelivery":                        wayOfDeliveriesURI,
+}
+
+// the operations of Client methods calling Fortnox, they are set on Call.Operation
+const (
+	getAbsenceTransactionsPageOp                 = "GetAbsenceTransactionsPage"
+	createNewAbsenceTransactionOp                = "CreateNewAbsenceTransaction"
+	getAbsenceTransactionByIDOp                  = "GetAbsenceTransactionByID"
+	updateAbsenceTransactionByIDOp               = "UpdateAbsenceTransactionByID"
+	deleteAbsenceTransactionByIDOp               = "DeleteAbsenceTransactionByID"
+	getAbsenceTransactionForEmployeeOp           = "GetAbsenceTransactionForEmployee"
+	getAccountChartsOp                           = "GetAccountCharts"
+	getAccountOp                                 = "GetAccount"
+	updateAccountOp                              = "UpdateAccount"
+	getAccountsPageOp                            = "GetAccountsPage"
+	createAccountOp                              = "CreateAccount"
+	getFileOrFolderOp                            = "GetFileOrFolder"
+	uploadFileToDirOp                            = "UploadFileToDir"
+	removeFilesOp                                = "RemoveFiles"
+	getFileOp                                    = "GetFile"
+	deleteFileOp                                 = "DeleteFile"
+	getArticleFileConnectionsPageOp              = "GetArticleFileConnectionsPage"
+	createArticleFileConnectionOp                = "CreateArticleFileConnection"
+	getArticleFileConnectionByIDOp               = "GetArticleFileConnectionByID"
+	deleteArticleFileConnectionOp                = "DeleteArticleFileConnection"
+	getArticleOp                                 = "GetArticle"
+	updateArticleOp                              = "UpdateArticle"
+	patchArticleOp                               = "PatchArticle"
+	deleteArticleOp                              = "DeleteArticle"
+	getArticlesPageOp                            = "GetArticlesPage"
+	createArticleOp                              = "CreateArticle"
+	getAssetFileConnectionsPageOp                = "GetAssetFileConnectionsPage"
+	createAssetFileConnectionOp                  = "CreateAssetFileConnection"
+	deleteAssetFileConnectionOp                  = "DeleteAssetFileConnection"
+	getAssetTypeOp                               = "GetAssetType"
+	createAssetTypeOp                            = "CreateAssetType"
+	updateAssetTypeOp                            = "UpdateAssetType"
+	deleteAssetTypeOp                            = "DeleteAssetType"
+	getAssetTypesPageOp                          = "GetAssetTypesPage"
+	getAssetsPageOp                              = "GetAssetsPage"
+	createAssetOp                                = "CreateAsset"
+	getAssetOp                                   = "GetAsset"
+	changeManualAssetOBValueOp                   = "ChangeManualAssetOBValue"
+	deleteOrVoidAssetOp                          = "DeleteOrVoidAsset"
+	getAssetsDepreciationListOp                  = "GetAssetsDepreciationList"
+	writeUpAssetOp                               = "WriteUpAsset"
+	writeDownAssetOp                             = "WriteDownAsset"
+	scrapAssetOp                                 = "ScrapAsset"
+	sellAssetOp                                  = "SellAsset"
+	performAssetDepreciationOp                   = "PerformAssetDepreciation"
+	getAttendanceTransactionsPageOp              = "GetAttendanceTransactionsPage"
+	createAttendanceTransactionOp                = "CreateAttendanceTransaction"
+	getAttendanceTransactionOp                   = "GetAttendanceTransaction"
+	updateAttendanceTransactionOp                = "UpdateAttendanceTransaction"
+	getCompanyInformationOp                      = "GetCompanyInformation"
+	getCompanySettingsOp                         = "GetCompanySettings"
+	getContractAccrualsPageOp                    = "GetContractAccrualsPage"
+	createContractAccrualOp                      = "CreateContractAccrual"
+	getContractAccrualOp                         = "GetContractAccrual"
+	updateContractAccrualOp                      = "UpdateContractAccrual"
+	removeContractAccrualOp                      = "RemoveContractAccrual"
+	getContractTemplatesPageOp                   = "GetContractTemplatesPage"
+	createContractTemplateOp                     = "CreateContractTemplate"
+	getContractTemplateOp                        = "GetContractTemplate"
+	updateContractTemplateOp                     = "UpdateContractTemplate"
+	getContractOp                                = "GetContract"
+	updateContractOp                             = "UpdateContract"
+	getContractsPageOp                           = "GetContractsPage"
+	createContractOp                             = "CreateContract"
+	setContractAsFinishedOp                      = "SetContractAsFinished"
+	createInvoiceFromContractOp                  = "CreateInvoiceFromContract"
+	increaseInvoiceCountOp                       = "IncreaseInvoiceCount"
+	getCostCentersPageOp                         = "GetCostCentersPage"
+	createCostCenterOp                           = "CreateCostCenter"
+	getCostCenterOp                              = "GetCostCenter"
+	updateCostCenterOp                           = "UpdateCostCenter"
+	removeCostCenterOp                           = "RemoveCostCenter"
+	getCurrenciesPageOp                          = "GetCurrenciesPage"
+	createCurrencyOp                             = "CreateCurrency"
+	getCurrencyOp                                = "GetCurrency"
+	updateCurrencyOp                             = "UpdateCurrency"
+	removeCurrencyOp                             = "RemoveCurrency"
+	getCustomerReferencesPageOp                  = "GetCustomerReferencesPage"
+	createCustomerReferenceOp                    = "CreateCustomerReference"
+	getCustomerReferenceOp                       = "GetCustomerReference"
+	updateCustomerReferenceOp                    = "UpdateCustomerReference"
+	deleteCustomerReferenceRowOp                 = "DeleteCustomerReferenceRow"
+	getCustomersPageOp                           = "GetCustomersPage"
+	createCustomerOp                             = "CreateCustomer"
+	getCustomerOp                                = "GetCustomer"
+	updateCustomerOp                             = "UpdateCustomer"
+	deleteCustomerOp                             = "DeleteCustomer"
+	getEUVATLimitDetailsOp                       = "GetEUVATLimitDetails"
+	getEmployeesPageOp                           = "GetEmployeesPage"
+	createEmployeeOp                             = "CreateEmployee"
+	getEmployeeOp                                = "GetEmployee"
+	updateEmployeeOp                             = "UpdateEmployee"
+	getExpensesPageOp                            = "GetExpensesPage"
+	createExpenseOp                              = "CreateExpense"
+	getExpenseOp                                 = "GetExpense"
+	getFinancialYearsPageOp                      = "GetFinancialYearsPage"
+	createFinancialYearOp                        = "CreateFinancialYear"
+	getFinancialYearByIDOp                       = "GetFinancialYearByID"
+	getRootDirectoryOp                           = "GetRootDirectory"
+	uploadFileOp                                 = "UploadFile"
+	getInboxFileOp                               = "GetInboxFile"
+	removeFileOrFolderOp                         = "RemoveFileOrFolder"
+	getInvoiceAccrualsPageOp                     = "GetInvoiceAccrualsPage"
+	createInvoiceAccrualOp                       = "CreateInvoiceAccrual"
+	getInvoiceAccrualOp                          = "GetInvoiceAccrual"
+	updateInvoiceAccrualOp                       = "UpdateInvoiceAccrual"
+	removeInvoiceAccrualOp                       = "RemoveInvoiceAccrual"
+	getInvoicePaymentsPageOp                     = "GetInvoicePaymentsPage"
+	createInvoicePaymentOp                       = "CreateInvoicePayment"
+	getInvoicePaymentOp                          = "GetInvoicePayment"
+	updateInvoicePaymentOp                       = "UpdateInvoicePayment"
+	removeInvoicePaymentOp                       = "RemoveInvoicePayment"
+	bookKeepInvoicePaymentOp                     = "BookKeepInvoicePayment"
+	getInvoiceOp                                 = "GetInvoice"
+	updateInvoiceOp                              = "UpdateInvoice"
+	getInvoicesPageOp                            = "GetInvoicesPage"
+	createInvoiceOp                              = "CreateInvoice"
+	bookKeepInvoiceOp                            = "BookKeepInvoice"
+	cancelInvoiceOp                              = "CancelInvoice"
+	creditInvoiceOp                              = "CreditInvoice"
+	setInvoiceAsSentOp                           = "SetInvoiceAsSent"
+	setInvoiceAsDoneOp                           = "SetInvoiceAsDone"
+	printInvoiceOp                               = "PrintInvoice"
+	sendInvoiceAsEmailOp                         = "SendInvoiceAsEmail"
+	sendInvoiceAsReminderOp                      = "SendInvoiceAsReminder"
+	previewInvoiceOp                             = "PreviewInvoice"
+	sendInvoiceAsEPrintOp                        = "SendInvoiceAsEPrint"
+	sendInvoiceAsEInvoiceOp                      = "SendInvoiceAsEInvoice"
+	getLabelsPageOp                              = "GetLabelsPage"
+	createLabelsOp                               = "CreateLabels"
+	updateLabelOp                                = "UpdateLabel"
+	deleteLabelOp                                = "DeleteLabel"
+	getLockedPeriodOp                            = "GetLockedPeriod"
+	getMeInformationOp                           = "GetMeInformation"
+	getModesOfPaymentsPageOp                     = "GetModesOfPaymentsPage"
+	createModeOfPaymentOp                        = "CreateModeOfPayment"
+	getModeOfPaymentOp                           = "GetModeOfPayment"
+	updateModeOfPaymentOp                        = "UpdateModeOfPayment"
+	getOffersPageOp                              = "GetOffersPage"
+	createOfferOp                                = "CreateOffer"
+	getOfferOp                                   = "GetOffer"
+	updateOfferOp                                = "UpdateOffer"
+	printOfferOp                                 = "PrintOffer"
+	sendOfferAsEmailOp                           = "SendOfferAsEmail"
+	previewOfferOp                               = "PreviewOffer"
+	createOrderOutOfOfferOp                      = "CreateOrderOutOfOffer"
+	cancelOfferOp                                = "CancelOffer"
+	setOfferAsSentOp                             = "SetOfferAsSent"
+	getOrdersPageOp                              = "GetOrdersPage"
+	createOrderOp                                = "CreateOrder"
+	getOrderOp                                   = "GetOrder"
+	updateOrderOp                                = "UpdateOrder"
+	printOrderOp                                 = "PrintOrder"
+	sendOrderAsEmailOp                           = "SendOrderAsEmail"
+	previewOrderOp                               = "PreviewOrder"
+	createInvoiceOutOfGivenOrderOp               = "CreateInvoiceOutOfGivenOrder"
+	cancelGivenOrderOp                           = "CancelGivenOrder"
+	setGivenOrderAsSentOp                        = "SetGivenOrderAsSent"
+	getPredefinedAccountsPageOp                  = "GetPredefinedAccountsPage"
+	getPredefinedAccountOp                       = "GetPredefinedAccount"
+	updatePredefinedAccountOp                    = "UpdatePredefinedAccount"
+	getPredefinedVoucherSeriesPageOp             = "GetPredefinedVoucherSeriesPage"
+	getPredefinedVoucherSeriesOp                 = "GetPredefinedVoucherSeries"
+	updatePredefinedVoucherSeriesOp              = "UpdatePredefinedVoucherSeries"
+	getPriceListsPageOp                          = "GetPriceListsPage"
+	createPriceListOp                            = "CreatePriceList"
+	getPriceListOp                               = "GetPriceList"
+	updatePriceListOp                            = "UpdatePriceList"
+	getPriceForArticleOp                         = "GetPriceForArticle"
+	updatePriceOp                                = "UpdatePrice"
+	deletePriceOp                                = "DeletePrice"
+	getArticlesWithPricesInPriceListPageOp       = "GetArticlesWithPricesInPriceListPage"
+	getFirstPriceForArticleOp                    = "GetFirstPriceForArticle"
+	updateFirstPriceForArticleOp                 = "UpdateFirstPriceForArticle"
+	createPriceOp                                = "CreatePrice"
+	getPrintTemplatesPageOp                      = "GetPrintTemplatesPage"
+	getProjectOp                                 = "GetProject"
+	updateProjectOp                              = "UpdateProject"
+	removeProjectOp                              = "RemoveProject"
+	getProjectsPageOp                            = "GetProjectsPage"
+	createProjectOp                              = "CreateProject"
+	getSIEFileOp                                 = "GetSIEFile"
+	getSalaryTransactionsForAllEmployeesPageOp   = "GetSalaryTransactionsForAllEmployeesPage"
+	createSalaryTransactionsForEmployeeOp        = "CreateSalaryTransactionsForEmployee"
+	getSalaryTransactionForEmployeesOp           = "GetSalaryTransactionForEmployees"
+	updateSalaryTransactionForEmployeeOp         = "UpdateSalaryTransactionForEmployee"
+	deleteSalaryTransactionForEmployeeOp         = "DeleteSalaryTransactionForEmployee"
+	getScheduleTimeOp                            = "GetScheduleTime"
+	updateScheduleTimeOp                         = "UpdateScheduleTime"
+	resetScheduleTimeOp                          = "ResetScheduleTime"
+	getSupplierInvoiceAccrualsPageOp             = "GetSupplierInvoiceAccrualsPage"
+	createSupplierInvoiceAccrualsOp              = "CreateSupplierInvoiceAccruals"
+	getSupplierInvoiceAccrualsOp                 = "GetSupplierInvoiceAccruals"
+	updateSupplierInvoiceAccrualsOp              = "UpdateSupplierInvoiceAccruals"
+	deleteSupplierInvoiceAccrualsOp              = "DeleteSupplierInvoiceAccruals"
+	getSupplierInvoiceExternalUrlConnectionOp    = "GetSupplierInvoiceExternalUrlConnection"
+	updateSupplierInvoiceExternalUrlConnectionOp = "UpdateSupplierInvoiceExternalUrlConnection"
+	deleteSupplierInvoiceExternalUrlConnectionOp = "DeleteSupplierInvoiceExternalUrlConnection"
+	createSupplierInvoiceExternalUrlConnectionOp = "CreateSupplierInvoiceExternalUrlConnection"
+	getSupplierInvoiceFileConnectionsPageOp      = "GetSupplierInvoiceFileConnectionsPage"
+	createSupplierInvoiceFileConnectionOp        = "CreateSupplierInvoiceFileConnection"
+	getSupplierInvoiceFileConnectionOp           = "GetSupplierInvoiceFileConnection"
+	removeSupplierInvoiceFileConnectionsOp       = "RemoveSupplierInvoiceFileConnections"
+	getSupplierInvoicePaymentsPageOp             = "GetSupplierInvoicePaymentsPage"
+	createSupplierInvoicePaymentOp               = "CreateSupplierInvoicePayment"
+	getSupplierInvoicePaymentOp                  = "GetSupplierInvoicePayment"
+	updateSupplierInvoicePaymentOp               = "UpdateSupplierInvoicePayment"
+	removeSupplierInvoicePaymentOp               = "RemoveSupplierInvoicePayment"
+	bookKeepSupplierInvoicePaymentOp             = "BookKeepSupplierInvoicePayment"
+	getSupplierInvoicesPageOp                    = "GetSupplierInvoicesPage"
+	createSupplierInvoiceOp                      = "CreateSupplierInvoice"
+	getSupplierInvoiceOp                         = "GetSupplierInvoice"
+	updateSupplierInvoiceOp                      = "UpdateSupplierInvoice"
+	bookKeepSupplierInvoiceOp                    = "BookKeepSupplierInvoice"
+	cancelSupplierInvoiceOp                      = "CancelSupplierInvoice"
+	creditSupplierInvoicePaymentOp               = "CreditSupplierInvoicePayment"
+	approvalSupplierInvoicePaymentOp             = "ApprovalSupplierInvoicePayment"
+	approvalSupplierInvoiceBookKeepOp            = "ApprovalSupplierInvoiceBookKeep"
+	getSuppliersPageOp                           = "GetSuppliersPage"
+	createSupplierOp                             = "CreateSupplier"
+	getSupplierOp                                = "GetSupplier"
+	updateSupplierOp                             = "UpdateSupplier"
+	getTaxReductionsPageOp                       = "GetTaxReductionsPage"
+	createTaxReductionOp                         = "CreateTaxReduction"
+	getTaxReductionOp                            = "GetTaxReduction"
+	updateTaxReductionOp                         = "UpdateTaxReduction"
+	removeTaxReductionOp                         = "RemoveTaxReduction"
+	getTermsOfDeliveriesPageOp                   = "GetTermsOfDeliveriesPage"
+	createTermsOfDeliveriesOp                    = "CreateTermsOfDeliveries"
+	getTermOfDeliveryOp                          = "GetTermOfDelivery"
+	updateTermOfDeliveryOp                       = "UpdateTermOfDelivery"
+	getTermsOfPaymentsPageOp                     = "GetTermsOfPaymentsPage"
+	createTermOfPaymentOp                        = "CreateTermOfPayment"
+	getTermOfPaymentOp                           = "GetTermOfPayment"
+	updateTermOfPaymentOp                        = "UpdateTermOfPayment"
+	removeTermOfPaymentOp                        = "RemoveTermOfPayment"
+	getAllTrustedAndRejectedEmailSendersOp       = "GetAllTrustedAndRejectedEmailSenders"
+	createTrustedEmailAddressOp                  = "CreateTrustedEmailAddress"
+	removeTrustedEmailAddressOp                  = "RemoveTrustedEmailAddress"
+	getUnitsPageOp                               = "GetUnitsPage"
+	createUnitOp                                 = "CreateUnit"
+	getUnitOp                                    = "GetUnit"
+	updateUnitOp                                 = "UpdateUnit"
+	removeUnitOp                                 = "RemoveUnit"
+	getVoucherFileConnectionsPageOp              = "GetVoucherFileConnectionsPage"
+	createVoucherFileConnectionOp                = "CreateVoucherFileConnection"
+	getVoucherFileConnectionByFileIDOp           = "GetVoucherFileConnectionByFileID"
+	removeVoucherFileConnectionsOp               = "RemoveVoucherFileConnections"
+	getVoucherSeriesPageOp                       = "GetVoucherSeriesPage"
+	createVoucherSeriesOp                        = "CreateVoucherSeries"
+	getVoucherSeriesByCodeOp                     = "GetVoucherSeriesByCode"
+	updateVoucherSeriesOp                        = "UpdateVoucherSeries"
+	getVoucherOp                                 = "GetVoucher"
+	getVouchersPageOp                            = "GetVouchersPage"
+	createVoucherOp                              = "CreateVoucher"
+	getVouchersBySeriesPageOp                    = "GetVouchersBySeriesPage"
+	getWayOfDeliveriesPageOp                     = "GetWayOfDeliveriesPage"
+	createWayOfDeliveriesOp                      = "CreateWayOfDeliveries"
+	getWayOfDeliveryByCodeOp                     = "GetWayOfDeliveryByCode"
+	updateWayOfDeliveryOp                        = "UpdateWayOfDelivery"
+	removeWayOfDeliveryOp                        = "RemoveWayOfDelivery"
+)
+
+// AbsenceTransactionsService groups the calls reached as Client.AbsenceTransactions, fortnoxfake.AbsenceTransactions fakes it
+type AbsenceTransactionsService interface {
+	// GetAllAbsenceTransactions does _GET https://api.fortnox.se/3/absencetransactions
+	//
+	// filter - may contain employeeID and date
+	GetAllAbsenceTransactions(ctx context.Context, filter *GetAbsenceTransactionsFilter) ([]AbsenceTransaction, error)
+
+	// GetAbsenceTransactionsPage does _GET https://api.fortnox.se/3/absencetransactions and returns a single page along with its MetaInformation
+	//
+	// filter - may contain employeeID and date
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetAbsenceTransactionsPage(ctx context.Context, filter *GetAbsenceTransactionsFilter, page *PageOptions) ([]AbsenceTransaction, *MetaInformation, error)
+
+	// IterateAbsenceTransactions lazily walks all pages of https://api.fortnox.se/3/absencetransactions
+	//
+	// filter - may contain employeeID and date
+	IterateAbsenceTransactions(filter *GetAbsenceTransactionsFilter) *Iterator[AbsenceTransaction]
+
+	// CreateNewAbsenceTransaction does _POST https://api.fortnox.se/3/absencetransactions
+	//
+	// at - absence transaction to create
+	CreateNewAbsenceTransaction(ctx context.Context, at *AbsenceTransaction) (*AbsenceTransaction, error)
+
+	// GetAbsenceTransactionByID does _GET https://api.fortnox.se/3/absencetransactions/{id}
+	//
+	// id - identifies the transaction
+	GetAbsenceTransactionByID(ctx context.Context, id string) (*AbsenceTransaction, error)
+
+	// UpdateAbsenceTransactionByID does _PUT https://api.fortnox.se/3/absencetransactions/{id}
+	//
+	// id - identifies the transaction
+	//
+	// at - absence transaction to update
+	UpdateAbsenceTransactionByID(ctx context.Context, id string, at *AbsenceTransaction) (*AbsenceTransaction, error)
+
+	// DeleteAbsenceTransactionByID does _DELETE https://api.fortnox.se/3/absencetransactions/{id}
+	//
+	// id - identifies the transaction
+	DeleteAbsenceTransactionByID(ctx context.Context, id string) (*AbsenceTransaction, error)
+
+	// GetAbsenceTransactionForEmployee does _GET https://api.fortnox.se/3/absencetransactions/{EmployeeId}/{Date}/{Code}
+	//
+	// employeeID - identifies the employee
+	//
+	// date - date of the absence transaction
+	//
+	// code - status code of the absence transaction
+	GetAbsenceTransactionForEmployee(ctx context.Context, employeeID string, date Date, code AbsenceTransactionStatusCode) ([]AbsenceTransaction, error)
+}
+
+type absenceTransactionsService struct {
+	*Client
+}
+
+// GetAllAbsenceTransactions forwards to AbsenceTransactionsService.GetAllAbsenceTransactions of c.AbsenceTransactions
+func (c *Client) GetAllAbsenceTransactions(ctx context.Context, filter *GetAbsenceTransactionsFilter) ([]AbsenceTransaction, error) {
+	return c.AbsenceTransactions.GetAllAbsenceTransactions(ctx, filter)
+}
+
+// GetAbsenceTransactionsPage forwards to AbsenceTransactionsService.GetAbsenceTransactionsPage of c.AbsenceTransactions
+func (c *Client) GetAbsenceTransactionsPage(ctx context.Context, filter *GetAbsenceTransactionsFilter, page *PageOptions) ([]AbsenceTransaction, *MetaInformation, error) {
+	return c.AbsenceTransactions.GetAbsenceTransactionsPage(ctx, filter, page)
+}
+
+// IterateAbsenceTransactions forwards to AbsenceTransactionsService.IterateAbsenceTransactions of c.AbsenceTransactions
+func (c *Client) IterateAbsenceTransactions(filter *GetAbsenceTransactionsFilter) *Iterator[AbsenceTransaction] {
+	return c.AbsenceTransactions.IterateAbsenceTransactions(filter)
+}
+
+// CreateNewAbsenceTransaction forwards to AbsenceTransactionsService.CreateNewAbsenceTransaction of c.AbsenceTransactions
+func (c *Client) CreateNewAbsenceTransaction(ctx context.Context, at *AbsenceTransaction) (*AbsenceTransaction, error) {
+	return c.AbsenceTransactions.CreateNewAbsenceTransaction(ctx, at)
+}
+
+// GetAbsenceTransactionByID forwards to AbsenceTransactionsService.GetAbsenceTransactionByID of c.AbsenceTransactions
+func (c *Client) GetAbsenceTransactionByID(ctx context.Context, id string) (*AbsenceTransaction, error) {
+	return c.AbsenceTransactions.GetAbsenceTransactionByID(ctx, id)
+}
+
+// UpdateAbsenceTransactionByID forwards to AbsenceTransactionsService.UpdateAbsenceTransactionByID of c.AbsenceTransactions
+func (c *Client) UpdateAbsenceTransactionByID(ctx context.Context, id string, at *AbsenceTransaction) (*AbsenceTransaction, error) {
+	return c.AbsenceTransactions.UpdateAbsenceTransactionByID(ctx, id, at)
+}
+
+// DeleteAbsenceTransactionByID forwards to AbsenceTransactionsService.DeleteAbsenceTransactionByID of c.AbsenceTransactions
+func (c *Client) DeleteAbsenceTransactionByID(ctx context.Context, id string) (*AbsenceTransaction, error) {
+	return c.AbsenceTransactions.DeleteAbsenceTransactionByID(ctx, id)
+}
+
+// GetAbsenceTransactionForEmployee forwards to AbsenceTransactionsService.GetAbsenceTransactionForEmployee of c.AbsenceTransactions
+func (c *Client) GetAbsenceTransactionForEmployee(ctx context.Context, employeeID string, date Date, code AbsenceTransactionStatusCode) ([]AbsenceTransaction, error) {
+	return c.AbsenceTransactions.GetAbsenceTransactionForEmployee(ctx, employeeID, date, code)
+}
+
+// AccountChartsService groups the calls reached as Client.AccountCharts, fortnoxfake.AccountCharts fakes it
+type AccountChartsService interface {
+	// GetAccountCharts does _GET https://api.fortnox.se/3/accountcharts
+	GetAccountCharts(ctx context.Context) ([]AccountChart, error)
+}
+
+type accountChartsService struct {
+	*Client
+}
+
+// GetAccountCharts forwards to AccountChartsService.GetAccountCharts of c.AccountCharts
+func (c *Client) GetAccountCharts(ctx context.Context) ([]AccountChart, error) {
+	return c.AccountCharts.GetAccountCharts(ctx)
+}
+
+// AccountsService groups the calls reached as Client.Accounts, fortnoxfake.Accounts fakes it
+type AccountsService interface {
+	// GetAccount does _GET https://api.fortnox.se/3/accounts/{Number}
+	//
+	// accountID - identifies the account
+	GetAccount(ctx context.Context, accountID int) (*Account, error)
+
+	// UpdateAccount does _PUT https://api.fortnox.se/3/accounts/{Number}
+	//
+	// accountID - identifies the account
+	//
+	// financialYear - financial year to update account against, param is optional
+	UpdateAccount(ctx context.Context, accountID int, a *Account, filter *FinancialYearFilter) (*Account, error)
+
+	// GetAllAccounts does _GET https://api.fortnox.se/3/accounts/
+	//
+	// filter - GetAllAccountsFilter
+	GetAllAccounts(ctx context.Context, filter *GetAllAccountsFilter) ([]Account, error)
+
+	// GetAccountsPage does _GET https://api.fortnox.se/3/accounts/ and returns a single page along with its MetaInformation
+	//
+	// filter - GetAllAccountsFilter
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetAccountsPage(ctx context.Context, filter *GetAllAccountsFilter, page *PageOptions) ([]Account, *MetaInformation, error)
+
+	// IterateAccounts lazily walks all pages of https://api.fortnox.se/3/accounts/
+	//
+	// filter - GetAllAccountsFilter
+	IterateAccounts(filter *GetAllAccountsFilter) *Iterator[Account]
+
+	// CreateAccount does _POST https://api.fortnox.se/3/accounts/
+	//
+	// filter - financial year to create account against
+	CreateAccount(ctx context.Context, a *Account, filter *FinancialYearFilter) (*Account, error)
+}
+
+type accountsService struct {
+	*Client
+}
+
+// GetAccount forwards to AccountsService.GetAccount of c.Accounts
+func (c *Client) GetAccount(ctx context.Context, accountID int) (*Account, error) {
+	return c.Accounts.GetAccount(ctx, accountID)
+}
+
+// UpdateAccount forwards to AccountsService.UpdateAccount of c.Accounts
+func (c *Client) UpdateAccount(ctx context.Context, accountID int, a *Account, filter *FinancialYearFilter) (*Account, error) {
+	return c.Accounts.UpdateAccount(ctx, accountID, a, filter)
+}
+
+// GetAllAccounts forwards to AccountsService.GetAllAccounts of c.Accounts
+func (c *Client) GetAllAccounts(ctx context.Context, filter *GetAllAccountsFilter) ([]Account, error) {
+	return c.Accounts.GetAllAccounts(ctx, filter)
+}
+
+// GetAccountsPage forwards to AccountsService.GetAccountsPage of c.Accounts
+func (c *Client) GetAccountsPage(ctx context.Context, filter *GetAllAccountsFilter, page *PageOptions) ([]Account, *MetaInformation, error) {
+	return c.Accounts.GetAccountsPage(ctx, filter, page)
+}
+
+// IterateAccounts forwards to AccountsService.IterateAccounts of c.Accounts
+func (c *Client) IterateAccounts(filter *GetAllAccountsFilter) *Iterator[Account] {
+	return c.Accounts.IterateAccounts(filter)
+}
+
+// CreateAccount forwards to AccountsService.CreateAccount of c.Accounts
+func (c *Client) CreateAccount(ctx context.Context, a *Account, filter *FinancialYearFilter) (*Account, error) {
+	return c.Accounts.CreateAccount(ctx, a, filter)
+}
+
+// ArchiveService groups the calls reached as Client.Archive, fortnoxfake.Archive fakes it
+type ArchiveService interface {
+	// GetFileOrFolder does _GET https://api.fortnox.se/3/archive/
+	// filter:
+	//
+	// 1. path - name of folder
+	//
+	// 2. fileID - fileId from fileattachments
+	GetFileOrFolder(ctx context.Context, filter *PathFileIDFilter) (*Folder, error)
+
+	// UploadFileToDir does _POST https://api.fortnox.se/3/archive/
+	//
+	// fileName - name of the file in the archive
+	//
+	// r - file contents, streamed as multipart/form-data
+	//
+	// filter:
+	//
+	// 1. path - name of folder
+	//
+	// 2. folderID - if of folder
+	UploadFileToDir(ctx context.Context, filter *PathFileIDFilter, fileName string, r io.Reader) (*File, error)
+
+	// RemoveFiles does _DELETE https://api.fortnox.se/3/archive/
+	//
+	// path - identifies file/folder to remove
+	RemoveFiles(ctx context.Context, path string) error
+
+	// GetFile does _GET https://api.fortnox.se/3/archive/{id}
+	//
+	// id - identifies the file
+	//
+	// fileID - fileId from fileattachments
+	GetFile(ctx context.Context, id, fileID string) (*Download, error)
+
+	// DeleteFile does _DELETE https://api.fortnox.se/3/archive/{id}
+	//
+	// id - identifies the file
+	DeleteFile(ctx context.Context, id string) error
+}
+
+type archiveService struct {
+	*Client
+}
+
+// GetFileOrFolder forwards to ArchiveService.GetFileOrFolder of c.Archive
+func (c *Client) GetFileOrFolder(ctx context.Context, filter *PathFileIDFilter) (*Folder, error) {
+	return c.Archive.GetFileOrFolder(ctx, filter)
+}
+
+// UploadFileToDir forwards to ArchiveService.UploadFileToDir of c.Archive
+func (c *Client) UploadFileToDir(ctx context.Context, filter *PathFileIDFilter, fileName string, r io.Reader) (*File, error) {
+	return c.Archive.UploadFileToDir(ctx, filter, fileName, r)
+}
+
+// RemoveFiles forwards to ArchiveService.RemoveFiles of c.Archive
+func (c *Client) RemoveFiles(ctx context.Context, path string) error {
+	return c.Archive.RemoveFiles(ctx, path)
+}
+
+// GetFile forwards to ArchiveService.GetFile of c.Archive
+func (c *Client) GetFile(ctx context.Context, id, fileID string) (*Download, error) {
+	return c.Archive.GetFile(ctx, id, fileID)
+}
+
+// DeleteFile forwards to ArchiveService.DeleteFile of c.Archive
+func (c *Client) DeleteFile(ctx context.Context, id string) error {
+	return c.Archive.DeleteFile(ctx, id)
+}
+
+// ArticleFileConnectionsService groups the calls reached as Client.ArticleFileConnections, fortnoxfake.ArticleFileConnections fakes it
+type ArticleFileConnectionsService interface {
+	// GetAllArticleFileConnections does _GET https://api.fortnox.se/3/articlefileconnections/
+	GetAllArticleFileConnections(ctx context.Context) ([]ArticleFileConnection, error)
+
+	// GetArticleFileConnectionsPage does _GET https://api.fortnox.se/3/articlefileconnections/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetArticleFileConnectionsPage(ctx context.Context, page *PageOptions) ([]ArticleFileConnection, *MetaInformation, error)
+
+	// IterateArticleFileConnections lazily walks all pages of https://api.fortnox.se/3/articlefileconnections/
+	IterateArticleFileConnections() *Iterator[ArticleFileConnection]
+
+	// CreateArticleFileConnection does _POST https://api.fortnox.se/3/articlefileconnections/
+	//
+	// req - article file connection to create
+	CreateArticleFileConnection(ctx context.Context, afc *ArticleFileConnection) (*ArticleFileConnection, error)
+
+	// GetArticleFileConnectionByID does _GET https://api.fortnox.se/3/articlefileconnections/{FileId}
+	//
+	// fileID - identifies the article file connection
+	GetArticleFileConnectionByID(ctx context.Context, fileID string) (*ArticleFileConnection, error)
+
+	// DeleteArticleFileConnection does _DELETE https://api.fortnox.se/3/articlefileconnections/{FileId}
+	//
+	// fileID - identifies the article file connection
+	DeleteArticleFileConnection(ctx context.Context, fileID string) error
+}
+
+type articleFileConnectionsService struct {
+	*Client
+}
+
+// GetAllArticleFileConnections forwards to ArticleFileConnectionsService.GetAllArticleFileConnections of c.ArticleFileConnections
+func (c *Client) GetAllArticleFileConnections(ctx context.Context) ([]ArticleFileConnection, error) {
+	return c.ArticleFileConnections.GetAllArticleFileConnections(ctx)
+}
+
+// GetArticleFileConnectionsPage forwards to ArticleFileConnectionsService.GetArticleFileConnectionsPage of c.ArticleFileConnections
+func (c *Client) GetArticleFileConnectionsPage(ctx context.Context, page *PageOptions) ([]ArticleFileConnection, *MetaInformation, error) {
+	return c.ArticleFileConnections.GetArticleFileConnectionsPage(ctx, page)
+}
+
+// IterateArticleFileConnections forwards to ArticleFileConnectionsService.IterateArticleFileConnections of c.ArticleFileConnections
+func (c *Client) IterateArticleFileConnections() *Iterator[ArticleFileConnection] {
+	return c.ArticleFileConnections.IterateArticleFileConnections()
+}
+
+// CreateArticleFileConnection forwards to ArticleFileConnectionsService.CreateArticleFileConnection of c.ArticleFileConnections
+func (c *Client) CreateArticleFileConnection(ctx context.Context, afc *ArticleFileConnection) (*ArticleFileConnection, error) {
+	return c.ArticleFileConnections.CreateArticleFileConnection(ctx, afc)
+}
+
+// GetArticleFileConnectionByID forwards to ArticleFileConnectionsService.GetArticleFileConnectionByID of c.ArticleFileConnections
+func (c *Client) GetArticleFileConnectionByID(ctx context.Context, fileID string) (*ArticleFileConnection, error) {
+	return c.ArticleFileConnections.GetArticleFileConnectionByID(ctx, fileID)
+}
+
+// DeleteArticleFileConnection forwards to ArticleFileConnectionsService.DeleteArticleFileConnection of c.ArticleFileConnections
+func (c *Client) DeleteArticleFileConnection(ctx context.Context, fileID string) error {
+	return c.ArticleFileConnections.DeleteArticleFileConnection(ctx, fileID)
+}
+
+// ArticlesService groups the calls reached as Client.Articles, fortnoxfake.Articles fakes it
+type ArticlesService interface {
+	// GetArticle does _GET https://api.fortnox.se/3/articles/{ArticleNumber}
+	//
+	// articleNumber - identifies the article
+	GetArticle(ctx context.Context, articleNumber int) (*Article, error)
+
+	// UpdateArticle does _PUT https://api.fortnox.se/3/articles/{ArticleNumber}
+	//
+	// articleNumber - identifies the article
+	//
+	// req - fields to update, only fields that are Set are sent
+	UpdateArticle(ctx context.Context, articleNumber int, req *UpdateArticleReq) (*UpdateArticleResp, error)
+
+	// PatchArticle does _PUT https://api.fortnox.se/3/articles/{ArticleNumber} sending only the fields changed between original and edited
+	//
+	// original - article as returned by Fortnox
+	//
+	// edited - modified copy of original
+	//
+	// Nothing is sent when no writable field changed, original is returned then.
+	PatchArticle(ctx context.Context, original, edited *Article) (*Article, error)
+
+	// DeleteArticle does _DELETE https://api.fortnox.se/3/articles/{ArticleNumber}
+	//
+	// articleNumber - identifies the article
+	DeleteArticle(ctx context.Context, articleNumber int) error
+
+	// GetArticles does _GET https://api.fortnox.se/3/articles
+	//
+	// filter - Enum: {"active", "inactive"}, possibility to filter supplier invoices
+	GetArticles(ctx context.Context, filter *ArticleFilter) (*GetArticlesResp, error)
+
+	// GetArticlesPage does _GET https://api.fortnox.se/3/articles and returns a single page along with its MetaInformation
+	//
+	// filter - Enum: {"active", "inactive"}, possibility to filter supplier invoices
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetArticlesPage(ctx context.Context, filter *ArticleFilter, page *PageOptions) ([]Article, *MetaInformation, error)
+
+	// IterateArticles lazily walks all pages of https://api.fortnox.se/3/articles
+	//
+	// filter - Enum: {"active", "inactive"}, possibility to filter supplier invoices
+	IterateArticles(filter *ArticleFilter) *Iterator[Article]
+
+	// CreateArticle does _POST https://api.fortnox.se/3/articles
+	//
+	// article - object to create
+	CreateArticle(ctx context.Context, req *CreateArticleReq) (*CreateArticleResp, error)
+}
+
+type articlesService struct {
+	*Client
+}
+
+// GetArticle forwards to ArticlesService.GetArticle of c.Articles
+func (c *Client) GetArticle(ctx context.Context, articleNumber int) (*Article, error) {
+	return c.Articles.GetArticle(ctx, articleNumber)
+}
+
+// UpdateArticle forwards to ArticlesService.UpdateArticle of c.Articles
+func (c *Client) UpdateArticle(ctx context.Context, articleNumber int, req *UpdateArticleReq) (*UpdateArticleResp, error) {
+	return c.Articles.UpdateArticle(ctx, articleNumber, req)
+}
+
+// PatchArticle forwards to ArticlesService.PatchArticle of c.Articles
+func (c *Client) PatchArticle(ctx context.Context, original, edited *Article) (*Article, error) {
+	return c.Articles.PatchArticle(ctx, original, edited)
+}
+
+// DeleteArticle forwards to ArticlesService.DeleteArticle of c.Articles
+func (c *Client) DeleteArticle(ctx context.Context, articleNumber int) error {
+	return c.Articles.DeleteArticle(ctx, articleNumber)
+}
+
+// GetArticles forwards to ArticlesService.GetArticles of c.Articles
+func (c *Client) GetArticles(ctx context.Context, filter *ArticleFilter) (*GetArticlesResp, error) {
+	return c.Articles.GetArticles(ctx, filter)
+}
+
+// GetArticlesPage forwards to ArticlesService.GetArticlesPage of c.Articles
+func (c *Client) GetArticlesPage(ctx context.Context, filter *ArticleFilter, page *PageOptions) ([]Article, *MetaInformation, error) {
+	return c.Articles.GetArticlesPage(ctx, filter, page)
+}
+
+// IterateArticles forwards to ArticlesService.IterateArticles of c.Articles
+func (c *Client) IterateArticles(filter *ArticleFilter) *Iterator[Article] {
+	return c.Articles.IterateArticles(filter)
+}
+
+// CreateArticle forwards to ArticlesService.CreateArticle of c.Articles
+func (c *Client) CreateArticle(ctx context.Context, req *CreateArticleReq) (*CreateArticleResp, error) {
+	return c.Articles.CreateArticle(ctx, req)
+}
+
+// AssetFileConnectionsService groups the calls reached as Client.AssetFileConnections, fortnoxfake.AssetFileConnections fakes it
+type AssetFileConnectionsService interface {
+	// GetAllAssetFileConnections does _GET https://api.fortnox.se/3/assetfileconnections
+	GetAllAssetFileConnections(ctx context.Context) (*GetAllAssetFileConnectionsResp, error)
+
+	// GetAssetFileConnectionsPage does _GET https://api.fortnox.se/3/assetfileconnections and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetAssetFileConnectionsPage(ctx context.Context, page *PageOptions) ([]AssetFileConnection, *MetaInformation, error)
+
+	// IterateAssetFileConnections lazily walks all pages of https://api.fortnox.se/3/assetfileconnections
+	IterateAssetFileConnections() *Iterator[AssetFileConnection]
+
+	// CreateAssetFileConnection does _POST https://api.fortnox.se/3/assetfileconnections
+	//
+	// req - asset file connection to create
+	CreateAssetFileConnection(ctx context.Context, req *CreateAssetFileConnectionReq) (*AssetFileConnection, error)
+
+	// DeleteAssetFileConnection does _DELETE https://api.fortnox.se/3/assetfileconnections/{fileId}
+	//
+	// fileID - fileId
+	DeleteAssetFileConnection(ctx context.Context, fileID string) error
+}
+
+type assetFileConnectionsService struct {
+	*Client
+}
+
+// GetAllAssetFileConnections forwards to AssetFileConnectionsService.GetAllAssetFileConnections of c.AssetFileConnections
+func (c *Client) GetAllAssetFileConnections(ctx context.Context) (*GetAllAssetFileConnectionsResp, error) {
+	return c.AssetFileConnections.GetAllAssetFileConnections(ctx)
+}
+
+// GetAssetFileConnectionsPage forwards to AssetFileConnectionsService.GetAssetFileConnectionsPage of c.AssetFileConnections
+func (c *Client) GetAssetFileConnectionsPage(ctx context.Context, page *PageOptions) ([]AssetFileConnection, *MetaInformation, error) {
+	return c.AssetFileConnections.GetAssetFileConnectionsPage(ctx, page)
+}
+
+// IterateAssetFileConnections forwards to AssetFileConnectionsService.IterateAssetFileConnections of c.AssetFileConnections
+func (c *Client) IterateAssetFileConnections() *Iterator[AssetFileConnection] {
+	return c.AssetFileConnections.IterateAssetFileConnections()
+}
+
+// CreateAssetFileConnection forwards to AssetFileConnectionsService.CreateAssetFileConnection of c.AssetFileConnections
+func (c *Client) CreateAssetFileConnection(ctx context.Context, req *CreateAssetFileConnectionReq) (*AssetFileConnection, error) {
+	return c.AssetFileConnections.CreateAssetFileConnection(ctx, req)
+}
+
+// DeleteAssetFileConnection forwards to AssetFileConnectionsService.DeleteAssetFileConnection of c.AssetFileConnections
+func (c *Client) DeleteAssetFileConnection(ctx context.Context, fileID string) error {
+	return c.AssetFileConnections.DeleteAssetFileConnection(ctx, fileID)
+}
+
+// AssetTypesService groups the calls reached as Client.AssetTypes, fortnoxfake.AssetTypes fakes it
+type AssetTypesService interface {
+	// GetAssetType does _GET https://api.fortnox.se/3/assets/types/{id}
+	//
+	// id - id
+	GetAssetType(ctx context.Context, id int) (*AssetType, error)
+
+	// CreateAssetType does _POST https://api.fortnox.se/3/assets/types
+	//
+	// at - asset type to create
+	CreateAssetType(ctx context.Context, at *AssetType) (*AssetType, error)
+
+	// UpdateAssetType does _PUT https://api.fortnox.se/3/assets/types/{id}
+	//
+	// id - id
+	//
+	// at - asset type to update, only Description and Notes can be changed
+	UpdateAssetType(ctx context.Context, id int, at *AssetType) (*AssetType, error)
+
+	// DeleteAssetType does _DELETE https://api.fortnox.se/3/assets/types/{id}
+	//
+	// id - id
+	DeleteAssetType(ctx context.Context, id int) error
+
+	// GetAllAssetTypes does _GET https://api.fortnox.se/3/assets/types/
+	GetAllAssetTypes(ctx context.Context) ([]AssetType, error)
+
+	// GetAssetTypesPage does _GET https://api.fortnox.se/3/assets/types/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetAssetTypesPage(ctx context.Context, page *PageOptions) ([]AssetType, *MetaInformation, error)
+
+	// IterateAssetTypes lazily walks all pages of https://api.fortnox.se/3/assets/types/
+	IterateAssetTypes() *Iterator[AssetType]
+}
+
+type assetTypesService struct {
+	*Client
+}
+
+// GetAssetType forwards to AssetTypesService.GetAssetType of c.AssetTypes
+func (c *Client) GetAssetType(ctx context.Context, id int) (*AssetType, error) {
+	return c.AssetTypes.GetAssetType(ctx, id)
+}
+
+// CreateAssetType forwards to AssetTypesService.CreateAssetType of c.AssetTypes
+func (c *Client) CreateAssetType(ctx context.Context, at *AssetType) (*AssetType, error) {
+	return c.AssetTypes.CreateAssetType(ctx, at)
+}
+
+// UpdateAssetType forwards to AssetTypesService.UpdateAssetType of c.AssetTypes
+func (c *Client) UpdateAssetType(ctx context.Context, id int, at *AssetType) (*AssetType, error) {
+	return c.AssetTypes.UpdateAssetType(ctx, id, at)
+}
+
+// DeleteAssetType forwards to AssetTypesService.DeleteAssetType of c.AssetTypes
+func (c *Client) DeleteAssetType(ctx context.Context, id int) error {
+	return c.AssetTypes.DeleteAssetType(ctx, id)
+}
+
+// GetAllAssetTypes forwards to AssetTypesService.GetAllAssetTypes of c.AssetTypes
+func (c *Client) GetAllAssetTypes(ctx context.Context) ([]AssetType, error) {
+	return c.AssetTypes.GetAllAssetTypes(ctx)
+}
+
+// GetAssetTypesPage forwards to AssetTypesService.GetAssetTypesPage of c.AssetTypes
+func (c *Client) GetAssetTypesPage(ctx context.Context, page *PageOptions) ([]AssetType, *MetaInformation, error) {
+	return c.AssetTypes.GetAssetTypesPage(ctx, page)
+}
+
+// IterateAssetTypes forwards to AssetTypesService.IterateAssetTypes of c.AssetTypes
+func (c *Client) IterateAssetTypes() *Iterator[AssetType] {
+	return c.AssetTypes.IterateAssetTypes()
+}
+
+// AssetsService groups the calls reached as Client.Assets, fortnoxfake.Assets fakes it
+type AssetsService interface {
+	// GetAllAssets does _GET https://api.fortnox.se/3/assets/
+	GetAllAssets(ctx context.Context) ([]Asset, error)
+
+	// GetAssetsPage does _GET https://api.fortnox.se/3/assets/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetAssetsPage(ctx context.Context, page *PageOptions) ([]Asset, *MetaInformation, error)
+
+	// IterateAssets lazily walks all pages of https://api.fortnox.se/3/assets/
+	IterateAssets() *Iterator[Asset]
+
+	// CreateAsset does _POST https://api.fortnox.se/3/assets/
+	//
+	// a - asset to create
+	CreateAsset(ctx context.Context, a *Asset) (*Asset, error)
+
+	// GetAsset does _GET https://api.fortnox.se/3/assets/{GivenNumber}
+	//
+	// givenNumber - asset number
+	GetAsset(ctx context.Context, givenNumber string) (*Asset, error)
+
+	// ChangeManualAssetOBValue does _PUT https://api.fortnox.se/3/assets/{GivenNumber}
+	//
+	// givenNumber - Asset number
+	//
+	// req - request
+	ChangeManualAssetOBValue(ctx context.Context, givenNumber int, req *ChangeManualAssetOBValueReq) (*Asset, error)
+
+	// DeleteOrVoidAsset does _DELETE https://api.fortnox.se/3/assets/{GivenNumber}
+	//
+	// givenNumber - asset number
+	//
+	// req - request
+	//
+	// TODO: pass to _DELETE req
+	DeleteOrVoidAsset(ctx context.Context, givenNumber int, req *DeleteOrVoidAssetReq) error
+
+	// GetAssetsDepreciationList does _GET https://api.fortnox.se/3/assets/depreciations/{ToDate}
+	//
+	// toDate - date up to which depreciations are listed
+	GetAssetsDepreciationList(ctx context.Context, toDate Date) ([]Asset, error)
+
+	// WriteUpAsset does _PUT https://api.fortnox.se/3/assets/writeup/{GivenNumber}
+	//
+	// givenNumber - asset number
+	//
+	// req - request
+	WriteUpAsset(ctx context.Context, givenNumber string, req *WriteUpAssetReq) (*Asset, error)
+
+	// WriteDownAsset does _PUT https://api.fortnox.se/3/assets/writedown/{GivenNumber}
+	//
+	// givenNumber - asset number
+	//
+	// req - request
+	WriteDownAsset(ctx context.Context, givenNumber string, req *WriteDownAssetReq) (*Asset, error)
+
+	// ScrapAsset does _PUT https://api.fortnox.se/3/assets/scrap/{GivenNumber}
+	//
+	// givenNumber - asset number
+	//
+	// req - request
+	ScrapAsset(ctx context.Context, givenNumber string, req *ScrapAssetReq) (*Asset, error)
+
+	// SellAsset does _PUT https://api.fortnox.se/3/assets/sell/{GivenNumber}
+	//
+	// givenNumber - asset number
+	//
+	// req - request
+	SellAsset(ctx context.Context, givenNumber string, req *SellAssetReq) (*Asset, error)
+
+	// PerformAssetDepreciation does _POST https://api.fortnox.se/3/assets/depreciate
+	//
+	// req - request
+	PerformAssetDepreciation(ctx context.Context, req *PerformAssetDepreciationReq) (*PerformAssetDepreciationResp, error)
+}
+
+type assetsService struct {
+	*Client
+}
+
+// GetAllAssets forwards to AssetsService.GetAllAssets of c.Assets
+func (c *Client) GetAllAssets(ctx context.Context) ([]Asset, error) {
+	return c.Assets.GetAllAssets(ctx)
+}
+
+// GetAssetsPage forwards to AssetsService.GetAssetsPage of c.Assets
+func (c *Client) GetAssetsPage(ctx context.Context, page *PageOptions) ([]Asset, *MetaInformation, error) {
+	return c.Assets.GetAssetsPage(ctx, page)
+}
+
+// IterateAssets forwards to AssetsService.IterateAssets of c.Assets
+func (c *Client) IterateAssets() *Iterator[Asset] {
+	return c.Assets.IterateAssets()
+}
+
+// CreateAsset forwards to AssetsService.CreateAsset of c.Assets
+func (c *Client) CreateAsset(ctx context.Context, a *Asset) (*Asset, error) {
+	return c.Assets.CreateAsset(ctx, a)
+}
+
+// GetAsset forwards to AssetsService.GetAsset of c.Assets
+func (c *Client) GetAsset(ctx context.Context, givenNumber string) (*Asset, error) {
+	return c.Assets.GetAsset(ctx, givenNumber)
+}
+
+// ChangeManualAssetOBValue forwards to AssetsService.ChangeManualAssetOBValue of c.Assets
+func (c *Client) ChangeManualAssetOBValue(ctx context.Context, givenNumber int, req *ChangeManualAssetOBValueReq) (*Asset, error) {
+	return c.Assets.ChangeManualAssetOBValue(ctx, givenNumber, req)
+}
+
+// DeleteOrVoidAsset forwards to AssetsService.DeleteOrVoidAsset of c.Assets
+func (c *Client) DeleteOrVoidAsset(ctx context.Context, givenNumber int, req *DeleteOrVoidAssetReq) error {
+	return c.Assets.DeleteOrVoidAsset(ctx, givenNumber, req)
+}
+
+// GetAssetsDepreciationList forwards to AssetsService.GetAssetsDepreciationList of c.Assets
+func (c *Client) GetAssetsDepreciationList(ctx context.Context, toDate Date) ([]Asset, error) {
+	return c.Assets.GetAssetsDepreciationList(ctx, toDate)
+}
+
+// WriteUpAsset forwards to AssetsService.WriteUpAsset of c.Assets
+func (c *Client) WriteUpAsset(ctx context.Context, givenNumber string, req *WriteUpAssetReq) (*Asset, error) {
+	return c.Assets.WriteUpAsset(ctx, givenNumber, req)
+}
+
+// WriteDownAsset forwards to AssetsService.WriteDownAsset of c.Assets
+func (c *Client) WriteDownAsset(ctx context.Context, givenNumber string, req *WriteDownAssetReq) (*Asset, error) {
+	return c.Assets.WriteDownAsset(ctx, givenNumber, req)
+}
+
+// ScrapAsset forwards to AssetsService.ScrapAsset of c.Assets
+func (c *Client) ScrapAsset(ctx context.Context, givenNumber string, req *ScrapAssetReq) (*Asset, error) {
+	return c.Assets.ScrapAsset(ctx, givenNumber, req)
+}
+
+// SellAsset forwards to AssetsService.SellAsset of c.Assets
+func (c *Client) SellAsset(ctx context.Context, givenNumber string, req *SellAssetReq) (*Asset, error) {
+	return c.Assets.SellAsset(ctx, givenNumber, req)
+}
+
+// PerformAssetDepreciation forwards to AssetsService.PerformAssetDepreciation of c.Assets
+func (c *Client) PerformAssetDepreciation(ctx context.Context, req *PerformAssetDepreciationReq) (*PerformAssetDepreciationResp, error) {
+	return c.Assets.PerformAssetDepreciation(ctx, req)
+}
+
+// AttendanceTransactionsService groups the calls reached as Client.AttendanceTransactions, fortnoxfake.AttendanceTransactions fakes it
+type AttendanceTransactionsService interface {
+	// GetAllAttendanceTransactions does _GET https://api.fortnox.se/3/attendancetransactions
+	//
+	// filter - GetAllAttendanceTransactionsFilter
+	GetAllAttendanceTransactions(ctx context.Context, filter *GetAllAttendanceTransactionsFilter) ([]AttendanceTransaction, error)
+
+	// GetAttendanceTransactionsPage does _GET https://api.fortnox.se/3/attendancetransactions and returns a single page along with its MetaInformation
+	//
+	// filter - GetAllAttendanceTransactionsFilter
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetAttendanceTransactionsPage(ctx context.Context, filter *GetAllAttendanceTransactionsFilter, page *PageOptions) ([]AttendanceTransaction, *MetaInformation, error)
+
+	// IterateAttendanceTransactions lazily walks all pages of https://api.fortnox.se/3/attendancetransactions
+	//
+	// filter - GetAllAttendanceTransactionsFilter
+	IterateAttendanceTransactions(filter *GetAllAttendanceTransactionsFilter) *Iterator[AttendanceTransaction]
+
+	// CreateAttendanceTransaction does _POST https://api.fortnox.se/3/attendancetransactions
+	//
+	// req - attendance transaction to create
+	CreateAttendanceTransaction(ctx context.Context, at *AttendanceTransaction) (*AttendanceTransaction, error)
+
+	// GetAttendanceTransaction does _GET https://api.fortnox.se/3/attendancetransactions/{id}
+	//
+	// id - identifies the transaction
+	GetAttendanceTransaction(ctx context.Context, id string) (*AttendanceTransaction, error)
+
+	// UpdateAttendanceTransaction does _PUT https://api.fortnox.se/3/attendancetransactions/{id}
+	//
+	// id - identifies the transaction
+	UpdateAttendanceTransaction(ctx context.Context, id string, at *AttendanceTransaction) (*AttendanceTransaction, error)
+}
+
+type attendanceTransactionsService struct {
+	*Client
+}
+
+// GetAllAttendanceTransactions forwards to AttendanceTransactionsService.GetAllAttendanceTransactions of c.AttendanceTransactions
+func (c *Client) GetAllAttendanceTransactions(ctx context.Context, filter *GetAllAttendanceTransactionsFilter) ([]AttendanceTransaction, error) {
+	return c.AttendanceTransactions.GetAllAttendanceTransactions(ctx, filter)
+}
+
+// GetAttendanceTransactionsPage forwards to AttendanceTransactionsService.GetAttendanceTransactionsPage of c.AttendanceTransactions
+func (c *Client) GetAttendanceTransactionsPage(ctx context.Context, filter *GetAllAttendanceTransactionsFilter, page *PageOptions) ([]AttendanceTransaction, *MetaInformation, error) {
+	return c.AttendanceTransactions.GetAttendanceTransactionsPage(ctx, filter, page)
+}
+
+// IterateAttendanceTransactions forwards to AttendanceTransactionsService.IterateAttendanceTransactions of c.AttendanceTransactions
+func (c *Client) IterateAttendanceTransactions(filter *GetAllAttendanceTransactionsFilter) *Iterator[AttendanceTransaction] {
+	return c.AttendanceTransactions.IterateAttendanceTransactions(filter)
+}
+
+// CreateAttendanceTransaction forwards to AttendanceTransactionsService.CreateAttendanceTransaction of c.AttendanceTransactions
+func (c *Client) CreateAttendanceTransaction(ctx context.Context, at *AttendanceTransaction) (*AttendanceTransaction, error) {
+	return c.AttendanceTransactions.CreateAttendanceTransaction(ctx, at)
+}
+
+// GetAttendanceTransaction forwards to AttendanceTransactionsService.GetAttendanceTransaction of c.AttendanceTransactions
+func (c *Client) GetAttendanceTransaction(ctx context.Context, id string) (*AttendanceTransaction, error) {
+	return c.AttendanceTransactions.GetAttendanceTransaction(ctx, id)
+}
+
+// UpdateAttendanceTransaction forwards to AttendanceTransactionsService.UpdateAttendanceTransaction of c.AttendanceTransactions
+func (c *Client) UpdateAttendanceTransaction(ctx context.Context, id string, at *AttendanceTransaction) (*AttendanceTransaction, error) {
+	return c.AttendanceTransactions.UpdateAttendanceTransaction(ctx, id, at)
+}
+
+// CompanyInformationService groups the calls reached as Client.CompanyInformation, fortnoxfake.CompanyInformation fakes it
+type CompanyInformationService interface {
+	// GetCompanyInformation does _GET https://api.fortnox.se/3/companyinformation
+	GetCompanyInformation(ctx context.Context) (*CompanyInformation, error)
+}
+
+type companyInformationService struct {
+	*Client
+}
+
+// GetCompanyInformation forwards to CompanyInformationService.GetCompanyInformation of c.CompanyInformation
+func (c *Client) GetCompanyInformation(ctx context.Context) (*CompanyInformation, error) {
+	return c.CompanyInformation.GetCompanyInformation(ctx)
+}
+
+// CompanySettingsService groups the calls reached as Client.CompanySettings, fortnoxfake.CompanySettings fakes it
+type CompanySettingsService interface {
+	// GetCompanySettings does _GET https://api.fortnox.se/3/settings/company/
+	GetCompanySettings(ctx context.Context) (*CompanySettings, error)
+}
+
+type companySettingsService struct {
+	*Client
+}
+
+// GetCompanySettings forwards to CompanySettingsService.GetCompanySettings of c.CompanySettings
+func (c *Client) GetCompanySettings(ctx context.Context) (*CompanySettings, error) {
+	return c.CompanySettings.GetCompanySettings(ctx)
+}
+
+// ContractAccrualsService groups the calls reached as Client.ContractAccruals, fortnoxfake.ContractAccruals fakes it
+type ContractAccrualsService interface {
+	// GetAllContractAccruals does _GET https://api.fortnox.se/3/contractaccruals/
+	GetAllContractAccruals(ctx context.Context) ([]ShortContractAccrual, error)
+
+	// GetContractAccrualsPage does _GET https://api.fortnox.se/3/contractaccruals/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetContractAccrualsPage(ctx context.Context, page *PageOptions) ([]ShortContractAccrual, *MetaInformation, error)
+
+	// IterateContractAccruals lazily walks all pages of https://api.fortnox.se/3/contractaccruals/
+	IterateContractAccruals() *Iterator[ShortContractAccrual]
+
+	// CreateContractAccrual does _POST https://api.fortnox.se/3/contractaccruals/
+	//
+	// req - contract accrual to create
+	CreateContractAccrual(ctx context.Context, fca *FullContractAccrual) (*FullContractAccrual, error)
+
+	// GetContractAccrual does _GET https://api.fortnox.se/3/contractaccruals/{DocumentNumber}
+	//
+	// documentNumber - identifies the contract accrual
+	GetContractAccrual(ctx context.Context, documentNumber int) (*FullContractAccrual, error)
+
+	// UpdateContractAccrual does _PUT https://api.fortnox.se/3/contractaccruals/{DocumentNumber}
+	//
+	// documentNumber - identifies the contract accrual
+	//
+	// req - contract accruals to update
+	UpdateContractAccrual(ctx context.Context, documentNumber int, fca *FullContractAccrual) (*FullContractAccrual, error)
+
+	// RemoveContractAccrual does _DELETE https://api.fortnox.se/3/contractaccruals/{DocumentNumber}
+	//
+	// documentNumber - identifies the contract accrual
+	RemoveContractAccrual(ctx context.Context, documentNumber int) error
+}
+
+type contractAccrualsService struct {
+	*Client
+}
+
+// GetAllContractAccruals forwards to ContractAccrualsService.GetAllContractAccruals of c.ContractAccruals
+func (c *Client) GetAllContractAccruals(ctx context.Context) ([]ShortContractAccrual, error) {
+	return c.ContractAccruals.GetAllContractAccruals(ctx)
+}
+
+// GetContractAccrualsPage forwards to ContractAccrualsService.GetContractAccrualsPage of c.ContractAccruals
+func (c *Client) GetContractAccrualsPage(ctx context.Context, page *PageOptions) ([]ShortContractAccrual, *MetaInformation, error) {
+	return c.ContractAccruals.GetContractAccrualsPage(ctx, page)
+}
+
+// IterateContractAccruals forwards to ContractAccrualsService.IterateContractAccruals of c.ContractAccruals
+func (c *Client) IterateContractAccruals() *Iterator[ShortContractAccrual] {
+	return c.ContractAccruals.IterateContractAccruals()
+}
+
+// CreateContractAccrual forwards to ContractAccrualsService.CreateContractAccrual of c.ContractAccruals
+func (c *Client) CreateContractAccrual(ctx context.Context, fca *FullContractAccrual) (*FullContractAccrual, error) {
+	return c.ContractAccruals.CreateContractAccrual(ctx, fca)
+}
+
+// GetContractAccrual forwards to ContractAccrualsService.GetContractAccrual of c.ContractAccruals
+func (c *Client) GetContractAccrual(ctx context.Context, documentNumber int) (*FullContractAccrual, error) {
+	return c.ContractAccruals.GetContractAccrual(ctx, documentNumber)
+}
+
+// UpdateContractAccrual forwards to ContractAccrualsService.UpdateContractAccrual of c.ContractAccruals
+func (c *Client) UpdateContractAccrual(ctx context.Context, documentNumber int, fca *FullContractAccrual) (*FullContractAccrual, error) {
+	return c.ContractAccruals.UpdateContractAccrual(ctx, documentNumber, fca)
+}
+
+// RemoveContractAccrual forwards to ContractAccrualsService.RemoveContractAccrual of c.ContractAccruals
+func (c *Client) RemoveContractAccrual(ctx context.Context, documentNumber int) error {
+	return c.ContractAccruals.RemoveContractAccrual(ctx, documentNumber)
+}
+
+// ContractTemplatesService groups the calls reached as Client.ContractTemplates, fortnoxfake.ContractTemplates fakes it
+type ContractTemplatesService interface {
+	// GetAllContractTemplates does _GET https://api.fortnox.se/3/contracttemplates/
+	GetAllContractTemplates(ctx context.Context) (*GetAllContractTemplatesResp, error)
+
+	// GetContractTemplatesPage does _GET https://api.fortnox.se/3/contracttemplates/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetContractTemplatesPage(ctx context.Context, page *PageOptions) ([]ShortContractTemplate, *MetaInformation, error)
+
+	// IterateContractTemplates lazily walks all pages of https://api.fortnox.se/3/contracttemplates/
+	IterateContractTemplates() *Iterator[ShortContractTemplate]
+
+	// CreateContractTemplate does _POST https://api.fortnox.se/3/contracttemplates/
+	//
+	// req - contract template to create
+	CreateContractTemplate(ctx context.Context, req *CreateContractTemplateReq) (*CreateContractTemplateResp, error)
+
+	// GetContractTemplate does _GET https://api.fortnox.se/3/contracttemplates/{DocumentNumber}
+	//
+	// templateNumber - identifies the contract accrual
+	GetContractTemplate(ctx context.Context, templateNumber int) (*GetContractTemplateResp, error)
+
+	// UpdateContractTemplate does _PUT https://api.fortnox.se/3/contracttemplates/{DocumentNumber}
+	//
+	// templateNumber - identifies the contract accrual
+	//
+	// req - contract template to update
+	UpdateContractTemplate(ctx context.Context, templateNumber int, req *UpdateContractTemplateReq) (*UpdateContractTemplateResp, error)
+}
+
+type contractTemplatesService struct {
+	*Client
+}
+
+// GetAllContractTemplates forwards to ContractTemplatesService.GetAllContractTemplates of c.ContractTemplates
+func (c *Client) GetAllContractTemplates(ctx context.Context) (*GetAllContractTemplatesResp, error) {
+	return c.ContractTemplates.GetAllContractTemplates(ctx)
+}
+
+// GetContractTemplatesPage forwards to ContractTemplatesService.GetContractTemplatesPage of c.ContractTemplates
+func (c *Client) GetContractTemplatesPage(ctx context.Context, page *PageOptions) ([]ShortContractTemplate, *MetaInformation, error) {
+	return c.ContractTemplates.GetContractTemplatesPage(ctx, page)
+}
+
+// IterateContractTemplates forwards to ContractTemplatesService.IterateContractTemplates of c.ContractTemplates
+func (c *Client) IterateContractTemplates() *Iterator[ShortContractTemplate] {
+	return c.ContractTemplates.IterateContractTemplates()
+}
+
+// CreateContractTemplate forwards to ContractTemplatesService.CreateContractTemplate of c.ContractTemplates
+func (c *Client) CreateContractTemplate(ctx context.Context, req *CreateContractTemplateReq) (*CreateContractTemplateResp, error) {
+	return c.ContractTemplates.CreateContractTemplate(ctx, req)
+}
+
+// GetContractTemplate forwards to ContractTemplatesService.GetContractTemplate of c.ContractTemplates
+func (c *Client) GetContractTemplate(ctx context.Context, templateNumber int) (*GetContractTemplateResp, error) {
+	return c.ContractTemplates.GetContractTemplate(ctx, templateNumber)
+}
+
+// UpdateContractTemplate forwards to ContractTemplatesService.UpdateContractTemplate of c.ContractTemplates
+func (c *Client) UpdateContractTemplate(ctx context.Context, templateNumber int, req *UpdateContractTemplateReq) (*UpdateContractTemplateResp, error) {
+	return c.ContractTemplates.UpdateContractTemplate(ctx, templateNumber, req)
+}
+
+// ContractsService groups the calls reached as Client.Contracts, fortnoxfake.Contracts fakes it
+type ContractsService interface {
+	// GetContract does _GET https://api.fortnox.se/3/contracts/{DocumentNumber}
+	//
+	// documentNumber - identifies the contract
+	GetContract(ctx context.Context, documentNumber string) (*Contract, error)
+
+	// UpdateContract does _PUT https://api.fortnox.se/3/contracts/{DocumentNumber}
+	//
+	// documentNumber - identifies the contract
+	//
+	// con - contract to update
+	UpdateContract(ctx context.Context, documentNumber int, con *Contract) (*Contract, error)
+
+	// GetAllContract does _GET https://api.fortnox.se/3/contracts/
+	//
+	// filter - Enum: "active" "inactive" "finished"  possibility to filter contracts
+	GetAllContract(ctx context.Context, filter GetAllContractFilter) ([]Contract, error)
+
+	// GetContractsPage does _GET https://api.fortnox.se/3/contracts/ and returns a single page along with its MetaInformation
+	//
+	// filter - Enum: "active" "inactive" "finished"  possibility to filter contracts
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetContractsPage(ctx context.Context, filter GetAllContractFilter, page *PageOptions) ([]Contract, *MetaInformation, error)
+
+	// IterateContracts lazily walks all pages of https://api.fortnox.se/3/contracts/
+	//
+	// filter - Enum: "active" "inactive" "finished"  possibility to filter contracts
+	IterateContracts(filter GetAllContractFilter) *Iterator[Contract]
+
+	// CreateContract does _POST https://api.fortnox.se/3/contracts/
+	//
+	// con - contract to create
+	CreateContract(ctx context.Context, con *Contract) (*Contract, error)
+
+	// SetContractAsFinished does _PUT https://api.fortnox.se/3/contracts/{DocumentNumber}/finish
+	//
+	// documentNumber - identifies the contract
+	SetContractAsFinished(ctx context.Context, documentNumber string) (*Contract, error)
+
+	// CreateInvoiceFromContract does _PUT https://api.fortnox.se/3/contracts/{DocumentNumber}/createinvoice
+	//
+	// documentNumber - identifies the contract
+	CreateInvoiceFromContract(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// IncreaseInvoiceCount does _PUT https://api.fortnox.se/3/contracts/{DocumentNumber}/increaseinvoicecount
+	//
+	// documentNumber - identifies the contract
+	IncreaseInvoiceCount(ctx context.Context, documentNumber string) (*Contract, error)
+}
+
+type contractsService struct {
+	*Client
+}
+
+// GetContract forwards to ContractsService.GetContract of c.Contracts
+func (c *Client) GetContract(ctx context.Context, documentNumber string) (*Contract, error) {
+	return c.Contracts.GetContract(ctx, documentNumber)
+}
+
+// UpdateContract forwards to ContractsService.UpdateContract of c.Contracts
+func (c *Client) UpdateContract(ctx context.Context, documentNumber int, con *Contract) (*Contract, error) {
+	return c.Contracts.UpdateContract(ctx, documentNumber, con)
+}
+
+// GetAllContract forwards to ContractsService.GetAllContract of c.Contracts
+func (c *Client) GetAllContract(ctx context.Context, filter GetAllContractFilter) ([]Contract, error) {
+	return c.Contracts.GetAllContract(ctx, filter)
+}
+
+// GetContractsPage forwards to ContractsService.GetContractsPage of c.Contracts
+func (c *Client) GetContractsPage(ctx context.Context, filter GetAllContractFilter, page *PageOptions) ([]Contract, *MetaInformation, error) {
+	return c.Contracts.GetContractsPage(ctx, filter, page)
+}
+
+// IterateContracts forwards to ContractsService.IterateContracts of c.Contracts
+func (c *Client) IterateContracts(filter GetAllContractFilter) *Iterator[Contract] {
+	return c.Contracts.IterateContracts(filter)
+}
+
+// CreateContract forwards to ContractsService.CreateContract of c.Contracts
+func (c *Client) CreateContract(ctx context.Context, con *Contract) (*Contract, error) {
+	return c.Contracts.CreateContract(ctx, con)
+}
+
+// SetContractAsFinished forwards to ContractsService.SetContractAsFinished of c.Contracts
+func (c *Client) SetContractAsFinished(ctx context.Context, documentNumber string) (*Contract, error) {
+	return c.Contracts.SetContractAsFinished(ctx, documentNumber)
+}
+
+// CreateInvoiceFromContract forwards to ContractsService.CreateInvoiceFromContract of c.Contracts
+func (c *Client) CreateInvoiceFromContract(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Contracts.CreateInvoiceFromContract(ctx, documentNumber)
+}
+
+// IncreaseInvoiceCount forwards to ContractsService.IncreaseInvoiceCount of c.Contracts
+func (c *Client) IncreaseInvoiceCount(ctx context.Context, documentNumber string) (*Contract, error) {
+	return c.Contracts.IncreaseInvoiceCount(ctx, documentNumber)
+}
+
+// CostCentersService groups the calls reached as Client.CostCenters, fortnoxfake.CostCenters fakes it
+type CostCentersService interface {
+	// GetAllCostCenters does _GET https://api.fortnox.se/3/costcenters
+	GetAllCostCenters(ctx context.Context) ([]CostCenter, error)
+
+	// GetCostCentersPage does _GET https://api.fortnox.se/3/costcenters and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetCostCentersPage(ctx context.Context, page *PageOptions) ([]CostCenter, *MetaInformation, error)
+
+	// IterateCostCenters lazily walks all pages of https://api.fortnox.se/3/costcenters
+	IterateCostCenters() *Iterator[CostCenter]
+
+	// CreateCostCenter does _POST https://api.fortnox.se/3/costcenters
+	//
+	// cc - cost center to create
+	CreateCostCenter(ctx context.Context, cc *CostCenter) (*CostCenter, error)
+
+	// GetCostCenter does _GET https://api.fortnox.se/3/costcenters/{Code}
+	//
+	// code - identifies the cost center
+	GetCostCenter(ctx context.Context, code string) (*CostCenter, error)
+
+	// UpdateCostCenter does _PUT https://api.fortnox.se/3/costcenters/{Code}
+	//
+	// code - identifies the cost center
+	//
+	// cc - cost center to update
+	UpdateCostCenter(ctx context.Context, code string, cc *CostCenter) (*CostCenter, error)
+
+	// RemoveCostCenter does _DELETE https://api.fortnox.se/3/costcenters/{Code}
+	//
+	// code - identifies the cost center to remove
+	RemoveCostCenter(ctx context.Context, code string) error
+}
+
+type costCentersService struct {
+	*Client
+}
+
+// GetAllCostCenters forwards to CostCentersService.GetAllCostCenters of c.CostCenters
+func (c *Client) GetAllCostCenters(ctx context.Context) ([]CostCenter, error) {
+	return c.CostCenters.GetAllCostCenters(ctx)
+}
+
+// GetCostCentersPage forwards to CostCentersService.GetCostCentersPage of c.CostCenters
+func (c *Client) GetCostCentersPage(ctx context.Context, page *PageOptions) ([]CostCenter, *MetaInformation, error) {
+	return c.CostCenters.GetCostCentersPage(ctx, page)
+}
+
+// IterateCostCenters forwards to CostCentersService.IterateCostCenters of c.CostCenters
+func (c *Client) IterateCostCenters() *Iterator[CostCenter] {
+	return c.CostCenters.IterateCostCenters()
+}
+
+// CreateCostCenter forwards to CostCentersService.CreateCostCenter of c.CostCenters
+func (c *Client) CreateCostCenter(ctx context.Context, cc *CostCenter) (*CostCenter, error) {
+	return c.CostCenters.CreateCostCenter(ctx, cc)
+}
+
+// GetCostCenter forwards to CostCentersService.GetCostCenter of c.CostCenters
+func (c *Client) GetCostCenter(ctx context.Context, code string) (*CostCenter, error) {
+	return c.CostCenters.GetCostCenter(ctx, code)
+}
+
+// UpdateCostCenter forwards to CostCentersService.UpdateCostCenter of c.CostCenters
+func (c *Client) UpdateCostCenter(ctx context.Context, code string, cc *CostCenter) (*CostCenter, error) {
+	return c.CostCenters.UpdateCostCenter(ctx, code, cc)
+}
+
+// RemoveCostCenter forwards to CostCentersService.RemoveCostCenter of c.CostCenters
+func (c *Client) RemoveCostCenter(ctx context.Context, code string) error {
+	return c.CostCenters.RemoveCostCenter(ctx, code)
+}
+
+// CurrenciesService groups the calls reached as Client.Currencies, fortnoxfake.Currencies fakes it
+type CurrenciesService interface {
+	// GetAllCurrencies does _GET https://api.fortnox.se/3/currencies
+	GetAllCurrencies(ctx context.Context) ([]Currency, error)
+
+	// GetCurrenciesPage does _GET https://api.fortnox.se/3/currencies and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetCurrenciesPage(ctx context.Context, page *PageOptions) ([]Currency, *MetaInformation, error)
+
+	// IterateCurrencies lazily walks all pages of https://api.fortnox.se/3/currencies
+	IterateCurrencies() *Iterator[Currency]
+
+	// CreateCurrency does _POST https://api.fortnox.se/3/currencies
+	//
+	// req - currency to create
+	CreateCurrency(ctx context.Context, cur *Currency) (*Currency, error)
+
+	// GetCurrency does _GET https://api.fortnox.se/3/currencies/{Code}
+	//
+	// code - identifies currency
+	GetCurrency(ctx context.Context, code string) (*Currency, error)
+
+	// UpdateCurrency does _PUT https://api.fortnox.se/3/currencies/{Code}
+	//
+	// code - identifies currency
+	//
+	// req - currency to update
+	UpdateCurrency(ctx context.Context, code string, cur *Currency) (*Currency, error)
+
+	// RemoveCurrency does _DELETE
+	//
+	// code - identifies the currency to remove
+	RemoveCurrency(ctx context.Context, code string) error
+}
+
+type currenciesService struct {
+	*Client
+}
+
+// GetAllCurrencies forwards to CurrenciesService.GetAllCurrencies of c.Currencies
+func (c *Client) GetAllCurrencies(ctx context.Context) ([]Currency, error) {
+	return c.Currencies.GetAllCurrencies(ctx)
+}
+
+// GetCurrenciesPage forwards to CurrenciesService.GetCurrenciesPage of c.Currencies
+func (c *Client) GetCurrenciesPage(ctx context.Context, page *PageOptions) ([]Currency, *MetaInformation, error) {
+	return c.Currencies.GetCurrenciesPage(ctx, page)
+}
+
+// IterateCurrencies forwards to CurrenciesService.IterateCurrencies of c.Currencies
+func (c *Client) IterateCurrencies() *Iterator[Currency] {
+	return c.Currencies.IterateCurrencies()
+}
+
+// CreateCurrency forwards to CurrenciesService.CreateCurrency of c.Currencies
+func (c *Client) CreateCurrency(ctx context.Context, cur *Currency) (*Currency, error) {
+	return c.Currencies.CreateCurrency(ctx, cur)
+}
+
+// GetCurrency forwards to CurrenciesService.GetCurrency of c.Currencies
+func (c *Client) GetCurrency(ctx context.Context, code string) (*Currency, error) {
+	return c.Currencies.GetCurrency(ctx, code)
+}
+
+// UpdateCurrency forwards to CurrenciesService.UpdateCurrency of c.Currencies
+func (c *Client) UpdateCurrency(ctx context.Context, code string, cur *Currency) (*Currency, error) {
+	return c.Currencies.UpdateCurrency(ctx, code, cur)
+}
+
+// RemoveCurrency forwards to CurrenciesService.RemoveCurrency of c.Currencies
+func (c *Client) RemoveCurrency(ctx context.Context, code string) error {
+	return c.Currencies.RemoveCurrency(ctx, code)
+}
+
+// CustomerReferencesService groups the calls reached as Client.CustomerReferences, fortnoxfake.CustomerReferences fakes it
+type CustomerReferencesService interface {
+	// GetAllCustomerReferences does _GET https://api.fortnox.se/3/customerreferences/
+	GetAllCustomerReferences(ctx context.Context) (*GetAllCustomerReferencesResp, error)
+
+	// GetCustomerReferencesPage does _GET https://api.fortnox.se/3/customerreferences/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetCustomerReferencesPage(ctx context.Context, page *PageOptions) ([]CustomerReferenceRow, *MetaInformation, error)
+
+	// IterateCustomerReferences lazily walks all pages of https://api.fortnox.se/3/customerreferences/
+	IterateCustomerReferences() *Iterator[CustomerReferenceRow]
+
+	// CreateCustomerReference does _POST https://api.fortnox.se/3/customerreferences/
+	//
+	// req - customer reference row to create
+	CreateCustomerReference(ctx context.Context, req *CreateCustomerReferenceReq) (*CreateCustomerReferenceResp, error)
+
+	// GetCustomerReference does _GET https://api.fortnox.se/3/customerreferences/{CustomerReferenceRowId}
+	//
+	// customerReferenceRowID - identifies the customer reference row
+	GetCustomerReference(ctx context.Context, customerReferenceRowID string) (*GetCustomerReferenceResp, error)
+
+	// UpdateCustomerReference does _PUT https://api.fortnox.se/3/customerreferences/{CustomerReferenceRowId}
+	//
+	// customerReferenceRowID - identifies the customer reference row
+	//
+	// req - customer reference row to update
+	UpdateCustomerReference(ctx context.Context, customerReferenceRowID string, req *UpdateCustomerReferenceReq) (*UpdateCustomerReferenceResp, error)
+
+	// DeleteCustomerReferenceRow does _DELETE https://api.fortnox.se/3/customerreferences/{CustomerReferenceRowId}
+	//
+	// customerReferenceRowID - identifies the customer reference row
+	DeleteCustomerReferenceRow(ctx context.Context, customerReferenceRowID string) error
+}
+
+type customerReferencesService struct {
+	*Client
+}
+
+// GetAllCustomerReferences forwards to CustomerReferencesService.GetAllCustomerReferences of c.CustomerReferences
+func (c *Client) GetAllCustomerReferences(ctx context.Context) (*GetAllCustomerReferencesResp, error) {
+	return c.CustomerReferences.GetAllCustomerReferences(ctx)
+}
+
+// GetCustomerReferencesPage forwards to CustomerReferencesService.GetCustomerReferencesPage of c.CustomerReferences
+func (c *Client) GetCustomerReferencesPage(ctx context.Context, page *PageOptions) ([]CustomerReferenceRow, *MetaInformation, error) {
+	return c.CustomerReferences.GetCustomerReferencesPage(ctx, page)
+}
+
+// IterateCustomerReferences forwards to CustomerReferencesService.IterateCustomerReferences of c.CustomerReferences
+func (c *Client) IterateCustomerReferences() *Iterator[CustomerReferenceRow] {
+	return c.CustomerReferences.IterateCustomerReferences()
+}
+
+// CreateCustomerReference forwards to CustomerReferencesService.CreateCustomerReference of c.CustomerReferences
+func (c *Client) CreateCustomerReference(ctx context.Context, req *CreateCustomerReferenceReq) (*CreateCustomerReferenceResp, error) {
+	return c.CustomerReferences.CreateCustomerReference(ctx, req)
+}
+
+// GetCustomerReference forwards to CustomerReferencesService.GetCustomerReference of c.CustomerReferences
+func (c *Client) GetCustomerReference(ctx context.Context, customerReferenceRowID string) (*GetCustomerReferenceResp, error) {
+	return c.CustomerReferences.GetCustomerReference(ctx, customerReferenceRowID)
+}
+
+// UpdateCustomerReference forwards to CustomerReferencesService.UpdateCustomerReference of c.CustomerReferences
+func (c *Client) UpdateCustomerReference(ctx context.Context, customerReferenceRowID string, req *UpdateCustomerReferenceReq) (*UpdateCustomerReferenceResp, error) {
+	return c.CustomerReferences.UpdateCustomerReference(ctx, customerReferenceRowID, req)
+}
+
+// DeleteCustomerReferenceRow forwards to CustomerReferencesService.DeleteCustomerReferenceRow of c.CustomerReferences
+func (c *Client) DeleteCustomerReferenceRow(ctx context.Context, customerReferenceRowID string) error {
+	return c.CustomerReferences.DeleteCustomerReferenceRow(ctx, customerReferenceRowID)
+}
+
+// CustomersService groups the calls reached as Client.Customers, fortnoxfake.Customers fakes it
+type CustomersService interface {
+	// GetAllCustomers does _GET https://api.fortnox.se/3/customers/
+	GetAllCustomers(ctx context.Context) ([]Customer, error)
+
+	// GetCustomersPage does _GET https://api.fortnox.se/3/customers/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetCustomersPage(ctx context.Context, page *PageOptions) ([]Customer, *MetaInformation, error)
+
+	// IterateCustomers lazily walks all pages of https://api.fortnox.se/3/customers/
+	IterateCustomers() *Iterator[Customer]
+
+	// CreateCustomer does _POST https://api.fortnox.se/3/customers/
+	//
+	// req - customer to create
+	CreateCustomer(ctx context.Context, cus *Customer) (*Customer, error)
+
+	// GetCustomer does _GET https://api.fortnox.se/3/customers/{CustomerId}
+	//
+	// customerNumber - identifies the customer
+	GetCustomer(ctx context.Context, customerNumber string) (*Customer, error)
+
+	// UpdateCustomer does _PUT https://api.fortnox.se/3/customers/{CustomerNumber}
+	//
+	// customerNumber - identifies the customer
+	//
+	// cus - fields to update, only fields that are Set are sent
+	UpdateCustomer(ctx context.Context, customerNumber string, cus *CustomerUpdate) (*Customer, error)
+
+	// PatchCustomer does _PUT https://api.fortnox.se/3/customers/{CustomerNumber} sending only the fields changed between original and edited
+	//
+	// original - customer as returned by Fortnox
+	//
+	// edited - modified copy of original
+	//
+	// Nothing is sent when no writable field changed, original is returned then.
+	PatchCustomer(ctx context.Context, original, edited *Customer) (*Customer, error)
+
+	// DeleteCustomer does _DELETE https://api.fortnox.se/3/customers/{CustomerNumber}
+	//
+	// customerNumber - identifies the customer
+	DeleteCustomer(ctx context.Context, customerNumber string) error
+}
+
+type customersService struct {
+	*Client
+}
+
+// GetAllCustomers forwards to CustomersService.GetAllCustomers of c.Customers
+func (c *Client) GetAllCustomers(ctx context.Context) ([]Customer, error) {
+	return c.Customers.GetAllCustomers(ctx)
+}
+
+// GetCustomersPage forwards to CustomersService.GetCustomersPage of c.Customers
+func (c *Client) GetCustomersPage(ctx context.Context, page *PageOptions) ([]Customer, *MetaInformation, error) {
+	return c.Customers.GetCustomersPage(ctx, page)
+}
+
+// IterateCustomers forwards to CustomersService.IterateCustomers of c.Customers
+func (c *Client) IterateCustomers() *Iterator[Customer] {
+	return c.Customers.IterateCustomers()
+}
+
+// CreateCustomer forwards to CustomersService.CreateCustomer of c.Customers
+func (c *Client) CreateCustomer(ctx context.Context, cus *Customer) (*Customer, error) {
+	return c.Customers.CreateCustomer(ctx, cus)
+}
+
+// GetCustomer forwards to CustomersService.GetCustomer of c.Customers
+func (c *Client) GetCustomer(ctx context.Context, customerNumber string) (*Customer, error) {
+	return c.Customers.GetCustomer(ctx, customerNumber)
+}
+
+// UpdateCustomer forwards to CustomersService.UpdateCustomer of c.Customers
+func (c *Client) UpdateCustomer(ctx context.Context, customerNumber string, cus *CustomerUpdate) (*Customer, error) {
+	return c.Customers.UpdateCustomer(ctx, customerNumber, cus)
+}
+
+// PatchCustomer forwards to CustomersService.PatchCustomer of c.Customers
+func (c *Client) PatchCustomer(ctx context.Context, original, edited *Customer) (*Customer, error) {
+	return c.Customers.PatchCustomer(ctx, original, edited)
+}
+
+// DeleteCustomer forwards to CustomersService.DeleteCustomer of c.Customers
+func (c *Client) DeleteCustomer(ctx context.Context, customerNumber string) error {
+	return c.Customers.DeleteCustomer(ctx, customerNumber)
+}
+
+// EUVatLimitRegulationService groups the calls reached as Client.EUVatLimitRegulation, fortnoxfake.EUVatLimitRegulation fakes it
+type EUVatLimitRegulationService interface {
+	// GetEUVATLimitDetails does _GET https://api.fortnox.se/3/euvatlimitregulation/
+	GetEUVATLimitDetails(ctx context.Context) (*EUVatLimitRegulation, error)
+}
+
+type euVatLimitRegulationService struct {
+	*Client
+}
+
+// GetEUVATLimitDetails forwards to EUVatLimitRegulationService.GetEUVATLimitDetails of c.EUVatLimitRegulation
+func (c *Client) GetEUVATLimitDetails(ctx context.Context) (*EUVatLimitRegulation, error) {
+	return c.EUVatLimitRegulation.GetEUVATLimitDetails(ctx)
+}
+
+// EmployeesService groups the calls reached as Client.Employees, fortnoxfake.Employees fakes it
+type EmployeesService interface {
+	// GetAllEmployees does _GET https://api.fortnox.se/3/employees/
+	GetAllEmployees(ctx context.Context) ([]Employee, error)
+
+	// GetEmployeesPage does _GET https://api.fortnox.se/3/employees/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetEmployeesPage(ctx context.Context, page *PageOptions) ([]Employee, *MetaInformation, error)
+
+	// IterateEmployees lazily walks all pages of https://api.fortnox.se/3/employees/
+	IterateEmployees() *Iterator[Employee]
+
+	// CreateEmployee does _POST https://api.fortnox.se/3/employees/
+	//
+	// e - employee to create
+	CreateEmployee(ctx context.Context, e *Employee) (*Employee, error)
+
+	// GetEmployee does _GET https://api.fortnox.se/3/employees/{EmployeeId}
+	//
+	// employeeID - identifies the employee
+	GetEmployee(ctx context.Context, employeeID string) (*Employee, error)
+
+	// UpdateEmployee does _PUT https://api.fortnox.se/3/employees/{EmployeeId}
+	//
+	// employeeID - identifies the employee
+	//
+	// e - employee to update
+	UpdateEmployee(ctx context.Context, employeeID string, e *Employee) (*Employee, error)
+}
+
+type employeesService struct {
+	*Client
+}
+
+// GetAllEmployees forwards to EmployeesService.GetAllEmployees of c.Employees
+func (c *Client) GetAllEmployees(ctx context.Context) ([]Employee, error) {
+	return c.Employees.GetAllEmployees(ctx)
+}
+
+// GetEmployeesPage forwards to EmployeesService.GetEmployeesPage of c.Employees
+func (c *Client) GetEmployeesPage(ctx context.Context, page *PageOptions) ([]Employee, *MetaInformation, error) {
+	return c.Employees.GetEmployeesPage(ctx, page)
+}
+
+// IterateEmployees forwards to EmployeesService.IterateEmployees of c.Employees
+func (c *Client) IterateEmployees() *Iterator[Employee] {
+	return c.Employees.IterateEmployees()
+}
+
+// CreateEmployee forwards to EmployeesService.CreateEmployee of c.Employees
+func (c *Client) CreateEmployee(ctx context.Context, e *Employee) (*Employee, error) {
+	return c.Employees.CreateEmployee(ctx, e)
+}
+
+// GetEmployee forwards to EmployeesService.GetEmployee of c.Employees
+func (c *Client) GetEmployee(ctx context.Context, employeeID string) (*Employee, error) {
+	return c.Employees.GetEmployee(ctx, employeeID)
+}
+
+// UpdateEmployee forwards to EmployeesService.UpdateEmployee of c.Employees
+func (c *Client) UpdateEmployee(ctx context.Context, employeeID string, e *Employee) (*Employee, error) {
+	return c.Employees.UpdateEmployee(ctx, employeeID, e)
+}
+
+// ExpensesService groups the calls reached as Client.Expenses, fortnoxfake.Expenses fakes it
+type ExpensesService interface {
+	// GetAllExpenses does _GET https://api.fortnox.se/3/expenses/
+	GetAllExpenses(ctx context.Context) ([]Expense, error)
+
+	// GetExpensesPage does _GET https://api.fortnox.se/3/expenses/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetExpensesPage(ctx context.Context, page *PageOptions) ([]Expense, *MetaInformation, error)
+
+	// IterateExpenses lazily walks all pages of https://api.fortnox.se/3/expenses/
+	IterateExpenses() *Iterator[Expense]
+
+	// CreateExpense does _POST https://api.fortnox.se/3/expenses/
+	//
+	// e - expense to create
+	CreateExpense(ctx context.Context, e *Expense) (*Expense, error)
+
+	// GetExpense does _GET https://api.fortnox.se/3/expenses/{ExpenseCode}
+	//
+	// expenseCode - expenseCode
+	GetExpense(ctx context.Context, expenseCode string) (*Expense, error)
+}
+
+type expensesService struct {
+	*Client
+}
+
+// GetAllExpenses forwards to ExpensesService.GetAllExpenses of c.Expenses
+func (c *Client) GetAllExpenses(ctx context.Context) ([]Expense, error) {
+	return c.Expenses.GetAllExpenses(ctx)
+}
+
+// GetExpensesPage forwards to ExpensesService.GetExpensesPage of c.Expenses
+func (c *Client) GetExpensesPage(ctx context.Context, page *PageOptions) ([]Expense, *MetaInformation, error) {
+	return c.Expenses.GetExpensesPage(ctx, page)
+}
+
+// IterateExpenses forwards to ExpensesService.IterateExpenses of c.Expenses
+func (c *Client) IterateExpenses() *Iterator[Expense] {
+	return c.Expenses.IterateExpenses()
+}
+
+// CreateExpense forwards to ExpensesService.CreateExpense of c.Expenses
+func (c *Client) CreateExpense(ctx context.Context, e *Expense) (*Expense, error) {
+	return c.Expenses.CreateExpense(ctx, e)
+}
+
+// GetExpense forwards to ExpensesService.GetExpense of c.Expenses
+func (c *Client) GetExpense(ctx context.Context, expenseCode string) (*Expense, error) {
+	return c.Expenses.GetExpense(ctx, expenseCode)
+}
+
+// FinancialYearsService groups the calls reached as Client.FinancialYears, fortnoxfake.FinancialYears fakes it
+type FinancialYearsService interface {
+	// GetAllFinancialYears does _GET https://api.fortnox.se/3/financialyears
+	//
+	// date - date to filter on, for example 2020-06-30
+	GetAllFinancialYears(ctx context.Context, date *GetAllFinancialYearsFilterDate) ([]FinancialYear, error)
+
+	// GetFinancialYearsPage does _GET https://api.fortnox.se/3/financialyears and returns a single page along with its MetaInformation
+	//
+	// date - date to filter on, for example 2020-06-30
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetFinancialYearsPage(ctx context.Context, date *GetAllFinancialYearsFilterDate, page *PageOptions) ([]FinancialYear, *MetaInformation, error)
+
+	// IterateFinancialYears lazily walks all pages of https://api.fortnox.se/3/financialyears
+	//
+	// date - date to filter on, for example 2020-06-30
+	IterateFinancialYears(date *GetAllFinancialYearsFilterDate) *Iterator[FinancialYear]
+
+	// CreateFinancialYear does _POST https://api.fortnox.se/3/financialyears
+	//
+	// fy - financial year to create
+	CreateFinancialYear(ctx context.Context, fy *FinancialYear) (*FinancialYear, error)
+
+	// GetFinancialYearByID does _GET https://api.fortnox.se/3/financialyears/{Id}
+	//
+	// id - identifies the year
+	GetFinancialYearByID(ctx context.Context, id int) (*FinancialYear, error)
+}
+
+type financialYearsService struct {
+	*Client
+}
+
+// GetAllFinancialYears forwards to FinancialYearsService.GetAllFinancialYears of c.FinancialYears
+func (c *Client) GetAllFinancialYears(ctx context.Context, date *GetAllFinancialYearsFilterDate) ([]FinancialYear, error) {
+	return c.FinancialYears.GetAllFinancialYears(ctx, date)
+}
+
+// GetFinancialYearsPage forwards to FinancialYearsService.GetFinancialYearsPage of c.FinancialYears
+func (c *Client) GetFinancialYearsPage(ctx context.Context, date *GetAllFinancialYearsFilterDate, page *PageOptions) ([]FinancialYear, *MetaInformation, error) {
+	return c.FinancialYears.GetFinancialYearsPage(ctx, date, page)
+}
+
+// IterateFinancialYears forwards to FinancialYearsService.IterateFinancialYears of c.FinancialYears
+func (c *Client) IterateFinancialYears(date *GetAllFinancialYearsFilterDate) *Iterator[FinancialYear] {
+	return c.FinancialYears.IterateFinancialYears(date)
+}
+
+// CreateFinancialYear forwards to FinancialYearsService.CreateFinancialYear of c.FinancialYears
+func (c *Client) CreateFinancialYear(ctx context.Context, fy *FinancialYear) (*FinancialYear, error) {
+	return c.FinancialYears.CreateFinancialYear(ctx, fy)
+}
+
+// GetFinancialYearByID forwards to FinancialYearsService.GetFinancialYearByID of c.FinancialYears
+func (c *Client) GetFinancialYearByID(ctx context.Context, id int) (*FinancialYear, error) {
+	return c.FinancialYears.GetFinancialYearByID(ctx, id)
+}
+
+// InboxService groups the calls reached as Client.Inbox, fortnoxfake.Inbox fakes it
+type InboxService interface {
+	// GetRootDirectory does _GET https://api.fortnox.se/3/inbox/
+	GetRootDirectory(ctx context.Context) (*InboxRootFolder, error)
+
+	// UploadFile does _POST https://api.fortnox.se/3/inbox/
+	//
+	// folderID - folder id
+	//
+	// path - path
+	//
+	// fileName - name of the file in the inbox
+	//
+	// r - file contents, streamed as multipart/form-data
+	UploadFile(ctx context.Context, params *UploadFileParams, fileName string, r io.Reader) (*InboxFile, error)
+
+	// GetInboxFile does _GET https://api.fortnox.se/3/inbox/{Id}
+	//
+	// id - identifies the folder
+	GetInboxFile(ctx context.Context, id string) (*Download, error)
+
+	// RemoveFileOrFolder does _DELETE https://api.fortnox.se/3/inbox/{Id}
+	//
+	// id - identifies the file to remove
+	RemoveFileOrFolder(ctx context.Context, id string) error
+}
+
+type inboxService struct {
+	*Client
+}
+
+// GetRootDirectory forwards to InboxService.GetRootDirectory of c.Inbox
+func (c *Client) GetRootDirectory(ctx context.Context) (*InboxRootFolder, error) {
+	return c.Inbox.GetRootDirectory(ctx)
+}
+
+// UploadFile forwards to InboxService.UploadFile of c.Inbox
+func (c *Client) UploadFile(ctx context.Context, params *UploadFileParams, fileName string, r io.Reader) (*InboxFile, error) {
+	return c.Inbox.UploadFile(ctx, params, fileName, r)
+}
+
+// GetInboxFile forwards to InboxService.GetInboxFile of c.Inbox
+func (c *Client) GetInboxFile(ctx context.Context, id string) (*Download, error) {
+	return c.Inbox.GetInboxFile(ctx, id)
+}
+
+// RemoveFileOrFolder forwards to InboxService.RemoveFileOrFolder of c.Inbox
+func (c *Client) RemoveFileOrFolder(ctx context.Context, id string) error {
+	return c.Inbox.RemoveFileOrFolder(ctx, id)
+}
+
+// InvoiceAccrualsService groups the calls reached as Client.InvoiceAccruals, fortnoxfake.InvoiceAccruals fakes it
+type InvoiceAccrualsService interface {
+	// GetAllInvoiceAccruals does _GET https://api.fortnox.se/3/invoiceaccruals/
+	GetAllInvoiceAccruals(ctx context.Context) (*GetAllInvoiceAccrualsResp, error)
+
+	// GetInvoiceAccrualsPage does _GET https://api.fortnox.se/3/invoiceaccruals/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetInvoiceAccrualsPage(ctx context.Context, page *PageOptions) ([]ShortInvoiceAccrual, *MetaInformation, error)
+
+	// IterateInvoiceAccruals lazily walks all pages of https://api.fortnox.se/3/invoiceaccruals/
+	IterateInvoiceAccruals() *Iterator[ShortInvoiceAccrual]
+
+	// CreateInvoiceAccrual does _POST https://api.fortnox.se/3/invoiceaccruals/
+	//
+	// req - invoice accrual to create
+	CreateInvoiceAccrual(ctx context.Context, req *CreateInvoiceAccrualReq) (*CreateInvoiceAccrualResp, error)
+
+	// GetInvoiceAccrual does _GET https://api.fortnox.se/3/invoiceaccruals/{InvoiceNumber}
+	//
+	// invoiceNumber - identifies the invoice accrual
+	GetInvoiceAccrual(ctx context.Context, invoiceNumber int) (*GetInvoiceAccrualResp, error)
+
+	// UpdateInvoiceAccrual does _PUT https://api.fortnox.se/3/invoiceaccruals/{InvoiceNumber}
+	//
+	// invoiceNumber - identifies the invoice accrual
+	//
+	// req - invoice accrual to update
+	UpdateInvoiceAccrual(ctx context.Context, invoiceNumber int, req *UpdateInvoiceAccrualReq) (*UpdateInvoiceAccrualResp, error)
+
+	// RemoveInvoiceAccrual does _DELETE https://api.fortnox.se/3/invoiceaccruals/{InvoiceNumber}
+	//
+	// invoiceNumber - identifies the invoice accrual
+	RemoveInvoiceAccrual(ctx context.Context, invoiceNumber int) error
+}
+
+type invoiceAccrualsService struct {
+	*Client
+}
+
+// GetAllInvoiceAccruals forwards to InvoiceAccrualsService.GetAllInvoiceAccruals of c.InvoiceAccruals
+func (c *Client) GetAllInvoiceAccruals(ctx context.Context) (*GetAllInvoiceAccrualsResp, error) {
+	return c.InvoiceAccruals.GetAllInvoiceAccruals(ctx)
+}
+
+// GetInvoiceAccrualsPage forwards to InvoiceAccrualsService.GetInvoiceAccrualsPage of c.InvoiceAccruals
+func (c *Client) GetInvoiceAccrualsPage(ctx context.Context, page *PageOptions) ([]ShortInvoiceAccrual, *MetaInformation, error) {
+	return c.InvoiceAccruals.GetInvoiceAccrualsPage(ctx, page)
+}
+
+// IterateInvoiceAccruals forwards to InvoiceAccrualsService.IterateInvoiceAccruals of c.InvoiceAccruals
+func (c *Client) IterateInvoiceAccruals() *Iterator[ShortInvoiceAccrual] {
+	return c.InvoiceAccruals.IterateInvoiceAccruals()
+}
+
+// CreateInvoiceAccrual forwards to InvoiceAccrualsService.CreateInvoiceAccrual of c.InvoiceAccruals
+func (c *Client) CreateInvoiceAccrual(ctx context.Context, req *CreateInvoiceAccrualReq) (*CreateInvoiceAccrualResp, error) {
+	return c.InvoiceAccruals.CreateInvoiceAccrual(ctx, req)
+}
+
+// GetInvoiceAccrual forwards to InvoiceAccrualsService.GetInvoiceAccrual of c.InvoiceAccruals
+func (c *Client) GetInvoiceAccrual(ctx context.Context, invoiceNumber int) (*GetInvoiceAccrualResp, error) {
+	return c.InvoiceAccruals.GetInvoiceAccrual(ctx, invoiceNumber)
+}
+
+// UpdateInvoiceAccrual forwards to InvoiceAccrualsService.UpdateInvoiceAccrual of c.InvoiceAccruals
+func (c *Client) UpdateInvoiceAccrual(ctx context.Context, invoiceNumber int, req *UpdateInvoiceAccrualReq) (*UpdateInvoiceAccrualResp, error) {
+	return c.InvoiceAccruals.UpdateInvoiceAccrual(ctx, invoiceNumber, req)
+}
+
+// RemoveInvoiceAccrual forwards to InvoiceAccrualsService.RemoveInvoiceAccrual of c.InvoiceAccruals
+func (c *Client) RemoveInvoiceAccrual(ctx context.Context, invoiceNumber int) error {
+	return c.InvoiceAccruals.RemoveInvoiceAccrual(ctx, invoiceNumber)
+}
+
+// InvoicePaymentsService groups the calls reached as Client.InvoicePayments, fortnoxfake.InvoicePayments fakes it
+type InvoicePaymentsService interface {
+	// GetAllInvoicePayments does _GET https://api.fortnox.se/3/invoicepayments/
+	GetAllInvoicePayments(ctx context.Context) ([]InvoicePayment, error)
+
+	// GetInvoicePaymentsPage does _GET https://api.fortnox.se/3/invoicepayments/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetInvoicePaymentsPage(ctx context.Context, page *PageOptions) ([]InvoicePayment, *MetaInformation, error)
+
+	// IterateInvoicePayments lazily walks all pages of https://api.fortnox.se/3/invoicepayments/
+	IterateInvoicePayments() *Iterator[InvoicePayment]
+
+	// CreateInvoicePayment does _POST https://api.fortnox.se/3/invoicepayments/
+	//
+	// ip - invoice payment to create
+	CreateInvoicePayment(ctx context.Context, ip *InvoicePayment) (*InvoicePayment, error)
+
+	// GetInvoicePayment does _GET https://api.fortnox.se/3/invoicepayments/{Number}
+	//
+	// number - identifies the invoice payment
+	GetInvoicePayment(ctx context.Context, number string) (*InvoicePayment, error)
+
+	// UpdateInvoicePayment does _PUT https://api.fortnox.se/3/invoicepayments/{Number}
+	//
+	// number - identifies the invoice payment
+	//
+	// ip - invoice payment to update
+	UpdateInvoicePayment(ctx context.Context, number string, ip *InvoicePayment) (*InvoicePayment, error)
+
+	// RemoveInvoicePayment does _DELETE https://api.fortnox.se/3/invoicepayments/{Number}
+	//
+	// number - identifies the invoice payment
+	RemoveInvoicePayment(ctx context.Context, number string) error
+
+	// BookKeepInvoicePayment does _PUT https://api.fortnox.se/3/invoicepayments/{Number}/bookkeep
+	//
+	// number - identifies the invoice payment
+	BookKeepInvoicePayment(ctx context.Context, number string, ip *InvoicePayment) (*InvoicePayment, error)
+}
+
+type invoicePaymentsService struct {
+	*Client
+}
+
+// GetAllInvoicePayments forwards to InvoicePaymentsService.GetAllInvoicePayments of c.InvoicePayments
+func (c *Client) GetAllInvoicePayments(ctx context.Context) ([]InvoicePayment, error) {
+	return c.InvoicePayments.GetAllInvoicePayments(ctx)
+}
+
+// GetInvoicePaymentsPage forwards to InvoicePaymentsService.GetInvoicePaymentsPage of c.InvoicePayments
+func (c *Client) GetInvoicePaymentsPage(ctx context.Context, page *PageOptions) ([]InvoicePayment, *MetaInformation, error) {
+	return c.InvoicePayments.GetInvoicePaymentsPage(ctx, page)
+}
+
+// IterateInvoicePayments forwards to InvoicePaymentsService.IterateInvoicePayments of c.InvoicePayments
+func (c *Client) IterateInvoicePayments() *Iterator[InvoicePayment] {
+	return c.InvoicePayments.IterateInvoicePayments()
+}
+
+// CreateInvoicePayment forwards to InvoicePaymentsService.CreateInvoicePayment of c.InvoicePayments
+func (c *Client) CreateInvoicePayment(ctx context.Context, ip *InvoicePayment) (*InvoicePayment, error) {
+	return c.InvoicePayments.CreateInvoicePayment(ctx, ip)
+}
+
+// GetInvoicePayment forwards to InvoicePaymentsService.GetInvoicePayment of c.InvoicePayments
+func (c *Client) GetInvoicePayment(ctx context.Context, number string) (*InvoicePayment, error) {
+	return c.InvoicePayments.GetInvoicePayment(ctx, number)
+}
+
+// UpdateInvoicePayment forwards to InvoicePaymentsService.UpdateInvoicePayment of c.InvoicePayments
+func (c *Client) UpdateInvoicePayment(ctx context.Context, number string, ip *InvoicePayment) (*InvoicePayment, error) {
+	return c.InvoicePayments.UpdateInvoicePayment(ctx, number, ip)
+}
+
+// RemoveInvoicePayment forwards to InvoicePaymentsService.RemoveInvoicePayment of c.InvoicePayments
+func (c *Client) RemoveInvoicePayment(ctx context.Context, number string) error {
+	return c.InvoicePayments.RemoveInvoicePayment(ctx, number)
+}
+
+// BookKeepInvoicePayment forwards to InvoicePaymentsService.BookKeepInvoicePayment of c.InvoicePayments
+func (c *Client) BookKeepInvoicePayment(ctx context.Context, number string, ip *InvoicePayment) (*InvoicePayment, error) {
+	return c.InvoicePayments.BookKeepInvoicePayment(ctx, number, ip)
+}
+
+// InvoicesService groups the calls reached as Client.Invoices, fortnoxfake.Invoices fakes it
+type InvoicesService interface {
+	// GetInvoice does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}
+	//
+	// documentNumber - identifies the invoice
+	GetInvoice(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// UpdateInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}
+	//
+	// documentNumber - identifies the invoice
+	//
+	// i - fields to update, only fields that are Set are sent
+	UpdateInvoice(ctx context.Context, documentNumber string, i *InvoiceUpdate) (*Invoice, error)
+
+	// PatchInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber} sending only the fields changed between original and edited
+	//
+	// original - invoice as returned by Fortnox
+	//
+	// edited - modified copy of original
+	//
+	// Nothing is sent when no writable field changed, original is returned then.
+	PatchInvoice(ctx context.Context, original, edited *Invoice) (*Invoice, error)
+
+	// GetAllInvoices does _GET https://api.fortnox.se/3/invoices
+	//
+	// queryParams - filters
+	GetAllInvoices(ctx context.Context, queryParams *GetAllInvoicesQueryParams) ([]Invoice, error)
+
+	// GetInvoicesPage does _GET https://api.fortnox.se/3/invoices and returns a single page along with its MetaInformation
+	//
+	// queryParams - filters
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetInvoicesPage(ctx context.Context, queryParams *GetAllInvoicesQueryParams, page *PageOptions) ([]Invoice, *MetaInformation, error)
+
+	// IterateInvoices lazily walks all pages of https://api.fortnox.se/3/invoices
+	//
+	// queryParams - filters
+	IterateInvoices(queryParams *GetAllInvoicesQueryParams) *Iterator[Invoice]
+
+	// CreateInvoice does _POST https://api.fortnox.se/3/invoices
+	//
+	// req - payload
+	CreateInvoice(ctx context.Context, i *Invoice) (*Invoice, error)
+
+	// BookKeepInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/bookkeep
+	//
+	// documentNumber - identifies the invoice
+	BookKeepInvoice(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// CancelInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/cancel
+	//
+	// documentNumber - identifies the invoice
+	CancelInvoice(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// CreditInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/credit
+	//
+	// documentNumber - identifies the invoice
+	CreditInvoice(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// SetInvoiceAsSent does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/externalprint
+	//
+	// documentNumber - identifies the invoice
+	SetInvoiceAsSent(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// SetInvoiceAsDone does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/warehouseready
+	//
+	// documentNumber - identifies the invoice
+	SetInvoiceAsDone(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// PrintInvoice does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/print
+	//
+	// documentNumber - identifies the invoice
+	PrintInvoice(ctx context.Context, documentNumber string) (*Download, error)
+
+	// SendInvoiceAsEmail does _PUT https://api.fortnox.se/3/invoices/{DocumentNumber}/email
+	//
+	// documentNumber - identifies the invoice
+	SendInvoiceAsEmail(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// SendInvoiceAsReminder does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}/printreminder
+	//
+	// documentNumber - identifies the invoice
+	SendInvoiceAsReminder(ctx context.Context, documentNumber string) (*Download, error)
+
+	// PreviewInvoice does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}/preview
+	//
+	// documentNumber - identifies the invoice
+	PreviewInvoice(ctx context.Context, documentNumber string) (*Download, error)
+
+	// SendInvoiceAsEPrint does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}/eprint
+	//
+	// documentNumber - identifies the invoice
+	SendInvoiceAsEPrint(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// SendInvoiceAsEInvoice does _GET https://api.fortnox.se/3/invoices/{DocumentNumber}/einvoice
+	//
+	// documentNumber - identifies the invoice
+	SendInvoiceAsEInvoice(ctx context.Context, documentNumber string) (*Invoice, error)
+}
+
+type invoicesService struct {
+	*Client
+}
+
+// GetInvoice forwards to InvoicesService.GetInvoice of c.Invoices
+func (c *Client) GetInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.GetInvoice(ctx, documentNumber)
+}
+
+// UpdateInvoice forwards to InvoicesService.UpdateInvoice of c.Invoices
+func (c *Client) UpdateInvoice(ctx context.Context, documentNumber string, i *InvoiceUpdate) (*Invoice, error) {
+	return c.Invoices.UpdateInvoice(ctx, documentNumber, i)
+}
+
+// PatchInvoice forwards to InvoicesService.PatchInvoice of c.Invoices
+func (c *Client) PatchInvoice(ctx context.Context, original, edited *Invoice) (*Invoice, error) {
+	return c.Invoices.PatchInvoice(ctx, original, edited)
+}
+
+// GetAllInvoices forwards to InvoicesService.GetAllInvoices of c.Invoices
+func (c *Client) GetAllInvoices(ctx context.Context, queryParams *GetAllInvoicesQueryParams) ([]Invoice, error) {
+	return c.Invoices.GetAllInvoices(ctx, queryParams)
+}
+
+// GetInvoicesPage forwards to InvoicesService.GetInvoicesPage of c.Invoices
+func (c *Client) GetInvoicesPage(ctx context.Context, queryParams *GetAllInvoicesQueryParams, page *PageOptions) ([]Invoice, *MetaInformation, error) {
+	return c.Invoices.GetInvoicesPage(ctx, queryParams, page)
+}
+
+// IterateInvoices forwards to InvoicesService.IterateInvoices of c.Invoices
+func (c *Client) IterateInvoices(queryParams *GetAllInvoicesQueryParams) *Iterator[Invoice] {
+	return c.Invoices.IterateInvoices(queryParams)
+}
+
+// CreateInvoice forwards to InvoicesService.CreateInvoice of c.Invoices
+func (c *Client) CreateInvoice(ctx context.Context, i *Invoice) (*Invoice, error) {
+	return c.Invoices.CreateInvoice(ctx, i)
+}
+
+// BookKeepInvoice forwards to InvoicesService.BookKeepInvoice of c.Invoices
+func (c *Client) BookKeepInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.BookKeepInvoice(ctx, documentNumber)
+}
+
+// CancelInvoice forwards to InvoicesService.CancelInvoice of c.Invoices
+func (c *Client) CancelInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.CancelInvoice(ctx, documentNumber)
+}
+
+// CreditInvoice forwards to InvoicesService.CreditInvoice of c.Invoices
+func (c *Client) CreditInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.CreditInvoice(ctx, documentNumber)
+}
+
+// SetInvoiceAsSent forwards to InvoicesService.SetInvoiceAsSent of c.Invoices
+func (c *Client) SetInvoiceAsSent(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.SetInvoiceAsSent(ctx, documentNumber)
+}
+
+// SetInvoiceAsDone forwards to InvoicesService.SetInvoiceAsDone of c.Invoices
+func (c *Client) SetInvoiceAsDone(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.SetInvoiceAsDone(ctx, documentNumber)
+}
+
+// PrintInvoice forwards to InvoicesService.PrintInvoice of c.Invoices
+func (c *Client) PrintInvoice(ctx context.Context, documentNumber string) (*Download, error) {
+	return c.Invoices.PrintInvoice(ctx, documentNumber)
+}
+
+// SendInvoiceAsEmail forwards to InvoicesService.SendInvoiceAsEmail of c.Invoices
+func (c *Client) SendInvoiceAsEmail(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.SendInvoiceAsEmail(ctx, documentNumber)
+}
+
+// SendInvoiceAsReminder forwards to InvoicesService.SendInvoiceAsReminder of c.Invoices
+func (c *Client) SendInvoiceAsReminder(ctx context.Context, documentNumber string) (*Download, error) {
+	return c.Invoices.SendInvoiceAsReminder(ctx, documentNumber)
+}
+
+// PreviewInvoice forwards to InvoicesService.PreviewInvoice of c.Invoices
+func (c *Client) PreviewInvoice(ctx context.Context, documentNumber string) (*Download, error) {
+	return c.Invoices.PreviewInvoice(ctx, documentNumber)
+}
+
+// SendInvoiceAsEPrint forwards to InvoicesService.SendInvoiceAsEPrint of c.Invoices
+func (c *Client) SendInvoiceAsEPrint(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.SendInvoiceAsEPrint(ctx, documentNumber)
+}
+
+// SendInvoiceAsEInvoice forwards to InvoicesService.SendInvoiceAsEInvoice of c.Invoices
+func (c *Client) SendInvoiceAsEInvoice(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Invoices.SendInvoiceAsEInvoice(ctx, documentNumber)
+}
+
+// LabelsService groups the calls reached as Client.Labels, fortnoxfake.Labels fakes it
+type LabelsService interface {
+	// GetAllLabels does _GET https://api.fortnox.se/3/labels
+	GetAllLabels(ctx context.Context) ([]Label, error)
+
+	// GetLabelsPage does _GET https://api.fortnox.se/3/labels and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetLabelsPage(ctx context.Context, page *PageOptions) ([]Label, *MetaInformation, error)
+
+	// IterateLabels lazily walks all pages of https://api.fortnox.se/3/labels
+	IterateLabels() *Iterator[Label]
+
+	// CreateLabels does _POST https://api.fortnox.se/3/labels
+	//
+	// name - label to create
+	CreateLabels(ctx context.Context, name string) (*Label, error)
+
+	// UpdateLabel does _PUT https://api.fortnox.se/3/labels/{Id}
+	//
+	// id - identifies the label
+	//
+	// name - to update
+	UpdateLabel(ctx context.Context, id int, name string) (*Label, error)
+
+	// DeleteLabel does _DELETE https://api.fortnox.se/3/labels/{Id}
+	//
+	// id - identifies the label
+	DeleteLabel(ctx context.Context, id int) error
+}
+
+type labelsService struct {
+	*Client
+}
+
+// GetAllLabels forwards to LabelsService.GetAllLabels of c.Labels
+func (c *Client) GetAllLabels(ctx context.Context) ([]Label, error) {
+	return c.Labels.GetAllLabels(ctx)
+}
+
+// GetLabelsPage forwards to LabelsService.GetLabelsPage of c.Labels
+func (c *Client) GetLabelsPage(ctx context.Context, page *PageOptions) ([]Label, *MetaInformation, error) {
+	return c.Labels.GetLabelsPage(ctx, page)
+}
+
+// IterateLabels forwards to LabelsService.IterateLabels of c.Labels
+func (c *Client) IterateLabels() *Iterator[Label] {
+	return c.Labels.IterateLabels()
+}
+
+// CreateLabels forwards to LabelsService.CreateLabels of c.Labels
+func (c *Client) CreateLabels(ctx context.Context, name string) (*Label, error) {
+	return c.Labels.CreateLabels(ctx, name)
+}
+
+// UpdateLabel forwards to LabelsService.UpdateLabel of c.Labels
+func (c *Client) UpdateLabel(ctx context.Context, id int, name string) (*Label, error) {
+	return c.Labels.UpdateLabel(ctx, id, name)
+}
+
+// DeleteLabel forwards to LabelsService.DeleteLabel of c.Labels
+func (c *Client) DeleteLabel(ctx context.Context, id int) error {
+	return c.Labels.DeleteLabel(ctx, id)
+}
+
+// LockedPeriodService groups the calls reached as Client.LockedPeriod, fortnoxfake.LockedPeriod fakes it
+type LockedPeriodService interface {
+	// GetLockedPeriod does _GET https://api.fortnox.se/3/settings/lockedperiod/
+	//
+	// If no date is returned, no period is locked.
+	GetLockedPeriod(ctx context.Context) (*LockedPeriod, error)
+}
+
+type lockedPeriodService struct {
+	*Client
+}
+
+// GetLockedPeriod forwards to LockedPeriodService.GetLockedPeriod of c.LockedPeriod
+func (c *Client) GetLockedPeriod(ctx context.Context) (*LockedPeriod, error) {
+	return c.LockedPeriod.GetLockedPeriod(ctx)
+}
+
+// MeService groups the calls reached as Client.Me, fortnoxfake.Me fakes it
+type MeService interface {
+	// GetMeInformation does _GET https://api.fortnox.se/3/me
+	GetMeInformation(ctx context.Context) (*MeInformation, error)
+}
+
+type meService struct {
+	*Client
+}
+
+// GetMeInformation forwards to MeService.GetMeInformation of c.Me
+func (c *Client) GetMeInformation(ctx context.Context) (*MeInformation, error) {
+	return c.Me.GetMeInformation(ctx)
+}
+
+// ModesOfPaymentsService groups the calls reached as Client.ModesOfPayments, fortnoxfake.ModesOfPayments fakes it
+type ModesOfPaymentsService interface {
+	// GetAllModesOfPayments does _GET https://api.fortnox.se/3/modesofpayments
+	GetAllModesOfPayments(ctx context.Context) ([]ModeOfPayment, error)
+
+	// GetModesOfPaymentsPage does _GET https://api.fortnox.se/3/modesofpayments and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetModesOfPaymentsPage(ctx context.Context, page *PageOptions) ([]ModeOfPayment, *MetaInformation, error)
+
+	// IterateModesOfPayments lazily walks all pages of https://api.fortnox.se/3/modesofpayments
+	IterateModesOfPayments() *Iterator[ModeOfPayment]
+
+	// CreateModeOfPayment does _POST https://api.fortnox.se/3/modesofpayments
+	//
+	// mop - mode of payment to create
+	CreateModeOfPayment(ctx context.Context, si *ModeOfPayment) (*ModeOfPayment, error)
+
+	// GetModeOfPayment does _GET https://api.fortnox.se/3/modesofpayments/{Code}
+	//
+	// code - identifies the mode of payment
+	GetModeOfPayment(ctx context.Context, code string) (*ModeOfPayment, error)
+
+	// UpdateModeOfPayment does _PUT https://api.fortnox.se/3/modesofpayments/{Code}
+	//
+	// code - identifies the mode of payment
+	//
+	// mop - mode of payment to update
+	UpdateModeOfPayment(ctx context.Context, code string, mop *ModeOfPayment) (*ModeOfPayment, error)
+}
+
+type modesOfPaymentsService struct {
+	*Client
+}
+
+// GetAllModesOfPayments forwards to ModesOfPaymentsService.GetAllModesOfPayments of c.ModesOfPayments
+func (c *Client) GetAllModesOfPayments(ctx context.Context) ([]ModeOfPayment, error) {
+	return c.ModesOfPayments.GetAllModesOfPayments(ctx)
+}
+
+// GetModesOfPaymentsPage forwards to ModesOfPaymentsService.GetModesOfPaymentsPage of c.ModesOfPayments
+func (c *Client) GetModesOfPaymentsPage(ctx context.Context, page *PageOptions) ([]ModeOfPayment, *MetaInformation, error) {
+	return c.ModesOfPayments.GetModesOfPaymentsPage(ctx, page)
+}
+
+// IterateModesOfPayments forwards to ModesOfPaymentsService.IterateModesOfPayments of c.ModesOfPayments
+func (c *Client) IterateModesOfPayments() *Iterator[ModeOfPayment] {
+	return c.ModesOfPayments.IterateModesOfPayments()
+}
+
+// CreateModeOfPayment forwards to ModesOfPaymentsService.CreateModeOfPayment of c.ModesOfPayments
+func (c *Client) CreateModeOfPayment(ctx context.Context, si *ModeOfPayment) (*ModeOfPayment, error) {
+	return c.ModesOfPayments.CreateModeOfPayment(ctx, si)
+}
+
+// GetModeOfPayment forwards to ModesOfPaymentsService.GetModeOfPayment of c.ModesOfPayments
+func (c *Client) GetModeOfPayment(ctx context.Context, code string) (*ModeOfPayment, error) {
+	return c.ModesOfPayments.GetModeOfPayment(ctx, code)
+}
+
+// UpdateModeOfPayment forwards to ModesOfPaymentsService.UpdateModeOfPayment of c.ModesOfPayments
+func (c *Client) UpdateModeOfPayment(ctx context.Context, code string, mop *ModeOfPayment) (*ModeOfPayment, error) {
+	return c.ModesOfPayments.UpdateModeOfPayment(ctx, code, mop)
+}
+
+// OffersService groups the calls reached as Client.Offers, fortnoxfake.Offers fakes it
+type OffersService interface {
+	// GetAllOffers does _GET https://api.fortnox.se/3/offers/
+	//
+	// filter - GetAllOffersFilter
+	GetAllOffers(ctx context.Context, filter *GetAllOffersFilter) ([]Offer, error)
+
+	// GetOffersPage does _GET https://api.fortnox.se/3/offers/ and returns a single page along with its MetaInformation
+	//
+	// filter - GetAllOffersFilter
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetOffersPage(ctx context.Context, filter *GetAllOffersFilter, page *PageOptions) ([]Offer, *MetaInformation, error)
+
+	// IterateOffers lazily walks all pages of https://api.fortnox.se/3/offers/
+	//
+	// filter - GetAllOffersFilter
+	IterateOffers(filter *GetAllOffersFilter) *Iterator[Offer]
+
+	// CreateOffer does _POST https://api.fortnox.se/3/offers/
+	//
+	// Errors that can be raised by this endpoint.
+	//
+	// # Error 	Code	HTTP Code	Description	Solution
+	//
+	// 2004167	400		An account must be provided when using a custom VAT rate and EasyVat has been enabled. Supply each row which has a custom VAT rate with an account.
+	CreateOffer(ctx context.Context, o *Offer) (*Offer, error)
+
+	// GetOffer does _GET https://api.fortnox.se/3/offers/{DocumentNumber}
+	//
+	// documentNumber - identifies the offer
+	GetOffer(ctx context.Context, documentNumber string) (*Offer, error)
+
+	// UpdateOffer does _PUT https://api.fortnox.se/3/offers/{DocumentNumber}
+	//
+	// documentNumber - identifies the offer
+	//
+	// o - fields to update, only fields that are Set are sent
+	UpdateOffer(ctx context.Context, documentNumber string, o *OfferUpdate) (*Offer, error)
+
+	// PatchOffer does _PUT https://api.fortnox.se/3/offers/{DocumentNumber} sending only the fields changed between original and edited
+	//
+	// original - offer as returned by Fortnox
+	//
+	// edited - modified copy of original
+	//
+	// Nothing is sent when no writable field changed, original is returned then.
+	PatchOffer(ctx context.Context, original, edited *Offer) (*Offer, error)
+
+	// PrintOffer does _GET https://api.fortnox.se/3/offers/{DocumentNumber}/print
+	//
+	// documentNumber - identifies the offer
+	PrintOffer(ctx context.Context, documentNumber string) (*Download, error)
+
+	// SendOfferAsEmail does _GET https://api.fortnox.se/3/offers/{DocumentNumber}/email
+	//
+	// documentNumber - identifies the offer
+	SendOfferAsEmail(ctx context.Context, documentNumber string) (*Offer, error)
+
+	// PreviewOffer does _GET https://api.fortnox.se/3/offers/{DocumentNumber}/preview
+	//
+	// documentNumber - identifies the offer
+	//
+	// The difference between this and the print-endpoint is that property Sent is not set to TRUE.
+	PreviewOffer(ctx context.Context, documentNumber string) (*Download, error)
+
+	// CreateOrderOutOfOffer does _PUT https://api.fortnox.se/3/offers/{DocumentNumber}/createorder
+	//
+	// documentNumber - identifies the offer
+	CreateOrderOutOfOffer(ctx context.Context, documentNumber string) (*Order, error)
+
+	// CancelOffer does _PUT https://api.fortnox.se/3/offers/{DocumentNumber}/cancel
+	//
+	// documentNumber - identifies the offer
+	CancelOffer(ctx context.Context, documentNumber string) (*Offer, error)
+
+	// SetOfferAsSent does _PUT https://api.fortnox.se/3/offers/{DocumentNumber}/externalprint
+	//
+	// documentNumber - identifies the offer
+	//
+	// Use this endpoint to set offer as sent, without generating an offer.
+	SetOfferAsSent(ctx context.Context, documentNumber string) (*Offer, error)
+}
+
+type offersService struct {
+	*Client
+}
+
+// GetAllOffers forwards to OffersService.GetAllOffers of c.Offers
+func (c *Client) GetAllOffers(ctx context.Context, filter *GetAllOffersFilter) ([]Offer, error) {
+	return c.Offers.GetAllOffers(ctx, filter)
+}
+
+// GetOffersPage forwards to OffersService.GetOffersPage of c.Offers
+func (c *Client) GetOffersPage(ctx context.Context, filter *GetAllOffersFilter, page *PageOptions) ([]Offer, *MetaInformation, error) {
+	return c.Offers.GetOffersPage(ctx, filter, page)
+}
+
+// IterateOffers forwards to OffersService.IterateOffers of c.Offers
+func (c *Client) IterateOffers(filter *GetAllOffersFilter) *Iterator[Offer] {
+	return c.Offers.IterateOffers(filter)
+}
+
+// CreateOffer forwards to OffersService.CreateOffer of c.Offers
+func (c *Client) CreateOffer(ctx context.Context, o *Offer) (*Offer, error) {
+	return c.Offers.CreateOffer(ctx, o)
+}
+
+// GetOffer forwards to OffersService.GetOffer of c.Offers
+func (c *Client) GetOffer(ctx context.Context, documentNumber string) (*Offer, error) {
+	return c.Offers.GetOffer(ctx, documentNumber)
+}
+
+// UpdateOffer forwards to OffersService.UpdateOffer of c.Offers
+func (c *Client) UpdateOffer(ctx context.Context, documentNumber string, o *OfferUpdate) (*Offer, error) {
+	return c.Offers.UpdateOffer(ctx, documentNumber, o)
+}
+
+// PatchOffer forwards to OffersService.PatchOffer of c.Offers
+func (c *Client) PatchOffer(ctx context.Context, original, edited *Offer) (*Offer, error) {
+	return c.Offers.PatchOffer(ctx, original, edited)
+}
+
+// PrintOffer forwards to OffersService.PrintOffer of c.Offers
+func (c *Client) PrintOffer(ctx context.Context, documentNumber string) (*Download, error) {
+	return c.Offers.PrintOffer(ctx, documentNumber)
+}
+
+// SendOfferAsEmail forwards to OffersService.SendOfferAsEmail of c.Offers
+func (c *Client) SendOfferAsEmail(ctx context.Context, documentNumber string) (*Offer, error) {
+	return c.Offers.SendOfferAsEmail(ctx, documentNumber)
+}
+
+// PreviewOffer forwards to OffersService.PreviewOffer of c.Offers
+func (c *Client) PreviewOffer(ctx context.Context, documentNumber string) (*Download, error) {
+	return c.Offers.PreviewOffer(ctx, documentNumber)
+}
+
+// CreateOrderOutOfOffer forwards to OffersService.CreateOrderOutOfOffer of c.Offers
+func (c *Client) CreateOrderOutOfOffer(ctx context.Context, documentNumber string) (*Order, error) {
+	return c.Offers.CreateOrderOutOfOffer(ctx, documentNumber)
+}
+
+// CancelOffer forwards to OffersService.CancelOffer of c.Offers
+func (c *Client) CancelOffer(ctx context.Context, documentNumber string) (*Offer, error) {
+	return c.Offers.CancelOffer(ctx, documentNumber)
+}
+
+// SetOfferAsSent forwards to OffersService.SetOfferAsSent of c.Offers
+func (c *Client) SetOfferAsSent(ctx context.Context, documentNumber string) (*Offer, error) {
+	return c.Offers.SetOfferAsSent(ctx, documentNumber)
+}
+
+// OrdersService groups the calls reached as Client.Orders, fortnoxfake.Orders fakes it
+type OrdersService interface {
+	// GetAllOrders does _GET https://api.fortnox.se/3/orders/
+	//
+	// filter - GetAllOffersFilter
+	GetAllOrders(ctx context.Context, filter *GetAllOrdersFilter) ([]Order, error)
+
+	// GetOrdersPage does _GET https://api.fortnox.se/3/orders/ and returns a single page along with its MetaInformation
+	//
+	// filter - GetAllOffersFilter
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetOrdersPage(ctx context.Context, filter *GetAllOrdersFilter, page *PageOptions) ([]Order, *MetaInformation, error)
+
+	// IterateOrders lazily walks all pages of https://api.fortnox.se/3/orders/
+	//
+	// filter - GetAllOffersFilter
+	IterateOrders(filter *GetAllOrdersFilter) *Iterator[Order]
+
+	// CreateOrder does _POST https://api.fortnox.se/3/orders/
+	//
+	// req - to create
+	//
+	// Should you have EasyVat enabled, it is mandatory to provide an account in the request should you use a custom VAT rate.
+	//
+	// This endpoint can produce errors, some of which may only be relevant for EasyVat. Refer to the table below.
+	//
+	// Errors that can be raised by this endpoint:
+	//
+	// | Error Code |	HTTP Code  | Description	| Solution |
+	// |------------|--------------|----------------|----------|
+	// | 2004167	| 400	       | An account must be provided when using a custom VAT rate and EasyVat has been enabled.	| Supply each row which has a custom VAT rate with an account. |
+	CreateOrder(ctx context.Context, o *Order) (*Order, error)
+
+	// GetOrder does _GET https://api.fortnox.se/3/orders/{DocumentNumber}
+	//
+	// documentNumber - identifies the order
+	GetOrder(ctx context.Context, documentNumber string) (*Order, error)
+
+	// UpdateOrder does _PUT https://api.fortnox.se/3/orders/{DocumentNumber}
+	//
+	// documentNumber - identifies the order
+	//
+	// o - fields to update, only fields that are Set are sent
+	//
+	// Note that there are two approaches for updating the rows on an order.
+	//
+	// If RowId is not specified on any row, the rows will be mapped and updated in the order in which they are set in the array. All rows that should remain on the order needs to be provided.
+	//
+	// If RowId is specified on one or more rows the following goes: Corresponding row with that id will be updated. The rows without RowId will be interpreted as new rows. If a row should not be updated but remain on the order then specify only RowId like { "RowId": 123 }, otherwise it will be removed. Note that new RowIds are generated for all rows every time an order is updated.
+	UpdateOrder(ctx context.Context, documentNumber string, o *OrderUpdate) (*Order, error)
+
+	// PatchOrder does _PUT https://api.fortnox.se/3/orders/{DocumentNumber} sending only the fields changed between original and edited
+	//
+	// original - order as returned by Fortnox
+	//
+	// edited - modified copy of original
+	//
+	// Nothing is sent when no writable field changed, original is returned then.
+	PatchOrder(ctx context.Context, original, edited *Order) (*Order, error)
+
+	// PrintOrder does _GET https://api.fortnox.se/3/orders/{DocumentNumber}/print
+	//
+	// documentNumber - identifies the order
+	PrintOrder(ctx context.Context, documentNumber string) (*Download, error)
+
+	// SendOrderAsEmail does _GET https://api.fortnox.se/3/orders/{DocumentNumber}/email
+	//
+	// documentNumber - identifies the order
+	//
+	// You can use the properties in the EmailInformation to customize the e-mail message on each order.
+	SendOrderAsEmail(ctx context.Context, documentNumber string) (*Order, error)
+
+	// PreviewOrder does _GET https://api.fortnox.se/3/orders/{DocumentNumber}/preview
+	// documentNumber - identifies the order
+	//
+	// The difference between this and the print-endpoint is that property Sent is not set to TRUE.
+	PreviewOrder(ctx context.Context, documentNumber string) (*Download, error)
+
+	// CreateInvoiceOutOfGivenOrder does _PUT https://api.fortnox.se/3/orders/{DocumentNumber}/createinvoice
+	//
+	// documentNumber - identifies the order
+	CreateInvoiceOutOfGivenOrder(ctx context.Context, documentNumber string) (*Invoice, error)
+
+	// CancelGivenOrder does _PUT https://api.fortnox.se/3/orders/{DocumentNumber}/cancel
+	//
+	// documentNumber - identifies the order
+	CancelGivenOrder(ctx context.Context, documentNumber string) (*Order, error)
+
+	// SetGivenOrderAsSent does _PUT https://api.fortnox.se/3/orders/{DocumentNumber}/externalprint
+	//
+	// documentNumber - identifies the order
+	//
+	// Use this endpoint to set order as sent, without generating an order.
+	SetGivenOrderAsSent(ctx context.Context, documentNumber string) (*Order, error)
+}
+
+type ordersService struct {
+	*Client
+}
+
+// GetAllOrders forwards to OrdersService.GetAllOrders of c.Orders
+func (c *Client) GetAllOrders(ctx context.Context, filter *GetAllOrdersFilter) ([]Order, error) {
+	return c.Orders.GetAllOrders(ctx, filter)
+}
+
+// GetOrdersPage forwards to OrdersService.GetOrdersPage of c.Orders
+func (c *Client) GetOrdersPage(ctx context.Context, filter *GetAllOrdersFilter, page *PageOptions) ([]Order, *MetaInformation, error) {
+	return c.Orders.GetOrdersPage(ctx, filter, page)
+}
+
+// IterateOrders forwards to OrdersService.IterateOrders of c.Orders
+func (c *Client) IterateOrders(filter *GetAllOrdersFilter) *Iterator[Order] {
+	return c.Orders.IterateOrders(filter)
+}
+
+// CreateOrder forwards to OrdersService.CreateOrder of c.Orders
+func (c *Client) CreateOrder(ctx context.Context, o *Order) (*Order, error) {
+	return c.Orders.CreateOrder(ctx, o)
+}
+
+// GetOrder forwards to OrdersService.GetOrder of c.Orders
+func (c *Client) GetOrder(ctx context.Context, documentNumber string) (*Order, error) {
+	return c.Orders.GetOrder(ctx, documentNumber)
+}
+
+// UpdateOrder forwards to OrdersService.UpdateOrder of c.Orders
+func (c *Client) UpdateOrder(ctx context.Context, documentNumber string, o *OrderUpdate) (*Order, error) {
+	return c.Orders.UpdateOrder(ctx, documentNumber, o)
+}
+
+// PatchOrder forwards to OrdersService.PatchOrder of c.Orders
+func (c *Client) PatchOrder(ctx context.Context, original, edited *Order) (*Order, error) {
+	return c.Orders.PatchOrder(ctx, original, edited)
+}
+
+// PrintOrder forwards to OrdersService.PrintOrder of c.Orders
+func (c *Client) PrintOrder(ctx context.Context, documentNumber string) (*Download, error) {
+	return c.Orders.PrintOrder(ctx, documentNumber)
+}
+
+// SendOrderAsEmail forwards to OrdersService.SendOrderAsEmail of c.Orders
+func (c *Client) SendOrderAsEmail(ctx context.Context, documentNumber string) (*Order, error) {
+	return c.Orders.SendOrderAsEmail(ctx, documentNumber)
+}
+
+// PreviewOrder forwards to OrdersService.PreviewOrder of c.Orders
+func (c *Client) PreviewOrder(ctx context.Context, documentNumber string) (*Download, error) {
+	return c.Orders.PreviewOrder(ctx, documentNumber)
+}
+
+// CreateInvoiceOutOfGivenOrder forwards to OrdersService.CreateInvoiceOutOfGivenOrder of c.Orders
+func (c *Client) CreateInvoiceOutOfGivenOrder(ctx context.Context, documentNumber string) (*Invoice, error) {
+	return c.Orders.CreateInvoiceOutOfGivenOrder(ctx, documentNumber)
+}
+
+// CancelGivenOrder forwards to OrdersService.CancelGivenOrder of c.Orders
+func (c *Client) CancelGivenOrder(ctx context.Context, documentNumber string) (*Order, error) {
+	return c.Orders.CancelGivenOrder(ctx, documentNumber)
+}
+
+// SetGivenOrderAsSent forwards to OrdersService.SetGivenOrderAsSent of c.Orders
+func (c *Client) SetGivenOrderAsSent(ctx context.Context, documentNumber string) (*Order, error) {
+	return c.Orders.SetGivenOrderAsSent(ctx, documentNumber)
+}
+
+// PredefinedAccountsService groups the calls reached as Client.PredefinedAccounts, fortnoxfake.PredefinedAccounts fakes it
+type PredefinedAccountsService interface {
+	// GetAllPredefinedAccounts does _GET https://api.fortnox.se/3/predefinedaccounts/
+	GetAllPredefinedAccounts(ctx context.Context) ([]PreDefinedAccount, error)
+
+	// GetPredefinedAccountsPage does _GET https://api.fortnox.se/3/predefinedaccounts/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetPredefinedAccountsPage(ctx context.Context, page *PageOptions) ([]PreDefinedAccount, *MetaInformation, error)
+
+	// IteratePredefinedAccounts lazily walks all pages of https://api.fortnox.se/3/predefinedaccounts/
+	IteratePredefinedAccounts() *Iterator[PreDefinedAccount]
+
+	// GetPredefinedAccount does _GET https://api.fortnox.se/3/predefinedaccounts/{name}
+	//
+	// name - identifies the predefined account
+	GetPredefinedAccount(ctx context.Context, name string) (*PreDefinedAccount, error)
+
+	// UpdatePredefinedAccount does _PUT https://api.fortnox.se/3/predefinedaccounts/{name}
+	//
+	// name - identifies the predefined account
+	//
+	// pda - predefined account to update
+	UpdatePredefinedAccount(ctx context.Context, name string, pda *PreDefinedAccount) (*PreDefinedAccount, error)
+}
+
+type predefinedAccountsService struct {
+	*Client
+}
+
+// GetAllPredefinedAccounts forwards to PredefinedAccountsService.GetAllPredefinedAccounts of c.PredefinedAccounts
+func (c *Client) GetAllPredefinedAccounts(ctx context.Context) ([]PreDefinedAccount, error) {
+	return c.PredefinedAccounts.GetAllPredefinedAccounts(ctx)
+}
+
+// GetPredefinedAccountsPage forwards to PredefinedAccountsService.GetPredefinedAccountsPage of c.PredefinedAccounts
+func (c *Client) GetPredefinedAccountsPage(ctx context.Context, page *PageOptions) ([]PreDefinedAccount, *MetaInformation, error) {
+	return c.PredefinedAccounts.GetPredefinedAccountsPage(ctx, page)
+}
+
+// IteratePredefinedAccounts forwards to PredefinedAccountsService.IteratePredefinedAccounts of c.PredefinedAccounts
+func (c *Client) IteratePredefinedAccounts() *Iterator[PreDefinedAccount] {
+	return c.PredefinedAccounts.IteratePredefinedAccounts()
+}
+
+// GetPredefinedAccount forwards to PredefinedAccountsService.GetPredefinedAccount of c.PredefinedAccounts
+func (c *Client) GetPredefinedAccount(ctx context.Context, name string) (*PreDefinedAccount, error) {
+	return c.PredefinedAccounts.GetPredefinedAccount(ctx, name)
+}
+
+// UpdatePredefinedAccount forwards to PredefinedAccountsService.UpdatePredefinedAccount of c.PredefinedAccounts
+func (c *Client) UpdatePredefinedAccount(ctx context.Context, name string, pda *PreDefinedAccount) (*PreDefinedAccount, error) {
+	return c.PredefinedAccounts.UpdatePredefinedAccount(ctx, name, pda)
+}
+
+// PredefinedVoucherSeriesService groups the calls reached as Client.PredefinedVoucherSeries, fortnoxfake.PredefinedVoucherSeries fakes it
+type PredefinedVoucherSeriesService interface {
+	// GetAllPredefinedVoucherSeries does _GET https://api.fortnox.se/3/predefinedvoucherseries/
+	GetAllPredefinedVoucherSeries(ctx context.Context) ([]PreDefinedVoucherSeries, error)
+
+	// GetPredefinedVoucherSeriesPage does _GET https://api.fortnox.se/3/predefinedvoucherseries/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetPredefinedVoucherSeriesPage(ctx context.Context, page *PageOptions) ([]PreDefinedVoucherSeries, *MetaInformation, error)
+
+	// IteratePredefinedVoucherSeries lazily walks all pages of https://api.fortnox.se/3/predefinedvoucherseries/
+	IteratePredefinedVoucherSeries() *Iterator[PreDefinedVoucherSeries]
+
+	// GetPredefinedVoucherSeries does _GET https://api.fortnox.se/3/predefinedvoucherseries/{Name}
+	//
+	// name - identifies the predefined voucher series
+	GetPredefinedVoucherSeries(ctx context.Context, name string) (*PreDefinedVoucherSeries, error)
+
+	// UpdatePredefinedVoucherSeries does _PUT https://api.fortnox.se/3/predefinedvoucherseries/{Name}
+	//
+	// name - identifies the predefined voucher series
+	//
+	// pdvs - predefined voucher series to update
+	UpdatePredefinedVoucherSeries(ctx context.Context, name string, pdvs *PreDefinedVoucherSeries) (*PreDefinedVoucherSeries, error)
+}
+
+type predefinedVoucherSeriesService struct {
+	*Client
+}
+
+// GetAllPredefinedVoucherSeries forwards to PredefinedVoucherSeriesService.GetAllPredefinedVoucherSeries of c.PredefinedVoucherSeries
+func (c *Client) GetAllPredefinedVoucherSeries(ctx context.Context) ([]PreDefinedVoucherSeries, error) {
+	return c.PredefinedVoucherSeries.GetAllPredefinedVoucherSeries(ctx)
+}
+
+// GetPredefinedVoucherSeriesPage forwards to PredefinedVoucherSeriesService.GetPredefinedVoucherSeriesPage of c.PredefinedVoucherSeries
+func (c *Client) GetPredefinedVoucherSeriesPage(ctx context.Context, page *PageOptions) ([]PreDefinedVoucherSeries, *MetaInformation, error) {
+	return c.PredefinedVoucherSeries.GetPredefinedVoucherSeriesPage(ctx, page)
+}
+
+// IteratePredefinedVoucherSeries forwards to PredefinedVoucherSeriesService.IteratePredefinedVoucherSeries of c.PredefinedVoucherSeries
+func (c *Client) IteratePredefinedVoucherSeries() *Iterator[PreDefinedVoucherSeries] {
+	return c.PredefinedVoucherSeries.IteratePredefinedVoucherSeries()
+}
+
+// GetPredefinedVoucherSeries forwards to PredefinedVoucherSeriesService.GetPredefinedVoucherSeries of c.PredefinedVoucherSeries
+func (c *Client) GetPredefinedVoucherSeries(ctx context.Context, name string) (*PreDefinedVoucherSeries, error) {
+	return c.PredefinedVoucherSeries.GetPredefinedVoucherSeries(ctx, name)
+}
+
+// UpdatePredefinedVoucherSeries forwards to PredefinedVoucherSeriesService.UpdatePredefinedVoucherSeries of c.PredefinedVoucherSeries
+func (c *Client) UpdatePredefinedVoucherSeries(ctx context.Context, name string, pdvs *PreDefinedVoucherSeries) (*PreDefinedVoucherSeries, error) {
+	return c.PredefinedVoucherSeries.UpdatePredefinedVoucherSeries(ctx, name, pdvs)
+}
+
+// PriceListsService groups the calls reached as Client.PriceLists, fortnoxfake.PriceLists fakes it
+type PriceListsService interface {
+	// GetAllPriceLists does _GET https://api.fortnox.se/3/pricelists
+	GetAllPriceLists(ctx context.Context) ([]PriceList, error)
+
+	// GetPriceListsPage does _GET https://api.fortnox.se/3/pricelists and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetPriceListsPage(ctx context.Context, page *PageOptions) ([]PriceList, *MetaInformation, error)
+
+	// IteratePriceLists lazily walks all pages of https://api.fortnox.se/3/pricelists
+	IteratePriceLists() *Iterator[PriceList]
+
+	// CreatePriceList does _POST https://api.fortnox.se/3/pricelists
+	//
+	// req - price list to create
+	CreatePriceList(ctx context.Context, pl *PriceList) (*PriceList, error)
+
+	// GetPriceList does _GET https://api.fortnox.se/3/pricelists/{Code}
+	//
+	// code - identifies the price list
+	GetPriceList(ctx context.Context, code string) (*PriceList, error)
+
+	// UpdatePriceList does _PUT https://api.fortnox.se/3/pricelists/{Code}
+	//
+	// code - identifies the price list
+	//
+	// req - price list to update
+	UpdatePriceList(ctx context.Context, code string, pl *PriceList) (*PriceList, error)
+}
+
+type priceListsService struct {
+	*Client
+}
+
+// GetAllPriceLists forwards to PriceListsService.GetAllPriceLists of c.PriceLists
+func (c *Client) GetAllPriceLists(ctx context.Context) ([]PriceList, error) {
+	return c.PriceLists.GetAllPriceLists(ctx)
+}
+
+// GetPriceListsPage forwards to PriceListsService.GetPriceListsPage of c.PriceLists
+func (c *Client) GetPriceListsPage(ctx context.Context, page *PageOptions) ([]PriceList, *MetaInformation, error) {
+	return c.PriceLists.GetPriceListsPage(ctx, page)
+}
+
+// IteratePriceLists forwards to PriceListsService.IteratePriceLists of c.PriceLists
+func (c *Client) IteratePriceLists() *Iterator[PriceList] {
+	return c.PriceLists.IteratePriceLists()
+}
+
+// CreatePriceList forwards to PriceListsService.CreatePriceList of c.PriceLists
+func (c *Client) CreatePriceList(ctx context.Context, pl *PriceList) (*PriceList, error) {
+	return c.PriceLists.CreatePriceList(ctx, pl)
+}
+
+// GetPriceList forwards to PriceListsService.GetPriceList of c.PriceLists
+func (c *Client) GetPriceList(ctx context.Context, code string) (*PriceList, error) {
+	return c.PriceLists.GetPriceList(ctx, code)
+}
+
+// UpdatePriceList forwards to PriceListsService.UpdatePriceList of c.PriceLists
+func (c *Client) UpdatePriceList(ctx context.Context, code string, pl *PriceList) (*PriceList, error) {
+	return c.PriceLists.UpdatePriceList(ctx, code, pl)
+}
+
+// PricesService groups the calls reached as Client.Prices, fortnoxfake.Prices fakes it
+type PricesService interface {
+	// GetPriceForArticle does _GET https://api.fortnox.se/3/prices/{PriceList}/{ArticleNumber}/{FromQuantity}
+	//
+	// priceList - identifies the price list
+	//
+	// articleNumber - identifies the article
+	//
+	// fromQuantity - identifies from quantity
+	GetPriceForArticle(ctx context.Context, priceList, articleNumber string, fromQuantity int) (*Price, error)
+
+	// UpdatePrice does _PUT https://api.fortnox.se/3/prices/{PriceList}/{ArticleNumber}/{FromQuantity}
+	//
+	// priceList - identifies the price list
+	//
+	// articleNumber - identifies the article
+	//
+	// fromQuantity - identifies from quantity
+	//
+	// p - price to update
+	UpdatePrice(ctx context.Context, priceList, articleNumber string, fromQuantity int, p *Price) (*Price, error)
+
+	// DeletePrice does _DELETE https://api.fortnox.se/3/prices/{PriceList}/{ArticleNumber}/{FromQuantity}
+	//
+	// priceList - identifies the price list
+	//
+	// articleNumber - identifies the article
+	//
+	// fromQuantity - identifies from quantity
+	DeletePrice(ctx context.Context, priceList, articleNumber string, fromQuantity int) error
+
+	// GetAllArticlesWithPricesInPriceList does _GET https://api.fortnox.se/3/prices/sublist/{PriceList}/{ArticleNumber}
+	//
+	// priceList - identifies the price list of the prices
+	//
+	// articleNumber - identifies the article number of the prices
+	GetAllArticlesWithPricesInPriceList(ctx context.Context, priceList, articleNumber string) ([]Price, error)
+
+	// GetArticlesWithPricesInPriceListPage does _GET https://api.fortnox.se/3/prices/sublist/{PriceList}/{ArticleNumber} and returns a single page along with its MetaInformation
+	//
+	// priceList - identifies the price list of the prices
+	//
+	// articleNumber - identifies the article number of the prices
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetArticlesWithPricesInPriceListPage(ctx context.Context, priceList, articleNumber string, page *PageOptions) ([]Price, *MetaInformation, error)
+
+	// IterateArticlesWithPricesInPriceList lazily walks all pages of https://api.fortnox.se/3/prices/sublist/{PriceList}/{ArticleNumber}
+	//
+	// priceList - identifies the price list of the prices
+	//
+	// articleNumber - identifies the article number of the prices
+	IterateArticlesWithPricesInPriceList(priceList, articleNumber string) *Iterator[Price]
+
+	// GetFirstPriceForArticle does _GET https://api.fortnox.se/3/prices/{PriceList}/{ArticleNumber}
+	//
+	// priceList - identifies the price list of the prices
+	//
+	// articleNumber - identifies the article number of the prices
+	GetFirstPriceForArticle(ctx context.Context, priceList, articleNumber string) (*Price, error)
+
+	// UpdateFirstPriceForArticle does _PUT https://api.fortnox.se/3/prices/{PriceList}/{ArticleNumber}
+	//
+	// priceList - identifies the price list of the prices
+	//
+	// articleNumber - identifies the article number of the prices
+	//
+	// p - price to update
+	UpdateFirstPriceForArticle(ctx context.Context, priceList, articleNumber string, p *Price) (*Price, error)
+
+	// CreatePrice does _POST https://api.fortnox.se/3/prices/
+	//
+	// p - price to create
+	CreatePrice(ctx context.Context, p *Price) (*Price, error)
+}
+
+type pricesService struct {
+	*Client
+}
+
+// GetPriceForArticle forwards to PricesService.GetPriceForArticle of c.Prices
+func (c *Client) GetPriceForArticle(ctx context.Context, priceList, articleNumber string, fromQuantity int) (*Price, error) {
+	return c.Prices.GetPriceForArticle(ctx, priceList, articleNumber, fromQuantity)
+}
+
+// UpdatePrice forwards to PricesService.UpdatePrice of c.Prices
+func (c *Client) UpdatePrice(ctx context.Context, priceList, articleNumber string, fromQuantity int, p *Price) (*Price, error) {
+	return c.Prices.UpdatePrice(ctx, priceList, articleNumber, fromQuantity, p)
+}
+
+// DeletePrice forwards to PricesService.DeletePrice of c.Prices
+func (c *Client) DeletePrice(ctx context.Context, priceList, articleNumber string, fromQuantity int) error {
+	return c.Prices.DeletePrice(ctx, priceList, articleNumber, fromQuantity)
+}
+
+// GetAllArticlesWithPricesInPriceList forwards to PricesService.GetAllArticlesWithPricesInPriceList of c.Prices
+func (c *Client) GetAllArticlesWithPricesInPriceList(ctx context.Context, priceList, articleNumber string) ([]Price, error) {
+	return c.Prices.GetAllArticlesWithPricesInPriceList(ctx, priceList, articleNumber)
+}
+
+// GetArticlesWithPricesInPriceListPage forwards to PricesService.GetArticlesWithPricesInPriceListPage of c.Prices
+func (c *Client) GetArticlesWithPricesInPriceListPage(ctx context.Context, priceList, articleNumber string, page *PageOptions) ([]Price, *MetaInformation, error) {
+	return c.Prices.GetArticlesWithPricesInPriceListPage(ctx, priceList, articleNumber, page)
+}
+
+// IterateArticlesWithPricesInPriceList forwards to PricesService.IterateArticlesWithPricesInPriceList of c.Prices
+func (c *Client) IterateArticlesWithPricesInPriceList(priceList, articleNumber string) *Iterator[Price] {
+	return c.Prices.IterateArticlesWithPricesInPriceList(priceList, articleNumber)
+}
+
+// GetFirstPriceForArticle forwards to PricesService.GetFirstPriceForArticle of c.Prices
+func (c *Client) GetFirstPriceForArticle(ctx context.Context, priceList, articleNumber string) (*Price, error) {
+	return c.Prices.GetFirstPriceForArticle(ctx, priceList, articleNumber)
+}
+
+// UpdateFirstPriceForArticle forwards to PricesService.UpdateFirstPriceForArticle of c.Prices
+func (c *Client) UpdateFirstPriceForArticle(ctx context.Context, priceList, articleNumber string, p *Price) (*Price, error) {
+	return c.Prices.UpdateFirstPriceForArticle(ctx, priceList, articleNumber, p)
+}
+
+// CreatePrice forwards to PricesService.CreatePrice of c.Prices
+func (c *Client) CreatePrice(ctx context.Context, p *Price) (*Price, error) {
+	return c.Prices.CreatePrice(ctx, p)
+}
+
+// PrintTemplatesService groups the calls reached as Client.PrintTemplates, fortnoxfake.PrintTemplates fakes it
+type PrintTemplatesService interface {
+	// GetAllPrintTemplates does _GET https://api.fortnox.se/3/printtemplates
+	GetAllPrintTemplates(ctx context.Context) ([]PrintTemplate, error)
+
+	// GetPrintTemplatesPage does _GET https://api.fortnox.se/3/printtemplates and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetPrintTemplatesPage(ctx context.Context, page *PageOptions) ([]PrintTemplate, *MetaInformation, error)
+
+	// IteratePrintTemplates lazily walks all pages of https://api.fortnox.se/3/printtemplates
+	IteratePrintTemplates() *Iterator[PrintTemplate]
+}
+
+type printTemplatesService struct {
+	*Client
+}
+
+// GetAllPrintTemplates forwards to PrintTemplatesService.GetAllPrintTemplates of c.PrintTemplates
+func (c *Client) GetAllPrintTemplates(ctx context.Context) ([]PrintTemplate, error) {
+	return c.PrintTemplates.GetAllPrintTemplates(ctx)
+}
+
+// GetPrintTemplatesPage forwards to PrintTemplatesService.GetPrintTemplatesPage of c.PrintTemplates
+func (c *Client) GetPrintTemplatesPage(ctx context.Context, page *PageOptions) ([]PrintTemplate, *MetaInformation, error) {
+	return c.PrintTemplates.GetPrintTemplatesPage(ctx, page)
+}
+
+// IteratePrintTemplates forwards to PrintTemplatesService.IteratePrintTemplates of c.PrintTemplates
+func (c *Client) IteratePrintTemplates() *Iterator[PrintTemplate] {
+	return c.PrintTemplates.IteratePrintTemplates()
+}
+
+// ProjectsService groups the calls reached as Client.Projects, fortnoxfake.Projects fakes it
+type ProjectsService interface {
+	// GetProject does _GET https://api.fortnox.se/3/projects/{ProjectNumber}
+	//
+	// projectNumber - identifies the project
+	GetProject(ctx context.Context, projectNumber int) (*Project, error)
+
+	// UpdateProject does _PUT https://api.fortnox.se/3/projects/{ProjectNumber}
+	//
+	// projectNumber - identifies the project
+	//
+	// p - project to update
+	UpdateProject(ctx context.Context, projectNumber int, p *Project) (*Project, error)
+
+	// RemoveProject does _DELETE https://api.fortnox.se/3/projects/{ProjectNumber}
+	//
+	// projectNumber - identifies the project
+	RemoveProject(ctx context.Context, projectNumber int) error
+
+	// GetAllProjects does _GET https://api.fortnox.se/3/projects
+	GetAllProjects(ctx context.Context) ([]Project, error)
+
+	// GetProjectsPage does _GET https://api.fortnox.se/3/projects and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetProjectsPage(ctx context.Context, page *PageOptions) ([]Project, *MetaInformation, error)
+
+	// IterateProjects lazily walks all pages of https://api.fortnox.se/3/projects
+	IterateProjects() *Iterator[Project]
+
+	// CreateProject does POST https://api.fortnox.se/3/projects/
+	//
+	// project - project to create
+	CreateProject(ctx context.Context, p *Project) (*Project, error)
+}
+
+type projectsService struct {
+	*Client
+}
+
+// GetProject forwards to ProjectsService.GetProject of c.Projects
+func (c *Client) GetProject(ctx context.Context, projectNumber int) (*Project, error) {
+	return c.Projects.GetProject(ctx, projectNumber)
+}
+
+// UpdateProject forwards to ProjectsService.UpdateProject of c.Projects
+func (c *Client) UpdateProject(ctx context.Context, projectNumber int, p *Project) (*Project, error) {
+	return c.Projects.UpdateProject(ctx, projectNumber, p)
+}
+
+// RemoveProject forwards to ProjectsService.RemoveProject of c.Projects
+func (c *Client) RemoveProject(ctx context.Context, projectNumber int) error {
+	return c.Projects.RemoveProject(ctx, projectNumber)
+}
+
+// GetAllProjects forwards to ProjectsService.GetAllProjects of c.Projects
+func (c *Client) GetAllProjects(ctx context.Context) ([]Project, error) {
+	return c.Projects.GetAllProjects(ctx)
+}
+
+// GetProjectsPage forwards to ProjectsService.GetProjectsPage of c.Projects
+func (c *Client) GetProjectsPage(ctx context.Context, page *PageOptions) ([]Project, *MetaInformation, error) {
+	return c.Projects.GetProjectsPage(ctx, page)
+}
+
+// IterateProjects forwards to ProjectsService.IterateProjects of c.Projects
+func (c *Client) IterateProjects() *Iterator[Project] {
+	return c.Projects.IterateProjects()
+}
+
+// CreateProject forwards to ProjectsService.CreateProject of c.Projects
+func (c *Client) CreateProject(ctx context.Context, p *Project) (*Project, error) {
+	return c.Projects.CreateProject(ctx, p)
+}
+
+// SIEService groups the calls reached as Client.SIE, fortnoxfake.SIE fakes it
+type SIEService interface {
+	// GetSIEFile does _GET https://api.fortnox.se/3/sie/{Type}
+	//
+	// typ - type
+	//
+	// filter - FinancialYearFilter
+	GetSIEFile(ctx context.Context, typ string, filter *FinancialYearFilter) (*Download, error)
+}
+
+type sieService struct {
+	*Client
+}
+
+// GetSIEFile forwards to SIEService.GetSIEFile of c.SIE
+func (c *Client) GetSIEFile(ctx context.Context, typ string, filter *FinancialYearFilter) (*Download, error) {
+	return c.SIE.GetSIEFile(ctx, typ, filter)
+}
+
+// SalaryTransactionsService groups the calls reached as Client.SalaryTransactions, fortnoxfake.SalaryTransactions fakes it
+type SalaryTransactionsService interface {
+	// GetAllSalaryTransactionsForAllEmployees does _GET https://api.fortnox.se/3/salarytransactions
+	//
+	// filter - GetAllSalaryTransactionsForAllEmployeesFilter
+	GetAllSalaryTransactionsForAllEmployees(ctx context.Context, filter *GetAllSalaryTransactionsForAllEmployeesFilter) ([]SalaryTransaction, error)
+
+	// GetSalaryTransactionsForAllEmployeesPage does _GET https://api.fortnox.se/3/salarytransactions and returns a single page along with its MetaInformation
+	//
+	// filter - GetAllSalaryTransactionsForAllEmployeesFilter
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetSalaryTransactionsForAllEmployeesPage(ctx context.Context, filter *GetAllSalaryTransactionsForAllEmployeesFilter, page *PageOptions) ([]SalaryTransaction, *MetaInformation, error)
+
+	// IterateSalaryTransactionsForAllEmployees lazily walks all pages of https://api.fortnox.se/3/salarytransactions
+	//
+	// filter - GetAllSalaryTransactionsForAllEmployeesFilter
+	IterateSalaryTransactionsForAllEmployees(filter *GetAllSalaryTransactionsForAllEmployeesFilter) *Iterator[SalaryTransaction]
+
+	// CreateSalaryTransactionsForEmployee does _POST https://api.fortnox.se/3/salarytransactions
+	//
+	// st - salary transaction to create
+	CreateSalaryTransactionsForEmployee(ctx context.Context, st *SalaryTransaction) (*SalaryTransaction, error)
+
+	// GetSalaryTransactionForEmployees does _GET https://api.fortnox.se/3/salarytransactions/{SalaryRow}
+	//
+	// salaryRow - identifies the salary transaction
+	GetSalaryTransactionForEmployees(ctx context.Context, salaryRow int) (*SalaryTransaction, error)
+
+	// UpdateSalaryTransactionForEmployee does _PUT https://api.fortnox.se/3/salarytransactions/{SalaryRow}
+	//
+	// salaryRow - identifies the salary transaction
+	//
+	// st - salary transaction to update
+	UpdateSalaryTransactionForEmployee(ctx context.Context, salaryRow int, st *SalaryTransaction) (*SalaryTransaction, error)
+
+	// DeleteSalaryTransactionForEmployee does _DELETE https://api.fortnox.se/3/salarytransactions/{SalaryRow}
+	//
+	// salaryRow - identifies the salary transaction
+	DeleteSalaryTransactionForEmployee(ctx context.Context, salaryRow int) (*SalaryTransaction, error)
+}
+
+type salaryTransactionsService struct {
+	*Client
+}
+
+// GetAllSalaryTransactionsForAllEmployees forwards to SalaryTransactionsService.GetAllSalaryTransactionsForAllEmployees of c.SalaryTransactions
+func (c *Client) GetAllSalaryTransactionsForAllEmployees(ctx context.Context, filter *GetAllSalaryTransactionsForAllEmployeesFilter) ([]SalaryTransaction, error) {
+	return c.SalaryTransactions.GetAllSalaryTransactionsForAllEmployees(ctx, filter)
+}
+
+// GetSalaryTransactionsForAllEmployeesPage forwards to SalaryTransactionsService.GetSalaryTransactionsForAllEmployeesPage of c.SalaryTransactions
+func (c *Client) GetSalaryTransactionsForAllEmployeesPage(ctx context.Context, filter *GetAllSalaryTransactionsForAllEmployeesFilter, page *PageOptions) ([]SalaryTransaction, *MetaInformation, error) {
+	return c.SalaryTransactions.GetSalaryTransactionsForAllEmployeesPage(ctx, filter, page)
+}
+
+// IterateSalaryTransactionsForAllEmployees forwards to SalaryTransactionsService.IterateSalaryTransactionsForAllEmployees of c.SalaryTransactions
+func (c *Client) IterateSalaryTransactionsForAllEmployees(filter *GetAllSalaryTransactionsForAllEmployeesFilter) *Iterator[SalaryTransaction] {
+	return c.SalaryTransactions.IterateSalaryTransactionsForAllEmployees(filter)
+}
+
+// CreateSalaryTransactionsForEmployee forwards to SalaryTransactionsService.CreateSalaryTransactionsForEmployee of c.SalaryTransactions
+func (c *Client) CreateSalaryTransactionsForEmployee(ctx context.Context, st *SalaryTransaction) (*SalaryTransaction, error) {
+	return c.SalaryTransactions.CreateSalaryTransactionsForEmployee(ctx, st)
+}
+
+// GetSalaryTransactionForEmployees forwards to SalaryTransactionsService.GetSalaryTransactionForEmployees of c.SalaryTransactions
+func (c *Client) GetSalaryTransactionForEmployees(ctx context.Context, salaryRow int) (*SalaryTransaction, error) {
+	return c.SalaryTransactions.GetSalaryTransactionForEmployees(ctx, salaryRow)
+}
+
+// UpdateSalaryTransactionForEmployee forwards to SalaryTransactionsService.UpdateSalaryTransactionForEmployee of c.SalaryTransactions
+func (c *Client) UpdateSalaryTransactionForEmployee(ctx context.Context, salaryRow int, st *SalaryTransaction) (*SalaryTransaction, error) {
+	return c.SalaryTransactions.UpdateSalaryTransactionForEmployee(ctx, salaryRow, st)
+}
+
+// DeleteSalaryTransactionForEmployee forwards to SalaryTransactionsService.DeleteSalaryTransactionForEmployee of c.SalaryTransactions
+func (c *Client) DeleteSalaryTransactionForEmployee(ctx context.Context, salaryRow int) (*SalaryTransaction, error) {
+	return c.SalaryTransactions.DeleteSalaryTransactionForEmployee(ctx, salaryRow)
+}
+
+// ScheduleTimesService groups the calls reached as Client.ScheduleTimes, fortnoxfake.ScheduleTimes fakes it
+type ScheduleTimesService interface {
+	// GetScheduleTime does _GET https://api.fortnox.se/3/scheduletimes/{EmployeeId}/{Date}
+	//
+	// employeeID - identifies the employee
+	//
+	// date - identifies the date
+	GetScheduleTime(ctx context.Context, employeeID string, date Date) (*ScheduleTime, error)
+
+	// UpdateScheduleTime does _PUT https://api.fortnox.se/3/scheduletimes/{EmployeeId}/{Date}
+	//
+	// employeeID - identifies the employee
+	//
+	// date - identifies the date
+	//
+	// st - schedule time to update
+	UpdateScheduleTime(ctx context.Context, employeeID string, date Date, st *ScheduleTime) (*ScheduleTime, error)
+
+	// ResetScheduleTime does _PUT https://api.fortnox.se/3/scheduletimes/{EmployeeId}/{Date}/resetday
+	//
+	// employeeID - identifies the employee
+	//
+	// date - identifies the date
+	ResetScheduleTime(ctx context.Context, employeeID string, date Date) (*ScheduleTime, error)
+}
+
+type scheduleTimesService struct {
+	*Client
+}
+
+// GetScheduleTime forwards to ScheduleTimesService.GetScheduleTime of c.ScheduleTimes
+func (c *Client) GetScheduleTime(ctx context.Context, employeeID string, date Date) (*ScheduleTime, error) {
+	return c.ScheduleTimes.GetScheduleTime(ctx, employeeID, date)
+}
+
+// UpdateScheduleTime forwards to ScheduleTimesService.UpdateScheduleTime of c.ScheduleTimes
+func (c *Client) UpdateScheduleTime(ctx context.Context, employeeID string, date Date, st *ScheduleTime) (*ScheduleTime, error) {
+	return c.ScheduleTimes.UpdateScheduleTime(ctx, employeeID, date, st)
+}
+
+// ResetScheduleTime forwards to ScheduleTimesService.ResetScheduleTime of c.ScheduleTimes
+func (c *Client) ResetScheduleTime(ctx context.Context, employeeID string, date Date) (*ScheduleTime, error) {
+	return c.ScheduleTimes.ResetScheduleTime(ctx, employeeID, date)
+}
+
+// SupplierInvoiceAccrualsService groups the calls reached as Client.SupplierInvoiceAccruals, fortnoxfake.SupplierInvoiceAccruals fakes it
+type SupplierInvoiceAccrualsService interface {
+	// GetAllSupplierInvoiceAccruals does _GET https://api.fortnox.se/3/supplierinvoiceaccruals/
+	GetAllSupplierInvoiceAccruals(ctx context.Context) ([]SupplierInvoiceAccrual, error)
+
+	// GetSupplierInvoiceAccrualsPage does _GET https://api.fortnox.se/3/supplierinvoiceaccruals/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetSupplierInvoiceAccrualsPage(ctx context.Context, page *PageOptions) ([]SupplierInvoiceAccrual, *MetaInformation, error)
+
+	// IterateSupplierInvoiceAccruals lazily walks all pages of https://api.fortnox.se/3/supplierinvoiceaccruals/
+	IterateSupplierInvoiceAccruals() *Iterator[SupplierInvoiceAccrual]
+
+	// CreateSupplierInvoiceAccruals does _POST https://api.fortnox.se/3/supplierinvoiceaccruals/
+	//
+	// req - supplier invoice accruals to create
+	CreateSupplierInvoiceAccruals(ctx context.Context, sia *SupplierInvoiceAccrual) (*SupplierInvoiceAccrual, error)
+
+	// GetSupplierInvoiceAccruals does _GET https://api.fortnox.se/3/supplierinvoiceaccruals/{SupplierInvoiceNumber}
+	//
+	// supplierInvoiceNumber - identifies the supplier invoice accrual
+	GetSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int) (*SupplierInvoiceAccrual, error)
+
+	// UpdateSupplierInvoiceAccruals does _PUT https://api.fortnox.se/3/supplierinvoiceaccruals/{SupplierInvoiceNumber}
+	//
+	// supplierInvoiceNumber - identifies the supplier invoice accrual
+	//
+	// req - supplier invoice accruals to update
+	UpdateSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int, sia *SupplierInvoiceAccrual) (*SupplierInvoiceAccrual, error)
+
+	// DeleteSupplierInvoiceAccruals does _DELETE https://api.fortnox.se/3/supplierinvoiceaccruals/{SupplierInvoiceNumber}
+	//
+	// supplierInvoiceNumber - identifies the supplier invoice accrual
+	DeleteSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int) error
+}
+
+type supplierInvoiceAccrualsService struct {
+	*Client
+}
+
+// GetAllSupplierInvoiceAccruals forwards to SupplierInvoiceAccrualsService.GetAllSupplierInvoiceAccruals of c.SupplierInvoiceAccruals
+func (c *Client) GetAllSupplierInvoiceAccruals(ctx context.Context) ([]SupplierInvoiceAccrual, error) {
+	return c.SupplierInvoiceAccruals.GetAllSupplierInvoiceAccruals(ctx)
+}
+
+// GetSupplierInvoiceAccrualsPage forwards to SupplierInvoiceAccrualsService.GetSupplierInvoiceAccrualsPage of c.SupplierInvoiceAccruals
+func (c *Client) GetSupplierInvoiceAccrualsPage(ctx context.Context, page *PageOptions) ([]SupplierInvoiceAccrual, *MetaInformation, error) {
+	return c.SupplierInvoiceAccruals.GetSupplierInvoiceAccrualsPage(ctx, page)
+}
+
+// IterateSupplierInvoiceAccruals forwards to SupplierInvoiceAccrualsService.IterateSupplierInvoiceAccruals of c.SupplierInvoiceAccruals
+func (c *Client) IterateSupplierInvoiceAccruals() *Iterator[SupplierInvoiceAccrual] {
+	return c.SupplierInvoiceAccruals.IterateSupplierInvoiceAccruals()
+}
+
+// CreateSupplierInvoiceAccruals forwards to SupplierInvoiceAccrualsService.CreateSupplierInvoiceAccruals of c.SupplierInvoiceAccruals
+func (c *Client) CreateSupplierInvoiceAccruals(ctx context.Context, sia *SupplierInvoiceAccrual) (*SupplierInvoiceAccrual, error) {
+	return c.SupplierInvoiceAccruals.CreateSupplierInvoiceAccruals(ctx, sia)
+}
+
+// GetSupplierInvoiceAccruals forwards to SupplierInvoiceAccrualsService.GetSupplierInvoiceAccruals of c.SupplierInvoiceAccruals
+func (c *Client) GetSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int) (*SupplierInvoiceAccrual, error) {
+	return c.SupplierInvoiceAccruals.GetSupplierInvoiceAccruals(ctx, supplierInvoiceNumber)
+}
+
+// UpdateSupplierInvoiceAccruals forwards to SupplierInvoiceAccrualsService.UpdateSupplierInvoiceAccruals of c.SupplierInvoiceAccruals
+func (c *Client) UpdateSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int, sia *SupplierInvoiceAccrual) (*SupplierInvoiceAccrual, error) {
+	return c.SupplierInvoiceAccruals.UpdateSupplierInvoiceAccruals(ctx, supplierInvoiceNumber, sia)
+}
+
+// DeleteSupplierInvoiceAccruals forwards to SupplierInvoiceAccrualsService.DeleteSupplierInvoiceAccruals of c.SupplierInvoiceAccruals
+func (c *Client) DeleteSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int) error {
+	return c.SupplierInvoiceAccruals.DeleteSupplierInvoiceAccruals(ctx, supplierInvoiceNumber)
+}
+
+// SupplierInvoiceExternalUrlConnectionsService groups the calls reached as Client.SupplierInvoiceExternalUrlConnections, fortnoxfake.SupplierInvoiceExternalUrlConnections fakes it
+type SupplierInvoiceExternalUrlConnectionsService interface {
+	// GetSupplierInvoiceExternalUrlConnection does _GET https://api.fortnox.se/3/supplierinvoiceexternalurlconnections/{Id}
+	//
+	// id - id
+	GetSupplierInvoiceExternalUrlConnection(ctx context.Context, id int) (*SupplierInvoiceExternalURLConnection, error)
+
+	// UpdateSupplierInvoiceExternalUrlConnection does _PUT https://api.fortnox.se/3/supplierinvoiceexternalurlconnections/{Id}
+	//
+	// id - id
+	//
+	// req - supplier invoice external url connection to update
+	UpdateSupplierInvoiceExternalUrlConnection(ctx context.Context, id int, req *UpdateSupplierInvoiceExternalUrlConnectionReq) (*SupplierInvoiceExternalURLConnection, error)
+
+	// DeleteSupplierInvoiceExternalUrlConnection does _DELETE https://api.fortnox.se/3/supplierinvoiceexternalurlconnections/{Id}
+	//
+	// id - id
+	DeleteSupplierInvoiceExternalUrlConnection(ctx context.Context, id int) error
+
+	// CreateSupplierInvoiceExternalUrlConnection does _POST https://api.fortnox.se/3/supplierinvoiceexternalurlconnections
+	//
+	// req - supplier invoice external url connection to update
+	CreateSupplierInvoiceExternalUrlConnection(ctx context.Context, req *CreateSupplierInvoiceExternalUrlConnectionReq) (*SupplierInvoiceExternalURLConnection, error)
+}
+
+type supplierInvoiceExternalUrlConnectionsService struct {
+	*Client
+}
+
+// GetSupplierInvoiceExternalUrlConnection forwards to SupplierInvoiceExternalUrlConnectionsService.GetSupplierInvoiceExternalUrlConnection of c.SupplierInvoiceExternalUrlConnections
+func (c *Client) GetSupplierInvoiceExternalUrlConnection(ctx context.Context, id int) (*SupplierInvoiceExternalURLConnection, error) {
+	return c.SupplierInvoiceExternalUrlConnections.GetSupplierInvoiceExternalUrlConnection(ctx, id)
+}
+
+// UpdateSupplierInvoiceExternalUrlConnection forwards to SupplierInvoiceExternalUrlConnectionsService.UpdateSupplierInvoiceExternalUrlConnection of c.SupplierInvoiceExternalUrlConnections
+func (c *Client) UpdateSupplierInvoiceExternalUrlConnection(ctx context.Context, id int, req *UpdateSupplierInvoiceExternalUrlConnectionReq) (*SupplierInvoiceExternalURLConnection, error) {
+	return c.SupplierInvoiceExternalUrlConnections.UpdateSupplierInvoiceExternalUrlConnection(ctx, id, req)
+}
+
+// DeleteSupplierInvoiceExternalUrlConnection forwards to SupplierInvoiceExternalUrlConnectionsService.DeleteSupplierInvoiceExternalUrlConnection of c.SupplierInvoiceExternalUrlConnections
+func (c *Client) DeleteSupplierInvoiceExternalUrlConnection(ctx context.Context, id int) error {
+	return c.SupplierInvoiceExternalUrlConnections.DeleteSupplierInvoiceExternalUrlConnection(ctx, id)
+}
+
+// CreateSupplierInvoiceExternalUrlConnection forwards to SupplierInvoiceExternalUrlConnectionsService.CreateSupplierInvoiceExternalUrlConnection of c.SupplierInvoiceExternalUrlConnections
+func (c *Client) CreateSupplierInvoiceExternalUrlConnection(ctx context.Context, req *CreateSupplierInvoiceExternalUrlConnectionReq) (*SupplierInvoiceExternalURLConnection, error) {
+	return c.SupplierInvoiceExternalUrlConnections.CreateSupplierInvoiceExternalUrlConnection(ctx, req)
+}
+
+// SupplierInvoiceFileConnectionsService groups the calls reached as Client.SupplierInvoiceFileConnections, fortnoxfake.SupplierInvoiceFileConnections fakes it
+type SupplierInvoiceFileConnectionsService interface {
+	// GetAllSupplierInvoiceFileConnections does _GET https://api.fortnox.se/3/supplierinvoicefileconnections/
+	GetAllSupplierInvoiceFileConnections(ctx context.Context) ([]SupplierInvoiceFileConnection, error)
+
+	// GetSupplierInvoiceFileConnectionsPage does _GET https://api.fortnox.se/3/supplierinvoicefileconnections/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetSupplierInvoiceFileConnectionsPage(ctx context.Context, page *PageOptions) ([]SupplierInvoiceFileConnection, *MetaInformation, error)
+
+	// IterateSupplierInvoiceFileConnections lazily walks all pages of https://api.fortnox.se/3/supplierinvoicefileconnections/
+	IterateSupplierInvoiceFileConnections() *Iterator[SupplierInvoiceFileConnection]
+
+	// CreateSupplierInvoiceFileConnection does _POST https://api.fortnox.se/3/supplierinvoicefileconnections/
+	//
+	// sifc - supplier invoice file connection to create
+	CreateSupplierInvoiceFileConnection(ctx context.Context, sifc *SupplierInvoiceFileConnection) (*SupplierInvoiceFileConnection, error)
+
+	// GetSupplierInvoiceFileConnection does _GET https://api.fortnox.se/3/supplierinvoicefileconnections/{FileId}
+	//
+	// fileID - identifies the file connection
+	GetSupplierInvoiceFileConnection(ctx context.Context, fileID string) (*SupplierInvoiceFileConnection, error)
+
+	// RemoveSupplierInvoiceFileConnections does _DELETE https://api.fortnox.se/3/supplierinvoicefileconnections/{FileId}
+	//
+	// fileID - identifies the file connection
+	RemoveSupplierInvoiceFileConnections(ctx context.Context, fieldID string) error
+}
+
+type supplierInvoiceFileConnectionsService struct {
+	*Client
+}
+
+// GetAllSupplierInvoiceFileConnections forwards to SupplierInvoiceFileConnectionsService.GetAllSupplierInvoiceFileConnections of c.SupplierInvoiceFileConnections
+func (c *Client) GetAllSupplierInvoiceFileConnections(ctx context.Context) ([]SupplierInvoiceFileConnection, error) {
+	return c.SupplierInvoiceFileConnections.GetAllSupplierInvoiceFileConnections(ctx)
+}
+
+// GetSupplierInvoiceFileConnectionsPage forwards to SupplierInvoiceFileConnectionsService.GetSupplierInvoiceFileConnectionsPage of c.SupplierInvoiceFileConnections
+func (c *Client) GetSupplierInvoiceFileConnectionsPage(ctx context.Context, page *PageOptions) ([]SupplierInvoiceFileConnection, *MetaInformation, error) {
+	return c.SupplierInvoiceFileConnections.GetSupplierInvoiceFileConnectionsPage(ctx, page)
+}
+
+// IterateSupplierInvoiceFileConnections forwards to SupplierInvoiceFileConnectionsService.IterateSupplierInvoiceFileConnections of c.SupplierInvoiceFileConnections
+func (c *Client) IterateSupplierInvoiceFileConnections() *Iterator[SupplierInvoiceFileConnection] {
+	return c.SupplierInvoiceFileConnections.IterateSupplierInvoiceFileConnections()
+}
+
+// CreateSupplierInvoiceFileConnection forwards to SupplierInvoiceFileConnectionsService.CreateSupplierInvoiceFileConnection of c.SupplierInvoiceFileConnections
+func (c *Client) CreateSupplierInvoiceFileConnection(ctx context.Context, sifc *SupplierInvoiceFileConnection) (*SupplierInvoiceFileConnection, error) {
+	return c.SupplierInvoiceFileConnections.CreateSupplierInvoiceFileConnection(ctx, sifc)
+}
+
+// GetSupplierInvoiceFileConnection forwards to SupplierInvoiceFileConnectionsService.GetSupplierInvoiceFileConnection of c.SupplierInvoiceFileConnections
+func (c *Client) GetSupplierInvoiceFileConnection(ctx context.Context, fileID string) (*SupplierInvoiceFileConnection, error) {
+	return c.SupplierInvoiceFileConnections.GetSupplierInvoiceFileConnection(ctx, fileID)
+}
+
+// RemoveSupplierInvoiceFileConnections forwards to SupplierInvoiceFileConnectionsService.RemoveSupplierInvoiceFileConnections of c.SupplierInvoiceFileConnections
+func (c *Client) RemoveSupplierInvoiceFileConnections(ctx context.Context, fieldID string) error {
+	return c.SupplierInvoiceFileConnections.RemoveSupplierInvoiceFileConnections(ctx, fieldID)
+}
+
+// SupplierInvoicePaymentsService groups the calls reached as Client.SupplierInvoicePayments, fortnoxfake.SupplierInvoicePayments fakes it
+type SupplierInvoicePaymentsService interface {
+	// GetAllSupplierInvoicePayments does _GET https://api.fortnox.se/3/supplierinvoicepayments/
+	GetAllSupplierInvoicePayments(ctx context.Context) (*GetAllSupplierInvoicePaymentsResp, error)
+
+	// GetSupplierInvoicePaymentsPage does _GET https://api.fortnox.se/3/supplierinvoicepayments/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetSupplierInvoicePaymentsPage(ctx context.Context, page *PageOptions) ([]SupplierInvoicePayment, *MetaInformation, error)
+
+	// IterateSupplierInvoicePayments lazily walks all pages of https://api.fortnox.se/3/supplierinvoicepayments/
+	IterateSupplierInvoicePayments() *Iterator[SupplierInvoicePayment]
+
+	// CreateSupplierInvoicePayment does _POST https://api.fortnox.se/3/supplierinvoicepayments/
+	//
+	// req - supplier invoice payment to create
+	CreateSupplierInvoicePayment(ctx context.Context, req *CreateSupplierInvoicePaymentReq) (*CreateSupplierInvoicePaymentResp, error)
+
+	// GetSupplierInvoicePayment does _GET https://api.fortnox.se/3/supplierinvoicepayments/{Number}
+	//
+	// number - identifies the supplier invoice payment
+	GetSupplierInvoicePayment(ctx context.Context, number int) (*GetSupplierInvoicePaymentResp, error)
+
+	// UpdateSupplierInvoicePayment does _PUT https://api.fortnox.se/3/supplierinvoicepayments/{Number}
+	//
+	// number - identifies the supplier invoice payment
+	//
+	// req - supplier invoice payment to update
+	UpdateSupplierInvoicePayment(ctx context.Context, number int, req *UpdateSupplierInvoicePaymentReq) (*UpdateSupplierInvoicePaymentResp, error)
+
+	// RemoveSupplierInvoicePayment does _DELETE https://api.fortnox.se/3/supplierinvoicepayments/{Number}
+	//
+	// number - identifies the supplier invoice payment
+	RemoveSupplierInvoicePayment(ctx context.Context, number int) error
+
+	// BookKeepSupplierInvoicePayment does _PUT https://api.fortnox.se/3/supplierinvoicepayments/{Number}/bookkeep
+	//
+	// number - identifies the supplier invoice payment
+	BookKeepSupplierInvoicePayment(ctx context.Context, number int) (*BookKeepSupplierInvoicePaymentResp, error)
+}
+
+type supplierInvoicePaymentsService struct {
+	*Client
+}
+
+// GetAllSupplierInvoicePayments forwards to SupplierInvoicePaymentsService.GetAllSupplierInvoicePayments of c.SupplierInvoicePayments
+func (c *Client) GetAllSupplierInvoicePayments(ctx context.Context) (*GetAllSupplierInvoicePaymentsResp, error) {
+	return c.SupplierInvoicePayments.GetAllSupplierInvoicePayments(ctx)
+}
+
+// GetSupplierInvoicePaymentsPage forwards to SupplierInvoicePaymentsService.GetSupplierInvoicePaymentsPage of c.SupplierInvoicePayments
+func (c *Client) GetSupplierInvoicePaymentsPage(ctx context.Context, page *PageOptions) ([]SupplierInvoicePayment, *MetaInformation, error) {
+	return c.SupplierInvoicePayments.GetSupplierInvoicePaymentsPage(ctx, page)
+}
+
+// IterateSupplierInvoicePayments forwards to SupplierInvoicePaymentsService.IterateSupplierInvoicePayments of c.SupplierInvoicePayments
+func (c *Client) IterateSupplierInvoicePayments() *Iterator[SupplierInvoicePayment] {
+	return c.SupplierInvoicePayments.IterateSupplierInvoicePayments()
+}
+
+// CreateSupplierInvoicePayment forwards to SupplierInvoicePaymentsService.CreateSupplierInvoicePayment of c.SupplierInvoicePayments
+func (c *Client) CreateSupplierInvoicePayment(ctx context.Context, req *CreateSupplierInvoicePaymentReq) (*CreateSupplierInvoicePaymentResp, error) {
+	return c.SupplierInvoicePayments.CreateSupplierInvoicePayment(ctx, req)
+}
+
+// GetSupplierInvoicePayment forwards to SupplierInvoicePaymentsService.GetSupplierInvoicePayment of c.SupplierInvoicePayments
+func (c *Client) GetSupplierInvoicePayment(ctx context.Context, number int) (*GetSupplierInvoicePaymentResp, error) {
+	return c.SupplierInvoicePayments.GetSupplierInvoicePayment(ctx, number)
+}
+
+// UpdateSupplierInvoicePayment forwards to SupplierInvoicePaymentsService.UpdateSupplierInvoicePayment of c.SupplierInvoicePayments
+func (c *Client) UpdateSupplierInvoicePayment(ctx context.Context, number int, req *UpdateSupplierInvoicePaymentReq) (*UpdateSupplierInvoicePaymentResp, error) {
+	return c.SupplierInvoicePayments.UpdateSupplierInvoicePayment(ctx, number, req)
+}
+
+// RemoveSupplierInvoicePayment forwards to SupplierInvoicePaymentsService.RemoveSupplierInvoicePayment of c.SupplierInvoicePayments
+func (c *Client) RemoveSupplierInvoicePayment(ctx context.Context, number int) error {
+	return c.SupplierInvoicePayments.RemoveSupplierInvoicePayment(ctx, number)
+}
+
+// BookKeepSupplierInvoicePayment forwards to SupplierInvoicePaymentsService.BookKeepSupplierInvoicePayment of c.SupplierInvoicePayments
+func (c *Client) BookKeepSupplierInvoicePayment(ctx context.Context, number int) (*BookKeepSupplierInvoicePaymentResp, error) {
+	return c.SupplierInvoicePayments.BookKeepSupplierInvoicePayment(ctx, number)
+}
+
+// SupplierInvoicesService groups the calls reached as Client.SupplierInvoices, fortnoxfake.SupplierInvoices fakes it
+type SupplierInvoicesService interface {
+	// GetAllSupplierInvoices does _GET https://api.fortnox.se/3/supplierinvoices/
+	//
+	// filter - Enum: "cancelled" "fullypaid" "unpaid" "unpaidoverdue" "unbooked" "pendingpayment" "authorizepending"
+	// possibility to filter supplier invoices
+	GetAllSupplierInvoices(ctx context.Context, filter *GetAllSupplierInvoicesFilter) ([]SupplierInvoice, error)
+
+	// GetSupplierInvoicesPage does _GET https://api.fortnox.se/3/supplierinvoices/ and returns a single page along with its MetaInformation
+	//
+	// filter - Enum: "cancelled" "fullypaid" "unpaid" "unpaidoverdue" "unbooked" "pendingpayment" "authorizepending"
+	// possibility to filter supplier invoices
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetSupplierInvoicesPage(ctx context.Context, filter *GetAllSupplierInvoicesFilter, page *PageOptions) ([]SupplierInvoice, *MetaInformation, error)
+
+	// IterateSupplierInvoices lazily walks all pages of https://api.fortnox.se/3/supplierinvoices/
+	//
+	// filter - Enum: "cancelled" "fullypaid" "unpaid" "unpaidoverdue" "unbooked" "pendingpayment" "authorizepending"
+	// possibility to filter supplier invoices
+	IterateSupplierInvoices(filter *GetAllSupplierInvoicesFilter) *Iterator[SupplierInvoice]
+
+	// CreateSupplierInvoice does _POST https://api.fortnox.se/3/supplierinvoices/
+	//
+	// req - supplier invoice to create
+	CreateSupplierInvoice(ctx context.Context, si *SupplierInvoice) (*SupplierInvoice, error)
+
+	// GetSupplierInvoice does _GET https://api.fortnox.se/3/supplierinvoices/{GivenNumber}
+	//
+	// givenNumber - identifies the invoice
+	GetSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error)
+
+	// UpdateSupplierInvoice does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}
+	//
+	// givenNumber - identifies the invoice
+	//
+	// si - supplier invoice to update
+	UpdateSupplierInvoice(ctx context.Context, givenNumber int, si *SupplierInvoice) (*SupplierInvoice, error)
+
+	// BookKeepSupplierInvoice does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/bookkeep
+	//
+	// givenNumber - identifies the invoice
+	BookKeepSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error)
+
+	// CancelSupplierInvoice does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/cancel
+	//
+	// givenNumber - identifies the invoice
+	CancelSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error)
+
+	// CreditSupplierInvoicePayment does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/credit
+	//
+	// givenNumber - identifies the invoice
+	CreditSupplierInvoicePayment(ctx context.Context, givenNumber int) (*SupplierInvoice, error)
+
+	// ApprovalSupplierInvoicePayment does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/approvalpayment
+	ApprovalSupplierInvoicePayment(ctx context.Context, givenNumber int) (*SupplierInvoice, error)
+
+	// ApprovalSupplierInvoiceBookKeep does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/approvalbookkeep
+	//
+	// givenNumber - identifies the invoice
+	ApprovalSupplierInvoiceBookKeep(ctx context.Context, givenNumber int) (*SupplierInvoice, error)
+}
+
+type supplierInvoicesService struct {
+	*Client
+}
+
+// GetAllSupplierInvoices forwards to SupplierInvoicesService.GetAllSupplierInvoices of c.SupplierInvoices
+func (c *Client) GetAllSupplierInvoices(ctx context.Context, filter *GetAllSupplierInvoicesFilter) ([]SupplierInvoice, error) {
+	return c.SupplierInvoices.GetAllSupplierInvoices(ctx, filter)
+}
+
+// GetSupplierInvoicesPage forwards to SupplierInvoicesService.GetSupplierInvoicesPage of c.SupplierInvoices
+func (c *Client) GetSupplierInvoicesPage(ctx context.Context, filter *GetAllSupplierInvoicesFilter, page *PageOptions) ([]SupplierInvoice, *MetaInformation, error) {
+	return c.SupplierInvoices.GetSupplierInvoicesPage(ctx, filter, page)
+}
+
+// IterateSupplierInvoices forwards to SupplierInvoicesService.IterateSupplierInvoices of c.SupplierInvoices
+func (c *Client) IterateSupplierInvoices(filter *GetAllSupplierInvoicesFilter) *Iterator[SupplierInvoice] {
+	return c.SupplierInvoices.IterateSupplierInvoices(filter)
+}
+
+// CreateSupplierInvoice forwards to SupplierInvoicesService.CreateSupplierInvoice of c.SupplierInvoices
+func (c *Client) CreateSupplierInvoice(ctx context.Context, si *SupplierInvoice) (*SupplierInvoice, error) {
+	return c.SupplierInvoices.CreateSupplierInvoice(ctx, si)
+}
+
+// GetSupplierInvoice forwards to SupplierInvoicesService.GetSupplierInvoice of c.SupplierInvoices
+func (c *Client) GetSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+	return c.SupplierInvoices.GetSupplierInvoice(ctx, givenNumber)
+}
+
+// UpdateSupplierInvoice forwards to SupplierInvoicesService.UpdateSupplierInvoice of c.SupplierInvoices
+func (c *Client) UpdateSupplierInvoice(ctx context.Context, givenNumber int, si *SupplierInvoice) (*SupplierInvoice, error) {
+	return c.SupplierInvoices.UpdateSupplierInvoice(ctx, givenNumber, si)
+}
+
+// BookKeepSupplierInvoice forwards to SupplierInvoicesService.BookKeepSupplierInvoice of c.SupplierInvoices
+func (c *Client) BookKeepSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+	return c.SupplierInvoices.BookKeepSupplierInvoice(ctx, givenNumber)
+}
+
+// CancelSupplierInvoice forwards to SupplierInvoicesService.CancelSupplierInvoice of c.SupplierInvoices
+func (c *Client) CancelSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+	return c.SupplierInvoices.CancelSupplierInvoice(ctx, givenNumber)
+}
+
+// CreditSupplierInvoicePayment forwards to SupplierInvoicesService.CreditSupplierInvoicePayment of c.SupplierInvoices
+func (c *Client) CreditSupplierInvoicePayment(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+	return c.SupplierInvoices.CreditSupplierInvoicePayment(ctx, givenNumber)
+}
+
+// ApprovalSupplierInvoicePayment forwards to SupplierInvoicesService.ApprovalSupplierInvoicePayment of c.SupplierInvoices
+func (c *Client) ApprovalSupplierInvoicePayment(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+	return c.SupplierInvoices.ApprovalSupplierInvoicePayment(ctx, givenNumber)
+}
+
+// ApprovalSupplierInvoiceBookKeep forwards to SupplierInvoicesService.ApprovalSupplierInvoiceBookKeep of c.SupplierInvoices
+func (c *Client) ApprovalSupplierInvoiceBookKeep(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+	return c.SupplierInvoices.ApprovalSupplierInvoiceBookKeep(ctx, givenNumber)
+}
+
+// SuppliersService groups the calls reached as Client.Suppliers, fortnoxfake.Suppliers fakes it
+type SuppliersService interface {
+	// GetAllSuppliers does _GET https://api.fortnox.se/3/suppliers
+	GetAllSuppliers(ctx context.Context) ([]Supplier, error)
+
+	// GetSuppliersPage does _GET https://api.fortnox.se/3/suppliers and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetSuppliersPage(ctx context.Context, page *PageOptions) ([]Supplier, *MetaInformation, error)
+
+	// IterateSuppliers lazily walks all pages of https://api.fortnox.se/3/suppliers
+	IterateSuppliers() *Iterator[Supplier]
+
+	// CreateSupplier does _POST https://api.fortnox.se/3/suppliers
+	//
+	// req - supplier to create
+	CreateSupplier(ctx context.Context, s *Supplier) (*Supplier, error)
+
+	// GetSupplier does _GET https://api.fortnox.se/3/suppliers/{SupplierNumber}
+	//
+	// supplierNumber - identifies the supplier
+	GetSupplier(ctx context.Context, supplierNumber string) (*Supplier, error)
+
+	// UpdateSupplier does _PUT https://api.fortnox.se/3/suppliers/{SupplierNumber}
+	//
+	// supplierNumber - identifies the supplier
+	//
+	// s - fields to update, only fields that are Set are sent
+	UpdateSupplier(ctx context.Context, supplierNumber string, s *SupplierUpdate) (*Supplier, error)
+
+	// PatchSupplier does _PUT https://api.fortnox.se/3/suppliers/{SupplierNumber} sending only the fields changed between original and edited
+	//
+	// original - supplier as returned by Fortnox
+	//
+	// edited - modified copy of original
+	//
+	// Nothing is sent when no writable field changed, original is returned then.
+	PatchSupplier(ctx context.Context, original, edited *Supplier) (*Supplier, error)
+}
+
+type suppliersService struct {
+	*Client
+}
+
+// GetAllSuppliers forwards to SuppliersService.GetAllSuppliers of c.Suppliers
+func (c *Client) GetAllSuppliers(ctx context.Context) ([]Supplier, error) {
+	return c.Suppliers.GetAllSuppliers(ctx)
+}
+
+// GetSuppliersPage forwards to SuppliersService.GetSuppliersPage of c.Suppliers
+func (c *Client) GetSuppliersPage(ctx context.Context, page *PageOptions) ([]Supplier, *MetaInformation, error) {
+	return c.Suppliers.GetSuppliersPage(ctx, page)
+}
+
+// IterateSuppliers forwards to SuppliersService.IterateSuppliers of c.Suppliers
+func (c *Client) IterateSuppliers() *Iterator[Supplier] {
+	return c.Suppliers.IterateSuppliers()
+}
+
+// CreateSupplier forwards to SuppliersService.CreateSupplier of c.Suppliers
+func (c *Client) CreateSupplier(ctx context.Context, s *Supplier) (*Supplier, error) {
+	return c.Suppliers.CreateSupplier(ctx, s)
+}
+
+// GetSupplier forwards to SuppliersService.GetSupplier of c.Suppliers
+func (c *Client) GetSupplier(ctx context.Context, supplierNumber string) (*Supplier, error) {
+	return c.Suppliers.GetSupplier(ctx, supplierNumber)
+}
+
+// UpdateSupplier forwards to SuppliersService.UpdateSupplier of c.Suppliers
+func (c *Client) UpdateSupplier(ctx context.Context, supplierNumber string, s *SupplierUpdate) (*Supplier, error) {
+	return c.Suppliers.UpdateSupplier(ctx, supplierNumber, s)
+}
+
+// PatchSupplier forwards to SuppliersService.PatchSupplier of c.Suppliers
+func (c *Client) PatchSupplier(ctx context.Context, original, edited *Supplier) (*Supplier, error) {
+	return c.Suppliers.PatchSupplier(ctx, original, edited)
+}
+
+// TaxReductionsService groups the calls reached as Client.TaxReductions, fortnoxfake.TaxReductions fakes it
+type TaxReductionsService interface {
+	// GetAllTaxReductions does _GET https://api.fortnox.se/3/taxreductions
+	//
+	// Enum: {"invoices" "orders" "offers"}, possibility to filter tax reductions
+	GetAllTaxReductions(ctx context.Context, filter *GetAllTaxReductionsFilter) ([]TaxReduction, error)
+
+	// GetTaxReductionsPage does _GET https://api.fortnox.se/3/taxreductions and returns a single page along with its MetaInformation
+	//
+	// Enum: {"invoices" "orders" "offers"}, possibility to filter tax reductions
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetTaxReductionsPage(ctx context.Context, filter *GetAllTaxReductionsFilter, page *PageOptions) ([]TaxReduction, *MetaInformation, error)
+
+	// IterateTaxReductions lazily walks all pages of https://api.fortnox.se/3/taxreductions
+	//
+	// Enum: {"invoices" "orders" "offers"}, possibility to filter tax reductions
+	IterateTaxReductions(filter *GetAllTaxReductionsFilter) *Iterator[TaxReduction]
+
+	// CreateTaxReduction does _POST https://api.fortnox.se/3/taxreductions
+	//
+	// req - tax reduction to create
+	CreateTaxReduction(ctx context.Context, tr *TaxReduction) (*TaxReduction, error)
+
+	// GetTaxReduction does _GET https://api.fortnox.se/3/taxreductions/{Id}
+	//
+	// id - identifies the tax reduction
+	GetTaxReduction(ctx context.Context, id int) (*TaxReduction, error)
+
+	// UpdateTaxReduction does _PUT https://api.fortnox.se/3/taxreductions/{Id}
+	//
+	// id - identifies the tax reduction
+	//
+	// req - tax reduction to update
+	UpdateTaxReduction(ctx context.Context, id int, tr *TaxReduction) (*TaxReduction, error)
+
+	// RemoveTaxReduction does _DELETE https://api.fortnox.se/3/taxreductions/{Id}
+	//
+	// id - identifies the tax reduction
+	RemoveTaxReduction(ctx context.Context, id int) error
+}
+
+type taxReductionsService struct {
+	*Client
+}
+
+// GetAllTaxReductions forwards to TaxReductionsService.GetAllTaxReductions of c.TaxReductions
+func (c *Client) GetAllTaxReductions(ctx context.Context, filter *GetAllTaxReductionsFilter) ([]TaxReduction, error) {
+	return c.TaxReductions.GetAllTaxReductions(ctx, filter)
+}
+
+// GetTaxReductionsPage forwards to TaxReductionsService.GetTaxReductionsPage of c.TaxReductions
+func (c *Client) GetTaxReductionsPage(ctx context.Context, filter *GetAllTaxReductionsFilter, page *PageOptions) ([]TaxReduction, *MetaInformation, error) {
+	return c.TaxReductions.GetTaxReductionsPage(ctx, filter, page)
+}
+
+// IterateTaxReductions forwards to TaxReductionsService.IterateTaxReductions of c.TaxReductions
+func (c *Client) IterateTaxReductions(filter *GetAllTaxReductionsFilter) *Iterator[TaxReduction] {
+	return c.TaxReductions.IterateTaxReductions(filter)
+}
+
+// CreateTaxReduction forwards to TaxReductionsService.CreateTaxReduction of c.TaxReductions
+func (c *Client) CreateTaxReduction(ctx context.Context, tr *TaxReduction) (*TaxReduction, error) {
+	return c.TaxReductions.CreateTaxReduction(ctx, tr)
+}
+
+// GetTaxReduction forwards to TaxReductionsService.GetTaxReduction of c.TaxReductions
+func (c *Client) GetTaxReduction(ctx context.Context, id int) (*TaxReduction, error) {
+	return c.TaxReductions.GetTaxReduction(ctx, id)
+}
+
+// UpdateTaxReduction forwards to TaxReductionsService.UpdateTaxReduction of c.TaxReductions
+func (c *Client) UpdateTaxReduction(ctx context.Context, id int, tr *TaxReduction) (*TaxReduction, error) {
+	return c.TaxReductions.UpdateTaxReduction(ctx, id, tr)
+}
+
+// RemoveTaxReduction forwards to TaxReductionsService.RemoveTaxReduction of c.TaxReductions
+func (c *Client) RemoveTaxReduction(ctx context.Context, id int) error {
+	return c.TaxReductions.RemoveTaxReduction(ctx, id)
+}
+
+// TermsOfDeliveriesService groups the calls reached as Client.TermsOfDeliveries, fortnoxfake.TermsOfDeliveries fakes it
+type TermsOfDeliveriesService interface {
+	// GetAllTermsOfDeliveries does _GET https://api.fortnox.se/3/termsofdeliveries
+	GetAllTermsOfDeliveries(ctx context.Context) ([]TermsOfDelivery, error)
+
+	// GetTermsOfDeliveriesPage does _GET https://api.fortnox.se/3/termsofdeliveries and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetTermsOfDeliveriesPage(ctx context.Context, page *PageOptions) ([]TermsOfDelivery, *MetaInformation, error)
+
+	// IterateTermsOfDeliveries lazily walks all pages of https://api.fortnox.se/3/termsofdeliveries
+	IterateTermsOfDeliveries() *Iterator[TermsOfDelivery]
+
+	// CreateTermsOfDeliveries does _POST https://api.fortnox.se/3/termsofdeliveries
+	//
+	// req - terms of delivery to create
+	CreateTermsOfDeliveries(ctx context.Context, tod *TermsOfDelivery) (*TermsOfDelivery, error)
+
+	// GetTermOfDelivery does _GET https://api.fortnox.se/3/termsofdeliveries/{Code}
+	//
+	// code - identifies the terms of delivery
+	GetTermOfDelivery(ctx context.Context, code string) (*TermsOfDelivery, error)
+
+	// UpdateTermOfDelivery does _PUT https://api.fortnox.se/3/termsofdeliveries/{Code}
+	//
+	// code - identifies the terms of delivery
+	//
+	// req - terms of delivery to update
+	UpdateTermOfDelivery(ctx context.Context, code string, tod *TermsOfDelivery) (*TermsOfDelivery, error)
+}
+
+type termsOfDeliveriesService struct {
+	*Client
+}
+
+// GetAllTermsOfDeliveries forwards to TermsOfDeliveriesService.GetAllTermsOfDeliveries of c.TermsOfDeliveries
+func (c *Client) GetAllTermsOfDeliveries(ctx context.Context) ([]TermsOfDelivery, error) {
+	return c.TermsOfDeliveries.GetAllTermsOfDeliveries(ctx)
+}
+
+// GetTermsOfDeliveriesPage forwards to TermsOfDeliveriesService.GetTermsOfDeliveriesPage of c.TermsOfDeliveries
+func (c *Client) GetTermsOfDeliveriesPage(ctx context.Context, page *PageOptions) ([]TermsOfDelivery, *MetaInformation, error) {
+	return c.TermsOfDeliveries.GetTermsOfDeliveriesPage(ctx, page)
+}
+
+// IterateTermsOfDeliveries forwards to TermsOfDeliveriesService.IterateTermsOfDeliveries of c.TermsOfDeliveries
+func (c *Client) IterateTermsOfDeliveries() *Iterator[TermsOfDelivery] {
+	return c.TermsOfDeliveries.IterateTermsOfDeliveries()
+}
+
+// CreateTermsOfDeliveries forwards to TermsOfDeliveriesService.CreateTermsOfDeliveries of c.TermsOfDeliveries
+func (c *Client) CreateTermsOfDeliveries(ctx context.Context, tod *TermsOfDelivery) (*TermsOfDelivery, error) {
+	return c.TermsOfDeliveries.CreateTermsOfDeliveries(ctx, tod)
+}
+
+// GetTermOfDelivery forwards to TermsOfDeliveriesService.GetTermOfDelivery of c.TermsOfDeliveries
+func (c *Client) GetTermOfDelivery(ctx context.Context, code string) (*TermsOfDelivery, error) {
+	return c.TermsOfDeliveries.GetTermOfDelivery(ctx, code)
+}
+
+// UpdateTermOfDelivery forwards to TermsOfDeliveriesService.UpdateTermOfDelivery of c.TermsOfDeliveries
+func (c *Client) UpdateTermOfDelivery(ctx context.Context, code string, tod *TermsOfDelivery) (*TermsOfDelivery, error) {
+	return c.TermsOfDeliveries.UpdateTermOfDelivery(ctx, code, tod)
+}
+
+// TermsOfPaymentsService groups the calls reached as Client.TermsOfPayments, fortnoxfake.TermsOfPayments fakes it
+type TermsOfPaymentsService interface {
+	// GetAllTermsOfPayments does _GET https://api.fortnox.se/3/termsofpayments
+	GetAllTermsOfPayments(ctx context.Context) ([]TermsOfPayment, error)
+
+	// GetTermsOfPaymentsPage does _GET https://api.fortnox.se/3/termsofpayments and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetTermsOfPaymentsPage(ctx context.Context, page *PageOptions) ([]TermsOfPayment, *MetaInformation, error)
+
+	// IterateTermsOfPayments lazily walks all pages of https://api.fortnox.se/3/termsofpayments
+	IterateTermsOfPayments() *Iterator[TermsOfPayment]
+
+	// CreateTermOfPayment does _POST https://api.fortnox.se/3/termsofpayments
+	//
+	// req - term of payment to create
+	CreateTermOfPayment(ctx context.Context, top *TermsOfPayment) (*TermsOfPayment, error)
+
+	// GetTermOfPayment does _GET https://api.fortnox.se/3/termsofpayments/{Code}
+	//
+	// code - identifies the terms of payment
+	GetTermOfPayment(ctx context.Context, code string) (*TermsOfPayment, error)
+
+	// UpdateTermOfPayment does _PUT https://api.fortnox.se/3/termsofpayments/{Code}
+	//
+	// code - identifies the terms of payment
+	//
+	// req - term of payment to update
+	UpdateTermOfPayment(ctx context.Context, code string, top *TermsOfPayment) (*TermsOfPayment, error)
+
+	// RemoveTermOfPayment does _DELETE https://api.fortnox.se/3/termsofpayments/{Code}
+	// code - identifies the terms of payment
+	RemoveTermOfPayment(ctx context.Context, code string) error
+}
+
+type termsOfPaymentsService struct {
+	*Client
+}
+
+// GetAllTermsOfPayments forwards to TermsOfPaymentsService.GetAllTermsOfPayments of c.TermsOfPayments
+func (c *Client) GetAllTermsOfPayments(ctx context.Context) ([]TermsOfPayment, error) {
+	return c.TermsOfPayments.GetAllTermsOfPayments(ctx)
+}
+
+// GetTermsOfPaymentsPage forwards to TermsOfPaymentsService.GetTermsOfPaymentsPage of c.TermsOfPayments
+func (c *Client) GetTermsOfPaymentsPage(ctx context.Context, page *PageOptions) ([]TermsOfPayment, *MetaInformation, error) {
+	return c.TermsOfPayments.GetTermsOfPaymentsPage(ctx, page)
+}
+
+// IterateTermsOfPayments forwards to TermsOfPaymentsService.IterateTermsOfPayments of c.TermsOfPayments
+func (c *Client) IterateTermsOfPayments() *Iterator[TermsOfPayment] {
+	return c.TermsOfPayments.IterateTermsOfPayments()
+}
+
+// CreateTermOfPayment forwards to TermsOfPaymentsService.CreateTermOfPayment of c.TermsOfPayments
+func (c *Client) CreateTermOfPayment(ctx context.Context, top *TermsOfPayment) (*TermsOfPayment, error) {
+	return c.TermsOfPayments.CreateTermOfPayment(ctx, top)
+}
+
+// GetTermOfPayment forwards to TermsOfPaymentsService.GetTermOfPayment of c.TermsOfPayments
+func (c *Client) GetTermOfPayment(ctx context.Context, code string) (*TermsOfPayment, error) {
+	return c.TermsOfPayments.GetTermOfPayment(ctx, code)
+}
+
+// UpdateTermOfPayment forwards to TermsOfPaymentsService.UpdateTermOfPayment of c.TermsOfPayments
+func (c *Client) UpdateTermOfPayment(ctx context.Context, code string, top *TermsOfPayment) (*TermsOfPayment, error) {
+	return c.TermsOfPayments.UpdateTermOfPayment(ctx, code, top)
+}
+
+// RemoveTermOfPayment forwards to TermsOfPaymentsService.RemoveTermOfPayment of c.TermsOfPayments
+func (c *Client) RemoveTermOfPayment(ctx context.Context, code string) error {
+	return c.TermsOfPayments.RemoveTermOfPayment(ctx, code)
+}
+
+// TrustedEmailSendersService groups the calls reached as Client.TrustedEmailSenders, fortnoxfake.TrustedEmailSenders fakes it
+type TrustedEmailSendersService interface {
+	// GetAllTrustedAndRejectedEmailSenders does _GET https://api.fortnox.se/3/emailsenders/
+	GetAllTrustedAndRejectedEmailSenders(ctx context.Context) (*EmailSenders, error)
+
+	// CreateTrustedEmailAddress does _POST https://api.fortnox.se/3/emailsenders/trusted
+	//
+	// req - trusted email sender to create
+	CreateTrustedEmailAddress(ctx context.Context, ts *TrustedSender) (*TrustedSender, error)
+
+	// RemoveTrustedEmailAddress does _DELETE https://api.fortnox.se/3/emailsenders/trusted/{Id}
+	//
+	// id - identifies the trusted email sender to delete
+	RemoveTrustedEmailAddress(ctx context.Context, id int) error
+}
+
+type trustedEmailSendersService struct {
+	*Client
+}
+
+// GetAllTrustedAndRejectedEmailSenders forwards to TrustedEmailSendersService.GetAllTrustedAndRejectedEmailSenders of c.TrustedEmailSenders
+func (c *Client) GetAllTrustedAndRejectedEmailSenders(ctx context.Context) (*EmailSenders, error) {
+	return c.TrustedEmailSenders.GetAllTrustedAndRejectedEmailSenders(ctx)
+}
+
+// CreateTrustedEmailAddress forwards to TrustedEmailSendersService.CreateTrustedEmailAddress of c.TrustedEmailSenders
+func (c *Client) CreateTrustedEmailAddress(ctx context.Context, ts *TrustedSender) (*TrustedSender, error) {
+	return c.TrustedEmailSenders.CreateTrustedEmailAddress(ctx, ts)
+}
+
+// RemoveTrustedEmailAddress forwards to TrustedEmailSendersService.RemoveTrustedEmailAddress of c.TrustedEmailSenders
+func (c *Client) RemoveTrustedEmailAddress(ctx context.Context, id int) error {
+	return c.TrustedEmailSenders.RemoveTrustedEmailAddress(ctx, id)
+}
+
+// UnitsService groups the calls reached as Client.Units, fortnoxfake.Units fakes it
+type UnitsService interface {
+	// GetAllUnits does _GET https://api.fortnox.se/3/units
+	GetAllUnits(ctx context.Context) ([]Unit, error)
+
+	// GetUnitsPage does _GET https://api.fortnox.se/3/units and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetUnitsPage(ctx context.Context, page *PageOptions) ([]Unit, *MetaInformation, error)
+
+	// IterateUnits lazily walks all pages of https://api.fortnox.se/3/units
+	IterateUnits() *Iterator[Unit]
+
+	// CreateUnit does _POST https://api.fortnox.se/3/units
+	//
+	// req - unit to create
+	CreateUnit(ctx context.Context, u *Unit) (*Unit, error)
+
+	// GetUnit does _GET https://api.fortnox.se/3/units/{Code}
+	//
+	// code - identifies the unit
+	GetUnit(ctx context.Context, code string) (*Unit, error)
+
+	// UpdateUnit does _PUT https://api.fortnox.se/3/units/{Code}
+	//
+	// code - identifies the unit
+	//
+	// req - unit to update
+	UpdateUnit(ctx context.Context, code string, u *Unit) (*Unit, error)
+
+	// RemoveUnit does _DELETE https://api.fortnox.se/3/units/{Code}
+	RemoveUnit(ctx context.Context, code string) error
+}
+
+type unitsService struct {
+	*Client
+}
+
+// GetAllUnits forwards to UnitsService.GetAllUnits of c.Units
+func (c *Client) GetAllUnits(ctx context.Context) ([]Unit, error) {
+	return c.Units.GetAllUnits(ctx)
+}
+
+// GetUnitsPage forwards to UnitsService.GetUnitsPage of c.Units
+func (c *Client) GetUnitsPage(ctx context.Context, page *PageOptions) ([]Unit, *MetaInformation, error) {
+	return c.Units.GetUnitsPage(ctx, page)
+}
+
+// IterateUnits forwards to UnitsService.IterateUnits of c.Units
+func (c *Client) IterateUnits() *Iterator[Unit] {
+	return c.Units.IterateUnits()
+}
+
+// CreateUnit forwards to UnitsService.CreateUnit of c.Units
+func (c *Client) CreateUnit(ctx context.Context, u *Unit) (*Unit, error) {
+	return c.Units.CreateUnit(ctx, u)
+}
+
+// GetUnit forwards to UnitsService.GetUnit of c.Units
+func (c *Client) GetUnit(ctx context.Context, code string) (*Unit, error) {
+	return c.Units.GetUnit(ctx, code)
+}
+
+// UpdateUnit forwards to UnitsService.UpdateUnit of c.Units
+func (c *Client) UpdateUnit(ctx context.Context, code string, u *Unit) (*Unit, error) {
+	return c.Units.UpdateUnit(ctx, code, u)
+}
+
+// RemoveUnit forwards to UnitsService.RemoveUnit of c.Units
+func (c *Client) RemoveUnit(ctx context.Context, code string) error {
+	return c.Units.RemoveUnit(ctx, code)
+}
+
+// VoucherFileConnectionsService groups the calls reached as Client.VoucherFileConnections, fortnoxfake.VoucherFileConnections fakes it
+type VoucherFileConnectionsService interface {
+	// GetAllVoucherFileConnections does _GET https://api.fortnox.se/3/voucherfileconnections/
+	GetAllVoucherFileConnections(ctx context.Context) ([]VoucherFileConnection, error)
+
+	// GetVoucherFileConnectionsPage does _GET https://api.fortnox.se/3/voucherfileconnections/ and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetVoucherFileConnectionsPage(ctx context.Context, page *PageOptions) ([]VoucherFileConnection, *MetaInformation, error)
+
+	// IterateVoucherFileConnections lazily walks all pages of https://api.fortnox.se/3/voucherfileconnections/
+	IterateVoucherFileConnections() *Iterator[VoucherFileConnection]
+
+	// CreateVoucherFileConnection does _POST https://api.fortnox.se/3/voucherfileconnections/
+	//
+	// req - voucher file connection to create
+	CreateVoucherFileConnection(ctx context.Context, vfc *VoucherFileConnection) (*VoucherFileConnection, error)
+
+	// GetVoucherFileConnectionByFileID does _GET https://api.fortnox.se/3/voucherfileconnections/{FileId}
+	//
+	// fileID - identifies the voucher file connection
+	GetVoucherFileConnectionByFileID(ctx context.Context, fileID string) (*VoucherFileConnection, error)
+
+	// RemoveVoucherFileConnections does _DELETE https://api.fortnox.se/3/voucherfileconnections/{FileId}
+	//
+	// fileID - identifies the voucher file connection
+	RemoveVoucherFileConnections(ctx context.Context, fieldID string) error
+}
+
+type voucherFileConnectionsService struct {
+	*Client
+}
+
+// GetAllVoucherFileConnections forwards to VoucherFileConnectionsService.GetAllVoucherFileConnections of c.VoucherFileConnections
+func (c *Client) GetAllVoucherFileConnections(ctx context.Context) ([]VoucherFileConnection, error) {
+	return c.VoucherFileConnections.GetAllVoucherFileConnections(ctx)
+}
+
+// GetVoucherFileConnectionsPage forwards to VoucherFileConnectionsService.GetVoucherFileConnectionsPage of c.VoucherFileConnections
+func (c *Client) GetVoucherFileConnectionsPage(ctx context.Context, page *PageOptions) ([]VoucherFileConnection, *MetaInformation, error) {
+	return c.VoucherFileConnections.GetVoucherFileConnectionsPage(ctx, page)
+}
+
+// IterateVoucherFileConnections forwards to VoucherFileConnectionsService.IterateVoucherFileConnections of c.VoucherFileConnections
+func (c *Client) IterateVoucherFileConnections() *Iterator[VoucherFileConnection] {
+	return c.VoucherFileConnections.IterateVoucherFileConnections()
+}
+
+// CreateVoucherFileConnection forwards to VoucherFileConnectionsService.CreateVoucherFileConnection of c.VoucherFileConnections
+func (c *Client) CreateVoucherFileConnection(ctx context.Context, vfc *VoucherFileConnection) (*VoucherFileConnection, error) {
+	return c.VoucherFileConnections.CreateVoucherFileConnection(ctx, vfc)
+}
+
+// GetVoucherFileConnectionByFileID forwards to VoucherFileConnectionsService.GetVoucherFileConnectionByFileID of c.VoucherFileConnections
+func (c *Client) GetVoucherFileConnectionByFileID(ctx context.Context, fileID string) (*VoucherFileConnection, error) {
+	return c.VoucherFileConnections.GetVoucherFileConnectionByFileID(ctx, fileID)
+}
+
+// RemoveVoucherFileConnections forwards to VoucherFileConnectionsService.RemoveVoucherFileConnections of c.VoucherFileConnections
+func (c *Client) RemoveVoucherFileConnections(ctx context.Context, fieldID string) error {
+	return c.VoucherFileConnections.RemoveVoucherFileConnections(ctx, fieldID)
+}
+
+// VoucherSeriesService groups the calls reached as Client.VoucherSeries, fortnoxfake.VoucherSeries fakes it
+type VoucherSeriesService interface {
+	// GetAllVoucherSeries does _GET https://api.fortnox.se/3/voucherseries
+	GetAllVoucherSeries(ctx context.Context) ([]VoucherSeries, error)
+
+	// GetVoucherSeriesPage does _GET https://api.fortnox.se/3/voucherseries and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetVoucherSeriesPage(ctx context.Context, page *PageOptions) ([]VoucherSeries, *MetaInformation, error)
+
+	// IterateVoucherSeries lazily walks all pages of https://api.fortnox.se/3/voucherseries
+	IterateVoucherSeries() *Iterator[VoucherSeries]
+
+	// CreateVoucherSeries does _POST https://api.fortnox.se/3/voucherseries
+	//
+	// req - voucher series to create
+	CreateVoucherSeries(ctx context.Context, vs *VoucherSeries) (*VoucherSeries, error)
+
+	// GetVoucherSeriesByCode doe _GET https://api.fortnox.se/3/voucherseries/{Code}
+	//
+	// code - identifies the voucher series
+	GetVoucherSeriesByCode(ctx context.Context, code string) (*VoucherSeries, error)
+
+	// UpdateVoucherSeries does _PUT https://api.fortnox.se/3/voucherseries/{Code}
+	//
+	// code - identifies the voucher series
+	//
+	// req - to update
+	UpdateVoucherSeries(ctx context.Context, code string, vs *VoucherSeries) (*VoucherSeries, error)
+}
+
+type voucherSeriesService struct {
+	*Client
+}
+
+// GetAllVoucherSeries forwards to VoucherSeriesService.GetAllVoucherSeries of c.VoucherSeries
+func (c *Client) GetAllVoucherSeries(ctx context.Context) ([]VoucherSeries, error) {
+	return c.VoucherSeries.GetAllVoucherSeries(ctx)
+}
+
+// GetVoucherSeriesPage forwards to VoucherSeriesService.GetVoucherSeriesPage of c.VoucherSeries
+func (c *Client) GetVoucherSeriesPage(ctx context.Context, page *PageOptions) ([]VoucherSeries, *MetaInformation, error) {
+	return c.VoucherSeries.GetVoucherSeriesPage(ctx, page)
+}
+
+// IterateVoucherSeries forwards to VoucherSeriesService.IterateVoucherSeries of c.VoucherSeries
+func (c *Client) IterateVoucherSeries() *Iterator[VoucherSeries] {
+	return c.VoucherSeries.IterateVoucherSeries()
+}
+
+// CreateVoucherSeries forwards to VoucherSeriesService.CreateVoucherSeries of c.VoucherSeries
+func (c *Client) CreateVoucherSeries(ctx context.Context, vs *VoucherSeries) (*VoucherSeries, error) {
+	return c.VoucherSeries.CreateVoucherSeries(ctx, vs)
+}
+
+// GetVoucherSeriesByCode forwards to VoucherSeriesService.GetVoucherSeriesByCode of c.VoucherSeries
+func (c *Client) GetVoucherSeriesByCode(ctx context.Context, code string) (*VoucherSeries, error) {
+	return c.VoucherSeries.GetVoucherSeriesByCode(ctx, code)
+}
+
+// UpdateVoucherSeries forwards to VoucherSeriesService.UpdateVoucherSeries of c.VoucherSeries
+func (c *Client) UpdateVoucherSeries(ctx context.Context, code string, vs *VoucherSeries) (*VoucherSeries, error) {
+	return c.VoucherSeries.UpdateVoucherSeries(ctx, code, vs)
+}
+
+// VouchersService groups the calls reached as Client.Vouchers, fortnoxfake.Vouchers fakes it
+type VouchersService interface {
+	// GetVoucher does _GET https://api.fortnox.se/3/vouchers/{VoucherSeries}/{VoucherNumber}
+	//
+	// voucherSeries - identifies the voucher series
+	//
+	// voucherNumber - identifies the voucher number
+	//
+	// financialYear - filter on financial year
+	GetVoucher(ctx context.Context, voucherSeries, voucherNumber string, filter *FinancialYearFilter) (*Voucher, error)
+
+	// GetAllVouchers does _GET https://api.fortnox.se/3/vouchers/
+	//
+	// filter - filter on financial year
+	GetAllVouchers(ctx context.Context, filter *FinancialYearFilter) ([]Voucher, error)
+
+	// GetVouchersPage does _GET https://api.fortnox.se/3/vouchers/ and returns a single page along with its MetaInformation
+	//
+	// filter - filter on financial year
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetVouchersPage(ctx context.Context, filter *FinancialYearFilter, page *PageOptions) ([]Voucher, *MetaInformation, error)
+
+	// IterateVouchers lazily walks all pages of https://api.fortnox.se/3/vouchers/
+	//
+	// filter - filter on financial year
+	IterateVouchers(filter *FinancialYearFilter) *Iterator[Voucher]
+
+	// CreateVoucher does _POST https://api.fortnox.se/3/vouchers/
+	//
+	// filter - financial year id, used to determine which financial year the voucher is created in
+	//
+	// req - voucher to create
+	CreateVoucher(ctx context.Context, filter FinancialYearFilter, v *Voucher) (*Voucher, error)
+
+	// GetVouchersBySeries does _GET https://api.fortnox.se/3/vouchers/sublist/{VoucherSeries}
+	//
+	// voucherSeries - identifies the voucher series
+	//
+	// filter - filter on financial year
+	GetVouchersBySeries(ctx context.Context, voucherSeries string, filter FinancialYearFilter) ([]Voucher, error)
+
+	// GetVouchersBySeriesPage does _GET https://api.fortnox.se/3/vouchers/sublist/{VoucherSeries} and returns a single page along with its MetaInformation
+	//
+	// voucherSeries - identifies the voucher series
+	//
+	// filter - filter on financial year
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetVouchersBySeriesPage(ctx context.Context, voucherSeries string, filter FinancialYearFilter, page *PageOptions) ([]Voucher, *MetaInformation, error)
+
+	// IterateVouchersBySeries lazily walks all pages of https://api.fortnox.se/3/vouchers/sublist/{VoucherSeries}
+	//
+	// voucherSeries - identifies the voucher series
+	//
+	// filter - filter on financial year
+	IterateVouchersBySeries(voucherSeries string, filter FinancialYearFilter) *Iterator[Voucher]
+}
+
+type vouchersService struct {
+	*Client
+}
+
+// GetVoucher forwards to VouchersService.GetVoucher of c.Vouchers
+func (c *Client) GetVoucher(ctx context.Context, voucherSeries, voucherNumber string, filter *FinancialYearFilter) (*Voucher, error) {
+	return c.Vouchers.GetVoucher(ctx, voucherSeries, voucherNumber, filter)
+}
+
+// GetAllVouchers forwards to VouchersService.GetAllVouchers of c.Vouchers
+func (c *Client) GetAllVouchers(ctx context.Context, filter *FinancialYearFilter) ([]Voucher, error) {
+	return c.Vouchers.GetAllVouchers(ctx, filter)
+}
+
+// GetVouchersPage forwards to VouchersService.GetVouchersPage of c.Vouchers
+func (c *Client) GetVouchersPage(ctx context.Context, filter *FinancialYearFilter, page *PageOptions) ([]Voucher, *MetaInformation, error) {
+	return c.Vouchers.GetVouchersPage(ctx, filter, page)
+}
+
+// IterateVouchers forwards to VouchersService.IterateVouchers of c.Vouchers
+func (c *Client) IterateVouchers(filter *FinancialYearFilter) *Iterator[Voucher] {
+	return c.Vouchers.IterateVouchers(filter)
+}
+
+// CreateVoucher forwards to VouchersService.CreateVoucher of c.Vouchers
+func (c *Client) CreateVoucher(ctx context.Context, filter FinancialYearFilter, v *Voucher) (*Voucher, error) {
+	return c.Vouchers.CreateVoucher(ctx, filter, v)
+}
+
+// GetVouchersBySeries forwards to VouchersService.GetVouchersBySeries of c.Vouchers
+func (c *Client) GetVouchersBySeries(ctx context.Context, voucherSeries string, filter FinancialYearFilter) ([]Voucher, error) {
+	return c.Vouchers.GetVouchersBySeries(ctx, voucherSeries, filter)
+}
+
+// GetVouchersBySeriesPage forwards to VouchersService.GetVouchersBySeriesPage of c.Vouchers
+func (c *Client) GetVouchersBySeriesPage(ctx context.Context, voucherSeries string, filter FinancialYearFilter, page *PageOptions) ([]Voucher, *MetaInformation, error) {
+	return c.Vouchers.GetVouchersBySeriesPage(ctx, voucherSeries, filter, page)
+}
+
+// IterateVouchersBySeries forwards to VouchersService.IterateVouchersBySeries of c.Vouchers
+func (c *Client) IterateVouchersBySeries(voucherSeries string, filter FinancialYearFilter) *Iterator[Voucher] {
+	return c.Vouchers.IterateVouchersBySeries(voucherSeries, filter)
+}
+
+// WayOfDeliveriesService groups the calls reached as Client.WayOfDeliveries, fortnoxfake.WayOfDeliveries fakes it
+type WayOfDeliveriesService interface {
+	// GetAllWayOfDeliveries does _GET https://api.fortnox.se/3/wayofdeliveries
+	GetAllWayOfDeliveries(ctx context.Context) ([]WayOfDelivery, error)
+
+	// GetWayOfDeliveriesPage does _GET https://api.fortnox.se/3/wayofdeliveries and returns a single page along with its MetaInformation
+	//
+	// page - page, limit and offset, nil means Fortnox's defaults
+	GetWayOfDeliveriesPage(ctx context.Context, page *PageOptions) ([]WayOfDelivery, *MetaInformation, error)
+
+	// IterateWayOfDeliveries lazily walks all pages of https://api.fortnox.se/3/wayofdeliveries
+	IterateWayOfDeliveries() *Iterator[WayOfDelivery]
+
+	// CreateWayOfDeliveries does _POST https://api.fortnox.se/3/wayofdeliveries
+	//
+	// req - way of delivery to create
+	CreateWayOfDeliveries(ctx context.Context, wod *WayOfDelivery) (*WayOfDelivery, error)
+
+	// GetWayOfDeliveryByCode does _GET https://api.fortnox.se/3/wayofdeliveries/{Code}
+	//
+	// code - identifies the way of delivery
+	GetWayOfDeliveryByCode(ctx context.Context, code string) (*WayOfDelivery, error)
+
+	// UpdateWayOfDelivery does _PUT https://api.fortnox.se/3/wayofdeliveries/{Code}
+	//
+	// code - identifies the way of delivery
+	//
+	// req - way of delivery to update
+	UpdateWayOfDelivery(ctx context.Context, code string, wod *WayOfDelivery) (*WayOfDelivery, error)
+
+	// RemoveWayOfDelivery does _DELETE https://api.fortnox.se/3/wayofdeliveries/{Code}
+	//
+	// code - identifies the way of delivery
+	RemoveWayOfDelivery(ctx context.Context, code string) error
+}
+
+type wayOfDeliveriesService struct {
+	*Client
+}
+
+// GetAllWayOfDeliveries forwards to WayOfDeliveriesService.GetAllWayOfDeliveries of c.WayOfDeliveries
+func (c *Client) GetAllWayOfDeliveries(ctx context.Context) ([]WayOfDelivery, error) {
+	return c.WayOfDeliveries.GetAllWayOfDeliveries(ctx)
+}
+
+// GetWayOfDeliveriesPage forwards to WayOfDeliveriesService.GetWayOfDeliveriesPage of c.WayOfDeliveries
+func (c *Client) GetWayOfDeliveriesPage(ctx context.Context, page *PageOptions) ([]WayOfDelivery, *MetaInformation, error) {
+	return c.WayOfDeliveries.GetWayOfDeliveriesPage(ctx, page)
+}
+
+// IterateWayOfDeliveries forwards to WayOfDeliveriesService.IterateWayOfDeliveries of c.WayOfDeliveries
+func (c *Client) IterateWayOfDeliveries() *Iterator[WayOfDelivery] {
+	return c.WayOfDeliveries.IterateWayOfDeliveries()
+}
+
+// CreateWayOfDeliveries forwards to WayOfDeliveriesService.CreateWayOfDeliveries of c.WayOfDeliveries
+func (c *Client) CreateWayOfDeliveries(ctx context.Context, wod *WayOfDelivery) (*WayOfDelivery, error) {
+	return c.WayOfDeliveries.CreateWayOfDeliveries(ctx, wod)
+}
+
+// GetWayOfDeliveryByCode forwards to WayOfDeliveriesService.GetWayOfDeliveryByCode of c.WayOfDeliveries
+func (c *Client) GetWayOfDeliveryByCode(ctx context.Context, code string) (*WayOfDelivery, error) {
+	return c.WayOfDeliveries.GetWayOfDeliveryByCode(ctx, code)
+}
+
+// UpdateWayOfDelivery forwards to WayOfDeliveriesService.UpdateWayOfDelivery of c.WayOfDeliveries
+func (c *Client) UpdateWayOfDelivery(ctx context.Context, code string, wod *WayOfDelivery) (*WayOfDelivery, error) {
+	return c.WayOfDeliveries.UpdateWayOfDelivery(ctx, code, wod)
+}
+
+// RemoveWayOfDelivery forwards to WayOfDeliveriesService.RemoveWayOfDelivery of c.WayOfDeliveries
+func (c *Client) RemoveWayOfDelivery(ctx context.Context, code string) error {
+	return c.WayOfDeliveries.RemoveWayOfDelivery(ctx, code)
+}
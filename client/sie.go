@@ -14,17 +14,13 @@ const (
 // typ - type
 //
 // filter - FinancialYearFilter
-func (c *Client) GetSIEFile(ctx context.Context, typ string, filter *FinancialYearFilter) (*[]byte, error) {
-	resp := &[]byte{}
-
+func (c *sieService) GetSIEFile(ctx context.Context, typ string, filter *FinancialYearFilter) (*Download, error) {
 	uri := fmt.Sprintf("%s/%s", sieURI, typ)
 
-	params := filter.urlValues()
-
-	err := c._GET(ctx, uri, params, resp)
+	params, err := filter.urlValues()
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c._GETDownload(ctx, getSIEFileOp, uri, params)
 }
@@ -10,28 +10,45 @@ const (
 )
 
 // GetAllSupplierInvoiceAccruals does _GET https://api.fortnox.se/3/supplierinvoiceaccruals/
-func (c *Client) GetAllSupplierInvoiceAccruals(ctx context.Context) ([]SupplierInvoiceAccrual, error) {
+func (c *supplierInvoiceAccrualsService) GetAllSupplierInvoiceAccruals(ctx context.Context) ([]SupplierInvoiceAccrual, error) {
+	return c.IterateSupplierInvoiceAccruals().All(ctx)
+}
+
+// GetSupplierInvoiceAccrualsPage does _GET https://api.fortnox.se/3/supplierinvoiceaccruals/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *supplierInvoiceAccrualsService) GetSupplierInvoiceAccrualsPage(
+	ctx context.Context,
+	page *PageOptions) ([]SupplierInvoiceAccrual, *MetaInformation, error) {
+
 	resp := &GetAllSupplierInvoiceAccrualsResp{}
 
-	err := c._GET(ctx, supplierInvoiceAccrualsURI, nil, resp)
+	err := c._GETPage(ctx, getSupplierInvoiceAccrualsPageOp, supplierInvoiceAccrualsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.SupplierInvoiceAccruals, nil
+	return resp.SupplierInvoiceAccruals, &resp.MetaInformation, nil
+}
+
+// IterateSupplierInvoiceAccruals lazily walks all pages of https://api.fortnox.se/3/supplierinvoiceaccruals/
+func (c *supplierInvoiceAccrualsService) IterateSupplierInvoiceAccruals() *Iterator[SupplierInvoiceAccrual] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]SupplierInvoiceAccrual, *MetaInformation, error) {
+		return c.GetSupplierInvoiceAccrualsPage(ctx, page)
+	})
 }
 
 // CreateSupplierInvoiceAccruals does _POST https://api.fortnox.se/3/supplierinvoiceaccruals/
 //
 // req - supplier invoice accruals to create
-func (c *Client) CreateSupplierInvoiceAccruals(
+func (c *supplierInvoiceAccrualsService) CreateSupplierInvoiceAccruals(
 	ctx context.Context,
 	sia *SupplierInvoiceAccrual) (*SupplierInvoiceAccrual, error) {
 
 	req := &CreateSupplierInvoiceAccrualsReq{SupplierInvoiceAccrual: *sia}
 	resp := &CreateSupplierInvoiceAccrualsResp{}
 
-	err := c._POST(ctx, supplierInvoiceAccrualsURI, nil, req, resp)
+	err := c._POST(ctx, createSupplierInvoiceAccrualsOp, supplierInvoiceAccrualsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +59,7 @@ func (c *Client) CreateSupplierInvoiceAccruals(
 // GetSupplierInvoiceAccruals does _GET https://api.fortnox.se/3/supplierinvoiceaccruals/{SupplierInvoiceNumber}
 //
 // supplierInvoiceNumber - identifies the supplier invoice accrual
-func (c *Client) GetSupplierInvoiceAccruals(
+func (c *supplierInvoiceAccrualsService) GetSupplierInvoiceAccruals(
 	ctx context.Context,
 	supplierInvoiceNumber int) (*SupplierInvoiceAccrual, error) {
 
@@ -50,7 +67,7 @@ func (c *Client) GetSupplierInvoiceAccruals(
 
 	uri := fmt.Sprintf("%s/%d", supplierInvoiceAccrualsURI, supplierInvoiceNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getSupplierInvoiceAccrualsOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +80,7 @@ func (c *Client) GetSupplierInvoiceAccruals(
 // supplierInvoiceNumber - identifies the supplier invoice accrual
 //
 // req - supplier invoice accruals to update
-func (c *Client) UpdateSupplierInvoiceAccruals(
+func (c *supplierInvoiceAccrualsService) UpdateSupplierInvoiceAccruals(
 	ctx context.Context,
 	supplierInvoiceNumber int,
 	sia *SupplierInvoiceAccrual) (*SupplierInvoiceAccrual, error) {
@@ -73,7 +90,7 @@ func (c *Client) UpdateSupplierInvoiceAccruals(
 
 	uri := fmt.Sprintf("%s/%d", supplierInvoiceAccrualsURI, supplierInvoiceNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateSupplierInvoiceAccrualsOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,13 +101,14 @@ func (c *Client) UpdateSupplierInvoiceAccruals(
 // DeleteSupplierInvoiceAccruals does _DELETE https://api.fortnox.se/3/supplierinvoiceaccruals/{SupplierInvoiceNumber}
 //
 // supplierInvoiceNumber - identifies the supplier invoice accrual
-func (c *Client) DeleteSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int) error {
+func (c *supplierInvoiceAccrualsService) DeleteSupplierInvoiceAccruals(ctx context.Context, supplierInvoiceNumber int) error {
 	uri := fmt.Sprintf("%s/%d", supplierInvoiceAccrualsURI, supplierInvoiceNumber)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteSupplierInvoiceAccrualsOp, uri)
 }
 
 type GetAllSupplierInvoiceAccrualsResp struct {
 	SupplierInvoiceAccruals []SupplierInvoiceAccrual `json:"SupplierInvoiceAccruals"`
+	MetaInformation         MetaInformation          `json:"MetaInformation"`
 }
 
 type CreateSupplierInvoiceAccrualsReq struct {
@@ -116,8 +134,8 @@ type UpdateSupplierInvoiceAccrualResp struct {
 type SupplierInvoiceAccrualRow struct {
 	Account                int    `json:"Account,omitempty"`
 	CostCenter             string `json:"CostCenter,omitempty"`
-	Credit                 int    `json:"Credit,omitempty"`
-	Debit                  int    `json:"Debit,omitempty"`
+	Credit                 Money  `json:"Credit,omitzero"`
+	Debit                  Money  `json:"Debit,omitzero"`
 	Project                string `json:"Project,omitempty"`
 	TransactionInformation string `json:"TransactionInformation,omitempty"`
 }
@@ -127,12 +145,12 @@ type SupplierInvoiceAccrual struct {
 	AccrualAccount             int                         `json:"AccrualAccount,omitempty"`
 	CostAccount                int                         `json:"CostAccount,omitempty"`
 	Description                string                      `json:"Description,omitempty"`
-	EndDate                    string                      `json:"EndDate,omitempty"`
+	EndDate                    Date                        `json:"EndDate,omitempty"`
 	SupplierInvoiceNumber      int                         `json:"SupplierInvoiceNumber,omitempty"`
 	Period                     string                      `json:"Period,omitempty"`
-	StartDate                  string                      `json:"StartDate,omitempty"`
+	StartDate                  Date                        `json:"StartDate,omitempty"`
 	Times                      int                         `json:"Times,omitempty"`
-	Total                      int                         `json:"Total,omitempty"`
+	Total                      Money                       `json:"Total,omitzero"`
 	VATIncluded                bool                        `json:"VATIncluded,omitempty"`
 	SupplierInvoiceAccrualRows []SupplierInvoiceAccrualRow `json:"SupplierInvoiceAccrualRows,omitempty"`
 }
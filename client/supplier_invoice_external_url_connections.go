@@ -12,7 +12,7 @@ const (
 // GetSupplierInvoiceExternalUrlConnection does _GET https://api.fortnox.se/3/supplierinvoiceexternalurlconnections/{Id}
 //
 // id - id
-func (c *Client) GetSupplierInvoiceExternalUrlConnection(
+func (c *supplierInvoiceExternalUrlConnectionsService) GetSupplierInvoiceExternalUrlConnection(
 	ctx context.Context,
 	id int) (*SupplierInvoiceExternalURLConnection, error) {
 
@@ -20,7 +20,7 @@ func (c *Client) GetSupplierInvoiceExternalUrlConnection(
 
 	uri := fmt.Sprintf("%s/%d", supplierInvoiceExternalUrlConnectionsURI, id)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getSupplierInvoiceExternalUrlConnectionOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +33,7 @@ func (c *Client) GetSupplierInvoiceExternalUrlConnection(
 // id - id
 //
 // req - supplier invoice external url connection to update
-func (c *Client) UpdateSupplierInvoiceExternalUrlConnection(
+func (c *supplierInvoiceExternalUrlConnectionsService) UpdateSupplierInvoiceExternalUrlConnection(
 	ctx context.Context,
 	id int,
 	req *UpdateSupplierInvoiceExternalUrlConnectionReq) (*SupplierInvoiceExternalURLConnection, error) {
@@ -42,7 +42,7 @@ func (c *Client) UpdateSupplierInvoiceExternalUrlConnection(
 
 	uri := fmt.Sprintf("%s/%d", supplierInvoiceExternalUrlConnectionsURI, id)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateSupplierInvoiceExternalUrlConnectionOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -53,21 +53,21 @@ func (c *Client) UpdateSupplierInvoiceExternalUrlConnection(
 // DeleteSupplierInvoiceExternalUrlConnection does _DELETE https://api.fortnox.se/3/supplierinvoiceexternalurlconnections/{Id}
 //
 // id - id
-func (c *Client) DeleteSupplierInvoiceExternalUrlConnection(ctx context.Context, id int) error {
+func (c *supplierInvoiceExternalUrlConnectionsService) DeleteSupplierInvoiceExternalUrlConnection(ctx context.Context, id int) error {
 	uri := fmt.Sprintf("%s/%d", supplierInvoiceExternalUrlConnectionsURI, id)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, deleteSupplierInvoiceExternalUrlConnectionOp, uri)
 }
 
 // CreateSupplierInvoiceExternalUrlConnection does _POST https://api.fortnox.se/3/supplierinvoiceexternalurlconnections
 //
 // req - supplier invoice external url connection to update
-func (c *Client) CreateSupplierInvoiceExternalUrlConnection(
+func (c *supplierInvoiceExternalUrlConnectionsService) CreateSupplierInvoiceExternalUrlConnection(
 	ctx context.Context,
 	req *CreateSupplierInvoiceExternalUrlConnectionReq) (*SupplierInvoiceExternalURLConnection, error) {
 
 	resp := &CreateSupplierInvoiceExternalUrlConnectionResp{}
 
-	err := c._POST(ctx, supplierInvoiceExternalUrlConnectionsURI, nil, req, resp)
+	err := c._POST(ctx, createSupplierInvoiceExternalUrlConnectionOp, supplierInvoiceExternalUrlConnectionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -10,28 +10,45 @@ const (
 )
 
 // GetAllSupplierInvoiceFileConnections does _GET https://api.fortnox.se/3/supplierinvoicefileconnections/
-func (c *Client) GetAllSupplierInvoiceFileConnections(ctx context.Context) ([]SupplierInvoiceFileConnection, error) {
+func (c *supplierInvoiceFileConnectionsService) GetAllSupplierInvoiceFileConnections(ctx context.Context) ([]SupplierInvoiceFileConnection, error) {
+	return c.IterateSupplierInvoiceFileConnections().All(ctx)
+}
+
+// GetSupplierInvoiceFileConnectionsPage does _GET https://api.fortnox.se/3/supplierinvoicefileconnections/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *supplierInvoiceFileConnectionsService) GetSupplierInvoiceFileConnectionsPage(
+	ctx context.Context,
+	page *PageOptions) ([]SupplierInvoiceFileConnection, *MetaInformation, error) {
+
 	resp := &GetAllSupplierInvoiceFileConnectionsResp{}
 
-	err := c._GET(ctx, supplierInvoiceFileConnectionsURI, nil, resp)
+	err := c._GETPage(ctx, getSupplierInvoiceFileConnectionsPageOp, supplierInvoiceFileConnectionsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.SupplierInvoiceFileConnections, nil
+	return resp.SupplierInvoiceFileConnections, &resp.MetaInformation, nil
+}
+
+// IterateSupplierInvoiceFileConnections lazily walks all pages of https://api.fortnox.se/3/supplierinvoicefileconnections/
+func (c *supplierInvoiceFileConnectionsService) IterateSupplierInvoiceFileConnections() *Iterator[SupplierInvoiceFileConnection] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]SupplierInvoiceFileConnection, *MetaInformation, error) {
+		return c.GetSupplierInvoiceFileConnectionsPage(ctx, page)
+	})
 }
 
 // CreateSupplierInvoiceFileConnection does _POST https://api.fortnox.se/3/supplierinvoicefileconnections/
 //
 // sifc - supplier invoice file connection to create
-func (c *Client) CreateSupplierInvoiceFileConnection(
+func (c *supplierInvoiceFileConnectionsService) CreateSupplierInvoiceFileConnection(
 	ctx context.Context,
 	sifc *SupplierInvoiceFileConnection) (*SupplierInvoiceFileConnection, error) {
 
 	req := &CreateSupplierInvoiceFileConnectionReq{SupplierInvoiceFileConnection: *sifc}
 	resp := &CreateSupplierInvoiceFileConnectionResp{}
 
-	err := c._POST(ctx, supplierInvoiceFileConnectionsURI, nil, req, resp)
+	err := c._POST(ctx, createSupplierInvoiceFileConnectionOp, supplierInvoiceFileConnectionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +59,7 @@ func (c *Client) CreateSupplierInvoiceFileConnection(
 // GetSupplierInvoiceFileConnection does _GET https://api.fortnox.se/3/supplierinvoicefileconnections/{FileId}
 //
 // fileID - identifies the file connection
-func (c *Client) GetSupplierInvoiceFileConnection(
+func (c *supplierInvoiceFileConnectionsService) GetSupplierInvoiceFileConnection(
 	ctx context.Context,
 	fileID string) (*SupplierInvoiceFileConnection, error) {
 
@@ -50,7 +67,7 @@ func (c *Client) GetSupplierInvoiceFileConnection(
 
 	uri := fmt.Sprintf("%s/%s", supplierInvoiceFileConnectionsURI, fileID)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getSupplierInvoiceFileConnectionOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -61,9 +78,9 @@ func (c *Client) GetSupplierInvoiceFileConnection(
 // RemoveSupplierInvoiceFileConnections does _DELETE https://api.fortnox.se/3/supplierinvoicefileconnections/{FileId}
 //
 // fileID - identifies the file connection
-func (c *Client) RemoveSupplierInvoiceFileConnections(ctx context.Context, fieldID string) error {
+func (c *supplierInvoiceFileConnectionsService) RemoveSupplierInvoiceFileConnections(ctx context.Context, fieldID string) error {
 	uri := fmt.Sprintf("%s/%s", supplierInvoiceFileConnectionsURI, fieldID)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeSupplierInvoiceFileConnectionsOp, uri)
 }
 
 type SupplierInvoiceFileConnection struct {
@@ -76,6 +93,7 @@ type SupplierInvoiceFileConnection struct {
 
 type GetAllSupplierInvoiceFileConnectionsResp struct {
 	SupplierInvoiceFileConnections []SupplierInvoiceFileConnection `json:"SupplierInvoiceFileConnections"`
+	MetaInformation                MetaInformation                 `json:"MetaInformation"`
 }
 
 type CreateSupplierInvoiceFileConnectionReq struct {
@@ -10,27 +10,56 @@ const (
 )
 
 // GetAllSupplierInvoicePayments does _GET https://api.fortnox.se/3/supplierinvoicepayments/
-func (c *Client) GetAllSupplierInvoicePayments(ctx context.Context) (*GetAllSupplierInvoicePaymentsResp, error) {
-	resp := &GetAllSupplierInvoicePaymentsResp{}
+func (c *supplierInvoicePaymentsService) GetAllSupplierInvoicePayments(ctx context.Context) (*GetAllSupplierInvoicePaymentsResp, error) {
+	it := c.IterateSupplierInvoicePayments()
 
-	err := c._GET(ctx, supplierInvoicePaymentsURI, nil, resp)
+	items, err := it.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp := &GetAllSupplierInvoicePaymentsResp{SupplierInvoicePayments: items}
+	if meta := it.PageInfo(); meta != nil {
+		resp.MetaInformation = *meta
+	}
+
 	return resp, nil
 }
 
+// GetSupplierInvoicePaymentsPage does _GET https://api.fortnox.se/3/supplierinvoicepayments/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *supplierInvoicePaymentsService) GetSupplierInvoicePaymentsPage(
+	ctx context.Context,
+	page *PageOptions) ([]SupplierInvoicePayment, *MetaInformation, error) {
+
+	resp := &GetAllSupplierInvoicePaymentsResp{}
+
+	err := c._GETPage(ctx, getSupplierInvoicePaymentsPageOp, supplierInvoicePaymentsURI, nil, page, resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.SupplierInvoicePayments, &resp.MetaInformation, nil
+}
+
+// IterateSupplierInvoicePayments lazily walks all pages of https://api.fortnox.se/3/supplierinvoicepayments/
+func (c *supplierInvoicePaymentsService) IterateSupplierInvoicePayments() *Iterator[SupplierInvoicePayment] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]SupplierInvoicePayment, *MetaInformation, error) {
+		return c.GetSupplierInvoicePaymentsPage(ctx, page)
+	})
+}
+
 // CreateSupplierInvoicePayment does _POST https://api.fortnox.se/3/supplierinvoicepayments/
 //
 // req - supplier invoice payment to create
-func (c *Client) CreateSupplierInvoicePayment(
+func (c *supplierInvoicePaymentsService) CreateSupplierInvoicePayment(
 	ctx context.Context,
 	req *CreateSupplierInvoicePaymentReq) (*CreateSupplierInvoicePaymentResp, error) {
 
 	resp := &CreateSupplierInvoicePaymentResp{}
 
-	err := c._POST(ctx, supplierInvoicePaymentsURI, nil, req, resp)
+	err := c._POST(ctx, createSupplierInvoicePaymentOp, supplierInvoicePaymentsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -41,12 +70,12 @@ func (c *Client) CreateSupplierInvoicePayment(
 // GetSupplierInvoicePayment does _GET https://api.fortnox.se/3/supplierinvoicepayments/{Number}
 //
 // number - identifies the supplier invoice payment
-func (c *Client) GetSupplierInvoicePayment(ctx context.Context, number int) (*GetSupplierInvoicePaymentResp, error) {
+func (c *supplierInvoicePaymentsService) GetSupplierInvoicePayment(ctx context.Context, number int) (*GetSupplierInvoicePaymentResp, error) {
 	resp := &GetSupplierInvoicePaymentResp{}
 
 	uri := fmt.Sprintf("%s/%d", supplierInvoicePaymentsURI, number)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getSupplierInvoicePaymentOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +88,7 @@ func (c *Client) GetSupplierInvoicePayment(ctx context.Context, number int) (*Ge
 // number - identifies the supplier invoice payment
 //
 // req - supplier invoice payment to update
-func (c *Client) UpdateSupplierInvoicePayment(
+func (c *supplierInvoicePaymentsService) UpdateSupplierInvoicePayment(
 	ctx context.Context,
 	number int,
 	req *UpdateSupplierInvoicePaymentReq) (*UpdateSupplierInvoicePaymentResp, error) {
@@ -68,7 +97,7 @@ func (c *Client) UpdateSupplierInvoicePayment(
 
 	uri := fmt.Sprintf("%s/%d", supplierInvoicePaymentsURI, number)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateSupplierInvoicePaymentOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -79,15 +108,15 @@ func (c *Client) UpdateSupplierInvoicePayment(
 // RemoveSupplierInvoicePayment does _DELETE https://api.fortnox.se/3/supplierinvoicepayments/{Number}
 //
 // number - identifies the supplier invoice payment
-func (c *Client) RemoveSupplierInvoicePayment(ctx context.Context, number int) error {
+func (c *supplierInvoicePaymentsService) RemoveSupplierInvoicePayment(ctx context.Context, number int) error {
 	uri := fmt.Sprintf("%s/%d", supplierInvoicePaymentsURI, number)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeSupplierInvoicePaymentOp, uri)
 }
 
 // BookKeepSupplierInvoicePayment does _PUT https://api.fortnox.se/3/supplierinvoicepayments/{Number}/bookkeep
 //
 // number - identifies the supplier invoice payment
-func (c *Client) BookKeepSupplierInvoicePayment(
+func (c *supplierInvoicePaymentsService) BookKeepSupplierInvoicePayment(
 	ctx context.Context,
 	number int) (*BookKeepSupplierInvoicePaymentResp, error) {
 
@@ -95,7 +124,7 @@ func (c *Client) BookKeepSupplierInvoicePayment(
 
 	uri := fmt.Sprintf("%s/%d/bookkeep", supplierInvoicePaymentsURI, number)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, bookKeepSupplierInvoicePaymentOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +134,7 @@ func (c *Client) BookKeepSupplierInvoicePayment(
 
 type GetAllSupplierInvoicePaymentsResp struct {
 	SupplierInvoicePayments []SupplierInvoicePayment `json:"SupplierInvoicePayments"`
+	MetaInformation         MetaInformation          `json:"MetaInformation"`
 }
 
 type CreateSupplierInvoicePaymentReq struct {
@@ -133,22 +163,22 @@ type BookKeepSupplierInvoicePaymentResp struct {
 
 type SupplierInvoicePayment struct {
 	Url                   string     `json:"@url,omitempty"`
-	Amount                int        `json:"Amount,omitempty"`
-	AmountCurrency        int        `json:"AmountCurrency,omitempty"`
+	Amount                Money      `json:"Amount,omitzero"`
+	AmountCurrency        Money      `json:"AmountCurrency,omitzero"`
 	Booked                bool       `json:"Booked,omitempty"`
 	Currency              string     `json:"Currency,omitempty"`
-	CurrencyRate          int        `json:"CurrencyRate,omitempty"`
+	CurrencyRate          Money      `json:"CurrencyRate,omitzero"`
 	CurrencyUnit          int        `json:"CurrencyUnit,omitempty"`
 	Information           string     `json:"Information,omitempty"`
 	InvoiceNumber         string     `json:"InvoiceNumber,omitempty"`
-	InvoiceDueDate        string     `json:"InvoiceDueDate,omitempty"`
+	InvoiceDueDate        Date       `json:"InvoiceDueDate,omitempty"`
 	InvoiceOCR            string     `json:"InvoiceOCR,omitempty"`
 	InvoiceSupplierName   string     `json:"InvoiceSupplierName,omitempty"`
 	InvoiceSupplierNumber string     `json:"InvoiceSupplierNumber,omitempty"`
-	InvoiceTotal          string     `json:"InvoiceTotal,omitempty"`
+	InvoiceTotal          Money      `json:"InvoiceTotal,omitzero"`
 	ModeOfPayment         string     `json:"ModeOfPayment,omitempty"`
 	Number                int        `json:"Number,omitempty"`
-	PaymentDate           string     `json:"PaymentDate,omitempty"`
+	PaymentDate           Date       `json:"PaymentDate,omitempty"`
 	Source                string     `json:"Source,omitempty"`
 	VoucherNumber         int        `json:"VoucherNumber,omitempty"`
 	VoucherSeries         string     `json:"VoucherSeries,omitempty"`
@@ -157,7 +187,7 @@ type SupplierInvoicePayment struct {
 }
 
 type WriteOff struct {
-	Amount                 int    `json:"Amount,omitempty"`
+	Amount                 Money  `json:"Amount,omitzero"`
 	AccountNumber          int    `json:"AccountNumber,omitempty"`
 	CostCenter             string `json:"CostCenter,omitempty"`
 	Currency               string `json:"Currency,omitempty"`
@@ -4,42 +4,64 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 )
 
 const (
 	supplierInvoiceURI = "supplierinvoices"
 )
 
-const (
-	getAllSupplierInvoicesFilterParamName = "filter"
-)
-
 // GetAllSupplierInvoices does _GET https://api.fortnox.se/3/supplierinvoices/
 //
 // filter - Enum: "cancelled" "fullypaid" "unpaid" "unpaidoverdue" "unbooked" "pendingpayment" "authorizepending"
 // possibility to filter supplier invoices
-func (c *Client) GetAllSupplierInvoices(ctx context.Context, filter *GetAllSupplierInvoicesFilter) ([]SupplierInvoice, error) {
+func (c *supplierInvoicesService) GetAllSupplierInvoices(ctx context.Context, filter *GetAllSupplierInvoicesFilter) ([]SupplierInvoice, error) {
+	return c.IterateSupplierInvoices(filter).All(ctx)
+}
+
+// GetSupplierInvoicesPage does _GET https://api.fortnox.se/3/supplierinvoices/ and returns a single page along with its MetaInformation
+//
+// filter - Enum: "cancelled" "fullypaid" "unpaid" "unpaidoverdue" "unbooked" "pendingpayment" "authorizepending"
+// possibility to filter supplier invoices
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *supplierInvoicesService) GetSupplierInvoicesPage(
+	ctx context.Context,
+	filter *GetAllSupplierInvoicesFilter,
+	page *PageOptions) ([]SupplierInvoice, *MetaInformation, error) {
+
 	resp := &GetAllSupplierInvoicesResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, supplierInvoiceURI, params, resp)
+	err = c._GETPage(ctx, getSupplierInvoicesPageOp, supplierInvoiceURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.SupplierInvoices, nil
+	return resp.SupplierInvoices, &resp.MetaInformation, nil
+}
+
+// IterateSupplierInvoices lazily walks all pages of https://api.fortnox.se/3/supplierinvoices/
+//
+// filter - Enum: "cancelled" "fullypaid" "unpaid" "unpaidoverdue" "unbooked" "pendingpayment" "authorizepending"
+// possibility to filter supplier invoices
+func (c *supplierInvoicesService) IterateSupplierInvoices(filter *GetAllSupplierInvoicesFilter) *Iterator[SupplierInvoice] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]SupplierInvoice, *MetaInformation, error) {
+		return c.GetSupplierInvoicesPage(ctx, filter, page)
+	})
 }
 
 // CreateSupplierInvoice does _POST https://api.fortnox.se/3/supplierinvoices/
 //
 // req - supplier invoice to create
-func (c *Client) CreateSupplierInvoice(ctx context.Context, si *SupplierInvoice) (*SupplierInvoice, error) {
+func (c *supplierInvoicesService) CreateSupplierInvoice(ctx context.Context, si *SupplierInvoice) (*SupplierInvoice, error) {
 	req := &CreateSupplierInvoiceReq{SupplierInvoice: *si}
 	resp := &CreateSupplierInvoiceResp{}
 
-	err := c._POST(ctx, supplierInvoiceURI, nil, req, resp)
+	err := c._POST(ctx, createSupplierInvoiceOp, supplierInvoiceURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -50,12 +72,12 @@ func (c *Client) CreateSupplierInvoice(ctx context.Context, si *SupplierInvoice)
 // GetSupplierInvoice does _GET https://api.fortnox.se/3/supplierinvoices/{GivenNumber}
 //
 // givenNumber - identifies the invoice
-func (c *Client) GetSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+func (c *supplierInvoicesService) GetSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
 	resp := &GetSupplierInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%d", supplierInvoiceURI, givenNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getSupplierInvoiceOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -68,13 +90,13 @@ func (c *Client) GetSupplierInvoice(ctx context.Context, givenNumber int) (*Supp
 // givenNumber - identifies the invoice
 //
 // si - supplier invoice to update
-func (c *Client) UpdateSupplierInvoice(ctx context.Context, givenNumber int, si *SupplierInvoice) (*SupplierInvoice, error) {
+func (c *supplierInvoicesService) UpdateSupplierInvoice(ctx context.Context, givenNumber int, si *SupplierInvoice) (*SupplierInvoice, error) {
 	req := &UpdateSupplierInvoiceReq{SupplierInvoice: *si}
 	resp := &UpdateSupplierInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%d", supplierInvoiceURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateSupplierInvoiceOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -85,12 +107,12 @@ func (c *Client) UpdateSupplierInvoice(ctx context.Context, givenNumber int, si
 // BookKeepSupplierInvoice does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/bookkeep
 //
 // givenNumber - identifies the invoice
-func (c *Client) BookKeepSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+func (c *supplierInvoicesService) BookKeepSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
 	resp := &BookKeepSupplierInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%d/bookkeep", supplierInvoiceURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, bookKeepSupplierInvoiceOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -101,12 +123,12 @@ func (c *Client) BookKeepSupplierInvoice(ctx context.Context, givenNumber int) (
 // CancelSupplierInvoice does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/cancel
 //
 // givenNumber - identifies the invoice
-func (c *Client) CancelSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+func (c *supplierInvoicesService) CancelSupplierInvoice(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
 	resp := &CancelSupplierInvoiceResp{}
 
 	uri := fmt.Sprintf("%s/%d/cancel", supplierInvoiceURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, cancelSupplierInvoiceOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -117,12 +139,12 @@ func (c *Client) CancelSupplierInvoice(ctx context.Context, givenNumber int) (*S
 // CreditSupplierInvoicePayment does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/credit
 //
 // givenNumber - identifies the invoice
-func (c *Client) CreditSupplierInvoicePayment(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+func (c *supplierInvoicesService) CreditSupplierInvoicePayment(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
 	resp := &CreditSupplierInvoicePaymentResp{}
 
 	uri := fmt.Sprintf("%s/%d/credit", supplierInvoiceURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, creditSupplierInvoicePaymentOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -131,12 +153,12 @@ func (c *Client) CreditSupplierInvoicePayment(ctx context.Context, givenNumber i
 }
 
 // ApprovalSupplierInvoicePayment does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/approvalpayment
-func (c *Client) ApprovalSupplierInvoicePayment(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+func (c *supplierInvoicesService) ApprovalSupplierInvoicePayment(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
 	resp := &ApprovalSupplierInvoicePaymentResp{}
 
 	uri := fmt.Sprintf("%s/%d/approvalpayment", supplierInvoiceURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, approvalSupplierInvoicePaymentOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -147,13 +169,13 @@ func (c *Client) ApprovalSupplierInvoicePayment(ctx context.Context, givenNumber
 // ApprovalSupplierInvoiceBookKeep does _PUT https://api.fortnox.se/3/supplierinvoices/{GivenNumber}/approvalbookkeep
 //
 // givenNumber - identifies the invoice
-func (c *Client) ApprovalSupplierInvoiceBookKeep(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
+func (c *supplierInvoicesService) ApprovalSupplierInvoiceBookKeep(ctx context.Context, givenNumber int) (*SupplierInvoice, error) {
 
 	resp := &ApprovalSupplierInvoiceBookKeepResp{}
 
 	uri := fmt.Sprintf("%s/%d/approvalbookkeep", supplierInvoiceURI, givenNumber)
 
-	err := c._PUT(ctx, uri, nil, nil, resp)
+	err := c._PUT(ctx, approvalSupplierInvoiceBookKeepOp, uri, nil, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -173,23 +195,19 @@ const (
 	AuthorizePendingSupplierInvoiceFilter GetAllSupplierInvoicesFilter = "authorizepending"
 )
 
-func (f *GetAllSupplierInvoicesFilter) urlValues() url.Values {
+func (f *GetAllSupplierInvoicesFilter) urlValues() (url.Values, error) {
 	if f == nil {
-		return nil
-	}
-
-	params := url.Values{}
-
-	fStr := string(*f)
-	if strings.TrimSpace(fStr) != "" {
-		params[getAllSupplierInvoicesFilterParamName] = []string{fStr}
+		return nil, nil
 	}
 
-	return params
+	return encodeQuery(struct {
+		Filter GetAllSupplierInvoicesFilter `fortnox:"filter"`
+	}{*f})
 }
 
 type GetAllSupplierInvoicesResp struct {
 	SupplierInvoices []SupplierInvoice `json:"SupplierInvoices"`
+	MetaInformation  MetaInformation   `json:"MetaInformation"`
 }
 
 type CreateSupplierInvoiceReq struct {
@@ -239,15 +257,15 @@ type SupplierInvoiceRow struct {
 	CostCenter             string `json:"CostCenter,omitempty"`
 	AccountDescription     string `json:"AccountDescription,omitempty"`
 	ItemDescription        string `json:"ItemDescription,omitempty"`
-	Debit                  int    `json:"Debit,omitempty"`
-	DebitCurrency          int    `json:"DebitCurrency,omitempty"`
-	Credit                 int    `json:"Credit,omitempty"`
-	CreditCurrency         int    `json:"CreditCurrency,omitempty"`
+	Debit                  Money  `json:"Debit,omitzero"`
+	DebitCurrency          Money  `json:"DebitCurrency,omitzero"`
+	Credit                 Money  `json:"Credit,omitzero"`
+	CreditCurrency         Money  `json:"CreditCurrency,omitzero"`
 	Project                string `json:"Project,omitempty"`
 	TransactionInformation string `json:"TransactionInformation,omitempty"`
-	Price                  int    `json:"Price,omitempty"`
+	Price                  Money  `json:"Price,omitzero"`
 	Quantity               int    `json:"Quantity,omitempty"`
-	Total                  int    `json:"Total,omitempty"`
+	Total                  Money  `json:"Total,omitzero"`
 	Unit                   string `json:"Unit,omitempty"`
 	StockPointCode         string `json:"StockPointCode,omitempty"`
 	StockLocationCode      string `json:"StockLocationCode,omitempty"`
@@ -255,8 +273,8 @@ type SupplierInvoiceRow struct {
 
 type SupplierInvoice struct {
 	Url                   string               `json:"@url,omitempty"`
-	AdministrationFee     string               `json:"AdministrationFee,omitempty"`
-	Balance               string               `json:"Balance,omitempty"`
+	AdministrationFee     Money                `json:"AdministrationFee,omitzero"`
+	Balance               Money                `json:"Balance,omitzero"`
 	Booked                bool                 `json:"Booked,omitempty"`
 	Cancelled             bool                 `json:"Cancelled,omitempty"`
 	Comments              string               `json:"Comments,omitempty"`
@@ -264,26 +282,26 @@ type SupplierInvoice struct {
 	Credit                bool                 `json:"Credit,omitempty"`
 	CreditReference       int                  `json:"CreditReference,omitempty"`
 	Currency              string               `json:"Currency,omitempty"`
-	CurrencyRate          string               `json:"CurrencyRate,omitempty"`
+	CurrencyRate          Money                `json:"CurrencyRate,omitzero"`
 	CurrencyUnit          int                  `json:"CurrencyUnit,omitempty"`
 	DisablePaymentFile    bool                 `json:"DisablePaymentFile,omitempty"`
-	DueDate               string               `json:"DueDate,omitempty"`
+	DueDate               Date                 `json:"DueDate,omitempty"`
 	ExternalInvoiceNumber string               `json:"ExternalInvoiceNumber,omitempty"`
 	ExternalInvoiceSeries string               `json:"ExternalInvoiceSeries,omitempty"`
-	Freight               string               `json:"Freight,omitempty"`
+	Freight               Money                `json:"Freight,omitzero"`
 	GivenNumber           string               `json:"GivenNumber,omitempty"`
-	InvoiceDate           string               `json:"InvoiceDate,omitempty"`
+	InvoiceDate           Date                 `json:"InvoiceDate,omitempty"`
 	InvoiceNumber         string               `json:"InvoiceNumber,omitempty"`
 	OCR                   string               `json:"OCR,omitempty"`
 	OurReference          string               `json:"OurReference,omitempty"`
 	PaymentPending        bool                 `json:"PaymentPending,omitempty"`
 	Project               string               `json:"Project,omitempty"`
-	RoundOffValue         string               `json:"RoundOffValue,omitempty"`
+	RoundOffValue         Money                `json:"RoundOffValue,omitzero"`
 	SupplierInvoiceRows   []SupplierInvoiceRow `json:"SupplierInvoiceRows,omitempty"`
 	SupplierNumber        string               `json:"SupplierNumber,omitempty"`
 	SupplierName          string               `json:"SupplierName,omitempty"`
-	Total                 string               `json:"Total,omitempty"`
-	VAT                   string               `json:"VAT,omitempty"`
+	Total                 Money                `json:"Total,omitzero"`
+	VAT                   Money                `json:"VAT,omitzero"`
 	YourReference         string               `json:"YourReference,omitempty"`
 	VoucherNumber         int                  `json:"VoucherNumber,omitempty"`
 	VoucherSeries         string               `json:"VoucherSeries,omitempty"`
@@ -292,5 +310,5 @@ type SupplierInvoice struct {
 	SalesType             string               `json:"SalesType,omitempty"`
 	AccountingMethod      string               `json:"AccountingMethod,omitempty"`
 	Vouchers              []Voucher            `json:"Vouchers,omitempty"`
-	FinalPayDate          string               `json:"FinalPayDate,omitempty"`
+	FinalPayDate          Date                 `json:"FinalPayDate,omitempty"`
 }
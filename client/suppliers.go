@@ -10,25 +10,39 @@ const (
 )
 
 // GetAllSuppliers does _GET https://api.fortnox.se/3/suppliers
-func (c *Client) GetAllSuppliers(ctx context.Context) ([]Supplier, error) {
+func (c *suppliersService) GetAllSuppliers(ctx context.Context) ([]Supplier, error) {
+	return c.IterateSuppliers().All(ctx)
+}
+
+// GetSuppliersPage does _GET https://api.fortnox.se/3/suppliers and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *suppliersService) GetSuppliersPage(ctx context.Context, page *PageOptions) ([]Supplier, *MetaInformation, error) {
 	resp := &GetAllSuppliersResp{}
 
-	err := c._GET(ctx, suppliersURI, nil, resp)
+	err := c._GETPage(ctx, getSuppliersPageOp, suppliersURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Suppliers, nil
+	return resp.Suppliers, &resp.MetaInformation, nil
+}
+
+// IterateSuppliers lazily walks all pages of https://api.fortnox.se/3/suppliers
+func (c *suppliersService) IterateSuppliers() *Iterator[Supplier] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Supplier, *MetaInformation, error) {
+		return c.GetSuppliersPage(ctx, page)
+	})
 }
 
 // CreateSupplier does _POST https://api.fortnox.se/3/suppliers
 //
 // req - supplier to create
-func (c *Client) CreateSupplier(ctx context.Context, s *Supplier) (*Supplier, error) {
+func (c *suppliersService) CreateSupplier(ctx context.Context, s *Supplier) (*Supplier, error) {
 	req := &CreateSupplierReq{Supplier: *s}
 	resp := &CreateSupplierResp{}
 
-	err := c._POST(ctx, suppliersURI, nil, req, resp)
+	err := c._POST(ctx, createSupplierOp, suppliersURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +53,12 @@ func (c *Client) CreateSupplier(ctx context.Context, s *Supplier) (*Supplier, er
 // GetSupplier does _GET https://api.fortnox.se/3/suppliers/{SupplierNumber}
 //
 // supplierNumber - identifies the supplier
-func (c *Client) GetSupplier(ctx context.Context, supplierNumber string) (*Supplier, error) {
+func (c *suppliersService) GetSupplier(ctx context.Context, supplierNumber string) (*Supplier, error) {
 	resp := &GetSupplierResp{}
 
 	uri := fmt.Sprintf("%s/%s", suppliersURI, supplierNumber)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getSupplierOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -55,13 +69,15 @@ func (c *Client) GetSupplier(ctx context.Context, supplierNumber string) (*Suppl
 // UpdateSupplier does _PUT https://api.fortnox.se/3/suppliers/{SupplierNumber}
 //
 // supplierNumber - identifies the supplier
-func (c *Client) UpdateSupplier(ctx context.Context, supplierNumber string, s *Supplier) (*Supplier, error) {
+//
+// s - fields to update, only fields that are Set are sent
+func (c *suppliersService) UpdateSupplier(ctx context.Context, supplierNumber string, s *SupplierUpdate) (*Supplier, error) {
 	req := &UpdateSupplierReq{Supplier: *s}
 	resp := &UpdateSupplierResp{}
 
 	uri := fmt.Sprintf("%s/%s", suppliersURI, supplierNumber)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateSupplierOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +85,25 @@ func (c *Client) UpdateSupplier(ctx context.Context, supplierNumber string, s *S
 	return &resp.Supplier, nil
 }
 
+// PatchSupplier does _PUT https://api.fortnox.se/3/suppliers/{SupplierNumber} sending only the fields changed between original and edited
+//
+// original - supplier as returned by Fortnox
+//
+// edited - modified copy of original
+//
+// Nothing is sent when no writable field changed, original is returned then.
+func (c *suppliersService) PatchSupplier(ctx context.Context, original, edited *Supplier) (*Supplier, error) {
+	update := DiffSupplier(original, edited)
+	if !hasSetFields(update) {
+		return original, nil
+	}
+
+	return c.UpdateSupplier(ctx, original.SupplierNumber, update)
+}
+
 type GetAllSuppliersResp struct {
-	Suppliers []Supplier `json:"Suppliers"`
+	Suppliers       []Supplier      `json:"Suppliers"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateSupplierReq struct {
@@ -86,7 +119,7 @@ type GetSupplierResp struct {
 }
 
 type UpdateSupplierReq struct {
-	Supplier Supplier `json:"Supplier"`
+	Supplier SupplierUpdate `json:"Supplier"`
 }
 
 type UpdateSupplierResp struct {
@@ -137,3 +170,67 @@ type Supplier struct {
 	YourReference       string `json:"YourReference,omitempty"`
 	ZipCode             string `json:"ZipCode,omitempty"`
 }
+
+// SupplierUpdate is the payload of UpdateSupplier, only fields that are Set are sent,
+// so they can be changed to their zero value
+type SupplierUpdate struct {
+	Active              Optional[bool]   `json:"Active"`
+	Address1            Optional[string] `json:"Address1"`
+	Address2            Optional[string] `json:"Address2"`
+	Bank                Optional[string] `json:"Bank"`
+	BankAccountNumber   Optional[string] `json:"BankAccountNumber"`
+	BG                  Optional[string] `json:"BG"`
+	BIC                 Optional[string] `json:"BIC"`
+	BranchCode          Optional[string] `json:"BranchCode"`
+	City                Optional[string] `json:"City"`
+	ClearingNumber      Optional[string] `json:"ClearingNumber"`
+	Comments            Optional[string] `json:"Comments"`
+	CostCenter          Optional[string] `json:"CostCenter"`
+	Country             Optional[string] `json:"Country"`
+	CountryCode         Optional[string] `json:"CountryCode"`
+	Currency            Optional[string] `json:"Currency"`
+	DisablePaymentFile  Optional[bool]   `json:"DisablePaymentFile"`
+	Email               Optional[string] `json:"Email"`
+	Fax                 Optional[string] `json:"Fax"`
+	IBAN                Optional[string] `json:"IBAN"`
+	Name                Optional[string] `json:"Name"`
+	OrganisationNumber  Optional[string] `json:"OrganisationNumber"`
+	OurReference        Optional[string] `json:"OurReference"`
+	OurCustomerNumber   Optional[string] `json:"OurCustomerNumber"`
+	PG                  Optional[string] `json:"PG"`
+	Phone1              Optional[string] `json:"Phone1"`
+	Phone2              Optional[string] `json:"Phone2"`
+	PreDefinedAccount   Optional[string] `json:"PreDefinedAccount"`
+	Project             Optional[string] `json:"Project"`
+	SupplierNumber      Optional[string] `json:"SupplierNumber"`
+	TermsOfPayment      Optional[string] `json:"TermsOfPayment"`
+	VATNumber           Optional[string] `json:"VATNumber"`
+	VATType             Optional[string] `json:"VATType"`
+	VisitingAddress     Optional[string] `json:"VisitingAddress"`
+	VisitingCity        Optional[string] `json:"VisitingCity"`
+	VisitingCountry     Optional[string] `json:"VisitingCountry"`
+	VisitingCountryCode Optional[string] `json:"VisitingCountryCode"`
+	VisitingZipCode     Optional[string] `json:"VisitingZipCode"`
+	WorkPlace           Optional[string] `json:"WorkPlace"`
+	WWW                 Optional[string] `json:"WWW"`
+	YourReference       Optional[string] `json:"YourReference"`
+	ZipCode             Optional[string] `json:"ZipCode"`
+}
+
+func (u SupplierUpdate) MarshalJSON() ([]byte, error) {
+	return marshalSetFields(u)
+}
+
+// supplierReadOnlyFields are computed or set by Fortnox, DiffSupplier never sends them
+var supplierReadOnlyFields = map[string]bool{
+	"SupplierNumber": true,
+}
+
+// DiffSupplier returns an update holding only the writable fields that differ between original, as returned by Fortnox,
+// and edited, a modified copy of it
+func DiffSupplier(original, edited *Supplier) *SupplierUpdate {
+	u := &SupplierUpdate{}
+	diffInto(original, edited, u, supplierReadOnlyFields)
+
+	return u
+}
@@ -4,41 +4,61 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 )
 
 const (
 	taxReductionsURI = "taxreductions"
 )
 
-const (
-	taxReductionFilterName = "filter"
-)
-
 // GetAllTaxReductions does _GET https://api.fortnox.se/3/taxreductions
 //
 // Enum: {"invoices" "orders" "offers"}, possibility to filter tax reductions
-func (c Client) GetAllTaxReductions(ctx context.Context, filter *GetAllTaxReductionsFilter) ([]TaxReduction, error) {
+func (c *taxReductionsService) GetAllTaxReductions(ctx context.Context, filter *GetAllTaxReductionsFilter) ([]TaxReduction, error) {
+	return c.IterateTaxReductions(filter).All(ctx)
+}
+
+// GetTaxReductionsPage does _GET https://api.fortnox.se/3/taxreductions and returns a single page along with its MetaInformation
+//
+// Enum: {"invoices" "orders" "offers"}, possibility to filter tax reductions
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *taxReductionsService) GetTaxReductionsPage(
+	ctx context.Context,
+	filter *GetAllTaxReductionsFilter,
+	page *PageOptions) ([]TaxReduction, *MetaInformation, error) {
+
 	resp := &GetAllTaxReductionsResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, taxReductionsURI, params, resp)
+	err = c._GETPage(ctx, getTaxReductionsPageOp, taxReductionsURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.TaxReductions, nil
+	return resp.TaxReductions, &resp.MetaInformation, nil
+}
+
+// IterateTaxReductions lazily walks all pages of https://api.fortnox.se/3/taxreductions
+//
+// Enum: {"invoices" "orders" "offers"}, possibility to filter tax reductions
+func (c *taxReductionsService) IterateTaxReductions(filter *GetAllTaxReductionsFilter) *Iterator[TaxReduction] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]TaxReduction, *MetaInformation, error) {
+		return c.GetTaxReductionsPage(ctx, filter, page)
+	})
 }
 
 // CreateTaxReduction does _POST https://api.fortnox.se/3/taxreductions
 //
 // req - tax reduction to create
-func (c *Client) CreateTaxReduction(ctx context.Context, tr *TaxReduction) (*TaxReduction, error) {
+func (c *taxReductionsService) CreateTaxReduction(ctx context.Context, tr *TaxReduction) (*TaxReduction, error) {
 	req := &CreateTaxReductionReq{TaxReduction: *tr}
 	resp := &CreateTaxReductionResp{}
 
-	err := c._POST(ctx, taxReductionsURI, nil, req, resp)
+	err := c._POST(ctx, createTaxReductionOp, taxReductionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -49,12 +69,12 @@ func (c *Client) CreateTaxReduction(ctx context.Context, tr *TaxReduction) (*Tax
 // GetTaxReduction does _GET https://api.fortnox.se/3/taxreductions/{Id}
 //
 // id - identifies the tax reduction
-func (c *Client) GetTaxReduction(ctx context.Context, id int) (*TaxReduction, error) {
+func (c *taxReductionsService) GetTaxReduction(ctx context.Context, id int) (*TaxReduction, error) {
 	resp := &GetTaxReductionResp{}
 
 	uri := fmt.Sprintf("%s/%d", taxReductionsURI, id)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getTaxReductionOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -67,13 +87,13 @@ func (c *Client) GetTaxReduction(ctx context.Context, id int) (*TaxReduction, er
 // id - identifies the tax reduction
 //
 // req - tax reduction to update
-func (c *Client) UpdateTaxReduction(ctx context.Context, id int, tr *TaxReduction) (*TaxReduction, error) {
+func (c *taxReductionsService) UpdateTaxReduction(ctx context.Context, id int, tr *TaxReduction) (*TaxReduction, error) {
 	req := &UpdateTaxReductionReq{TaxReduction: *tr}
 	resp := &UpdateTaxReductionResp{}
 
 	uri := fmt.Sprintf("%s/%d", taxReductionsURI, id)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateTaxReductionOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,9 +104,9 @@ func (c *Client) UpdateTaxReduction(ctx context.Context, id int, tr *TaxReductio
 // RemoveTaxReduction does _DELETE https://api.fortnox.se/3/taxreductions/{Id}
 //
 // id - identifies the tax reduction
-func (c Client) RemoveTaxReduction(ctx context.Context, id int) error {
+func (c *taxReductionsService) RemoveTaxReduction(ctx context.Context, id int) error {
 	uri := fmt.Sprintf("%s/%d", taxReductionsURI, id)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeTaxReductionOp, uri)
 }
 
 type GetAllTaxReductionsFilter string
@@ -97,27 +117,21 @@ const (
 	OffersTaxReductionFilter   GetAllTaxReductionsFilter = "invoices"
 )
 
-func (f *GetAllTaxReductionsFilter) urlValues() url.Values {
+func (f *GetAllTaxReductionsFilter) urlValues() (url.Values, error) {
 	if f == nil {
-		return nil
-	}
-
-	params := url.Values{}
-
-	fStr := string(*f)
-
-	if strings.TrimSpace(fStr) != "" {
-		params[taxReductionFilterName] = []string{fStr}
+		return nil, nil
 	}
 
-	return params
+	return encodeQuery(struct {
+		Filter GetAllTaxReductionsFilter `fortnox:"filter"`
+	}{*f})
 }
 
 type TaxReduction struct {
 	Url                                    string               `json:"@url,omitempty"`
-	ApprovedAmount                         int                  `json:"ApprovedAmount,omitempty"`
-	AskedAmount                            int                  `json:"AskedAmount,omitempty"`
-	BilledAmount                           int                  `json:"BilledAmount,omitempty"`
+	ApprovedAmount                         Money                `json:"ApprovedAmount,omitzero"`
+	AskedAmount                            Money                `json:"AskedAmount,omitzero"`
+	BilledAmount                           Money                `json:"BilledAmount,omitzero"`
 	CustomerName                           string               `json:"CustomerName,omitempty"`
 	Id                                     int                  `json:"Id,omitempty"`
 	PropertyDesignation                    string               `json:"PropertyDesignation,omitempty"`
@@ -134,12 +148,13 @@ type TaxReduction struct {
 }
 
 type TaxReductionAmount struct {
-	AskedAmount int    `json:"askedAmount,omitempty"`
+	AskedAmount Money  `json:"askedAmount,omitzero"`
 	WorkType    string `json:"workType,omitempty"`
 }
 
 type GetAllTaxReductionsResp struct {
-	TaxReductions []TaxReduction `json:"TaxReductions"`
+	TaxReductions   []TaxReduction  `json:"TaxReductions"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateTaxReductionReq struct {
@@ -10,25 +10,42 @@ const (
 )
 
 // GetAllTermsOfDeliveries does _GET https://api.fortnox.se/3/termsofdeliveries
-func (c *Client) GetAllTermsOfDeliveries(ctx context.Context) ([]TermsOfDelivery, error) {
+func (c *termsOfDeliveriesService) GetAllTermsOfDeliveries(ctx context.Context) ([]TermsOfDelivery, error) {
+	return c.IterateTermsOfDeliveries().All(ctx)
+}
+
+// GetTermsOfDeliveriesPage does _GET https://api.fortnox.se/3/termsofdeliveries and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *termsOfDeliveriesService) GetTermsOfDeliveriesPage(
+	ctx context.Context,
+	page *PageOptions) ([]TermsOfDelivery, *MetaInformation, error) {
+
 	resp := &GetAllTermsOfDeliveriesResp{}
 
-	err := c._GET(ctx, termsOfDeliveriesURI, nil, resp)
+	err := c._GETPage(ctx, getTermsOfDeliveriesPageOp, termsOfDeliveriesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.TermsOfDeliveries, nil
+	return resp.TermsOfDeliveries, &resp.MetaInformation, nil
+}
+
+// IterateTermsOfDeliveries lazily walks all pages of https://api.fortnox.se/3/termsofdeliveries
+func (c *termsOfDeliveriesService) IterateTermsOfDeliveries() *Iterator[TermsOfDelivery] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]TermsOfDelivery, *MetaInformation, error) {
+		return c.GetTermsOfDeliveriesPage(ctx, page)
+	})
 }
 
 // CreateTermsOfDeliveries does _POST https://api.fortnox.se/3/termsofdeliveries
 //
 // req - terms of delivery to create
-func (c *Client) CreateTermsOfDeliveries(ctx context.Context, tod *TermsOfDelivery) (*TermsOfDelivery, error) {
+func (c *termsOfDeliveriesService) CreateTermsOfDeliveries(ctx context.Context, tod *TermsOfDelivery) (*TermsOfDelivery, error) {
 	req := &CreateTermsOfDeliveriesReq{TermsOfDelivery: *tod}
 	resp := &CreateTermsOfDeliveriesResp{}
 
-	err := c._POST(ctx, termsOfDeliveriesURI, nil, req, resp)
+	err := c._POST(ctx, createTermsOfDeliveriesOp, termsOfDeliveriesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +56,12 @@ func (c *Client) CreateTermsOfDeliveries(ctx context.Context, tod *TermsOfDelive
 // GetTermOfDelivery does _GET https://api.fortnox.se/3/termsofdeliveries/{Code}
 //
 // code - identifies the terms of delivery
-func (c *Client) GetTermOfDelivery(ctx context.Context, code string) (*TermsOfDelivery, error) {
+func (c *termsOfDeliveriesService) GetTermOfDelivery(ctx context.Context, code string) (*TermsOfDelivery, error) {
 	resp := &GetTermOfDeliveryResp{}
 
 	uri := fmt.Sprintf("%s/%s", termsOfDeliveriesURI, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getTermOfDeliveryOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +74,7 @@ func (c *Client) GetTermOfDelivery(ctx context.Context, code string) (*TermsOfDe
 // code - identifies the terms of delivery
 //
 // req - terms of delivery to update
-func (c *Client) UpdateTermOfDelivery(
+func (c *termsOfDeliveriesService) UpdateTermOfDelivery(
 	ctx context.Context,
 	code string,
 	tod *TermsOfDelivery) (*TermsOfDelivery, error) {
@@ -67,7 +84,7 @@ func (c *Client) UpdateTermOfDelivery(
 
 	uri := fmt.Sprintf("%s/%s", termsOfDeliveriesURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateTermOfDeliveryOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +101,7 @@ type TermsOfDelivery struct {
 
 type GetAllTermsOfDeliveriesResp struct {
 	TermsOfDeliveries []TermsOfDelivery `json:"TermsOfDeliveries"`
+	MetaInformation   MetaInformation   `json:"MetaInformation"`
 }
 
 type CreateTermsOfDeliveriesReq struct {
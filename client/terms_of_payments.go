@@ -10,25 +10,42 @@ const (
 )
 
 // GetAllTermsOfPayments does _GET https://api.fortnox.se/3/termsofpayments
-func (c *Client) GetAllTermsOfPayments(ctx context.Context) ([]TermsOfPayment, error) {
+func (c *termsOfPaymentsService) GetAllTermsOfPayments(ctx context.Context) ([]TermsOfPayment, error) {
+	return c.IterateTermsOfPayments().All(ctx)
+}
+
+// GetTermsOfPaymentsPage does _GET https://api.fortnox.se/3/termsofpayments and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *termsOfPaymentsService) GetTermsOfPaymentsPage(
+	ctx context.Context,
+	page *PageOptions) ([]TermsOfPayment, *MetaInformation, error) {
+
 	resp := &GetAllTermsOfPaymentsResp{}
 
-	err := c._GET(ctx, termsOfPaymentsURI, nil, resp)
+	err := c._GETPage(ctx, getTermsOfPaymentsPageOp, termsOfPaymentsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.TermsOfPayments, nil
+	return resp.TermsOfPayments, &resp.MetaInformation, nil
+}
+
+// IterateTermsOfPayments lazily walks all pages of https://api.fortnox.se/3/termsofpayments
+func (c *termsOfPaymentsService) IterateTermsOfPayments() *Iterator[TermsOfPayment] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]TermsOfPayment, *MetaInformation, error) {
+		return c.GetTermsOfPaymentsPage(ctx, page)
+	})
 }
 
 // CreateTermOfPayment does _POST https://api.fortnox.se/3/termsofpayments
 //
 // req - term of payment to create
-func (c Client) CreateTermOfPayment(ctx context.Context, top *TermsOfPayment) (*TermsOfPayment, error) {
+func (c *termsOfPaymentsService) CreateTermOfPayment(ctx context.Context, top *TermsOfPayment) (*TermsOfPayment, error) {
 	req := &CreateTermOfPaymentReq{TermsOfPayment: *top}
 	resp := &CreateTermOfPaymentResp{}
 
-	err := c._POST(ctx, termsOfPaymentsURI, nil, req, resp)
+	err := c._POST(ctx, createTermOfPaymentOp, termsOfPaymentsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +56,12 @@ func (c Client) CreateTermOfPayment(ctx context.Context, top *TermsOfPayment) (*
 // GetTermOfPayment does _GET https://api.fortnox.se/3/termsofpayments/{Code}
 //
 // code - identifies the terms of payment
-func (c *Client) GetTermOfPayment(ctx context.Context, code string) (*TermsOfPayment, error) {
+func (c *termsOfPaymentsService) GetTermOfPayment(ctx context.Context, code string) (*TermsOfPayment, error) {
 	resp := &GetTermOfPaymentResp{}
 
 	uri := fmt.Sprintf("%s/%s", termsOfPaymentsURI, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getTermOfPaymentOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +74,7 @@ func (c *Client) GetTermOfPayment(ctx context.Context, code string) (*TermsOfPay
 // code - identifies the terms of payment
 //
 // req - term of payment to update
-func (c *Client) UpdateTermOfPayment(
+func (c *termsOfPaymentsService) UpdateTermOfPayment(
 	ctx context.Context,
 	code string,
 	top *TermsOfPayment) (*TermsOfPayment, error) {
@@ -67,7 +84,7 @@ func (c *Client) UpdateTermOfPayment(
 
 	uri := fmt.Sprintf("%s/%s", termsOfPaymentsURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateTermOfPaymentOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -77,9 +94,9 @@ func (c *Client) UpdateTermOfPayment(
 
 // RemoveTermOfPayment does _DELETE https://api.fortnox.se/3/termsofpayments/{Code}
 // code - identifies the terms of payment
-func (c *Client) RemoveTermOfPayment(ctx context.Context, code string) error {
+func (c *termsOfPaymentsService) RemoveTermOfPayment(ctx context.Context, code string) error {
 	uri := fmt.Sprintf("%s/%s", termsOfPaymentsURI, code)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeTermOfPaymentOp, uri)
 }
 
 type TermsOfPayment struct {
@@ -90,6 +107,7 @@ type TermsOfPayment struct {
 
 type GetAllTermsOfPaymentsResp struct {
 	TermsOfPayments []TermsOfPayment `json:"TermsOfPayments"`
+	MetaInformation MetaInformation  `json:"MetaInformation"`
 }
 
 type CreateTermOfPaymentReq struct {
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the access token of every request in place of the client's own token pair,
+// see WithTokenSourceOpt. Token is called before each request and must cache the token itself.
+type TokenSource interface {
+	Token(ctx context.Context) (*TokenInfo, error)
+}
+
+// TokenExpirer is implemented by a TokenSource that can drop a token Fortnox rejected,
+// the request is then sent once more with a new token
+type TokenExpirer interface {
+	Expire(accessToken string)
+}
+
+// ClientCredentials is a TokenSource for service accounts using the client credentials flow,
+// a token is requested for TenantID with the integration's credentials whenever the cached one is about to expire.
+// There is no refresh token, so nothing needs to be stored between runs.
+//
+// Set the fields before first use, it is safe for concurrent use afterwards.
+type ClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+	// TenantID is the Fortnox tenant the service account was authorized for, sent in the TenantId header
+	TenantID string
+	// Scopes narrows the token to a subset of the authorized scopes, all of them when empty
+	Scopes Scopes
+	// TokenURL defaults to the Fortnox token endpoint
+	TokenURL string
+	// HTTPClient requests tokens, one with a 10 second timeout by default
+	HTTPClient *http.Client
+
+	mu       sync.Mutex
+	token    *TokenInfo
+	inflight *refreshCall
+}
+
+// Token returns the cached token, or requests a new one when it is about to expire.
+// Concurrent callers share a single request, which runs detached from their contexts
+// bounded by refreshTimeout, so a cancelled caller doesn't fail the others waiting for it.
+func (s *ClientCredentials) Token(ctx context.Context) (*TokenInfo, error) {
+	for {
+		s.mu.Lock()
+
+		if s.token != nil && !expiresSoon(s.token) {
+			t := *s.token
+			s.mu.Unlock()
+			return &t, nil
+		}
+
+		call := s.inflight
+		if call == nil {
+			call = &refreshCall{done: make(chan struct{})}
+			s.inflight = call
+
+			go func() {
+				fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+				defer cancel()
+
+				token, err := s.fetch(fetchCtx)
+
+				s.mu.Lock()
+				if err == nil {
+					s.token = token
+				}
+				s.inflight = nil
+				call.err = err
+				s.mu.Unlock()
+
+				close(call.done)
+			}()
+		}
+
+		s.mu.Unlock()
+
+		select {
+		case <-call.done:
+			if call.err != nil {
+				return nil, call.err
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		s.mu.Lock()
+		token := s.token
+		s.mu.Unlock()
+
+		// the fetched token was expired again in the meantime
+		if token == nil {
+			continue
+		}
+
+		t := *token
+		return &t, nil
+	}
+}
+
+// expiresSoon reports whether token should be replaced. The leeway is refreshLeeway
+// but at most half the token's lifetime, so a short lived token is still used for a while,
+// and a token without a known expiry is used until Fortnox rejects it.
+func expiresSoon(token *TokenInfo) bool {
+	if token.ExpiresAt.IsZero() {
+		return false
+	}
+
+	leeway := refreshLeeway
+	if lifetime := time.Duration(token.ExpiresIn) * time.Second; lifetime > 0 && lifetime/2 < leeway {
+		leeway = lifetime / 2
+	}
+
+	return time.Until(token.ExpiresAt) <= leeway
+}
+
+// Expire drops the cached token if it is accessToken, the next call to Token requests a new one
+func (s *ClientCredentials) Expire(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken == accessToken {
+		s.token = nil
+	}
+}
+
+// current returns the cached access token, empty when there is none
+func (s *ClientCredentials) current() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return ""
+	}
+
+	return s.token.AccessToken
+}
+
+func (s *ClientCredentials) fetch(ctx context.Context) (*TokenInfo, error) {
+	tokenURL := s.TokenURL
+	if tokenURL == "" {
+		tokenURL = postToken
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(s.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.Scopes.toStrings(), " "))
+	}
+
+	header := http.Header{}
+	header.Set("TenantId", s.TenantID)
+
+	token, err := tokenRequest(ctx, s.HTTPClient, tokenURL, s.ClientID, s.ClientSecret, form, header)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.ExpiresAt.IsZero() && token.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+// WithTokenSourceOpt makes the client take its access token from src instead of WithAuthOpt and WithRefreshOpt,
+// the tenant of a *ClientCredentials is used for rate limiting unless WithTenantIDOpt is given
+func WithTokenSourceOpt(src TokenSource) OptionFunc {
+	return func(co *Options) {
+		co.TokenSource = src
+	}
+}
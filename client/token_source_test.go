@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// clientCredentialsServer hands out token-1, token-2, ... once release is closed, release nil answers right away
+func clientCredentialsServer(t *testing.T, expiresIn int, release chan struct{}) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if r.PostForm.Get("grant_type") != "client_credentials" || r.Header.Get("TenantId") != "42" {
+			t.Errorf("unexpected token request %v %v", r.Header, r.PostForm)
+		}
+
+		if release != nil {
+			<-release
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, fmt.Sprintf(`{"access_token":"token-%d","expires_in":%d,"token_type":"bearer"}`, n, expiresIn))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func newClientCredentials(server *httptest.Server) *ClientCredentials {
+	return &ClientCredentials{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		TenantID:     "42",
+		TokenURL:     server.URL,
+	}
+}
+
+func TestClientCredentialsCachesToken(t *testing.T) {
+	server, requests := clientCredentialsServer(t, 3600, nil)
+	src := newClientCredentials(server)
+
+	for i := 0; i < 3; i++ {
+		token, err := src.Token(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.AccessToken != "token-1" {
+			t.Errorf("token = %q, want token-1", token.AccessToken)
+		}
+	}
+
+	src.Expire("token-1")
+
+	token, err := src.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "token-2" || requests.Load() != 2 {
+		t.Errorf("token after Expire = %q after %d requests, want token-2 after 2", token.AccessToken, requests.Load())
+	}
+}
+
+func TestClientCredentialsConcurrentCallersShareRequest(t *testing.T) {
+	release := make(chan struct{})
+	server, requests := clientCredentialsServer(t, 3600, release)
+	src := newClientCredentials(server)
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 10)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			token, err := src.Token(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			tokens[i] = token.AccessToken
+		}(i)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, token := range tokens {
+		if token != "token-1" {
+			t.Errorf("caller %d got %q, want token-1", i, token)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("server got %d token requests, want 1", got)
+	}
+}
+
+func TestClientCredentialsLeaderCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server, requests := clientCredentialsServer(t, 3600, release)
+	src := newClientCredentials(server)
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := src.Token(leaderCtx)
+		leaderErr <- err
+	}()
+
+	// wait for the leader's request to reach the server
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	follower := make(chan *TokenInfo, 1)
+	go func() {
+		token, err := src.Token(context.Background())
+		if err != nil {
+			t.Error(err)
+		}
+		follower <- token
+	}()
+
+	cancelLeader()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("leader error = %v, want Canceled", err)
+	}
+
+	close(release)
+
+	if token := <-follower; token == nil || token.AccessToken != "token-1" {
+		t.Errorf("follower token = %+v, want token-1", token)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("server got %d token requests, want 1", got)
+	}
+
+	// the request outlived the leader, its token is cached for the next caller
+	token, err := src.Token(context.Background())
+	if err != nil || token.AccessToken != "token-1" {
+		t.Errorf("cached token = %+v, %v", token, err)
+	}
+}
+
+func TestExpiresSoon(t *testing.T) {
+	tests := []struct {
+		name  string
+		token TokenInfo
+		want  bool
+	}{
+		{"no expiry", TokenInfo{}, false},
+		{"fresh", TokenInfo{ExpiresIn: 3600, ExpiresAt: time.Now().Add(time.Hour)}, false},
+		{"within leeway", TokenInfo{ExpiresIn: 3600, ExpiresAt: time.Now().Add(refreshLeeway / 2)}, true},
+		{"expired", TokenInfo{ExpiresIn: 3600, ExpiresAt: time.Now().Add(-time.Second)}, true},
+		{"short lived", TokenInfo{ExpiresIn: 20, ExpiresAt: time.Now().Add(15 * time.Second)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expiresSoon(&tt.token); got != tt.want {
+				t.Errorf("expiresSoon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrTokenNotFound = errors.New("token not found in token store")
+)
+
+// TokenStore persists the OAuth token pair, so a rotated refresh token survives restarts.
+//
+// Load returns ErrTokenNotFound when nothing has been saved yet.
+type TokenStore interface {
+	Load(ctx context.Context) (*TokenInfo, error)
+	Save(ctx context.Context, token *TokenInfo) error
+}
+
+// TokenDeleter is implemented by a TokenStore that can delete the token, Client.Disconnect
+// saves an empty token to stores that don't implement it
+type TokenDeleter interface {
+	Delete(ctx context.Context) error
+}
+
+// TokenRefreshedFunc is called after a refreshed token was persisted and taken into use
+type TokenRefreshedFunc func(ctx context.Context, token *TokenInfo)
+
+// MemoryTokenStore keeps the token in memory, useful for tests and short-lived processes
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *TokenInfo
+}
+
+// NewMemoryTokenStore creates a MemoryTokenStore, token may be nil
+func NewMemoryTokenStore(token *TokenInfo) *MemoryTokenStore {
+	s := &MemoryTokenStore{}
+	if token != nil {
+		t := *token
+		s.token = &t
+	}
+
+	return s
+}
+
+func (s *MemoryTokenStore) Load(_ context.Context) (*TokenInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil, ErrTokenNotFound
+	}
+
+	t := *s.token
+	return &t, nil
+}
+
+func (s *MemoryTokenStore) Save(_ context.Context, token *TokenInfo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := *token
+	s.token = &t
+
+	return nil
+}
+
+func (s *MemoryTokenStore) Delete(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = nil
+
+	return nil
+}
+
+// FileTokenStore keeps the token as JSON in a file readable only by the owner.
+// Save writes a temporary file and renames it over the old one, so the file never holds a partial token.
+type FileTokenStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileTokenStore creates a FileTokenStore persisting to path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+func (s *FileTokenStore) Load(_ context.Context) (*TokenInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bts, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrTokenNotFound
+		}
+		return nil, err
+	}
+
+	token := &TokenInfo{}
+	err = json.Unmarshal(bts, token)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode token file")
+	}
+
+	return token, nil
+}
+
+func (s *FileTokenStore) Save(_ context.Context, token *TokenInfo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bts, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+
+	// the temp file is only left behind when something failed
+	defer func() {
+		_ = os.Remove(tmpName)
+	}()
+
+	if err := tmp.Chmod(0o600); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if _, err := tmp.Write(bts); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpName, s.path)
+}
+
+func (s *FileTokenStore) Delete(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := os.Remove(s.path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
@@ -10,10 +10,10 @@ const (
 )
 
 // GetAllTrustedAndRejectedEmailSenders does _GET https://api.fortnox.se/3/emailsenders/
-func (c *Client) GetAllTrustedAndRejectedEmailSenders(ctx context.Context) (*EmailSenders, error) {
+func (c *trustedEmailSendersService) GetAllTrustedAndRejectedEmailSenders(ctx context.Context) (*EmailSenders, error) {
 	resp := &GetAllTrustedAndRejectedEmailSendersResp{}
 
-	err := c._GET(ctx, emailSendersURI, nil, resp)
+	err := c._GET(ctx, getAllTrustedAndRejectedEmailSendersOp, emailSendersURI, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -24,13 +24,13 @@ func (c *Client) GetAllTrustedAndRejectedEmailSenders(ctx context.Context) (*Ema
 // CreateTrustedEmailAddress does _POST https://api.fortnox.se/3/emailsenders/trusted
 //
 // req - trusted email sender to create
-func (c *Client) CreateTrustedEmailAddress(ctx context.Context, ts *TrustedSender) (*TrustedSender, error) {
+func (c *trustedEmailSendersService) CreateTrustedEmailAddress(ctx context.Context, ts *TrustedSender) (*TrustedSender, error) {
 	req := &CreateTrustedEmailAddressReq{TrustedSender: *ts}
 	resp := &CreateTrustedEmailAddressResp{}
 
 	uri := fmt.Sprintf("%s/trusted", emailSendersURI)
 
-	err := c._POST(ctx, uri, nil, req, resp)
+	err := c._POST(ctx, createTrustedEmailAddressOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -41,9 +41,9 @@ func (c *Client) CreateTrustedEmailAddress(ctx context.Context, ts *TrustedSende
 // RemoveTrustedEmailAddress does _DELETE https://api.fortnox.se/3/emailsenders/trusted/{Id}
 //
 // id - identifies the trusted email sender to delete
-func (c *Client) RemoveTrustedEmailAddress(ctx context.Context, id int) error {
-	uri := fmt.Sprintf("%s/trusted/%d", unitsURI, id)
-	return c._DELETE(ctx, uri)
+func (c *trustedEmailSendersService) RemoveTrustedEmailAddress(ctx context.Context, id int) error {
+	uri := fmt.Sprintf("%s/trusted/%d", emailSendersURI, id)
+	return c._DELETE(ctx, removeTrustedEmailAddressOp, uri)
 }
 
 type EmailSenders struct {
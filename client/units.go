@@ -10,25 +10,39 @@ const (
 )
 
 // GetAllUnits does _GET https://api.fortnox.se/3/units
-func (c *Client) GetAllUnits(ctx context.Context) ([]Unit, error) {
+func (c *unitsService) GetAllUnits(ctx context.Context) ([]Unit, error) {
+	return c.IterateUnits().All(ctx)
+}
+
+// GetUnitsPage does _GET https://api.fortnox.se/3/units and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *unitsService) GetUnitsPage(ctx context.Context, page *PageOptions) ([]Unit, *MetaInformation, error) {
 	resp := &GetAllUnitsResp{}
 
-	err := c._GET(ctx, unitsURI, nil, resp)
+	err := c._GETPage(ctx, getUnitsPageOp, unitsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Units, nil
+	return resp.Units, &resp.MetaInformation, nil
+}
+
+// IterateUnits lazily walks all pages of https://api.fortnox.se/3/units
+func (c *unitsService) IterateUnits() *Iterator[Unit] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Unit, *MetaInformation, error) {
+		return c.GetUnitsPage(ctx, page)
+	})
 }
 
 // CreateUnit does _POST https://api.fortnox.se/3/units
 //
 // req - unit to create
-func (c *Client) CreateUnit(ctx context.Context, u *Unit) (*Unit, error) {
+func (c *unitsService) CreateUnit(ctx context.Context, u *Unit) (*Unit, error) {
 	req := CreateUnitReq{Unit: *u}
 	resp := &CreateUnitResp{}
 
-	err := c._POST(ctx, unitsURI, nil, req, resp)
+	err := c._POST(ctx, createUnitOp, unitsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +53,12 @@ func (c *Client) CreateUnit(ctx context.Context, u *Unit) (*Unit, error) {
 // GetUnit does _GET https://api.fortnox.se/3/units/{Code}
 //
 // code - identifies the unit
-func (c *Client) GetUnit(ctx context.Context, code string) (*Unit, error) {
+func (c *unitsService) GetUnit(ctx context.Context, code string) (*Unit, error) {
 	resp := &GetUnitResp{}
 
 	uri := fmt.Sprintf("%s/%s", unitsURI, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getUnitOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,13 +71,13 @@ func (c *Client) GetUnit(ctx context.Context, code string) (*Unit, error) {
 // code - identifies the unit
 //
 // req - unit to update
-func (c *Client) UpdateUnit(ctx context.Context, code string, u *Unit) (*Unit, error) {
+func (c *unitsService) UpdateUnit(ctx context.Context, code string, u *Unit) (*Unit, error) {
 	req := &UpdateUnitReq{Unit: *u}
 	resp := &UpdateUnitResp{}
 
 	uri := fmt.Sprintf("%s/%s", unitsURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateUnitOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -72,9 +86,9 @@ func (c *Client) UpdateUnit(ctx context.Context, code string, u *Unit) (*Unit, e
 }
 
 // RemoveUnit does _DELETE https://api.fortnox.se/3/units/{Code}
-func (c *Client) RemoveUnit(ctx context.Context, code string) error {
+func (c *unitsService) RemoveUnit(ctx context.Context, code string) error {
 	uri := fmt.Sprintf("%s/%s", unitsURI, code)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeUnitOp, uri)
 }
 
 type Unit struct {
@@ -85,7 +99,8 @@ type Unit struct {
 }
 
 type GetAllUnitsResp struct {
-	Units []Unit `json:"Units"`
+	Units           []Unit          `json:"Units"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateUnitReq struct {
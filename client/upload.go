@@ -0,0 +1,109 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	uploadFormField = "file"
+)
+
+var (
+	ErrUploadNotReplayable = errors.New("upload can't be sent again, its reader is not an io.Seeker")
+)
+
+// requestBody produces a fresh body for every attempt of a request
+type requestBody interface {
+	open() (body io.Reader, contentType string, err error)
+	// replayable reports whether open can be called again after the body was sent
+	replayable() bool
+}
+
+// jsonBody is an encoded JSON body, nil for requests without body
+type jsonBody []byte
+
+func (b jsonBody) open() (io.Reader, string, error) {
+	if b == nil {
+		return http.NoBody, "", nil
+	}
+
+	return bytes.NewReader(b), mimeType, nil
+}
+
+func (b jsonBody) replayable() bool {
+	return true
+}
+
+// fileUpload streams a file as multipart/form-data without buffering it in memory.
+// The file is read once per attempt, so it can only be sent again if the reader is an io.Seeker.
+type fileUpload struct {
+	fileName string
+	r        io.Reader
+	opened   bool
+	start    int64
+}
+
+func newFileUpload(fileName string, r io.Reader) *fileUpload {
+	return &fileUpload{fileName: fileName, r: r}
+}
+
+func (u *fileUpload) replayable() bool {
+	_, seekable := u.r.(io.Seeker)
+	return seekable
+}
+
+func (u *fileUpload) open() (io.Reader, string, error) {
+	s, seekable := u.r.(io.Seeker)
+
+	if !u.opened {
+		if seekable {
+			start, err := s.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return nil, "", err
+			}
+			u.start = start
+		}
+		u.opened = true
+	} else {
+		if !seekable {
+			return nil, "", ErrUploadNotReplayable
+		}
+		if _, err := s.Seek(u.start, io.SeekStart); err != nil {
+			return nil, "", err
+		}
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	// the writer stops as soon as the transport closes the reader
+	go func() {
+		part, err := mw.CreateFormFile(uploadFormField, u.fileName)
+		if err == nil {
+			_, err = io.Copy(part, u.r)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	return pr, mw.FormDataContentType(), nil
+}
+
+// logValue describes the body for debug logs without exposing file contents
+func logValue(body requestBody) string {
+	switch b := body.(type) {
+	case jsonBody:
+		return redactBody(b)
+	case *fileUpload:
+		return "file " + redactString(b.fileName)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServiceURIs(t *testing.T) {
+	var gotMethod, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithAuthOpt("access-token", "client-secret"),
+		WithURLOpt(server.URL+"/3/"),
+		WithRateLimitOpt(0, 0),
+		WithRetryPolicyOpt(NoRetryPolicy))
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		call       func() error
+		wantMethod string
+		wantPath   string
+	}{
+		{
+			"DeleteAssetFileConnection",
+			func() error { return c.DeleteAssetFileConnection(ctx, "f1") },
+			http.MethodDelete, "/3/assetfileconnections/f1",
+		},
+		{
+			"RemoveCostCenter",
+			func() error { return c.RemoveCostCenter(ctx, "CC1") },
+			http.MethodDelete, "/3/costcenters/CC1",
+		},
+		{
+			"UpdateCustomerReference",
+			func() error {
+				_, err := c.UpdateCustomerReference(ctx, "5", &UpdateCustomerReferenceReq{})
+				return err
+			},
+			http.MethodPut, "/3/customerreferences/5",
+		},
+		{
+			"RemoveTrustedEmailAddress",
+			func() error { return c.RemoveTrustedEmailAddress(ctx, 7) },
+			http.MethodDelete, "/3/emailsenders/trusted/7",
+		},
+		{
+			"CreateVoucherSeries",
+			func() error {
+				_, err := c.CreateVoucherSeries(ctx, &VoucherSeries{})
+				return err
+			},
+			http.MethodPost, "/3/voucherseries",
+		},
+		{
+			"GetVoucherSeriesByCode",
+			func() error {
+				_, err := c.GetVoucherSeriesByCode(ctx, "A")
+				return err
+			},
+			http.MethodGet, "/3/voucherseries/A",
+		},
+		{
+			"UpdateVoucherSeries",
+			func() error {
+				_, err := c.UpdateVoucherSeries(ctx, "A", &VoucherSeries{})
+				return err
+			},
+			http.MethodPut, "/3/voucherseries/A",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+
+			if gotMethod != tt.wantMethod || gotPath != tt.wantPath {
+				t.Errorf("sent %s %s, want %s %s", gotMethod, gotPath, tt.wantMethod, tt.wantPath)
+			}
+
+			// the scope required by the method follows from the same URI
+			if uri := methodURIs[tt.name]; !strings.HasPrefix(tt.wantPath, "/3/"+uri) {
+				t.Errorf("methodURIs[%q] = %q, want the prefix of %s", tt.name, uri, tt.wantPath)
+			}
+		})
+	}
+}
@@ -10,28 +10,45 @@ const (
 )
 
 // GetAllVoucherFileConnections does _GET https://api.fortnox.se/3/voucherfileconnections/
-func (c *Client) GetAllVoucherFileConnections(ctx context.Context) ([]VoucherFileConnection, error) {
+func (c *voucherFileConnectionsService) GetAllVoucherFileConnections(ctx context.Context) ([]VoucherFileConnection, error) {
+	return c.IterateVoucherFileConnections().All(ctx)
+}
+
+// GetVoucherFileConnectionsPage does _GET https://api.fortnox.se/3/voucherfileconnections/ and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *voucherFileConnectionsService) GetVoucherFileConnectionsPage(
+	ctx context.Context,
+	page *PageOptions) ([]VoucherFileConnection, *MetaInformation, error) {
+
 	resp := &GetAllVoucherFileConnectionsResp{}
 
-	err := c._GET(ctx, voucherFileConnectionsURI, nil, resp)
+	err := c._GETPage(ctx, getVoucherFileConnectionsPageOp, voucherFileConnectionsURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.VoucherFileConnections, nil
+	return resp.VoucherFileConnections, &resp.MetaInformation, nil
+}
+
+// IterateVoucherFileConnections lazily walks all pages of https://api.fortnox.se/3/voucherfileconnections/
+func (c *voucherFileConnectionsService) IterateVoucherFileConnections() *Iterator[VoucherFileConnection] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]VoucherFileConnection, *MetaInformation, error) {
+		return c.GetVoucherFileConnectionsPage(ctx, page)
+	})
 }
 
 // CreateVoucherFileConnection does _POST https://api.fortnox.se/3/voucherfileconnections/
 //
 // req - voucher file connection to create
-func (c *Client) CreateVoucherFileConnection(
+func (c *voucherFileConnectionsService) CreateVoucherFileConnection(
 	ctx context.Context,
 	vfc *VoucherFileConnection) (*VoucherFileConnection, error) {
 
 	req := &CreateVoucherFileConnectionReq{VoucherFileConnection: *vfc}
 	resp := &CreateVoucherFileConnectionResp{}
 
-	err := c._POST(ctx, voucherFileConnectionsURI, nil, req, resp)
+	err := c._POST(ctx, createVoucherFileConnectionOp, voucherFileConnectionsURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +59,7 @@ func (c *Client) CreateVoucherFileConnection(
 // GetVoucherFileConnectionByFileID does _GET https://api.fortnox.se/3/voucherfileconnections/{FileId}
 //
 // fileID - identifies the voucher file connection
-func (c *Client) GetVoucherFileConnectionByFileID(
+func (c *voucherFileConnectionsService) GetVoucherFileConnectionByFileID(
 	ctx context.Context,
 	fileID string) (*VoucherFileConnection, error) {
 
@@ -50,7 +67,7 @@ func (c *Client) GetVoucherFileConnectionByFileID(
 
 	uri := fmt.Sprintf("%s/%s", voucherFileConnectionsURI, fileID)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getVoucherFileConnectionByFileIDOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -61,9 +78,9 @@ func (c *Client) GetVoucherFileConnectionByFileID(
 // RemoveVoucherFileConnections does _DELETE https://api.fortnox.se/3/voucherfileconnections/{FileId}
 //
 // fileID - identifies the voucher file connection
-func (c *Client) RemoveVoucherFileConnections(ctx context.Context, fieldID string) error {
+func (c *voucherFileConnectionsService) RemoveVoucherFileConnections(ctx context.Context, fieldID string) error {
 	uri := fmt.Sprintf("%s/%s", voucherFileConnectionsURI, fieldID)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeVoucherFileConnectionsOp, uri)
 }
 
 type VoucherFileConnection struct {
@@ -77,6 +94,7 @@ type VoucherFileConnection struct {
 
 type GetAllVoucherFileConnectionsResp struct {
 	VoucherFileConnections []VoucherFileConnection `json:"VoucherFileConnections"`
+	MetaInformation        MetaInformation         `json:"MetaInformation"`
 }
 
 type CreateVoucherFileConnectionReq struct {
@@ -10,25 +10,42 @@ const (
 )
 
 // GetAllVoucherSeries does _GET https://api.fortnox.se/3/voucherseries
-func (c *Client) GetAllVoucherSeries(ctx context.Context) ([]VoucherSeries, error) {
+func (c *voucherSeriesService) GetAllVoucherSeries(ctx context.Context) ([]VoucherSeries, error) {
+	return c.IterateVoucherSeries().All(ctx)
+}
+
+// GetVoucherSeriesPage does _GET https://api.fortnox.se/3/voucherseries and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *voucherSeriesService) GetVoucherSeriesPage(
+	ctx context.Context,
+	page *PageOptions) ([]VoucherSeries, *MetaInformation, error) {
+
 	resp := &GetAllVoucherSeriesResp{}
 
-	err := c._GET(ctx, voucherSeriesURI, nil, resp)
+	err := c._GETPage(ctx, getVoucherSeriesPageOp, voucherSeriesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.VoucherSeriesCollection, nil
+	return resp.VoucherSeriesCollection, &resp.MetaInformation, nil
+}
+
+// IterateVoucherSeries lazily walks all pages of https://api.fortnox.se/3/voucherseries
+func (c *voucherSeriesService) IterateVoucherSeries() *Iterator[VoucherSeries] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]VoucherSeries, *MetaInformation, error) {
+		return c.GetVoucherSeriesPage(ctx, page)
+	})
 }
 
 // CreateVoucherSeries does _POST https://api.fortnox.se/3/voucherseries
 //
 // req - voucher series to create
-func (c *Client) CreateVoucherSeries(ctx context.Context, vs *VoucherSeries) (*VoucherSeries, error) {
+func (c *voucherSeriesService) CreateVoucherSeries(ctx context.Context, vs *VoucherSeries) (*VoucherSeries, error) {
 	req := &CreateVoucherSeriesReq{VoucherSeries: *vs}
 	resp := &CreateVoucherSeriesResp{}
 
-	err := c._POST(ctx, vouchersURI, nil, req, resp)
+	err := c._POST(ctx, createVoucherSeriesOp, voucherSeriesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -39,11 +56,11 @@ func (c *Client) CreateVoucherSeries(ctx context.Context, vs *VoucherSeries) (*V
 // GetVoucherSeriesByCode doe _GET https://api.fortnox.se/3/voucherseries/{Code}
 //
 // code - identifies the voucher series
-func (c *Client) GetVoucherSeriesByCode(ctx context.Context, code string) (*VoucherSeries, error) {
+func (c *voucherSeriesService) GetVoucherSeriesByCode(ctx context.Context, code string) (*VoucherSeries, error) {
 	resp := &GetVoucherSeriesByCodeResp{}
 
-	uri := fmt.Sprintf("%s/%s", vouchersURI, code)
-	err := c._GET(ctx, uri, nil, resp)
+	uri := fmt.Sprintf("%s/%s", voucherSeriesURI, code)
+	err := c._GET(ctx, getVoucherSeriesByCodeOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +73,7 @@ func (c *Client) GetVoucherSeriesByCode(ctx context.Context, code string) (*Vouc
 // code - identifies the voucher series
 //
 // req - to update
-func (c *Client) UpdateVoucherSeries(
+func (c *voucherSeriesService) UpdateVoucherSeries(
 	ctx context.Context,
 	code string,
 	vs *VoucherSeries) (*VoucherSeries, error) {
@@ -64,9 +81,9 @@ func (c *Client) UpdateVoucherSeries(
 	req := &UpdateVoucherSeriesReq{VoucherSeries: *vs}
 	resp := &UpdateVoucherSeriesResp{}
 
-	uri := fmt.Sprintf("%s/%s", vouchersURI, code)
+	uri := fmt.Sprintf("%s/%s", voucherSeriesURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateVoucherSeriesOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +108,7 @@ type Approver struct {
 
 type GetAllVoucherSeriesResp struct {
 	VoucherSeriesCollection []VoucherSeries `json:"VoucherSeriesCollection"`
+	MetaInformation         MetaInformation `json:"MetaInformation"`
 }
 
 type CreateVoucherSeriesReq struct {
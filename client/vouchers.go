@@ -16,7 +16,7 @@ const (
 // voucherNumber - identifies the voucher number
 //
 // financialYear - filter on financial year
-func (c *Client) GetVoucher(
+func (c *vouchersService) GetVoucher(
 	ctx context.Context,
 	voucherSeries, voucherNumber string,
 	filter *FinancialYearFilter) (*Voucher, error) {
@@ -25,9 +25,12 @@ func (c *Client) GetVoucher(
 
 	uri := fmt.Sprintf("%s/%s/%s", vouchersURI, voucherSeries, voucherNumber)
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, err
+	}
 
-	err := c._GET(ctx, uri, params, resp)
+	err = c._GET(ctx, getVoucherOp, uri, params, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -38,17 +41,42 @@ func (c *Client) GetVoucher(
 // GetAllVouchers does _GET https://api.fortnox.se/3/vouchers/
 //
 // filter - filter on financial year
-func (c *Client) GetAllVouchers(ctx context.Context, filter *FinancialYearFilter) ([]Voucher, error) {
+func (c *vouchersService) GetAllVouchers(ctx context.Context, filter *FinancialYearFilter) ([]Voucher, error) {
+	return c.IterateVouchers(filter).All(ctx)
+}
+
+// GetVouchersPage does _GET https://api.fortnox.se/3/vouchers/ and returns a single page along with its MetaInformation
+//
+// filter - filter on financial year
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *vouchersService) GetVouchersPage(
+	ctx context.Context,
+	filter *FinancialYearFilter,
+	page *PageOptions) ([]Voucher, *MetaInformation, error) {
+
 	resp := &GetAllVouchersResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, vouchersURI, params, resp)
+	err = c._GETPage(ctx, getVouchersPageOp, vouchersURI, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Vouchers, nil
+	return resp.Vouchers, &resp.MetaInformation, nil
+}
+
+// IterateVouchers lazily walks all pages of https://api.fortnox.se/3/vouchers/
+//
+// filter - filter on financial year
+func (c *vouchersService) IterateVouchers(filter *FinancialYearFilter) *Iterator[Voucher] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Voucher, *MetaInformation, error) {
+		return c.GetVouchersPage(ctx, filter, page)
+	})
 }
 
 // CreateVoucher does _POST https://api.fortnox.se/3/vouchers/
@@ -56,7 +84,7 @@ func (c *Client) GetAllVouchers(ctx context.Context, filter *FinancialYearFilter
 // filter - financial year id, used to determine which financial year the voucher is created in
 //
 // req - voucher to create
-func (c *Client) CreateVoucher(
+func (c *vouchersService) CreateVoucher(
 	ctx context.Context,
 	filter FinancialYearFilter,
 	v *Voucher) (*Voucher, error) {
@@ -64,9 +92,12 @@ func (c *Client) CreateVoucher(
 	req := &CreateVoucherReq{Voucher: *v}
 	resp := &CreateVoucherResp{}
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, err
+	}
 
-	err := c._POST(ctx, vouchersURI, params, req, resp)
+	err = c._POST(ctx, createVoucherOp, vouchersURI, params, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -79,23 +110,52 @@ func (c *Client) CreateVoucher(
 // voucherSeries - identifies the voucher series
 //
 // filter - filter on financial year
-func (c *Client) GetVouchersBySeries(
+func (c *vouchersService) GetVouchersBySeries(
 	ctx context.Context,
 	voucherSeries string,
 	filter FinancialYearFilter) ([]Voucher, error) {
+	return c.IterateVouchersBySeries(voucherSeries, filter).All(ctx)
+}
+
+// GetVouchersBySeriesPage does _GET https://api.fortnox.se/3/vouchers/sublist/{VoucherSeries} and returns a single page along with its MetaInformation
+//
+// voucherSeries - identifies the voucher series
+//
+// filter - filter on financial year
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *vouchersService) GetVouchersBySeriesPage(
+	ctx context.Context,
+	voucherSeries string,
+	filter FinancialYearFilter,
+	page *PageOptions) ([]Voucher, *MetaInformation, error) {
 
 	resp := &GetVouchersBySeriesResp{}
 
 	uri := fmt.Sprintf("%s/sublist/%s", vouchersURI, voucherSeries)
 
-	params := filter.urlValues()
+	params, err := filter.urlValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := c._GET(ctx, uri, params, resp)
+	err = c._GETPage(ctx, getVouchersBySeriesPageOp, uri, params, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.Vouchers, nil
+	return resp.Vouchers, &resp.MetaInformation, nil
+}
+
+// IterateVouchersBySeries lazily walks all pages of https://api.fortnox.se/3/vouchers/sublist/{VoucherSeries}
+//
+// voucherSeries - identifies the voucher series
+//
+// filter - filter on financial year
+func (c *vouchersService) IterateVouchersBySeries(voucherSeries string, filter FinancialYearFilter) *Iterator[Voucher] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]Voucher, *MetaInformation, error) {
+		return c.GetVouchersBySeriesPage(ctx, voucherSeries, filter, page)
+	})
 }
 
 type GetVoucherResp struct {
@@ -103,7 +163,8 @@ type GetVoucherResp struct {
 }
 
 type GetAllVouchersResp struct {
-	Vouchers []Voucher `json:"Vouchers"`
+	Vouchers        []Voucher       `json:"Vouchers"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateVoucherReq struct {
@@ -115,15 +176,16 @@ type CreateVoucherResp struct {
 }
 
 type GetVouchersBySeriesResp struct {
-	Vouchers []Voucher `json:"Vouchers"`
+	Vouchers        []Voucher       `json:"Vouchers"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type VoucherRow struct {
 	Account                int    `json:"Account,omitempty"`
 	CostCenter             string `json:"CostCenter,omitempty"`
-	Credit                 int    `json:"Credit,omitempty"`
+	Credit                 Money  `json:"Credit,omitzero"`
 	Description            string `json:"Description,omitempty"`
-	Debit                  int    `json:"Debit,omitempty"`
+	Debit                  Money  `json:"Debit,omitzero"`
 	Project                string `json:"Project,omitempty"`
 	Removed                bool   `json:"Removed,omitempty"`
 	TransactionInformation string `json:"TransactionInformation,omitempty"`
@@ -138,7 +200,7 @@ type Voucher struct {
 	Project         string       `json:"Project,omitempty"`
 	ReferenceNumber string       `json:"ReferenceNumber,omitempty"`
 	ReferenceType   string       `json:"ReferenceType,omitempty"`
-	TransactionDate string       `json:"TransactionDate,omitempty"`
+	TransactionDate Date         `json:"TransactionDate,omitempty"`
 	VoucherNumber   int          `json:"VoucherNumber,omitempty"`
 	VoucherRows     []VoucherRow `json:"VoucherRows,omitempty"`
 	VoucherSeries   string       `json:"VoucherSeries,omitempty"`
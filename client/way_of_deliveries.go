@@ -10,28 +10,45 @@ const (
 )
 
 // GetAllWayOfDeliveries does _GET https://api.fortnox.se/3/wayofdeliveries
-func (c *Client) GetAllWayOfDeliveries(ctx context.Context) ([]WayOfDelivery, error) {
+func (c *wayOfDeliveriesService) GetAllWayOfDeliveries(ctx context.Context) ([]WayOfDelivery, error) {
+	return c.IterateWayOfDeliveries().All(ctx)
+}
+
+// GetWayOfDeliveriesPage does _GET https://api.fortnox.se/3/wayofdeliveries and returns a single page along with its MetaInformation
+//
+// page - page, limit and offset, nil means Fortnox's defaults
+func (c *wayOfDeliveriesService) GetWayOfDeliveriesPage(
+	ctx context.Context,
+	page *PageOptions) ([]WayOfDelivery, *MetaInformation, error) {
+
 	resp := &GetAllWayOfDeliveriesResp{}
 
-	err := c._GET(ctx, wayOfDeliveriesURI, nil, resp)
+	err := c._GETPage(ctx, getWayOfDeliveriesPageOp, wayOfDeliveriesURI, nil, page, resp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.WayOfDeliveries, nil
+	return resp.WayOfDeliveries, &resp.MetaInformation, nil
+}
+
+// IterateWayOfDeliveries lazily walks all pages of https://api.fortnox.se/3/wayofdeliveries
+func (c *wayOfDeliveriesService) IterateWayOfDeliveries() *Iterator[WayOfDelivery] {
+	return newIterator(func(ctx context.Context, page *PageOptions) ([]WayOfDelivery, *MetaInformation, error) {
+		return c.GetWayOfDeliveriesPage(ctx, page)
+	})
 }
 
 // CreateWayOfDeliveries does _POST https://api.fortnox.se/3/wayofdeliveries
 //
 // req - way of delivery to create
-func (c *Client) CreateWayOfDeliveries(
+func (c *wayOfDeliveriesService) CreateWayOfDeliveries(
 	ctx context.Context,
 	wod *WayOfDelivery) (*WayOfDelivery, error) {
 
 	req := &CreateWayOfDeliveriesReq{WayOfDelivery: *wod}
 	resp := &CreateWayOfDeliveriesResp{}
 
-	err := c._POST(ctx, wayOfDeliveriesURI, nil, req, resp)
+	err := c._POST(ctx, createWayOfDeliveriesOp, wayOfDeliveriesURI, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,12 +59,12 @@ func (c *Client) CreateWayOfDeliveries(
 // GetWayOfDeliveryByCode does _GET https://api.fortnox.se/3/wayofdeliveries/{Code}
 //
 // code - identifies the way of delivery
-func (c *Client) GetWayOfDeliveryByCode(ctx context.Context, code string) (*WayOfDelivery, error) {
+func (c *wayOfDeliveriesService) GetWayOfDeliveryByCode(ctx context.Context, code string) (*WayOfDelivery, error) {
 	resp := &GetWayOfDeliveryByCodeResp{}
 
 	uri := fmt.Sprintf("%s/%s", wayOfDeliveriesURI, code)
 
-	err := c._GET(ctx, uri, nil, resp)
+	err := c._GET(ctx, getWayOfDeliveryByCodeOp, uri, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +77,7 @@ func (c *Client) GetWayOfDeliveryByCode(ctx context.Context, code string) (*WayO
 // code - identifies the way of delivery
 //
 // req - way of delivery to update
-func (c *Client) UpdateWayOfDelivery(
+func (c *wayOfDeliveriesService) UpdateWayOfDelivery(
 	ctx context.Context,
 	code string,
 	wod *WayOfDelivery) (*WayOfDelivery, error) {
@@ -70,7 +87,7 @@ func (c *Client) UpdateWayOfDelivery(
 
 	uri := fmt.Sprintf("%s/%s", wayOfDeliveriesURI, code)
 
-	err := c._PUT(ctx, uri, nil, req, resp)
+	err := c._PUT(ctx, updateWayOfDeliveryOp, uri, nil, req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -81,9 +98,9 @@ func (c *Client) UpdateWayOfDelivery(
 // RemoveWayOfDelivery does _DELETE https://api.fortnox.se/3/wayofdeliveries/{Code}
 //
 // code - identifies the way of delivery
-func (c *Client) RemoveWayOfDelivery(ctx context.Context, code string) error {
+func (c *wayOfDeliveriesService) RemoveWayOfDelivery(ctx context.Context, code string) error {
 	uri := fmt.Sprintf("%s/%s", wayOfDeliveriesURI, code)
-	return c._DELETE(ctx, uri)
+	return c._DELETE(ctx, removeWayOfDeliveryOp, uri)
 }
 
 type WayOfDelivery struct {
@@ -95,6 +112,7 @@ type WayOfDelivery struct {
 
 type GetAllWayOfDeliveriesResp struct {
 	WayOfDeliveries []WayOfDelivery `json:"WayOfDeliveries"`
+	MetaInformation MetaInformation `json:"MetaInformation"`
 }
 
 type CreateWayOfDeliveriesReq struct {
@@ -1,5 +1,5 @@
 module github.com/thats4fun/go-fortnox-sdk
 
-go 1.18
+go 1.24
 
 require github.com/pkg/errors v0.9.1
@@ -0,0 +1,587 @@
+// Command genservices generates the service interfaces of package client, the flat Client methods forwarding to them
+// and the fakes of package fortnoxfake.
+//
+// Every method with a receiver of an unexported type named xxxService, e.g. *invoicesService, becomes part of the
+// exported XxxService interface, reachable as Client.Xxx. A method sending requests passes the generated constant
+// naming it, e.g. getInvoiceOp for GetInvoice, to the request helpers, generation fails when it passes another one.
+// Run it through go generate in the client directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	clientPkg     = "client"
+	clientPkgPath = "github.com/thats4fun/go-fortnox-sdk/client"
+	fakePkg       = "fortnoxfake"
+	serviceSuffix = "Service"
+	uriSuffix     = "URI"
+	opSuffix      = "Op"
+	header        = "// Code generated by genservices. DO NOT EDIT.\n\n"
+)
+
+// exportedNames overrides the exported name of services whose name is not their impl type with an upper first letter
+var exportedNames = map[string]string{
+	"sieService":                  "SIE",
+	"euVatLimitRegulationService": "EUVatLimitRegulation",
+}
+
+type param struct {
+	names    []string
+	typ      ast.Expr
+	variadic bool
+}
+
+type method struct {
+	name    string
+	uri     string
+	doc     []string
+	params  []param
+	results []ast.Expr
+	// requests is set when the method calls Fortnox itself, it is then given an xxxOp constant naming it
+	requests bool
+}
+
+type service struct {
+	impl     string
+	exported string
+	methods  []*method
+	imports  map[string]string
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory of package client")
+	out := flag.String("out", "services_gen.go", "file the interfaces and forwards are written to, relative to dir")
+	fakeOut := flag.String("fake", "fortnoxfake/fakes_gen.go", "file the fakes are written to, relative to dir")
+	flag.Parse()
+
+	services, err := parseServices(*dir, *out)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := write(filepath.Join(*dir, *out), genClient(services)); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := write(filepath.Join(*dir, *fakeOut), genFakes(services)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseServices(dir, skip string) ([]*service, error) {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != filepath.Base(skip)
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs[clientPkg]
+	if !ok {
+		return nil, fmt.Errorf("no package %s in %s", clientPkg, dir)
+	}
+
+	uriConsts := map[string]bool{}
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					if strings.HasSuffix(name.Name, uriSuffix) {
+						uriConsts[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	byImpl := map[string]*service{}
+
+	fileNames := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		file := pkg.Files[fileName]
+
+		imports := map[string]string{}
+		for _, imp := range file.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			imports[name] = path
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			impl := receiverType(fn)
+			if !strings.HasSuffix(impl, serviceSuffix) || ast.IsExported(impl) {
+				continue
+			}
+
+			svc, ok := byImpl[impl]
+			if !ok {
+				svc = &service{
+					impl:     impl,
+					exported: exportedName(impl),
+					imports:  map[string]string{},
+				}
+				byImpl[impl] = svc
+			}
+
+			m := newMethod(fn)
+			m.uri = resourceURI(fn.Body, uriConsts)
+			if m.requests, err = checkOperations(fset, fn); err != nil {
+				return nil, err
+			}
+			svc.methods = append(svc.methods, m)
+			for name, path := range imports {
+				svc.imports[name] = path
+			}
+		}
+	}
+
+	services := make([]*service, 0, len(byImpl))
+	for _, svc := range byImpl {
+		inheritURI(svc)
+		services = append(services, svc)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].exported < services[j].exported
+	})
+
+	return services, nil
+}
+
+// resourceURI returns the first xxxURI constant used in body, the resource the method calls
+func resourceURI(body *ast.BlockStmt, uriConsts map[string]bool) string {
+	uri := ""
+	ast.Inspect(body, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && uri == "" && uriConsts[ident.Name] {
+			uri = ident.Name
+		}
+		return uri == ""
+	})
+
+	return uri
+}
+
+// requestHelpers are the methods of Client sending a request, their second argument is the operation
+var requestHelpers = map[string]bool{
+	"_GET":              true,
+	"_GETPage":          true,
+	"_GETDownload":      true,
+	"_POST":             true,
+	"_PUT":              true,
+	"_DELETE":           true,
+	"_DELETEWithResult": true,
+}
+
+// opName returns the name of the constant naming the operation of method, e.g. getInvoiceOp for GetInvoice
+func opName(method string) string {
+	return string(unicode.ToLower(rune(method[0]))) + method[1:] + opSuffix
+}
+
+// checkOperations reports whether fn sends requests, each must be named by the xxxOp constant of fn
+func checkOperations(fset *token.FileSet, fn *ast.FuncDecl) (bool, error) {
+	want := opName(fn.Name.Name)
+	requests := false
+
+	var err error
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || err != nil {
+			return err == nil
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !requestHelpers[sel.Sel.Name] {
+			return true
+		}
+
+		requests = true
+		if len(call.Args) < 2 {
+			err = fmt.Errorf("%s: %s calls %s without an operation", fset.Position(call.Pos()), fn.Name.Name, sel.Sel.Name)
+			return false
+		}
+		if op, ok := call.Args[1].(*ast.Ident); !ok || op.Name != want {
+			err = fmt.Errorf("%s: %s calls %s with operation %s, want %s",
+				fset.Position(call.Pos()), fn.Name.Name, sel.Sel.Name, exprString(call.Args[1], ""), want)
+			return false
+		}
+
+		return true
+	})
+
+	return requests, err
+}
+
+// inheritURI sets the resource of methods that only call other methods of the service, e.g. GetAllInvoices,
+// to the resource used most by the service
+func inheritURI(svc *service) {
+	counts := map[string]int{}
+	for _, m := range svc.methods {
+		if m.uri != "" {
+			counts[m.uri]++
+		}
+	}
+
+	common := ""
+	for uri, n := range counts {
+		if n > counts[common] || (n == counts[common] && uri < common) {
+			common = uri
+		}
+	}
+
+	for _, m := range svc.methods {
+		if m.uri == "" {
+			m.uri = common
+		}
+	}
+}
+
+func receiverType(fn *ast.FuncDecl) string {
+	typ := fn.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+func exportedName(impl string) string {
+	if name, ok := exportedNames[impl]; ok {
+		return name
+	}
+
+	name := strings.TrimSuffix(impl, serviceSuffix)
+	return string(unicode.ToUpper(rune(name[0]))) + name[1:]
+}
+
+func newMethod(fn *ast.FuncDecl) *method {
+	m := &method{name: fn.Name.Name}
+
+	if fn.Doc != nil {
+		for _, c := range fn.Doc.List {
+			m.doc = append(m.doc, c.Text)
+		}
+	}
+
+	for i, field := range fn.Type.Params.List {
+		p := param{typ: field.Type}
+
+		if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
+			p.typ = ellipsis.Elt
+			p.variadic = true
+		}
+
+		for _, name := range field.Names {
+			p.names = append(p.names, name.Name)
+		}
+		if len(p.names) == 0 || p.names[0] == "_" {
+			p.names = []string{fmt.Sprintf("arg%d", i)}
+		}
+
+		m.params = append(m.params, p)
+	}
+
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.results = append(m.results, field.Type)
+			}
+		}
+	}
+
+	return m
+}
+
+// signature returns the params and results of m, qualifying the types of package client with qualifier if not empty
+func (m *method) signature(qualifier string) string {
+	var params []string
+	for _, p := range m.params {
+		typ := exprString(p.typ, qualifier)
+		if p.variadic {
+			typ = "..." + typ
+		}
+		params = append(params, strings.Join(p.names, ", ")+" "+typ)
+	}
+
+	var results []string
+	for _, r := range m.results {
+		results = append(results, exprString(r, qualifier))
+	}
+
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+
+	return sig
+}
+
+// args returns the params of m as arguments of a call
+func (m *method) args() string {
+	var args []string
+	for _, p := range m.params {
+		for _, name := range p.names {
+			if p.variadic {
+				name += "..."
+			}
+			args = append(args, name)
+		}
+	}
+
+	return strings.Join(args, ", ")
+}
+
+// call returns the statement calling fn with the params of m
+func (m *method) call(fn string) string {
+	call := fmt.Sprintf("%s(%s)", fn, m.args())
+	if len(m.results) == 0 {
+		return call
+	}
+
+	return "return " + call
+}
+
+// exprString prints a type expression, qualifying exported identifiers with qualifier
+func exprString(expr ast.Expr, qualifier string) string {
+	if qualifier != "" {
+		expr = qualify(expr, qualifier)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := format.Node(buf, token.NewFileSet(), expr); err != nil {
+		log.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+// qualify returns a copy of a type expression where the identifiers declared by package client are qualified
+func qualify(expr ast.Expr, qualifier string) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent(qualifier), Sel: ast.NewIdent(e.Name)}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X, qualifier)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt, qualifier)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key, qualifier), Value: qualify(e.Value, qualifier)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: qualify(e.X, qualifier), Index: qualify(e.Index, qualifier)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt, qualifier)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: qualify(e.Value, qualifier)}
+	}
+
+	// selectors such as context.Context are already qualified, func and struct types are not used by the services
+	return expr
+}
+
+// usedImports returns the imports of services referenced by their signatures
+func usedImports(services []*service) []string {
+	used := map[string]bool{}
+
+	for _, svc := range services {
+		for _, m := range svc.methods {
+			var exprs []ast.Expr
+			for _, p := range m.params {
+				exprs = append(exprs, p.typ)
+			}
+			exprs = append(exprs, m.results...)
+
+			for _, expr := range exprs {
+				ast.Inspect(expr, func(n ast.Node) bool {
+					sel, ok := n.(*ast.SelectorExpr)
+					if !ok {
+						return true
+					}
+					if pkg, ok := sel.X.(*ast.Ident); ok {
+						if path, ok := svc.imports[pkg.Name]; ok {
+							used[path] = true
+						}
+					}
+					return false
+				})
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(used))
+	for path := range used {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+func writeImports(buf *bytes.Buffer, paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+
+	buf.WriteString("import (\n")
+	for _, path := range paths {
+		fmt.Fprintf(buf, "\t%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+}
+
+func genClient(services []*service) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString(header)
+	fmt.Fprintf(buf, "package %s\n\n", clientPkg)
+	writeImports(buf, usedImports(services))
+
+	buf.WriteString("// Services holds the services of a Client, it is embedded so they are reached as client.Invoices\n")
+	buf.WriteString("type Services struct {\n")
+	for _, svc := range services {
+		fmt.Fprintf(buf, "\t%s %s%s\n", svc.exported, svc.exported, serviceSuffix)
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("func newServices(c *Client) Services {\n\treturn Services{\n")
+	for _, svc := range services {
+		fmt.Fprintf(buf, "\t\t%s: &%s{c},\n", svc.exported, svc.impl)
+	}
+	buf.WriteString("\t}\n}\n\n")
+
+	buf.WriteString("// methodURIs maps the methods of Client to the resource they call, see RequiredScopes\n")
+	buf.WriteString("var methodURIs = map[string]string{\n")
+	for _, svc := range services {
+		for _, m := range svc.methods {
+			if m.uri != "" {
+				fmt.Fprintf(buf, "\t%q: %s,\n", m.name, m.uri)
+			}
+		}
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// the operations of Client methods calling Fortnox, they are set on Call.Operation\n")
+	buf.WriteString("const (\n")
+	for _, svc := range services {
+		for _, m := range svc.methods {
+			if m.requests {
+				fmt.Fprintf(buf, "\t%s = %q\n", opName(m.name), m.name)
+			}
+		}
+	}
+	buf.WriteString(")\n\n")
+
+	for _, svc := range services {
+		fmt.Fprintf(buf, "// %s%s groups the calls reached as Client.%s, fortnoxfake.%s fakes it\n",
+			svc.exported, serviceSuffix, svc.exported, svc.exported)
+		fmt.Fprintf(buf, "type %s%s interface {\n", svc.exported, serviceSuffix)
+		for i, m := range svc.methods {
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			for _, line := range m.doc {
+				fmt.Fprintf(buf, "\t%s\n", line)
+			}
+			fmt.Fprintf(buf, "\t%s%s\n", m.name, m.signature(""))
+		}
+		buf.WriteString("}\n\n")
+
+		fmt.Fprintf(buf, "type %s struct {\n\t*Client\n}\n\n", svc.impl)
+
+		for _, m := range svc.methods {
+			fmt.Fprintf(buf, "// %s forwards to %s%s.%s of c.%s\n", m.name, svc.exported, serviceSuffix, m.name, svc.exported)
+			fmt.Fprintf(buf, "func (c *Client) %s%s {\n\t%s\n}\n\n", m.name, m.signature(""), m.call("c."+svc.exported+"."+m.name))
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func genFakes(services []*service) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString(header)
+	fmt.Fprintf(buf, "package %s\n\n", fakePkg)
+	writeImports(buf, append(usedImports(services), clientPkgPath))
+
+	for _, svc := range services {
+		fmt.Fprintf(buf, "var _ %s.%s%s = (*%s)(nil)\n", clientPkg, svc.exported, serviceSuffix, svc.exported)
+	}
+	buf.WriteString("\n")
+
+	for _, svc := range services {
+		fmt.Fprintf(buf, "// %s fakes client.%s%s, each method calls the field named after it with Func appended\n",
+			svc.exported, svc.exported, serviceSuffix)
+		buf.WriteString("// and panics when that field is nil\n")
+		fmt.Fprintf(buf, "type %s struct {\n", svc.exported)
+		for _, m := range svc.methods {
+			fmt.Fprintf(buf, "\t%sFunc func%s\n", m.name, m.signature(clientPkg))
+		}
+		buf.WriteString("}\n\n")
+
+		for _, m := range svc.methods {
+			fmt.Fprintf(buf, "func (f *%s) %s%s {\n", svc.exported, m.name, m.signature(clientPkg))
+			fmt.Fprintf(buf, "\tif f.%sFunc == nil {\n\t\tpanic(notImplemented(%q, %q))\n\t}\n", m.name, svc.exported, m.name)
+			fmt.Fprintf(buf, "\t%s\n}\n\n", m.call("f."+m.name+"Func"))
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func write(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("format %s: %w\n%s", path, err, src)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, formatted, 0o644)
+}